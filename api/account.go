@@ -10,7 +10,12 @@ import (
 	"time"
 )
 
-// AccountCreate represents the data needed to create a new account
+// AccountCreate represents the data needed to create a new account.
+// GrpTRESMins, GrpTRESRunMins and MaxTRESMinsPerJob are TRES-minute limits
+// keyed by TRES type, such as "cpu" or "gres/gpu". slurmdbd keeps them on
+// the account's associations, which a new account does not have yet, so
+// Create rejects them; set them with Update once the account is associated
+// with a cluster.
 type AccountCreate struct {
 	Name                 string           `json:"name"`
 	Description          string           `json:"description,omitempty"`
@@ -44,12 +49,20 @@ type AccountCreate struct {
 	GrpTRES              map[string]int64 `json:"grp_tres,omitempty"`
 	GrpTRESMins          map[string]int64 `json:"grp_tres_mins,omitempty"`
 	GrpTRESRunMins       map[string]int64 `json:"grp_tres_run_mins,omitempty"`
+	MaxTRESMinsPerJob    map[string]int64 `json:"max_tres_mins_per_job,omitempty"`
 	MaxTRES              map[string]int64 `json:"max_tres,omitempty"`
 	MaxTRESPerNode       map[string]int64 `json:"max_tres_per_node,omitempty"`
 	MinTRES              map[string]int64 `json:"min_tres,omitempty"`
 }
 
-// AccountUpdate represents the data needed to update an account
+// AccountUpdate represents the data needed to update an account.
+// GrpTRESMins, GrpTRESRunMins and MaxTRESMinsPerJob are applied to every
+// association of the account itself (the ones without a user); a count of
+// -1 removes the limit for that TRES. slurmdbd has no MaxTRESRunMins limit
+// for associations: running TRES-minutes per user or account are QoS
+// limits, see QoSUpdate. The account and its associations are updated in
+// separate requests, so the update is not atomic: a failed association
+// update leaves the earlier writes in place.
 type AccountUpdate struct {
 	Description          *string          `json:"description,omitempty"`
 	Organization         *string          `json:"organization,omitempty"`
@@ -81,6 +94,7 @@ type AccountUpdate struct {
 	GrpTRES              map[string]int64 `json:"grp_tres,omitempty"`
 	GrpTRESMins          map[string]int64 `json:"grp_tres_mins,omitempty"`
 	GrpTRESRunMins       map[string]int64 `json:"grp_tres_run_mins,omitempty"`
+	MaxTRESMinsPerJob    map[string]int64 `json:"max_tres_mins_per_job,omitempty"`
 	MaxTRES              map[string]int64 `json:"max_tres,omitempty"`
 	MaxTRESPerNode       map[string]int64 `json:"max_tres_per_node,omitempty"`
 	MinTRES              map[string]int64 `json:"min_tres,omitempty"`
@@ -120,6 +134,16 @@ type AccountList struct {
 	Total    int       `json:"total"`
 }
 
+// AccountTree represents an account and its sub-accounts, as returned by
+// AccountManager.Subtree. Children and Users are sorted by name.
+type AccountTree struct {
+	Name     string         `json:"name"`
+	Account  *Account       `json:"account,omitempty"`
+	Depth    int            `json:"depth"`
+	Users    []string       `json:"users,omitempty"`
+	Children []*AccountTree `json:"children,omitempty"`
+}
+
 // AccountUsage represents usage statistics for an account
 type AccountUsage struct {
 	AccountName     string             `json:"account_name"`
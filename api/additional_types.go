@@ -35,30 +35,25 @@ type JobSubmitOptions struct {
 	Verbose bool `json:"verbose,omitempty"`
 }
 
-// JobWatchOptions represents options for watching job events
-type JobWatchOptions struct {
-	// JobId is the specific job to watch (0 means all jobs) - matches OpenAPI casing
-	JobId int32 `json:"job_id,omitempty"`
-	// StartTime to begin watching from
-	StartTime *time.Time `json:"start_time,omitempty"`
-	// EventTypes to filter (e.g., "submit", "start", "end", "fail")
-	EventTypes []string `json:"event_types,omitempty"`
-	// MaxEvents maximum number of events to return
-	MaxEvents int32 `json:"max_events,omitempty"`
-	// IncludeSteps includes job step events
-	IncludeSteps bool `json:"include_steps,omitempty"`
-	// PollInterval is the interval between polling requests (default: 5s)
-	PollInterval time.Duration `json:"poll_interval,omitempty"`
-}
+// Event types reported by Jobs().Watch and watch.JobPoller
+const (
+	// JobEventAdded reports a job that was not in the previous listing
+	JobEventAdded = "added"
+	// JobEventModified reports a job whose state, reason or nodes changed
+	JobEventModified = "modified"
+	// JobEventDeleted reports a job that has left the listing, because it
+	// was purged from slurmctld or no longer matches the filters
+	JobEventDeleted = "deleted"
+)
 
 // JobEvent represents a job state change event
 type JobEvent struct {
 	// EventTime when the event occurred
 	EventTime time.Time `json:"event_time"`
-	// EventType type of event (submit, start, end, fail, etc.)
+	// EventType is JobEventAdded, JobEventModified or JobEventDeleted
 	EventType string `json:"event_type"`
 	// JobId of the job (matches OpenAPI field name)
-	JobId int32 `json:"job_id"`
+	JobId int64 `json:"job_id"`
 	// JobName of the job
 	JobName string `json:"job_name,omitempty"`
 	// UserName who owns the job
@@ -159,25 +154,27 @@ type AssociationLimits struct {
 	MinTRES map[string]int64 `json:"min_tres,omitempty"`
 }
 
-// NodeWatchOptions represents options for watching node events
-type NodeWatchOptions struct {
-	// NodeNames is the list of specific nodes to watch (empty means all nodes)
-	NodeNames []string `json:"node_names,omitempty"`
-	// States to filter by (e.g., "idle", "allocated", "down")
-	States []NodeState `json:"states,omitempty"`
-	// Partitions to filter by
-	Partitions []string `json:"partitions,omitempty"`
-	// MaxEvents maximum number of events to return
-	MaxEvents int32 `json:"max_events,omitempty"`
-	// PollInterval is the interval between polling requests (default: 5s)
-	PollInterval time.Duration `json:"poll_interval,omitempty"`
-}
+// Event types reported by Nodes().Watch and watch.NodePoller
+const (
+	// NodeEventAdded reports a node that was not in the previous listing;
+	// unless SkipInitial is set, every existing node is first reported as
+	// added, before NodeEventSynced
+	NodeEventAdded = "added"
+	// NodeEventModified reports a node whose state or reason changed
+	NodeEventModified = "modified"
+	// NodeEventDeleted reports a node that has left the listing
+	NodeEventDeleted = "deleted"
+	// NodeEventSynced is a bookmark sent once after the existing nodes have
+	// been reported; the events after it are changes. It names no node.
+	NodeEventSynced = "synced"
+)
 
 // NodeEvent represents a node state change event
 type NodeEvent struct {
 	// EventTime when the event occurred
 	EventTime time.Time `json:"event_time"`
-	// EventType type of event (state_change, drain, resume, etc.)
+	// EventType is NodeEventAdded, NodeEventModified, NodeEventDeleted or
+	// NodeEventSynced
 	EventType string `json:"event_type"`
 	// NodeName of the node
 	NodeName string `json:"node_name"`
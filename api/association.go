@@ -7,6 +7,8 @@
 package api
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -93,6 +95,137 @@ type AssociationUpdate struct {
 	MaxTRESPerNode       map[string]int64 `json:"max_tres_per_node,omitempty"`
 	MaxTRESMins          map[string]int64 `json:"max_tres_mins,omitempty"`
 	MinTRES              map[string]int64 `json:"min_tres,omitempty"`
+
+	// QoSDelta adds QOS to and removes QOS from the association's current
+	// list instead of replacing it, and cannot be combined with QoSList
+	QoSDelta *QoSDelta `json:"-"`
+}
+
+// AddQoS adds the named QOS to the association's list, keeping the QOS it
+// already has
+func (u *AssociationUpdate) AddQoS(names ...string) *AssociationUpdate {
+	if u.QoSDelta == nil {
+		u.QoSDelta = &QoSDelta{}
+	}
+	u.QoSDelta.Add = append(u.QoSDelta.Add, names...)
+	return u
+}
+
+// RemoveQoS removes the named QOS from the association's list, keeping the
+// rest
+func (u *AssociationUpdate) RemoveQoS(names ...string) *AssociationUpdate {
+	if u.QoSDelta == nil {
+		u.QoSDelta = &QoSDelta{}
+	}
+	u.QoSDelta.Remove = append(u.QoSDelta.Remove, names...)
+	return u
+}
+
+// QoSDelta is a change to a QOS list written the way sacctmgr takes it, as
+// in "+urgent,-scavenger": QOS to add and QOS to remove, with every other
+// QOS on the list left in place
+type QoSDelta struct {
+	Add    []string
+	Remove []string
+}
+
+// ParseQoSDelta parses a QOS delta such as "+urgent,-scavenger". Each name
+// needs a + or - prefix, since a bare name would replace the whole list.
+func ParseQoSDelta(list string) (*QoSDelta, error) {
+	delta := &QoSDelta{}
+	for _, entry := range splitQoSList(list) {
+		name := strings.TrimSpace(entry[1:])
+		if name == "" {
+			return nil, fmt.Errorf("QOS delta %q has an entry without a name", list)
+		}
+		switch entry[0] {
+		case '+':
+			delta.Add = append(delta.Add, name)
+		case '-':
+			delta.Remove = append(delta.Remove, name)
+		default:
+			return nil, fmt.Errorf("QOS delta entry %q must start with + or -", entry)
+		}
+	}
+	return delta, nil
+}
+
+// Apply returns qos with the delta applied. The QOS already on the list keep
+// their order and added QOS follow in the order given; a QOS both added and
+// removed ends up removed.
+func (d *QoSDelta) Apply(qos []string) []string {
+	removed := make(map[string]bool)
+	if d != nil {
+		for _, name := range d.Remove {
+			removed[name] = true
+		}
+	}
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(qos))
+	keep := func(name string) {
+		if !removed[name] && !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	for _, name := range qos {
+		keep(name)
+	}
+	if d != nil {
+		for _, name := range d.Add {
+			keep(name)
+		}
+	}
+	return result
+}
+
+// String formats the delta the way sacctmgr takes it
+func (d *QoSDelta) String() string {
+	if d == nil {
+		return ""
+	}
+	entries := make([]string, 0, len(d.Add)+len(d.Remove))
+	for _, name := range d.Add {
+		entries = append(entries, "+"+name)
+	}
+	for _, name := range d.Remove {
+		entries = append(entries, "-"+name)
+	}
+	return strings.Join(entries, ",")
+}
+
+// AllowedQoS returns the QOS the association may submit jobs with. slurmdbd
+// can report entries that add to or take from the parent's list as "+name"
+// or "-name"; added names are included without the prefix and removed ones
+// are left out.
+func (a *Association) AllowedQoS() []string {
+	if a == nil {
+		return nil
+	}
+	var allowed []string
+	for _, entry := range a.QoS {
+		for _, name := range splitQoSList(entry) {
+			switch name[0] {
+			case '-':
+				continue
+			case '+':
+				name = strings.TrimSpace(name[1:])
+			}
+			if name != "" {
+				allowed = append(allowed, name)
+			}
+		}
+	}
+	return allowed
+}
+
+// DefaultQoS returns the QOS jobs under the association get when they do not
+// ask for one; empty if none is set
+func (a *Association) DefaultQoS() string {
+	if a == nil || a.Default == nil || a.Default.QoS == nil {
+		return ""
+	}
+	return *a.Default.QoS
 }
 
 // AssociationListOptions represents options for listing associations
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQoSDelta(t *testing.T) {
+	delta, err := ParseQoSDelta("+urgent, -scavenger,+debug")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"urgent", "debug"}, delta.Add)
+	assert.Equal(t, []string{"scavenger"}, delta.Remove)
+	assert.Equal(t, "+urgent,+debug,-scavenger", delta.String())
+
+	// A bare name would replace the list, so it is not a delta
+	_, err = ParseQoSDelta("+urgent,normal")
+	assert.Error(t, err)
+	_, err = ParseQoSDelta("+")
+	assert.Error(t, err)
+}
+
+func TestQoSDelta_Apply(t *testing.T) {
+	delta := &QoSDelta{Add: []string{"urgent", "normal", "debug"}, Remove: []string{"scavenger", "debug"}}
+
+	// Existing QOS keep their place, duplicates are dropped and a QOS both
+	// added and removed is removed
+	assert.Equal(t, []string{"normal", "high", "urgent"}, delta.Apply([]string{"normal", "scavenger", "high"}))
+	assert.Equal(t, []string{"urgent", "normal"}, delta.Apply(nil))
+	assert.Equal(t, []string{"normal"}, (*QoSDelta)(nil).Apply([]string{"normal"}))
+
+	update := (&AssociationUpdate{}).AddQoS("urgent").RemoveQoS("scavenger").AddQoS("debug")
+	assert.Equal(t, "+urgent,+debug,-scavenger", update.QoSDelta.String())
+}
+
+func TestAssociation_AllowedQoS(t *testing.T) {
+	normal := "normal"
+	association := &Association{
+		QoS:     []string{"normal", "+urgent", "-scavenger", "high,debug"},
+		Default: &AssociationDefault{QoS: &normal},
+	}
+	assert.Equal(t, []string{"normal", "urgent", "high", "debug"}, association.AllowedQoS())
+	assert.Equal(t, "normal", association.DefaultQoS())
+
+	assert.Nil(t, (&Association{}).AllowedQoS())
+	assert.Equal(t, "", (&Association{}).DefaultQoS())
+}
@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import "github.com/jontk/slurm-client/pkg/events"
+
+// ClientEvent is something a client did on its own while serving requests,
+// such as retrying one or refreshing its credentials; see package events
+// for the event types
+type ClientEvent = events.Event
@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Constraint is a node feature expression for JobSubmission.Constraints,
+// the same expression sbatch takes with --constraint. Build one from
+// ConstraintFeature, ConstraintAnd, ConstraintOr and ConstraintBracket, or
+// pass an expression already in Slurm syntax as a ConstraintExpr.
+type Constraint interface {
+	// String returns the expression in Slurm syntax
+	String() string
+	// Validate checks that the expression is well formed
+	Validate() error
+}
+
+// ConstraintExpr is a constraint written in Slurm syntax, such as
+// "haswell|broadwell" or "[rack1*2&rack2*2]". It is sent as written once
+// its syntax has been checked; an empty expression means no constraint.
+type ConstraintExpr string
+
+// ConstraintFeature requires a single node feature. A positive Count asks
+// for that many nodes with the feature ("rack1*2") instead of all of them.
+type ConstraintFeature struct {
+	Name  string
+	Count int
+}
+
+// ConstraintAnd requires every one of its constraints ("ib&rack3")
+type ConstraintAnd []Constraint
+
+// ConstraintOr requires any one of its constraints ("haswell|broadwell").
+// Different nodes of the job may satisfy different alternatives; wrap it
+// in a ConstraintBracket to make every node match the same one.
+type ConstraintOr []Constraint
+
+// ConstraintBracket encloses a constraint in square brackets. Around a
+// ConstraintOr all nodes must match the same alternative ("[rack1|rack2]");
+// around a ConstraintAnd of counted features each count is met separately
+// ("[rack1*2&rack2*2]"). Brackets cannot be nested.
+type ConstraintBracket struct {
+	Expr Constraint
+}
+
+func (c ConstraintExpr) String() string { return string(c) }
+
+// Validate checks the syntax of the expression
+func (c ConstraintExpr) Validate() error {
+	if c == "" {
+		return nil
+	}
+	return validateConstraintSyntax(string(c))
+}
+
+func (c ConstraintFeature) String() string {
+	if c.Count > 0 {
+		return c.Name + "*" + strconv.Itoa(c.Count)
+	}
+	return c.Name
+}
+
+// Validate checks the feature name and count
+func (c ConstraintFeature) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("feature name cannot be empty")
+	}
+	for i := 0; i < len(c.Name); i++ {
+		if !isFeatureChar(c.Name[i]) {
+			return fmt.Errorf("invalid character %q in feature %q", c.Name[i], c.Name)
+		}
+	}
+	if c.Count < 0 {
+		return fmt.Errorf("count of feature %q cannot be negative", c.Name)
+	}
+	return nil
+}
+
+func (c ConstraintAnd) String() string { return joinConstraints(c, "&") }
+
+// Validate checks every operand
+func (c ConstraintAnd) Validate() error { return validateOperands(c, "&") }
+
+func (c ConstraintOr) String() string { return joinConstraints(c, "|") }
+
+// Validate checks every operand
+func (c ConstraintOr) Validate() error { return validateOperands(c, "|") }
+
+func (c ConstraintBracket) String() string {
+	if c.Expr == nil {
+		return "[]"
+	}
+	return "[" + c.Expr.String() + "]"
+}
+
+// Validate checks the enclosed constraint
+func (c ConstraintBracket) Validate() error {
+	if c.Expr == nil {
+		return fmt.Errorf("brackets cannot be empty")
+	}
+	if err := c.Expr.Validate(); err != nil {
+		return err
+	}
+	// Catches brackets nested anywhere inside
+	return validateConstraintSyntax(c.String())
+}
+
+// MarshalJSON writes Constraints as its expression in Slurm syntax
+func (j JobSubmission) MarshalJSON() ([]byte, error) {
+	type plain JobSubmission
+	return json.Marshal(struct {
+		plain
+		Constraints string `json:"constraints,omitempty"`
+	}{plain(j), constraintString(j.Constraints)})
+}
+
+// UnmarshalJSON reads Constraints back as a ConstraintExpr
+func (j *JobSubmission) UnmarshalJSON(data []byte) error {
+	type plain JobSubmission
+	decoded := struct {
+		*plain
+		Constraints string `json:"constraints"`
+	}{plain: (*plain)(j)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	j.Constraints = constraintFromString(decoded.Constraints)
+	return nil
+}
+
+// MarshalJSON writes Constraints as its expression in Slurm syntax
+func (c JobComponent) MarshalJSON() ([]byte, error) {
+	type plain JobComponent
+	return json.Marshal(struct {
+		plain
+		Constraints string `json:"constraints,omitempty"`
+	}{plain(c), constraintString(c.Constraints)})
+}
+
+// UnmarshalJSON reads Constraints back as a ConstraintExpr
+func (c *JobComponent) UnmarshalJSON(data []byte) error {
+	type plain JobComponent
+	decoded := struct {
+		*plain
+		Constraints string `json:"constraints"`
+	}{plain: (*plain)(c)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	c.Constraints = constraintFromString(decoded.Constraints)
+	return nil
+}
+
+func constraintString(c Constraint) string {
+	if c == nil {
+		return ""
+	}
+	return c.String()
+}
+
+func constraintFromString(s string) Constraint {
+	if s == "" {
+		return nil
+	}
+	return ConstraintExpr(s)
+}
+
+// joinConstraints writes operands separated by op. An operand combined with
+// the other operator is parenthesized, as Slurm gives & and | no precedence.
+func joinConstraints(operands []Constraint, op string) string {
+	parts := make([]string, 0, len(operands))
+	for _, operand := range operands {
+		if operand == nil {
+			continue
+		}
+		s := operand.String()
+		switch o := operand.(type) {
+		case ConstraintAnd:
+			if op != "&" && len(o) > 1 {
+				s = "(" + s + ")"
+			}
+		case ConstraintOr:
+			if op != "|" && len(o) > 1 {
+				s = "(" + s + ")"
+			}
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, op)
+}
+
+func validateOperands(operands []Constraint, op string) error {
+	if len(operands) == 0 {
+		return fmt.Errorf("%q needs at least one operand", op)
+	}
+	for _, operand := range operands {
+		if operand == nil {
+			return fmt.Errorf("%q operand cannot be nil", op)
+		}
+		if err := operand.Validate(); err != nil {
+			return err
+		}
+		if expr, ok := operand.(ConstraintExpr); ok && expr == "" {
+			return fmt.Errorf("%q operand cannot be empty", op)
+		}
+	}
+	return nil
+}
+
+// isFeatureChar reports whether b may appear in a node feature name
+func isFeatureChar(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9' ||
+		b == '_' || b == '-' || b == '.' || b == ':'
+}
+
+// validateConstraintSyntax checks expr against the --constraint grammar:
+//
+//	expr   = factor { ("&" | "|") factor }
+//	factor = feature [ "*" count ] | "(" expr ")" [ "*" count ] | "[" expr "]"
+func validateConstraintSyntax(expr string) error {
+	p := &constraintParser{expr: expr}
+	if err := p.parseExpr(); err != nil {
+		return err
+	}
+	if p.pos < len(p.expr) {
+		return p.errorf("unexpected %q", p.expr[p.pos])
+	}
+	return nil
+}
+
+type constraintParser struct {
+	expr      string
+	pos       int
+	inBracket bool
+}
+
+func (p *constraintParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid constraint %q at offset %d: %s", p.expr, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *constraintParser) parseExpr() error {
+	for {
+		if err := p.parseFactor(); err != nil {
+			return err
+		}
+		if p.pos == len(p.expr) || (p.expr[p.pos] != '&' && p.expr[p.pos] != '|') {
+			return nil
+		}
+		p.pos++
+	}
+}
+
+func (p *constraintParser) parseFactor() error {
+	if p.pos == len(p.expr) {
+		return p.errorf("expected a feature")
+	}
+	switch c := p.expr[p.pos]; {
+	case c == '(':
+		p.pos++
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+		if err := p.expect(')'); err != nil {
+			return err
+		}
+		return p.parseCount()
+	case c == '[':
+		if p.inBracket {
+			return p.errorf("brackets cannot be nested")
+		}
+		p.pos++
+		p.inBracket = true
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+		p.inBracket = false
+		return p.expect(']')
+	case isFeatureChar(c):
+		for p.pos < len(p.expr) && isFeatureChar(p.expr[p.pos]) {
+			p.pos++
+		}
+		return p.parseCount()
+	default:
+		return p.errorf("unexpected %q", c)
+	}
+}
+
+// parseCount reads an optional "*<count>" suffix
+func (p *constraintParser) parseCount() error {
+	if p.pos == len(p.expr) || p.expr[p.pos] != '*' {
+		return nil
+	}
+	p.pos++
+	start := p.pos
+	for p.pos < len(p.expr) && p.expr[p.pos] >= '0' && p.expr[p.pos] <= '9' {
+		p.pos++
+	}
+	if n, err := strconv.Atoi(p.expr[start:p.pos]); err != nil || n == 0 {
+		return p.errorf("expected a positive count after '*'")
+	}
+	return nil
+}
+
+func (p *constraintParser) expect(c byte) error {
+	if p.pos == len(p.expr) || p.expr[p.pos] != c {
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
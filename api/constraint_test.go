@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraint_String(t *testing.T) {
+	feature := func(name string, count int) ConstraintFeature {
+		return ConstraintFeature{Name: name, Count: count}
+	}
+	tests := []struct {
+		name     string
+		c        Constraint
+		expected string
+	}{
+		{"or", ConstraintOr{feature("haswell", 0), feature("broadwell", 0)}, "haswell|broadwell"},
+		{"and", ConstraintAnd{feature("ib", 0), feature("rack3", 0)}, "ib&rack3"},
+		{"counted", ConstraintBracket{ConstraintAnd{feature("rack1", 2), feature("rack2", 2)}}, "[rack1*2&rack2*2]"},
+		{"matching or", ConstraintBracket{ConstraintOr{feature("rack1", 0), feature("rack2", 0)}}, "[rack1|rack2]"},
+		{"nested", ConstraintAnd{ConstraintOr{feature("a100", 0), feature("h100", 0)}, feature("ib", 0)}, "(a100|h100)&ib"},
+		{"raw", ConstraintExpr("ib&rack3"), "ib&rack3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.c.Validate())
+			assert.Equal(t, tt.expected, tt.c.String())
+		})
+	}
+}
+
+func TestConstraintExpr_Validate(t *testing.T) {
+	valid := []string{
+		"",
+		"haswell",
+		"haswell|broadwell",
+		"ib&rack3",
+		"[rack1*2&rack2*2]",
+		"[rack1|rack2|rack3]",
+		"(a100|h100)&ib",
+		"[(knl&snc4&flat)*4&haswell*1]",
+		"intel-gold_6248.v2",
+	}
+	for _, expr := range valid {
+		assert.NoError(t, ConstraintExpr(expr).Validate(), expr)
+	}
+
+	invalid := []string{
+		"haswell|",
+		"&ib",
+		"ib&&rack3",
+		"(a100|h100",
+		"[rack1*2&rack2*2",
+		"[[rack1]]",
+		"rack1*",
+		"rack1*0",
+		"ib rack3",
+		"gpu=a100",
+	}
+	for _, expr := range invalid {
+		assert.Error(t, ConstraintExpr(expr).Validate(), expr)
+	}
+}
+
+func TestConstraint_ValidateStructured(t *testing.T) {
+	assert.Error(t, ConstraintFeature{}.Validate())
+	assert.Error(t, ConstraintFeature{Name: "ib|eth"}.Validate())
+	assert.Error(t, ConstraintFeature{Name: "rack1", Count: -1}.Validate())
+	assert.Error(t, ConstraintAnd{}.Validate())
+	assert.Error(t, ConstraintOr{ConstraintFeature{Name: "ib"}, nil}.Validate())
+	assert.Error(t, ConstraintBracket{}.Validate())
+	assert.Error(t, ConstraintBracket{ConstraintAnd{
+		ConstraintBracket{ConstraintFeature{Name: "rack1"}},
+	}}.Validate())
+}
+
+func TestJobSubmission_ConstraintsJSON(t *testing.T) {
+	job := JobSubmission{
+		Name:        "mpi",
+		Constraints: ConstraintAnd{ConstraintFeature{Name: "ib"}, ConstraintOr{ConstraintFeature{Name: "haswell"}, ConstraintFeature{Name: "broadwell"}}},
+		Components:  []JobComponent{{CPUs: 4, Constraints: ConstraintExpr("gpu")}, {CPUs: 2}},
+	}
+	data, err := json.Marshal(job)
+	require.NoError(t, err)
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(data, &fields))
+	assert.Equal(t, "ib&(haswell|broadwell)", fields["constraints"])
+	assert.Equal(t, "mpi", fields["name"])
+
+	var decoded JobSubmission
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "mpi", decoded.Name)
+	assert.Equal(t, ConstraintExpr("ib&(haswell|broadwell)"), decoded.Constraints)
+	require.Len(t, decoded.Components, 2)
+	assert.Equal(t, 4, decoded.Components[0].CPUs)
+	assert.Equal(t, ConstraintExpr("gpu"), decoded.Components[0].Constraints)
+	assert.Nil(t, decoded.Components[1].Constraints)
+
+	// Without constraints the field is left out and decodes as nil
+	data, err = json.Marshal(JobSubmission{Name: "plain"})
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "constraints")
+	decoded = JobSubmission{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Nil(t, decoded.Constraints)
+}
@@ -6,8 +6,12 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"time"
+
+	"github.com/jontk/slurm-client/pkg/codec"
+	"github.com/jontk/slurm-client/pkg/config"
 )
 
 // ============================================================================
@@ -24,6 +28,7 @@ type JobSubmission struct {
 	Command     string            `json:"command,omitempty"`
 	Args        []string          `json:"args,omitempty"`
 	Partition   string            `json:"partition,omitempty"`
+	QoS         string            `json:"qos,omitempty"`
 	CPUs        int               `json:"cpus,omitempty"`
 	Memory      int               `json:"memory,omitempty"`
 	TimeLimit   int               `json:"time_limit,omitempty"`
@@ -31,6 +36,133 @@ type JobSubmission struct {
 	Environment map[string]string `json:"environment,omitempty"`
 	Nodes       int               `json:"nodes,omitempty"`
 	Priority    int               `json:"priority,omitempty"`
+	// StdOut and StdErr are the paths of the job's output files (sbatch
+	// --output and --error); Slurm's defaults apply when they are empty
+	StdOut string `json:"std_out,omitempty"`
+	StdErr string `json:"std_err,omitempty"`
+	// StdIn is the path of the file connected to the job's standard input
+	// (sbatch --input)
+	StdIn string `json:"std_in,omitempty"`
+	// OpenMode is whether StdOut and StdErr are truncated or appended to
+	// (sbatch --open-mode); the cluster's JobFileAppend setting applies
+	// when it is empty
+	OpenMode OpenModeValue `json:"open_mode,omitempty"`
+	// ArraySpec submits the job as a job array over the given task IDs
+	// (sbatch --array), e.g. "1-100:2" or "0,4,8". Each task's record
+	// carries the array's job ID in ArrayJobID and its own ArrayTaskID.
+	ArraySpec string `json:"array_spec,omitempty"`
+	// ArrayMaxConcurrent limits how many tasks of the array run at once,
+	// as a "%N" suffix to ArraySpec would; zero means no limit
+	ArrayMaxConcurrent int `json:"array_max_concurrent,omitempty"`
+	// Dependencies delays the job until the listed jobs reach the given
+	// states (sbatch --dependency); all of them must be satisfied. For
+	// example {Type: "afterok", JobIDs: []int64{12345}} starts the job
+	// once job 12345 has completed successfully.
+	Dependencies []JobDependency `json:"dependencies,omitempty"`
+	// Exclusive requests whole nodes (sbatch --exclusive)
+	Exclusive bool `json:"exclusive,omitempty"`
+	// Oversubscribe allows sharing allocated resources with other jobs
+	// (sbatch --oversubscribe). It cannot be combined with Exclusive.
+	Oversubscribe bool `json:"oversubscribe,omitempty"`
+	// Constraints restricts the job to nodes with the given features
+	// (sbatch --constraint), e.g. ConstraintExpr("ib&rack3") or
+	// ConstraintOr{ConstraintFeature{Name: "haswell"}, ConstraintFeature{Name: "broadwell"}}.
+	// In JSON it is the expression in Slurm syntax, and it decodes as a
+	// ConstraintExpr.
+	Constraints Constraint `json:"-"`
+	// CPUBind sets the task to CPU binding, e.g. "cores" or "map_cpu:0,2"
+	// (srun --cpu-bind)
+	CPUBind string `json:"cpu_bind,omitempty"`
+	// Nice lowers the job's scheduling priority by the given amount
+	// (sbatch --nice); only privileged users can give a negative value to
+	// raise it. Zero leaves the priority alone.
+	Nice int `json:"nice,omitempty"`
+	// Reboot asks for the allocated nodes to be rebooted before the job
+	// starts (sbatch --reboot)
+	Reboot bool `json:"reboot,omitempty"`
+	// GRESFlags sets how the job's GPUs are bound to its CPUs (sbatch
+	// --gres-flags): GRESFlagEnforceBinding or GRESFlagDisableBinding
+	GRESFlags []string `json:"gres_flags,omitempty"`
+	// SpankOptions sets SPANK plugin options, as `sbatch --<option>=<value>`
+	// would for a plugin loaded on the submit host. Keys are written as
+	// "<plugin>:<option>"; use an empty value for options without an argument.
+	SpankOptions map[string]string `json:"spank_options,omitempty"`
+	// Extra is site-specific metadata stored in the job's extra field as a
+	// JSON object (v0.0.42+)
+	Extra map[string]any `json:"extra,omitempty"`
+	// EnsureUniqueName makes Submit fail with a conflict error instead of
+	// submitting when a job with the same Name is already pending or
+	// running, so a repeated cron run does not duplicate work
+	EnsureUniqueName bool `json:"ensure_unique_name,omitempty"`
+	// User limits the EnsureUniqueName check to this user's jobs. It
+	// defaults to the user the client authenticates as; when the auth
+	// provider names no user, jobs of every user on the cluster count.
+	// slurmrestd lists every user's jobs, and Slurm takes the submitting
+	// user from the credentials, so this field is not sent with the job.
+	User string `json:"user,omitempty"`
+	// Components submits a heterogeneous job (sbatch with components
+	// separated by ":"), one resource group per entry. Script,
+	// Name, the output files and the other job-wide settings are shared by
+	// all components; the resource fields above are defaults that each
+	// component can override. Dependencies apply to the first component,
+	// and heterogeneous jobs cannot be job arrays.
+	Components []JobComponent `json:"components,omitempty"`
+}
+
+// JobComponent is one resource group of a heterogeneous job. Zero fields
+// take the value of the JobSubmission.
+type JobComponent struct {
+	Partition string `json:"partition,omitempty"`
+	Account   string `json:"account,omitempty"`
+	QoS       string `json:"qos,omitempty"`
+	CPUs      int    `json:"cpus,omitempty"`
+	Memory    int    `json:"memory,omitempty"`
+	Nodes     int    `json:"nodes,omitempty"`
+	TimeLimit int    `json:"time_limit,omitempty"`
+	// TRESPerNode requests generic resources on each node, e.g. "gres/gpu:4"
+	TRESPerNode string     `json:"tres_per_node,omitempty"`
+	// Constraints is encoded as for JobSubmission.Constraints
+	Constraints Constraint `json:"-"`
+	Exclusive   bool       `json:"exclusive,omitempty"`
+}
+
+// ValidationIssue describes a single problem with one field of a request.
+type ValidationIssue struct {
+	Field   string      `json:"field"`
+	Value   interface{} `json:"value,omitempty"`
+	Message string      `json:"message"`
+}
+
+// ValidationResult holds every problem found while validating a request,
+// rather than only the first one.
+type ValidationResult struct {
+	Issues []ValidationIssue `json:"issues,omitempty"`
+}
+
+// Valid reports whether validation found no issues.
+func (r *ValidationResult) Valid() bool {
+	return r == nil || len(r.Issues) == 0
+}
+
+// Add records an issue for field.
+func (r *ValidationResult) Add(field string, value interface{}, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, ValidationIssue{
+		Field:   field,
+		Value:   value,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Fields returns the fields that have issues, in the order they were found.
+func (r *ValidationResult) Fields() []string {
+	if r == nil {
+		return nil
+	}
+	fields := make([]string, 0, len(r.Issues))
+	for _, issue := range r.Issues {
+		fields = append(fields, issue.Field)
+	}
+	return fields
 }
 
 // JobStepList represents a list of job steps.
@@ -39,17 +171,64 @@ type JobStepList struct {
 	Total int       `json:"total"`
 }
 
-// JobStep represents a single job step.
+// JobStep represents a single job step. ID is the step part of the full step
+// ID, such as "0", "batch" or "extern"; Memory is in megabytes.
 type JobStep struct {
-	ID        string     `json:"id"`
-	JobID     string     `json:"job_id"`
-	Name      string     `json:"name"`
-	State     string     `json:"state"`
-	CPUs      int        `json:"cpus"`
-	Memory    int        `json:"memory"`
-	StartTime *time.Time `json:"start_time,omitempty"`
-	EndTime   *time.Time `json:"end_time,omitempty"`
-	ExitCode  int        `json:"exit_code"`
+	ID           string     `json:"id"`
+	JobID        string     `json:"job_id"`
+	Name         string     `json:"name"`
+	State        string     `json:"state"`
+	CPUs         int        `json:"cpus"`
+	Memory       int        `json:"memory"`
+	Nodes        string     `json:"nodes,omitempty"`
+	TRESAllocStr string     `json:"tres_alloc_str,omitempty"`
+	StartTime    *time.Time `json:"start_time,omitempty"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
+	ExitCode     int        `json:"exit_code"`
+}
+
+// TRESUsageReport is the resource usage of a job as recorded in the
+// accounting database. The totals and the per-node figures are derived from
+// the steps.
+type TRESUsageReport struct {
+	JobID string `json:"job_id"`
+	// Allocated is the TRES allocated to the job, keyed by TRES type such as
+	// "cpu", "mem" or "gres/gpu"
+	Allocated map[string]int64 `json:"allocated,omitempty"`
+	// MaxRSS is the largest resident set size of any task, in bytes
+	MaxRSS int64 `json:"max_rss"`
+	// CPUTime is the user plus system CPU time of all steps
+	CPUTime time.Duration `json:"cpu_time"`
+	// EnergyConsumed is in joules, and zero without energy accounting
+	EnergyConsumed int64           `json:"energy_consumed"`
+	Steps          []StepTRESUsage `json:"steps"`
+	Nodes          []NodeTRESUsage `json:"nodes"`
+}
+
+// StepTRESUsage is the resource usage of one job step
+type StepTRESUsage struct {
+	StepID         string        `json:"step_id"`
+	Name           string        `json:"name"`
+	Nodes          []string      `json:"nodes"`
+	MaxRSS         int64         `json:"max_rss"`
+	CPUTime        time.Duration `json:"cpu_time"`
+	EnergyConsumed int64         `json:"energy_consumed"`
+	// ConsumedMax holds the per-task peaks and ConsumedTotal the sums over
+	// all tasks, keyed like TRESUsageReport.Allocated
+	ConsumedMax   map[string]int64 `json:"consumed_max,omitempty"`
+	ConsumedTotal map[string]int64 `json:"consumed_total,omitempty"`
+}
+
+// NodeTRESUsage is the resource usage of a job on one node. slurmdbd keeps
+// step totals rather than per-node figures, so CPU time and energy of a
+// multi-node step are divided evenly between its nodes, and MaxRSS is the
+// largest per-task peak of the steps that ran on the node.
+type NodeTRESUsage struct {
+	Node           string        `json:"node"`
+	Steps          []string      `json:"steps"`
+	MaxRSS         int64         `json:"max_rss"`
+	CPUTime        time.Duration `json:"cpu_time"`
+	EnergyConsumed int64         `json:"energy_consumed"`
 }
 
 // ============================================================================
@@ -69,8 +248,155 @@ type ListJobsOptions struct {
 	UserID    string   `json:"user_id,omitempty"`
 	States    []string `json:"states,omitempty"`
 	Partition string   `json:"partition,omitempty"`
-	Limit     int      `json:"limit,omitempty"`
-	Offset    int      `json:"offset,omitempty"`
+	// Reasons matches jobs pending or blocked for one of the given state
+	// reason codes, e.g. "Priority" or "QOSMaxJobsPerUserLimit". Matching is
+	// case-insensitive and done client-side.
+	Reasons []string `json:"reasons,omitempty"`
+	// HeldOnly matches only jobs held by their owner or an administrator;
+	// see Job.HeldBy. It is applied client-side.
+	HeldOnly bool `json:"held_only,omitempty"`
+	// SubmittedAfter, StartedAfter and EndBefore match jobs whose submit,
+	// start or end time falls in the window, client-side. Pending jobs have
+	// not started, so StartedAfter never matches them.
+	SubmittedAfter *time.Time `json:"submitted_after,omitempty"`
+	StartedAfter   *time.Time `json:"started_after,omitempty"`
+	EndBefore      *time.Time `json:"end_before,omitempty"`
+	Limit          int        `json:"limit,omitempty"`
+	Offset         int        `json:"offset,omitempty"`
+	// Flags are sent to slurmrestd as is; without QueryFlagAll the jobs in
+	// hidden partitions are left out
+	Flags []QueryFlag `json:"flags,omitempty"`
+}
+
+// DefaultListAllMaxJobs is the most jobs ListAll returns when
+// ListAllJobsOptions.MaxJobs is not set
+const DefaultListAllMaxJobs = 100000
+
+// ListAllJobsOptions configures Jobs().ListAll, which returns every
+// matching job in one slice.
+type ListAllJobsOptions struct {
+	// Filters selects the jobs; its Limit and Offset are ignored
+	Filters *ListJobsOptions `json:"filters,omitempty"`
+	// MaxJobs is the most matching jobs ListAll returns; it fails rather
+	// than return more (default DefaultListAllMaxJobs). It caps the result,
+	// not the response: slurmrestd's answer is decoded whole before the
+	// matching jobs are counted.
+	MaxJobs int `json:"max_jobs,omitempty"`
+}
+
+// JobHistoryOptions selects the accounting records Jobs().History returns.
+type JobHistoryOptions struct {
+	// Since and Until bound the period the jobs were eligible or running
+	// in; a zero Since leaves slurmdbd's default of midnight today
+	Since time.Time `json:"since,omitempty"`
+	Until time.Time `json:"until,omitempty"`
+	// Partition and QoS, when set, select jobs that ran in them
+	Partition string `json:"partition,omitempty"`
+	QoS       string `json:"qos,omitempty"`
+}
+
+// DefaultCancelWaitPollInterval is how often Jobs().CancelAndWait checks
+// the cancelled jobs when no interval is given
+const DefaultCancelWaitPollInterval = 2 * time.Second
+
+// DefaultCancelWaitConcurrency is how many jobs Jobs().CancelAndWait
+// handles at once when no concurrency is given
+const DefaultCancelWaitConcurrency = 8
+
+// CancelAndWaitOptions configures Jobs().CancelAndWait.
+type CancelAndWaitOptions struct {
+	// Concurrency is the most jobs cancelled and waited for at once
+	// (default DefaultCancelWaitConcurrency)
+	Concurrency int `json:"concurrency,omitempty"`
+	// PollInterval is the time between checks of each job
+	// (default DefaultCancelWaitPollInterval)
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+	// Timeout bounds the whole call; zero waits as long as ctx allows
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// DefaultArrayPollInterval is how often Jobs().WaitForArray checks the
+// array when no interval is given
+const DefaultArrayPollInterval = 10 * time.Second
+
+// WaitForArrayOptions configures Jobs().WaitForArray.
+type WaitForArrayOptions struct {
+	// PollInterval is the time between checks
+	// (default DefaultArrayPollInterval)
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+	// Timeout bounds the whole wait; zero waits as long as ctx allows
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// StopOnFirstFailure returns as soon as any task has failed instead of
+	// waiting for the rest of the array
+	StopOnFirstFailure bool `json:"stop_on_first_failure,omitempty"`
+}
+
+// ArrayTaskResult is the state of one task when WaitForArray returned.
+type ArrayTaskResult struct {
+	TaskID uint32   `json:"task_id"`
+	State  JobState `json:"state"`
+	// Job is the last record seen for the task
+	Job *Job `json:"job,omitempty"`
+}
+
+// ArrayResult summarizes a job array once WaitForArray is done with it.
+type ArrayResult struct {
+	ArrayJobID uint32 `json:"array_job_id"`
+	// Tasks holds every task in task ID order
+	Tasks []ArrayTaskResult `json:"tasks"`
+	// Completed counts the tasks that finished successfully
+	Completed int `json:"completed"`
+	// Failed counts the tasks that ended in any other terminal state
+	Failed int `json:"failed"`
+	// Unfinished counts the tasks that had not finished, because the wait
+	// stopped on a failure or the task left the queue before it was seen
+	// to finish
+	Unfinished int `json:"unfinished"`
+}
+
+// Succeeded reports whether every task of the array completed successfully
+func (r *ArrayResult) Succeeded() bool {
+	return r != nil && r.Failed == 0 && r.Unfinished == 0 && r.Completed > 0
+}
+
+// RunArrayOptions configures Jobs().RunArray.
+type RunArrayOptions struct {
+	// Wait configures the wait for the array once it is submitted
+	Wait *WaitForArrayOptions `json:"wait,omitempty"`
+}
+
+// ArrayTaskReport is the outcome of one task of an array run by RunArray.
+type ArrayTaskReport struct {
+	TaskID uint32   `json:"task_id"`
+	JobID  int64    `json:"job_id,omitempty"`
+	State  JobState `json:"state,omitempty"`
+	// ExitCode is the return code of the task's batch script; it is nil
+	// when Slurm reported none, as for a task that never ran
+	ExitCode *int `json:"exit_code,omitempty"`
+	// Signal names the signal that ended the task, if any
+	Signal string `json:"signal,omitempty"`
+}
+
+// ArrayRunReport summarizes a job array submitted and waited for by RunArray.
+type ArrayRunReport struct {
+	ArrayJobID uint32 `json:"array_job_id"`
+	// Tasks holds every task of the array specification in task ID order,
+	// including tasks that were never seen in the queue
+	Tasks      []ArrayTaskReport `json:"tasks"`
+	Completed  int               `json:"completed"`
+	Failed     int               `json:"failed"`
+	Unfinished int               `json:"unfinished"`
+	// SuccessRate is the fraction of tasks that completed successfully,
+	// from 0 to 1
+	SuccessRate float64 `json:"success_rate"`
+}
+
+// GetJobOptions configures a single job lookup.
+type GetJobOptions struct {
+	// IncludeAccounting merges the slurmdbd record (TRES usage, start/end
+	// times, exit codes) into the result when the job has finished, and
+	// returns the record alone once slurmctld has purged the job
+	IncludeAccounting bool `json:"include_accounting,omitempty"`
 }
 
 // ListNodesOptions configures node listing.
@@ -80,6 +406,41 @@ type ListNodesOptions struct {
 	Features  []string `json:"features,omitempty"`
 	Limit     int      `json:"limit,omitempty"`
 	Offset    int      `json:"offset,omitempty"`
+	// Flags are sent to slurmrestd as is, e.g. QueryFlagFuture to include
+	// FUTURE nodes
+	Flags []QueryFlag `json:"flags,omitempty"`
+	// MPSAvailable keeps only nodes with MPS shares not allocated to jobs.
+	// slurmrestd cannot filter on GRES, so this is done client side, before
+	// Limit and Offset are applied.
+	MPSAvailable bool `json:"mps_available,omitempty"`
+}
+
+// DefaultNodeStatePollInterval is how often Nodes().WaitForState checks the
+// nodes when no interval is given
+const DefaultNodeStatePollInterval = 5 * time.Second
+
+// WaitForNodeStateOptions configures Nodes().WaitForState.
+type WaitForNodeStateOptions struct {
+	// PollInterval is the time between checks
+	// (default DefaultNodeStatePollInterval)
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+	// Timeout bounds the whole wait; zero waits as long as ctx allows
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// OnProgress is called after every check with the nodes that have not
+	// reached the state yet
+	OnProgress func(waiting []string) `json:"-"`
+}
+
+// RebootOptions configures Nodes().Reboot.
+type RebootOptions struct {
+	// ASAP drains the node so no new jobs start on it, and reboots it as
+	// soon as its running jobs have finished
+	ASAP bool `json:"asap,omitempty"`
+	// NextState is the state the node is given once it is back: "RESUME"
+	// or "DOWN". Empty leaves it to slurmctld.
+	NextState string `json:"next_state,omitempty"`
+	// Reason is recorded on the node until it has rebooted
+	Reason string `json:"reason,omitempty"`
 }
 
 // ListPartitionsOptions configures partition listing.
@@ -87,6 +448,9 @@ type ListPartitionsOptions struct {
 	States []string `json:"states,omitempty"`
 	Limit  int      `json:"limit,omitempty"`
 	Offset int      `json:"offset,omitempty"`
+	// Flags are sent to slurmrestd as is; without QueryFlagAll hidden
+	// partitions are left out
+	Flags []QueryFlag `json:"flags,omitempty"`
 }
 
 // ListReservationsOptions configures reservation listing.
@@ -178,12 +542,18 @@ type ListUsersOptions struct {
 
 // WatchJobsOptions configures job watching.
 type WatchJobsOptions struct {
-	UserID           string   `json:"user_id,omitempty"`
-	States           []string `json:"states,omitempty"`
-	Partition        string   `json:"partition,omitempty"`
-	JobIDs           []string `json:"job_ids,omitempty"`
-	ExcludeNew       bool     `json:"exclude_new,omitempty"`
-	ExcludeCompleted bool     `json:"exclude_completed,omitempty"`
+	UserID     string   `json:"user_id,omitempty"`
+	States     []string `json:"states,omitempty"`
+	Partition  string   `json:"partition,omitempty"`
+	JobIDs     []string `json:"job_ids,omitempty"`
+	ExcludeNew bool     `json:"exclude_new,omitempty"`
+	// ExcludeCompleted drops the events of jobs finishing: modified events
+	// whose new state is terminal, such as COMPLETED or FAILED, and deleted
+	// events of jobs leaving the listing
+	ExcludeCompleted bool `json:"exclude_completed,omitempty"`
+	// PollInterval is the time between listings
+	// (default watch.DefaultPollInterval)
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
 }
 
 // WatchNodesOptions configures node watching.
@@ -192,6 +562,12 @@ type WatchNodesOptions struct {
 	Partition string   `json:"partition,omitempty"`
 	Features  []string `json:"features,omitempty"`
 	NodeNames []string `json:"node_names,omitempty"`
+	// SkipInitial starts without reporting the nodes that already exist;
+	// the NodeEventSynced bookmark is still sent once they are known
+	SkipInitial bool `json:"skip_initial,omitempty"`
+	// PollInterval is the time between listings
+	// (default watch.DefaultPollInterval)
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
 }
 
 // WatchPartitionsOptions configures partition watching.
@@ -312,23 +688,23 @@ type ListAccountUsersOptions struct {
 
 // UserAccount represents a user's account association.
 type UserAccount struct {
-	AccountName   string         `json:"account_name"`
-	Partition     string         `json:"partition,omitempty"`
-	QoS           string         `json:"qos,omitempty"`
-	DefaultQoS    string         `json:"default_qos,omitempty"`
-	MaxJobs       int            `json:"max_jobs,omitempty"`
-	MaxSubmitJobs int            `json:"max_submit_jobs,omitempty"`
-	MaxWallTime   int            `json:"max_wall_time,omitempty"`
-	Priority      int            `json:"priority,omitempty"`
-	GraceTime     int            `json:"grace_time,omitempty"`
-	TRES          map[string]int `json:"tres,omitempty"`
-	MaxTRES       map[string]int `json:"max_tres,omitempty"`
-	MinTRES       map[string]int `json:"min_tres,omitempty"`
-	IsDefault     bool           `json:"is_default"`
-	IsActive      bool           `json:"is_active"`
-	Flags         []string       `json:"flags,omitempty"`
-	Created       time.Time      `json:"created"`
-	Modified      time.Time      `json:"modified"`
+	AccountName   string            `json:"account_name"`
+	Partition     string            `json:"partition,omitempty"`
+	QoS           string            `json:"qos,omitempty"`
+	DefaultQoS    string            `json:"default_qos,omitempty"`
+	MaxJobs       int               `json:"max_jobs,omitempty"`
+	MaxSubmitJobs int               `json:"max_submit_jobs,omitempty"`
+	MaxWallTime   int               `json:"max_wall_time,omitempty"`
+	Priority      int               `json:"priority,omitempty"`
+	GraceTime     int               `json:"grace_time,omitempty"`
+	TRES          map[string]int    `json:"tres,omitempty"`
+	MaxTRES       map[string]int    `json:"max_tres,omitempty"`
+	MinTRES       map[string]int    `json:"min_tres,omitempty"`
+	IsDefault     bool              `json:"is_default"`
+	IsActive      bool              `json:"is_active"`
+	Flags         []AssociationFlag `json:"flags,omitempty"`
+	Created       time.Time         `json:"created"`
+	Modified      time.Time         `json:"modified"`
 }
 
 // UserQuota represents a user's resource quotas.
@@ -350,6 +726,68 @@ type UserQuota struct {
 	Enforcement    string                       `json:"enforcement"`
 }
 
+// Levels an EffectiveLimit can come from
+const (
+	LimitSourceAssociation = "association"
+	LimitSourceAccount     = "account"
+	LimitSourceQoS         = "qos"
+	LimitSourcePartition   = "partition"
+)
+
+// UserEffectiveLimits gives the limits a user's jobs run under. A job runs
+// on one path: an association (an account, and a partition if the
+// association names one) and a QoS it may request. Along a path the limits
+// of the association, the accounts above it, the QoS and the partition all
+// apply, so the tightest of them counts. The paths are alternatives, so the
+// embedded limit set is the loosest limit on each resource across paths: no
+// job can exceed it, but a job within it may still need the right account,
+// partition or QoS. A nil limit is not set on at least one path.
+type UserEffectiveLimits struct {
+	UserName string `json:"user_name"`
+	EffectiveLimitSet
+	// Paths are the limits of each association and QoS the user may
+	// submit under
+	Paths []UserLimitPath `json:"paths,omitempty"`
+}
+
+// UserLimitPath is the tightest limit on each resource along one
+// association and QoS.
+type UserLimitPath struct {
+	Account string `json:"account"`
+	// Partition is empty for an association that applies to all partitions
+	Partition string `json:"partition,omitempty"`
+	// QoS is empty when no QoS is allowed on the association
+	QoS string `json:"qos,omitempty"`
+	EffectiveLimitSet
+}
+
+// EffectiveLimitSet is a limit per resource. A nil limit is not set.
+type EffectiveLimitSet struct {
+	// MaxJobs is the most jobs the user may have running at once
+	MaxJobs *EffectiveLimit `json:"max_jobs,omitempty"`
+	// MaxSubmitJobs is the most jobs the user may have pending or running
+	MaxSubmitJobs *EffectiveLimit `json:"max_submit_jobs,omitempty"`
+	// MaxWallMinutes is the longest time limit a job may have
+	MaxWallMinutes *EffectiveLimit `json:"max_wall_minutes,omitempty"`
+	// MaxNodesPerJob is the most nodes a job may use
+	MaxNodesPerJob *EffectiveLimit `json:"max_nodes_per_job,omitempty"`
+	// MaxTRESPerJob is the most of each TRES a job may use, keyed by TRES
+	// such as "cpu", "mem" (in megabytes) or "gres/gpu"
+	MaxTRESPerJob map[string]*EffectiveLimit `json:"max_tres_per_job,omitempty"`
+	// MaxTRESPerNode is the most of each TRES a job may use on one node
+	MaxTRESPerNode map[string]*EffectiveLimit `json:"max_tres_per_node,omitempty"`
+}
+
+// EffectiveLimit is a limit and where it is set.
+type EffectiveLimit struct {
+	Value int64 `json:"value"`
+	// Source is one of the LimitSource levels
+	Source string `json:"source"`
+	// Name is the account, QoS or partition that sets the limit; for an
+	// association it is the association's account
+	Name string `json:"name"`
+}
+
 // UserAccountQuota represents user-account specific quotas.
 type UserAccountQuota struct {
 	AccountName   string         `json:"account_name"`
@@ -499,7 +937,7 @@ type UserAccountAssociation struct {
 	Created         time.Time              `json:"created"`
 	Modified        time.Time              `json:"modified"`
 	LastAccessed    time.Time              `json:"last_accessed"`
-	Flags           []string               `json:"flags,omitempty"`
+	Flags           []AssociationFlag      `json:"flags,omitempty"`
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -554,6 +992,10 @@ type ClientConfig struct {
 	BaseURL    string
 	HTTPClient HTTPDoer
 	Debug      bool
+	// Timeouts are the default deadlines of each class of operation
+	Timeouts config.Timeouts
+	// Codec encodes request and decodes response bodies (nil = JSON)
+	Codec codec.Codec
 }
 
 // ============================================================================
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Slurm's "extra" field on nodes and jobs is a free-form string that sites
+// use for their own metadata, usually a JSON object. The helpers below read
+// and write it as a map. Slurmrestd accepts extra on job submission and node
+// update from v0.0.42; earlier versions ignore it.
+
+// ParseExtra decodes an extra string holding a JSON object. An empty string
+// yields a nil map. Numbers are decoded as json.Number so large IDs keep
+// their exact value.
+func ParseExtra(extra string) (map[string]any, error) {
+	if extra == "" {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(extra))
+	decoder.UseNumber()
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("extra is not a JSON object: %w", err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("extra has data after the JSON object")
+	}
+	return fields, nil
+}
+
+// FormatExtra encodes fields as a JSON object with keys in sorted order. An
+// empty map yields an empty string.
+func FormatExtra(fields map[string]any) (string, error) {
+	if len(fields) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode extra: %w", err)
+	}
+	return string(data), nil
+}
+
+// ExtraFields decodes the job's extra field
+func (j *Job) ExtraFields() (map[string]any, error) {
+	if j.Extra == nil {
+		return nil, nil
+	}
+	return ParseExtra(*j.Extra)
+}
+
+// ExtraFields decodes the node's extra field
+func (n *Node) ExtraFields() (map[string]any, error) {
+	if n.Extra == nil {
+		return nil, nil
+	}
+	return ParseExtra(*n.Extra)
+}
+
+// SetExtraFields encodes fields into the job's extra field. An empty map
+// leaves the field unset.
+func (j *JobCreate) SetExtraFields(fields map[string]any) error {
+	extra, err := FormatExtra(fields)
+	if err != nil {
+		return err
+	}
+	j.Extra = nil
+	if extra != "" {
+		j.Extra = &extra
+	}
+	return nil
+}
+
+// SetExtraFields encodes fields into the node's extra field. An empty map
+// leaves the field unset; set Extra to an empty string to clear it.
+func (u *NodeUpdate) SetExtraFields(fields map[string]any) error {
+	extra, err := FormatExtra(fields)
+	if err != nil {
+		return err
+	}
+	u.Extra = nil
+	if extra != "" {
+		u.Extra = &extra
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtraFields_RoundTrip(t *testing.T) {
+	fields := map[string]any{
+		"cost_center": "physics",
+		"rack":        "r12",
+		"asset_id":    json.Number("9007199254740993"),
+		"tags":        []any{"gpu", "nvlink"},
+	}
+
+	create := &JobCreate{}
+	require.NoError(t, create.SetExtraFields(fields))
+	require.NotNil(t, create.Extra)
+	assert.JSONEq(t, `{"asset_id":9007199254740993,"cost_center":"physics","rack":"r12","tags":["gpu","nvlink"]}`, *create.Extra)
+
+	job := &Job{Extra: create.Extra}
+	decoded, err := job.ExtraFields()
+	require.NoError(t, err)
+	assert.Equal(t, fields, decoded)
+
+	update := &NodeUpdate{}
+	require.NoError(t, update.SetExtraFields(decoded))
+	node := &Node{Extra: update.Extra}
+	decoded, err = node.ExtraFields()
+	require.NoError(t, err)
+	assert.Equal(t, fields, decoded)
+}
+
+func TestParseExtra(t *testing.T) {
+	fields, err := ParseExtra("")
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+
+	fields, err = (&Node{}).ExtraFields()
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+
+	_, err = ParseExtra("rack=r12")
+	assert.Error(t, err)
+
+	_, err = ParseExtra(`{"rack":"r12"} trailing`)
+	assert.Error(t, err)
+
+	// An empty map leaves the field unset
+	create := &JobCreate{}
+	require.NoError(t, create.SetExtraFields(map[string]any{}))
+	assert.Nil(t, create.Extra)
+
+	_, err = FormatExtra(map[string]any{"bad": make(chan int)})
+	assert.Error(t, err)
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// AccountFlag is a flag of an account; see the AccountFlags* constants
+type AccountFlag = AccountFlagsValue
+
+// AssociationFlag is a flag of an association; see the
+// AssociationDefaultFlags* constants
+type AssociationFlag = AssociationDefaultFlagsValue
+
+// QoSFlag is a flag of a QoS; see the QoSFlags* constants
+type QoSFlag = QoSFlagsValue
+
+// PartitionOversubscribeFlag is a flag of a partition's OverSubscribe
+// setting; see the PartitionMaximumsOversubscribeFlags* constants
+type PartitionOversubscribeFlag = PartitionMaximumsOversubscribeFlagsValue
+
+// AccountFlagValues returns the account flags this client knows, for use
+// with flags.Validate
+func AccountFlagValues() []AccountFlag {
+	return []AccountFlag{
+		AccountFlagsDeleted,
+		AccountFlagsWithassociations,
+		AccountFlagsWithcoordinators,
+		AccountFlagsNousersarecoords,
+		AccountFlagsUsersarecoords,
+	}
+}
+
+// AssociationFlagValues returns the association flags this client knows,
+// for use with flags.Validate
+func AssociationFlagValues() []AssociationFlag {
+	return []AssociationFlag{
+		AssociationDefaultFlagsDeleted,
+		AssociationDefaultFlagsNoupdate,
+		AssociationDefaultFlagsExact,
+		AssociationDefaultFlagsNousersarecoords,
+		AssociationDefaultFlagsUsersarecoords,
+	}
+}
+
+// QoSFlagValues returns the QoS flags this client knows, for use with
+// flags.Validate. NOT_SET, ADD, REMOVE and DELETED are left out: they tell
+// slurmdbd how to apply a flag list rather than being flags of a QoS.
+func QoSFlagValues() []QoSFlag {
+	return []QoSFlag{
+		QoSFlagsPartitionMinimumNode,
+		QoSFlagsPartitionMaximumNode,
+		QoSFlagsPartitionTimeLimit,
+		QoSFlagsEnforceUsageThreshold,
+		QoSFlagsNoReserve,
+		QoSFlagsRequiredReservation,
+		QoSFlagsDenyLimit,
+		QoSFlagsOverridePartitionQoS,
+		QoSFlagsPartitionQoS,
+		QoSFlagsNoDecay,
+		QoSFlagsUsageFactorSafe,
+		QoSFlagsRelative,
+	}
+}
+
+// ReservationFlagValues returns the reservation flags this client knows,
+// for use with flags.Validate
+func ReservationFlagValues() []ReservationFlag {
+	return []ReservationFlag{
+		ReservationFlagsMaint,
+		ReservationFlagsNoMaint,
+		ReservationFlagsDaily,
+		ReservationFlagsNoDaily,
+		ReservationFlagsWeekly,
+		ReservationFlagsNoWeekly,
+		ReservationFlagsIgnoreJobs,
+		ReservationFlagsNoIgnoreJobs,
+		ReservationFlagsAnyNodes,
+		ReservationFlagsNoAnyNodes,
+		ReservationFlagsStatic,
+		ReservationFlagsNoStatic,
+		ReservationFlagsPartNodes,
+		ReservationFlagsNoPartNodes,
+		ReservationFlagsOverlap,
+		ReservationFlagsSpecNodes,
+		ReservationFlagsTimeFloat,
+		ReservationFlagsReplace,
+		ReservationFlagsAllNodes,
+		ReservationFlagsPurgeComp,
+		ReservationFlagsWeekday,
+		ReservationFlagsNoWeekday,
+		ReservationFlagsWeekend,
+		ReservationFlagsNoWeekend,
+		ReservationFlagsFlex,
+		ReservationFlagsNoFlex,
+		ReservationFlagsDurationPlus,
+		ReservationFlagsDurationMinus,
+		ReservationFlagsNoHoldJobsAfterEnd,
+		ReservationFlagsReplaceDown,
+		ReservationFlagsNoPurgeComp,
+		ReservationFlagsMagnetic,
+		ReservationFlagsNoMagnetic,
+		ReservationFlagsSkip,
+		ReservationFlagsHourly,
+		ReservationFlagsNoHourly,
+		ReservationFlagsUserDelete,
+		ReservationFlagsForceStart,
+		ReservationFlagsNoUserDelete,
+		ReservationFlagsReoccurring,
+		ReservationFlagsTRESPerNode,
+	}
+}
+
+// PartitionOversubscribeFlagValues returns the OverSubscribe flags this
+// client knows, for use with flags.Validate
+func PartitionOversubscribeFlagValues() []PartitionOversubscribeFlag {
+	return []PartitionOversubscribeFlag{
+		PartitionMaximumsOversubscribeFlagsForce,
+	}
+}
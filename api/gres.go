@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+// GRES names with helpers of their own
+const (
+	GRESGPU = "gpu"
+	// GRESMPS is CUDA Multi-Process Service, which shares a node's GPUs
+	// between jobs in shares rather than whole devices
+	GRESMPS = "mps"
+)
+
+// GRES flags of a job (sbatch --gres-flags)
+const (
+	// GRESFlagEnforceBinding only allocates the GPUs bound to the sockets
+	// of the CPUs allocated to the job
+	GRESFlagEnforceBinding = "enforce-binding"
+	// GRESFlagDisableBinding lets the job use GPUs on any socket, whatever
+	// the node's binding
+	GRESFlagDisableBinding = "disable-binding"
+)
+
+// gresJobFlags maps each GRES flag to the job flag slurmrestd takes for it
+var gresJobFlags = map[string]FlagsValue{
+	GRESFlagEnforceBinding: FlagsGRESBindingEnforced,
+	GRESFlagDisableBinding: FlagsGRESBindingDisabled,
+}
+
+// GRESJobFlag returns the job flag slurmrestd takes for a GRES flag, such
+// as GRES_BINDING_ENFORCED for "enforce-binding". It reports false for a
+// flag it does not know.
+func GRESJobFlag(flag string) (FlagsValue, bool) {
+	value, ok := gresJobFlags[flag]
+	return value, ok
+}
+
+// GRESFlags returns the GRES flags the job was submitted with, such as
+// GRESFlagEnforceBinding
+func (j *Job) GRESFlags() []string {
+	if j == nil {
+		return nil
+	}
+	var flags []string
+	for _, flag := range j.Flags {
+		for name, value := range gresJobFlags {
+			if flag == value {
+				flags = append(flags, name)
+			}
+		}
+	}
+	return flags
+}
+
+// GRESEntry is one entry of a node's GRES string, such as "gpu:a100:4(S:0-1)"
+type GRESEntry struct {
+	Name  string // "gpu", "mps", ...
+	Type  string // "a100"; empty when the entry has no type
+	Count int64
+}
+
+// GRESUsage is the configured and allocated count of a generic resource on
+// a node
+type GRESUsage struct {
+	Total int64 `json:"total"`
+	Used  int64 `json:"used"`
+}
+
+// Available returns the count not allocated to jobs
+func (u GRESUsage) Available() int64 {
+	if u.Used >= u.Total {
+		return 0
+	}
+	return u.Total - u.Used
+}
+
+// ParseGRES reads a node GRES string such as
+// "gpu:a100:4(S:0-1),mps:400(S:0-1)" or "gpu:a100:2(IDX:0,2)" into its
+// entries. The socket and index details in parentheses are dropped, counts
+// may carry a K, M or G suffix, and an entry without a count counts one.
+func ParseGRES(gres string) []GRESEntry {
+	var entries []GRESEntry
+	for _, item := range splitGRES(gres) {
+		if i := strings.IndexByte(item, '('); i >= 0 {
+			item = item[:i]
+		}
+		item = strings.TrimPrefix(strings.TrimSpace(item), "gres/")
+		if item == "" || item == "(null)" {
+			continue
+		}
+		parts := strings.Split(item, ":")
+		entry := GRESEntry{Name: parts[0], Count: 1}
+		rest := parts[1:]
+		if len(rest) > 0 {
+			if n, ok := parseGRESCount(rest[len(rest)-1]); ok {
+				entry.Count = n
+				rest = rest[:len(rest)-1]
+			}
+		}
+		entry.Type = strings.Join(rest, ":")
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// splitGRES splits a GRES string at the commas between entries, leaving
+// the commas of an index list such as "(IDX:0,2)" alone
+func splitGRES(gres string) []string {
+	var items []string
+	depth, start := 0, 0
+	for i, r := range gres {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				items = append(items, gres[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(items, gres[start:])
+}
+
+func parseGRESCount(s string) (int64, bool) {
+	multiplier := int64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		multiplier = 1024
+	case strings.HasSuffix(s, "M"):
+		multiplier = 1024 * 1024
+	case strings.HasSuffix(s, "G"):
+		multiplier = 1024 * 1024 * 1024
+	}
+	if multiplier > 1 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n * multiplier, true
+}
+
+// GRESUsage returns how much of the named generic resource the node has
+// and how much of it is allocated, summed over all types of it, so that
+// "gpu" and "mps" are counted apart
+func (n *Node) GRESUsage(name string) GRESUsage {
+	var usage GRESUsage
+	if n == nil {
+		return usage
+	}
+	if n.GRES != nil {
+		usage.Total = sumGRES(*n.GRES, name)
+	}
+	if n.GRESUsed != nil {
+		usage.Used = sumGRES(*n.GRESUsed, name)
+	}
+	return usage
+}
+
+// GPUUsage returns the node's GPU devices and how many are allocated
+func (n *Node) GPUUsage() GRESUsage {
+	return n.GRESUsage(GRESGPU)
+}
+
+// MPSUsage returns the node's MPS shares and how many are allocated
+func (n *Node) MPSUsage() GRESUsage {
+	return n.GRESUsage(GRESMPS)
+}
+
+func sumGRES(gres, name string) int64 {
+	var total int64
+	for _, entry := range ParseGRES(gres) {
+		if entry.Name == name {
+			total += entry.Count
+		}
+	}
+	return total
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGRES(t *testing.T) {
+	assert.Equal(t, []GRESEntry{
+		{Name: "gpu", Type: "a100", Count: 2},
+		{Name: "mps", Count: 200},
+		{Name: "shard", Count: 1},
+	}, ParseGRES("gpu:a100:2(IDX:0,2),mps:200(S:0-1),shard"))
+
+	assert.Equal(t, []GRESEntry{{Name: "gpu", Count: 4}, {Name: "mps", Count: 2048}},
+		ParseGRES("gres/gpu:4,gres/mps:2K"))
+	assert.Empty(t, ParseGRES(""))
+	assert.Empty(t, ParseGRES("(null)"))
+}
+
+func TestNode_GPUAndMPSUsage(t *testing.T) {
+	gres := "gpu:a100:2(S:0),gpu:v100:2(S:1),mps:400(S:0-1)"
+	used := "gpu:a100:2(IDX:0-1),gpu:v100:0(IDX:N/A),mps:150(IDX:2-3)"
+	node := &Node{GRES: &gres, GRESUsed: &used}
+
+	gpu := node.GPUUsage()
+	assert.Equal(t, GRESUsage{Total: 4, Used: 2}, gpu)
+	assert.Equal(t, int64(2), gpu.Available())
+
+	mps := node.MPSUsage()
+	assert.Equal(t, GRESUsage{Total: 400, Used: 150}, mps)
+	assert.Equal(t, int64(250), mps.Available())
+
+	// A node with GPUs but no MPS has no MPS to offer
+	gpuOnly := "gpu:a100:4"
+	assert.Equal(t, GRESUsage{}, (&Node{GRES: &gpuOnly}).MPSUsage())
+	assert.Equal(t, GRESUsage{}, (*Node)(nil).GPUUsage())
+}
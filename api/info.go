@@ -3,6 +3,8 @@
 
 package api
 
+import "time"
+
 // ClusterInfo represents cluster information (mirrors interfaces.ClusterInfo)
 type ClusterInfo struct {
 	Version     string `json:"version"`
@@ -33,3 +35,37 @@ type APIVersion struct {
 	Description string `json:"description"`
 	Deprecated  bool   `json:"deprecated"`
 }
+
+// VersionInfo gathers the versions involved in talking to a cluster.
+// slurmrestd does not expose the release slurmctld runs: the meta block of
+// every answer carries the build of slurmrestd itself. The controller's
+// release is read from "scontrol show config" when the CLI fallback is
+// configured. The release of slurmdbd is not reported by either.
+type VersionInfo struct {
+	// RestdVersion is the slurmrestd version in the meta block of its
+	// answers, e.g. "24.11.1"
+	RestdVersion string `json:"restd_version"`
+	// ControllerVersion is the SLURM_VERSION slurmctld reports to
+	// "scontrol show config"; empty without the CLI fallback
+	ControllerVersion string `json:"controller_version,omitempty"`
+	// PluginVersion is the slurmrestd data parser plugin that answered,
+	// e.g. "data_parser/v0.0.44"
+	PluginVersion string `json:"plugin_version"`
+	// ClientVersion is the REST API version the client speaks, e.g. "v0.0.44"
+	ClientVersion string `json:"client_version"`
+}
+
+// PingResult is the answer of one slurmctld to a ping. Clusters with a
+// backup controller report one result per controller.
+type PingResult struct {
+	Hostname string `json:"hostname"`
+	// Pinged reports whether the controller answered
+	Pinged bool `json:"pinged"`
+	// Mode is the controller's operating mode, e.g. "primary" or "backup"
+	Mode string `json:"mode,omitempty"`
+	// Primary reports whether the controller is acting as primary
+	Primary bool `json:"primary"`
+	// Latency is how long the ping took, or how long it waited before
+	// timing out
+	Latency time.Duration `json:"latency"`
+}
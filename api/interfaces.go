@@ -8,6 +8,7 @@ package api
 
 import (
 	"context"
+	"time"
 )
 
 // ============================================================================
@@ -22,6 +23,11 @@ type SlurmClient interface {
 	// Capabilities returns the features supported by this client version
 	Capabilities() ClientCapabilities
 
+	// ImplementedMethods returns, per manager accessor such as "Jobs", the
+	// methods this client version implements rather than rejecting as
+	// unsupported
+	ImplementedMethods() map[string][]string
+
 	// Jobs returns the JobManager for this version
 	Jobs() JobManager
 
@@ -91,8 +97,31 @@ type SlurmClient interface {
 	// Reconfigure triggers a SLURM reconfiguration
 	Reconfigure(ctx context.Context) (*ReconfigureResponse, error)
 
+	// Warmup opens n connections to the cluster ahead of the first
+	// requests, so they don't pay for connection setup and TLS handshakes
+	Warmup(ctx context.Context, n int) error
+
+	// Versions returns the slurmrestd version, the slurmctld version when
+	// the CLI fallback is configured, the REST plugin version and the API
+	// version the client negotiated, for diagnostics
+	Versions(ctx context.Context) (*VersionInfo, error)
+
+	// CheckSLO runs a small probe workload against the cluster and reports
+	// whether its p95 latency and error rate meet slo
+	CheckSLO(ctx context.Context, slo SLOConfig) (*SLOResult, error)
+
+	// Events returns the channel the client reports retries, rate-limit
+	// waits, circuit-breaker transitions and credential refreshes on.
+	// Events are dropped rather than delaying requests when it is not read.
+	Events() <-chan ClientEvent
+
 	// Close closes the client and any resources
 	Close() error
+
+	// Shutdown stops background watchers, waits for in-flight requests until
+	// ctx is done, flushes buffered metrics and logs, and closes the client.
+	// It is safe to call more than once.
+	Shutdown(ctx context.Context) error
 }
 
 // ============================================================================
@@ -102,8 +131,29 @@ type SlurmClient interface {
 // JobReader provides read-only job operations
 type JobReader interface {
 	List(ctx context.Context, opts *ListJobsOptions) (*JobList, error)
+	// ListAll returns every job matching the filters in a single request,
+	// failing when more than the configured maximum match
+	ListAll(ctx context.Context, opts *ListAllJobsOptions) ([]Job, error)
 	Get(ctx context.Context, jobID string) (*Job, error)
-	// Note: Job steps are available via Job.Steps field from Get() - no separate endpoint exists
+	// GetWithOptions is Get with lookup options, such as merging the
+	// accounting record of a finished job
+	GetWithOptions(ctx context.Context, jobID string, opts *GetJobOptions) (*Job, error)
+	// ListArrayTasks returns one record per task of a job array, expanding
+	// pending tasks that Slurm reports as a single collapsed record
+	ListArrayTasks(ctx context.Context, arrayJobID string) ([]*Job, error)
+	// Script returns the batch script of a job as stored by Slurm
+	Script(ctx context.Context, jobID string) (string, error)
+	// ListByTag returns jobs whose admin comment carries the key=value tag
+	ListByTag(ctx context.Context, key, value string) ([]*Job, error)
+	// ListSteps returns the steps of a running or completed job, as recorded
+	// in the accounting database
+	ListSteps(ctx context.Context, jobID string) ([]JobStep, error)
+	// TRESUsage returns the resources a job consumed per step and per node,
+	// as recorded in the accounting database
+	TRESUsage(ctx context.Context, jobID string) (*TRESUsageReport, error)
+	// History returns the jobs recorded in the accounting database,
+	// including those slurmctld has already purged from the queue
+	History(ctx context.Context, opts *JobHistoryOptions) ([]Job, error)
 }
 
 // JobWriter provides job mutation operations
@@ -116,6 +166,9 @@ type JobWriter interface {
 	// dependencies, constraints, etc.) without the lossy conversion of Submit.
 	SubmitRaw(ctx context.Context, job *JobCreate) (*JobSubmitResponse, error)
 	Update(ctx context.Context, jobID string, update *JobUpdate) error
+	// Validate checks a submission without submitting it and reports every
+	// problem found, not just the first
+	Validate(ctx context.Context, job *JobSubmission) (*ValidationResult, error)
 }
 
 // JobController provides job control operations
@@ -123,14 +176,41 @@ type JobController interface {
 	Cancel(ctx context.Context, jobID string) error
 	Hold(ctx context.Context, jobID string) error
 	Release(ctx context.Context, jobID string) error
-	Signal(ctx context.Context, jobID string, signal string) error
+	// Signal sends a signal to a job, given by name ("SIGUSR1", "USR1")
+	// or number ("10")
+	Signal(ctx context.Context, jobID string, signal string, opts *SignalOptions) error
 	Notify(ctx context.Context, jobID string, message string) error
-	Requeue(ctx context.Context, jobID string) error
+	// Requeue puts a running or finished batch job back in the queue. A
+	// job Slurm refuses to requeue in its state gives a
+	// NotRequeueableError from pkg/errors. RequeueOptions.Hold is a
+	// separate request after the requeue, not an atomic requeue-and-hold.
+	Requeue(ctx context.Context, jobID string, opts *RequeueOptions) error
+	// CancelByName cancels all pending and running jobs with the given name
+	// (scancel --name) and returns how many were cancelled
+	CancelByName(ctx context.Context, name string) (int, error)
+	// CancelByUser cancels all pending and running jobs of the given user
+	// (scancel --user) and returns how many were cancelled
+	CancelByUser(ctx context.Context, user string) (int, error)
+	// CancelAndWait cancels the jobs and returns once every one of them has
+	// reached a terminal state and released its nodes
+	CancelAndWait(ctx context.Context, jobIDs []string, opts *CancelAndWaitOptions) error
+	// CancelIfPending cancels the job only if it has not started yet and
+	// reports whether it was cancelled
+	CancelIfPending(ctx context.Context, jobID string) (bool, error)
+	// CancelStep cancels one step of a running job, such as an srun in an
+	// interactive allocation, without ending the job (scancel <job>.<step>)
+	CancelStep(ctx context.Context, jobID, stepID string) error
 }
 
 // JobWatcher provides real-time job operations
 type JobWatcher interface {
 	Watch(ctx context.Context, opts *WatchJobsOptions) (<-chan JobEvent, error)
+	// WaitForArray polls a job array until every task has finished and
+	// returns each task's final state with a summary
+	WaitForArray(ctx context.Context, arrayJobID string, opts *WaitForArrayOptions) (*ArrayResult, error)
+	// RunArray submits base as a job array over arraySpec, such as "0-99%10",
+	// waits for every task and reports each task's exit code
+	RunArray(ctx context.Context, base *JobSubmission, arraySpec string, opts *RunArrayOptions) (*ArrayRunReport, error)
 	Allocate(ctx context.Context, req *JobAllocateRequest) (*JobAllocateResponse, error)
 }
 
@@ -151,9 +231,27 @@ type NodeManager interface {
 	Get(ctx context.Context, nodeName string) (*Node, error)
 	Update(ctx context.Context, nodeName string, update *NodeUpdate) error
 	Delete(ctx context.Context, nodeName string) error
+	// Drain stops new jobs from starting on a node; Slurm requires a reason
 	Drain(ctx context.Context, nodeName string, reason string) error
+	// DrainNodes drains each of the named nodes with the same reason
+	DrainNodes(ctx context.Context, nodeNames []string, reason string) error
+	// Resume returns a drained, down or failed node to service; it does
+	// nothing for a node that is already available
 	Resume(ctx context.Context, nodeName string) error
+	// Reboot asks slurmctld to reboot a node, by default once it is idle
+	Reboot(ctx context.Context, nodeName string, opts *RebootOptions) error
 	Watch(ctx context.Context, opts *WatchNodesOptions) (<-chan NodeEvent, error)
+	// PowerUsage returns the latest power readings of every node that
+	// reports them, keyed by node name
+	PowerUsage(ctx context.Context) (map[string]PowerStats, error)
+	// SetPowerState asks for a node to be powered up (NodePowerUp) or down
+	// (NodePowerDown)
+	SetPowerState(ctx context.Context, nodeName string, state NodePowerState) error
+	// GetPowerState returns a node's current power status
+	GetPowerState(ctx context.Context, nodeName string) (NodePowerState, error)
+	// WaitForState polls until every named node is in state, such as IDLE
+	// after a reboot
+	WaitForState(ctx context.Context, nodes []string, state NodeState, opts *WaitForNodeStateOptions) error
 }
 
 // ============================================================================
@@ -176,9 +274,20 @@ type PartitionManager interface {
 type InfoManager interface {
 	Get(ctx context.Context) (*ClusterInfo, error)
 	Ping(ctx context.Context) error
+	// PingControllers pings the cluster and returns the answer of each
+	// slurmctld, so a down backup controller is visible
+	PingControllers(ctx context.Context) ([]PingResult, error)
 	PingDatabase(ctx context.Context) error
 	Stats(ctx context.Context) (*ClusterStats, error)
 	Version(ctx context.Context) (*APIVersion, error)
+	// TRESList returns the TRES defined on the cluster (ID, type and name),
+	// so TRES IDs in accounting data can be mapped to names. The table is
+	// read from slurmdbd once and cached for the life of the client.
+	TRESList(ctx context.Context) ([]TRES, error)
+	// QueueDepthHistory samples the controller diagnostics over window and
+	// returns how the pending job count and backfill depth moved, oldest
+	// sample first. It blocks for the whole window.
+	QueueDepthHistory(ctx context.Context, window time.Duration) ([]QueueDepthSample, error)
 }
 
 // ============================================================================
@@ -191,6 +300,24 @@ type ReservationManager interface {
 	Create(ctx context.Context, reservation *ReservationCreate) (*ReservationCreateResponse, error)
 	Update(ctx context.Context, reservationName string, update *ReservationUpdate) error
 	Delete(ctx context.Context, reservationName string) error
+	// Jobs returns the pending and running jobs that use the reservation
+	Jobs(ctx context.Context, reservationName string) ([]*Job, error)
+	// Skip cancels the next occurrence of a recurring reservation
+	Skip(ctx context.Context, reservationName string) error
+	// StartNow moves the start of the reservation to the current time
+	StartNow(ctx context.Context, reservationName string) error
+	// DrainJobs cancels, or requeues, every job using the reservation and
+	// returns how many were handled
+	DrainJobs(ctx context.Context, reservationName string, opts *DrainReservationJobsOptions) (int, error)
+	// ExportICal returns the reservations as an iCalendar feed that
+	// calendar apps can subscribe to
+	ExportICal(ctx context.Context, opts *ExportICalOptions) (string, error)
+	// ReserveLicenses holds count of the named license for ttl in a
+	// license-only reservation; the returned token releases them. Creating
+	// the reservation needs Slurm operator or admin rights.
+	ReserveLicenses(ctx context.Context, license string, count int, ttl time.Duration, opts *ReserveLicensesOptions) (*LicenseReservation, error)
+	// ReleaseLicenses ends a reservation made by ReserveLicenses
+	ReleaseLicenses(ctx context.Context, token string) error
 }
 
 // ============================================================================
@@ -215,6 +342,12 @@ type AccountManager interface {
 	Create(ctx context.Context, account *AccountCreate) (*AccountCreateResponse, error)
 	Update(ctx context.Context, accountName string, update *AccountUpdate) error
 	Delete(ctx context.Context, accountName string) error
+	// Subtree returns the account hierarchy below root, built from a single
+	// listing of the associations rather than one request per level
+	Subtree(ctx context.Context, root string) (*AccountTree, error)
+	// FindEmpty returns the leaf accounts that have neither users nor jobs
+	// in the queue
+	FindEmpty(ctx context.Context) ([]*Account, error)
 }
 
 // ============================================================================
@@ -227,6 +360,13 @@ type UserManager interface {
 	Create(ctx context.Context, user *UserCreate) (*UserCreateResponse, error)
 	Update(ctx context.Context, userName string, update *UserUpdate) error
 	Delete(ctx context.Context, userName string) error
+	// AllowedQoS returns the QoS names the user may request under the given
+	// account, derived from the user and account associations
+	AllowedQoS(ctx context.Context, userName, accountName string) ([]string, error)
+	// EffectiveLimits merges the limits of the user's associations, their
+	// accounts, QoS and partitions into the tightest limit per resource
+	// along each association and QoS, and the loosest across them
+	EffectiveLimits(ctx context.Context, userName string) (*UserEffectiveLimits, error)
 }
 
 // ============================================================================
@@ -251,6 +391,9 @@ type AssociationManager interface {
 	Create(ctx context.Context, associations []*AssociationCreate) (*AssociationCreateResponse, error)
 	Update(ctx context.Context, associations []*AssociationUpdate) error
 	Delete(ctx context.Context, associationID string) error
+	// FindOrphans returns the associations that refer to a user, account or
+	// partition that no longer exists
+	FindOrphans(ctx context.Context) ([]*Association, error)
 }
 
 // ============================================================================
@@ -62,7 +62,7 @@ type Job struct {
 	HetJobIDSet *string `json:"het_job_id_set,omitempty"` // Job ID range for all heterogeneous job components
 	HetJobOffset *uint32 `json:"het_job_offset,omitempty"` // Unique sequence number applied to this component of the heterogeneous job (32...
 	Hold *bool `json:"hold,omitempty"` // Hold (true) or release (false) job (Job held)
-	JobID *int32 `json:"job_id,omitempty"` // Job ID
+	JobID *int64 `json:"job_id,omitempty"` // Job ID
 	JobResources *JobResources `json:"job_resources,omitempty"` // Resources used by the job
 	JobSizeStr []string `json:"job_size_str,omitempty"` // Number of nodes (in a range) required for this job
 	JobState []JobState `json:"job_state,omitempty"` // Current state
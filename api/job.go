@@ -8,6 +8,8 @@ package api
 
 import (
 	"time"
+
+	"github.com/jontk/slurm-client/pkg/hostlist"
 )
 
 // ResourceRequests represents the resource requirements for a job
@@ -22,12 +24,14 @@ type ResourceRequests struct {
 	Threads       int32 `json:"threads_per_core,omitempty"`
 }
 
-// JobDependency represents a job dependency (helper type for user convenience)
+// JobDependency represents a job dependency (helper type for user convenience).
+// Type is one of after, afterany, afterok, afternotok, aftercorr or
+// singleton; singleton takes no job IDs.
 // Note: When using the generated JobCreate, specify dependencies as a string
 // in SLURM dependency format (e.g., "afterok:123:456")
 type JobDependency struct {
 	Type   string  `json:"type"`
-	JobIDs []int32 `json:"job_ids,omitempty"`
+	JobIDs []int64 `json:"job_ids,omitempty"`
 	State  string  `json:"state,omitempty"`
 }
 
@@ -44,11 +48,24 @@ type JobUpdate = JobCreate
 
 // JobSubmitResponse represents the response from job submission
 type JobSubmitResponse struct {
-	JobId            int32    `json:"job_id"`          // Matches OpenAPI: JobId *int32
+	JobId            int64    `json:"job_id"`            // Matches OpenAPI: JobId *int64
 	StepId           string   `json:"step_id,omitempty"` // Matches OpenAPI casing
 	JobSubmitUserMsg string   `json:"job_submit_user_msg,omitempty"`
 	Error            []string `json:"error,omitempty"`
 	Warning          []string `json:"warning,omitempty"`
+	// HetJobID is the ID of a heterogeneous job, that of its first
+	// component; zero for other jobs
+	HetJobID int64 `json:"het_job_id,omitempty"`
+	// HetJobComponents lists the components of a heterogeneous job in
+	// submission order
+	HetJobComponents []HetJobComponent `json:"het_job_components,omitempty"`
+}
+
+// HetJobComponent identifies one component of a heterogeneous job. Slurm
+// gives each component its own job ID, HetJobID plus Offset.
+type HetJobComponent struct {
+	Offset int32 `json:"offset"`
+	JobID  int64 `json:"job_id"`
 }
 
 // JobCancelRequest represents the request to cancel a job
@@ -72,11 +89,26 @@ type JobListOptions struct {
 	States       []JobState `json:"states,omitempty"`
 	Partitions   []string   `json:"partitions,omitempty"`
 	QoS          []string   `json:"qos,omitempty"`
-	JobIDs       []int32    `json:"job_ids,omitempty"`
+	JobIDs       []int64    `json:"job_ids,omitempty"`
 	JobNames     []string   `json:"job_names,omitempty"`
+	// ArrayJobIDs matches the tasks of the given job arrays, including the
+	// record of their pending tasks. Applied client-side.
+	ArrayJobIDs []int64 `json:"array_job_ids,omitempty"`
+	// Reasons matches jobs whose StateReason is one of the given codes
+	// (e.g. "Priority", "QOSMaxJobsPerUserLimit"). Applied client-side.
+	Reasons []string `json:"reasons,omitempty"`
+	// HeldOnly matches only held jobs (see Job.HeldBy). Applied client-side.
+	HeldOnly  bool       `json:"held_only,omitempty"`
 	StartTime *time.Time `json:"start_time,omitempty"`
 	EndTime   *time.Time `json:"end_time,omitempty"`
 
+	// SubmittedAfter, StartedAfter and EndBefore match jobs by their submit,
+	// start and end times. They are applied client-side, and a job without
+	// the timestamp does not match; see MatchesTimeWindows.
+	SubmittedAfter *time.Time `json:"submitted_after,omitempty"`
+	StartedAfter   *time.Time `json:"started_after,omitempty"`
+	EndBefore      *time.Time `json:"end_before,omitempty"`
+
 	// Limit specifies the maximum number of jobs to return.
 	// WARNING: Due to SLURM REST API limitations, this is CLIENT-SIDE pagination.
 	// The full job list is fetched from the server, then sliced. For large clusters
@@ -89,6 +121,9 @@ type JobListOptions struct {
 	Offset int `json:"offset,omitempty"`
 
 	IncludeSteps bool `json:"include_steps,omitempty"`
+
+	// Flags are passed to slurmrestd as the "flags" query parameter
+	Flags []QueryFlag `json:"flags,omitempty"`
 }
 
 // JobList represents a list of jobs
@@ -97,26 +132,124 @@ type JobList struct {
 	Total int   `json:"total"`
 }
 
+// NodeNames returns the job's allocated nodes with the compressed Nodes
+// hostlist (e.g. "node[01-03]") expanded to one name per node. It returns
+// nil for jobs without an allocation.
+func (j *Job) NodeNames() ([]string, error) {
+	if j == nil || j.Nodes == nil {
+		return nil, nil
+	}
+	return hostlist.Expand(*j.Nodes)
+}
+
+// RequeueCount returns how many times the job has been requeued or
+// restarted (RestartCnt), or 0 when Slurm did not report it.
+func (j *Job) RequeueCount() int {
+	if j == nil || j.RestartCnt == nil {
+		return 0
+	}
+	return int(*j.RestartCnt)
+}
+
+// MatchesTimeWindows reports whether job falls inside the SubmittedAfter,
+// StartedAfter and EndBefore windows. A pending job's StartTime is only the
+// scheduler's estimate, so pending jobs never match StartedAfter; the
+// EndTime of a running job is when its time limit runs out.
+func (o *JobListOptions) MatchesTimeWindows(job *Job) bool {
+	if o == nil {
+		return true
+	}
+	if o.SubmittedAfter != nil && !job.SubmitTime.After(*o.SubmittedAfter) {
+		return false
+	}
+	if o.StartedAfter != nil {
+		if job.StartTime.IsZero() || !job.StartTime.After(*o.StartedAfter) || job.hasState(JobStatePending) {
+			return false
+		}
+	}
+	if o.EndBefore != nil && (job.EndTime.IsZero() || !job.EndTime.Before(*o.EndBefore)) {
+		return false
+	}
+	return true
+}
+
+// MatchesArrayJobIDs reports whether job is a task of one of the
+// ArrayJobIDs arrays
+func (o *JobListOptions) MatchesArrayJobIDs(job *Job) bool {
+	if o == nil || len(o.ArrayJobIDs) == 0 {
+		return true
+	}
+	for _, id := range o.ArrayJobIDs {
+		if job.ArrayJobID != nil && int64(*job.ArrayJobID) == id {
+			return true
+		}
+		// The array's own record may report ArrayJobID as 0 in older versions
+		if job.JobID != nil && *job.JobID == id && job.ArrayTaskString != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasState reports whether state is one of the job's states
+func (j *Job) hasState(state JobState) bool {
+	for _, s := range j.JobState {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
 // JobSignalRequest represents a request to signal a job
 type JobSignalRequest struct {
 	Signal string `json:"signal"`
-	JobId  int32  `json:"job_id"`  // Matches OpenAPI casing
+	JobId  int64  `json:"job_id"`  // Matches OpenAPI casing
 	StepId string `json:"step_id,omitempty"`
+	// BatchOnly signals only the batch script's shell, not its steps
+	BatchOnly bool `json:"batch_only,omitempty"`
+}
+
+// SignalOptions configures Jobs().Signal. Without options the signal goes
+// to every step of the job, as with scancel --signal.
+type SignalOptions struct {
+	// StepID signals only this step of the job, e.g. "0" or "batch"
+	// (scancel --signal <job>.<step>). slurmrestd cannot address single
+	// steps, so this needs the Slurm CLI fallback.
+	StepID string `json:"step_id,omitempty"`
+	// BatchOnly signals only the shell running the batch script, which
+	// can trap it and pass it on (scancel --batch)
+	BatchOnly bool `json:"batch_only,omitempty"`
 }
 
 // JobHoldRequest represents a request to hold/release a job
 type JobHoldRequest struct {
-	JobId    int32 `json:"job_id"`  // Matches OpenAPI casing
+	JobId    int64 `json:"job_id"`  // Matches OpenAPI casing
 	Hold     bool  `json:"hold"`
 	Priority int32 `json:"priority,omitempty"`
 }
 
 // JobNotifyRequest represents a request to notify a job
 type JobNotifyRequest struct {
-	JobId   int32  `json:"job_id"`  // Matches OpenAPI casing
+	JobId   int64  `json:"job_id"`  // Matches OpenAPI casing
 	Message string `json:"message"`
 }
 
+// RequeueOptions configures Jobs().Requeue.
+type RequeueOptions struct {
+	// Hold holds the job once it is back in the queue, so it does not
+	// start again until released. Unlike scontrol requeuehold this is a
+	// requeue followed by a separate hold, not one atomic operation: the
+	// scheduler may start the job in between, and a failed hold leaves
+	// the job requeued but not held.
+	Hold bool `json:"hold,omitempty"`
+	// Increment makes Requeue check that slurmctld bumped the job's
+	// restart count (Job.RequeueCount) for this requeue, so max-retry
+	// logic built on the count cannot miss a restart. The count is read
+	// before and after the requeue; an unchanged count is a CONFLICT error.
+	Increment bool `json:"increment,omitempty"`
+}
+
 // JobAllocateRequest represents a request to allocate resources for a job
 type JobAllocateRequest struct {
 	// Job specification
@@ -152,7 +285,7 @@ type JobAllocateRequest struct {
 
 // JobAllocateResponse represents the response from a job allocation request
 type JobAllocateResponse struct {
-	JobId   int32  `json:"job_id"`  // Matches OpenAPI casing
+	JobId   int64  `json:"job_id"`  // Matches OpenAPI casing
 	Status  string `json:"status"`
 	Message string `json:"message,omitempty"`
 
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// JobPriorityComponents is how a queued job's priority is made up, as far
+// as slurmrestd reports it. slurmrestd returns neither sprio's per-factor
+// breakdown (age, fairshare, job size, partition, QoS, TRES) nor the
+// priority weights for real jobs in any supported version, so those are
+// only available as estimates from Users().CalculateJobPriority.
+type JobPriorityComponents struct {
+	// Priority is the job's priority in the partition it is queued in
+	Priority uint32 `json:"priority"`
+	// Nice is the adjustment requested with --nice; a positive value lowers
+	// the priority by that much
+	Nice int32 `json:"nice,omitempty"`
+	// ByPartition is the priority the job has in each partition it was
+	// submitted to, which differs between partitions by their
+	// PriorityJobFactor. Reported from v0.0.42 for multi-partition jobs.
+	ByPartition map[string]int32 `json:"by_partition,omitempty"`
+}
+
+// PriorityComponents returns the priority components reported for the job,
+// or nil if no priority was reported
+func (j *Job) PriorityComponents() *JobPriorityComponents {
+	if j.Priority == nil {
+		return nil
+	}
+	components := &JobPriorityComponents{Priority: *j.Priority}
+	if j.Nice != nil {
+		components.Nice = *j.Nice
+	}
+	for _, p := range j.PriorityByPartition {
+		if p.Partition == nil || p.Priority == nil {
+			continue
+		}
+		if components.ByPartition == nil {
+			components.ByPartition = make(map[string]int32, len(j.PriorityByPartition))
+		}
+		components.ByPartition[*p.Partition] = *p.Priority
+	}
+	return components
+}
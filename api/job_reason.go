@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import "strings"
+
+// reasonExplanations maps Slurm job state reason codes (as shown in the
+// NODELIST(REASON) column of squeue) to a short explanation
+var reasonExplanations = map[string]string{
+	"None":                            "No reason is recorded for the job's current state",
+	"Resources":                       "The job is waiting for resources to become available",
+	"Priority":                        "One or more higher priority jobs are queued ahead of this job",
+	"Dependency":                      "The job is waiting for a job it depends on to complete",
+	"DependencyNeverSatisfied":        "The job's dependency can never be satisfied",
+	"BeginTime":                       "The job's earliest start time has not been reached",
+	"JobHeldUser":                     "The job is held by its owner",
+	"JobHeldAdmin":                    "The job is held by an administrator",
+	"PartitionDown":                   "The partition requested by the job is down",
+	"PartitionInactive":               "The partition requested by the job is inactive and cannot start jobs",
+	"PartitionNodeLimit":              "The job requests more or fewer nodes than the partition allows",
+	"PartitionTimeLimit":              "The job's time limit exceeds the partition's limit",
+	"PartitionConfig":                 "The job requests more resources than the partition is configured with",
+	"NodeDown":                        "A node required by the job is down",
+	"BadConstraints":                  "The job's constraints cannot be satisfied by any node",
+	"ReqNodeNotAvail":                 "Some nodes specifically required by the job are not currently available",
+	"Reservation":                     "The job is waiting for its advanced reservation to become available",
+	"Licenses":                        "The job is waiting for a license",
+	"Cleaning":                        "The job is being requeued and is still cleaning up from its previous run",
+	"Prolog":                          "The job's prolog program is still running",
+	"launch failed requeued held":     "The job launch failed and it was requeued in a held state",
+	"NonZeroExitCode":                 "The job terminated with a non-zero exit code",
+	"TimeLimit":                       "The job exhausted its time limit",
+	"OutOfMemory":                     "The job ran out of memory",
+	"SystemFailure":                   "The job failed because of a failure in the Slurm system",
+	"InactiveLimit":                   "The job reached the system inactive limit",
+	"InvalidAccount":                  "The job's account is invalid",
+	"InvalidQOS":                      "The job's QOS is invalid",
+	"QOSUsageThreshold":               "The required QOS threshold has been breached",
+	"QOSJobLimit":                     "The job's QOS has reached its maximum job count",
+	"QOSResourceLimit":                "The job's QOS has reached a resource limit",
+	"QOSTimeLimit":                    "The job's QOS has reached its time limit",
+	"AssocGrpCpuLimit":                "The job's association has reached its aggregate CPU limit",
+	"AssocGrpJobsLimit":               "The job's association has reached its maximum number of running jobs",
+	"AssocGrpNodeLimit":               "The job's association has reached its aggregate node limit",
+	"AssocGrpMemLimit":                "The job's association has reached its aggregate memory limit",
+	"AssocGrpSubmitJobsLimit":         "The job's association has reached its maximum number of queued jobs",
+	"AssocGrpCPUMinutesLimit":         "The job's association has used up its CPU minutes",
+	"AssocMaxJobsLimit":               "The user has reached the maximum number of running jobs for the association",
+	"AssocMaxWallDurationPerJobLimit": "The job's time limit exceeds the association's maximum wall time",
+	"QOSGrpCpuLimit":                  "The job's QOS has reached its aggregate CPU limit",
+	"QOSGrpJobsLimit":                 "The job's QOS has reached its maximum number of running jobs",
+	"QOSGrpNodeLimit":                 "The job's QOS has reached its aggregate node limit",
+	"QOSGrpMemLimit":                  "The job's QOS has reached its aggregate memory limit",
+	"QOSGrpGRES":                      "The job's QOS has reached its aggregate GRES limit",
+	"QOSMaxCpuPerUserLimit":           "The user has reached the maximum number of CPUs allowed per user by the QOS",
+	"QOSMaxCpuPerJobLimit":            "The job requests more CPUs than the QOS allows per job",
+	"QOSMaxNodePerUserLimit":          "The user has reached the maximum number of nodes allowed per user by the QOS",
+	"QOSMaxNodePerJobLimit":           "The job requests more nodes than the QOS allows per job",
+	"QOSMaxMemoryPerUser":             "The user has reached the maximum memory allowed per user by the QOS",
+	"QOSMaxJobsPerUserLimit":          "The user has reached the maximum number of running jobs allowed by the QOS",
+	"QOSMaxSubmitJobPerUserLimit":     "The user has reached the maximum number of queued jobs allowed by the QOS",
+	"QOSMaxWallDurationPerJobLimit":   "The job's time limit exceeds the QOS's maximum wall time",
+	"QOSMaxGRESPerUser":               "The user has reached the maximum GRES allowed per user by the QOS",
+	"QOSMinGRES":                      "The job requests fewer GRES than the QOS requires",
+	"FrontEndDown":                    "No front end node is available to run the job",
+	"JobArrayTaskLimit":               "The job array has reached its limit of simultaneously running tasks",
+	"MaxRequeue":                      "The job has been requeued the maximum number of times",
+	"SchedDefer":                      "Immediate scheduling is deferred by the scheduler configuration",
+	"PowerUpNode":                     "A node allocated to the job is powering up",
+	"NodeNotAvail":                    "A node allocated to the job is not available",
+	"Deadline":                        "The job cannot complete before its deadline",
+	"BurstBufferResources":            "The job is waiting for burst buffer resources",
+	"BurstBufferStageIn":              "The job's burst buffer is staging in data",
+	"BurstBufferOperation":            "A burst buffer operation for the job failed",
+	"AccountNotAllowed":               "The job's account is not allowed to use the requested partition",
+	"QOSNotAllowed":                   "The job's QOS is not allowed to use the requested partition",
+}
+
+// ExplainReason returns a human-readable explanation of a Slurm job state
+// reason code such as "Resources" or "QOSMaxCpuPerUserLimit". Matching is
+// case-insensitive. Unknown codes are returned as-is so callers can always
+// display the result.
+func ExplainReason(code string) string {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ""
+	}
+	if text, ok := reasonExplanations[code]; ok {
+		return text
+	}
+	for known, text := range reasonExplanations {
+		if strings.EqualFold(known, code) {
+			return text
+		}
+	}
+	return code
+}
+
+// Holders of a job, as returned by Job.HeldBy
+const (
+	JobHeldByUser  = "user"
+	JobHeldByAdmin = "admin"
+)
+
+// HeldBy returns JobHeldByUser for a job its owner has held,
+// JobHeldByAdmin for a job held by an administrator or by Slurm after a
+// failed launch, and "" for a job that is not held. Slurm holds a job by
+// setting its priority to zero, which slurmrestd reports as hold; any hold
+// without the JobHeldUser reason is an administrator's.
+func (j *Job) HeldBy() string {
+	if j == nil {
+		return ""
+	}
+	switch reason := derefReason(j.StateReason); {
+	case strings.EqualFold(reason, "JobHeldUser"):
+		return JobHeldByUser
+	case strings.EqualFold(reason, "JobHeldAdmin"), strings.EqualFold(reason, "launch failed requeued held"):
+		return JobHeldByAdmin
+	}
+	if j.Hold != nil && *j.Hold {
+		return JobHeldByAdmin
+	}
+	return ""
+}
+
+// HoldReason returns why a held job is held: the state description when
+// Slurm recorded one, otherwise an explanation of its reason code. It is ""
+// for a job that is not held.
+func (j *Job) HoldReason() string {
+	if j.HeldBy() == "" {
+		return ""
+	}
+	if description := strings.TrimSpace(derefReason(j.StateDescription)); description != "" {
+		return description
+	}
+	return ExplainReason(derefReason(j.StateReason))
+}
+
+func derefReason(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ReasonExplanation returns a human-readable explanation of the job's
+// StateReason, or "" if the job has no reason set
+func (j *Job) ReasonExplanation() string {
+	if j.StateReason == nil {
+		return ""
+	}
+	return ExplainReason(*j.StateReason)
+}
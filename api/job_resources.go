@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ResourceSpec is a job's resources in one place: CPUs, memory, nodes and
+// generic resources, as totals for the whole job.
+type ResourceSpec struct {
+	CPUs     int   `json:"cpus,omitempty"`
+	MemoryMB int64 `json:"memory_mb,omitempty"`
+	Nodes    int   `json:"nodes,omitempty"`
+	// GRES counts generic resources by name, e.g. "gpu" for all GPUs and
+	// "gpu:a100" for GPUs of one type
+	GRES map[string]int64 `json:"gres,omitempty"`
+}
+
+// GPUs returns the total number of GPUs in the spec
+func (s ResourceSpec) GPUs() int64 {
+	return s.GRES["gpu"]
+}
+
+// IsZero reports whether the spec holds no resources
+func (s ResourceSpec) IsZero() bool {
+	return s.CPUs == 0 && s.MemoryMB == 0 && s.Nodes == 0 && len(s.GRES) == 0
+}
+
+// ParseTRESSpec reads a TRES string such as
+// "cpu=8,mem=32G,node=2,billing=8,gres/gpu=4,gres/gpu:a100=4". TRES types
+// other than cpu, mem, node and gres are ignored.
+func ParseTRESSpec(tres string) ResourceSpec {
+	var spec ResourceSpec
+	for _, item := range strings.Split(tres, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "cpu":
+			n, _ := strconv.Atoi(value)
+			spec.CPUs = n
+		case name == "mem":
+			spec.MemoryMB = parseMemoryMB(value)
+		case name == "node":
+			n, _ := strconv.Atoi(value)
+			spec.Nodes = n
+		case strings.HasPrefix(name, "gres/"):
+			if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+				spec.addGRES(strings.TrimPrefix(name, "gres/"), n)
+			}
+		}
+	}
+	return spec
+}
+
+// Requested returns the resources the job asked for. It reads the TRES
+// request string when the API reports one and otherwise derives the totals
+// from the CPU, node, memory and per-node TRES fields.
+func (j *Job) Requested() ResourceSpec {
+	if j == nil {
+		return ResourceSpec{}
+	}
+	if j.TRESReqStr != nil && *j.TRESReqStr != "" {
+		return ParseTRESSpec(*j.TRESReqStr)
+	}
+
+	var spec ResourceSpec
+	if j.CPUs != nil {
+		spec.CPUs = int(*j.CPUs)
+	}
+	if j.NodeCount != nil {
+		spec.Nodes = int(*j.NodeCount)
+	}
+	nodes := int64(spec.Nodes)
+	if nodes == 0 {
+		nodes = 1
+	}
+	switch {
+	case j.MemoryPerNode != nil && *j.MemoryPerNode > 0:
+		spec.MemoryMB = int64(*j.MemoryPerNode) * nodes
+	case j.MemoryPerCPU != nil && *j.MemoryPerCPU > 0:
+		spec.MemoryMB = int64(*j.MemoryPerCPU) * int64(spec.CPUs)
+	}
+	if j.TRESPerNode != nil {
+		// Per-node requests are written "gres/gpu:2" or "gres/gpu:a100:2"
+		for _, item := range strings.Split(*j.TRESPerNode, ",") {
+			item = strings.TrimPrefix(strings.TrimSpace(item), "gres/")
+			i := strings.LastIndex(item, ":")
+			if i < 0 {
+				continue
+			}
+			n, err := strconv.ParseInt(item[i+1:], 10, 64)
+			if err != nil {
+				continue
+			}
+			name := item[:i]
+			spec.addGRES(name, n*nodes)
+			if base, _, typed := strings.Cut(name, ":"); typed {
+				spec.addGRES(base, n*nodes)
+			}
+		}
+	}
+	return spec
+}
+
+// Allocated returns the resources the job was given, read from the TRES
+// allocation string, or from the job's resources and node list when the
+// API does not report one. It is zero for jobs that have not started.
+func (j *Job) Allocated() ResourceSpec {
+	if j == nil {
+		return ResourceSpec{}
+	}
+	if j.TRESAllocStr != nil && *j.TRESAllocStr != "" {
+		return ParseTRESSpec(*j.TRESAllocStr)
+	}
+
+	var spec ResourceSpec
+	if j.JobResources != nil {
+		spec.CPUs = int(j.JobResources.CPUs)
+		if j.JobResources.Nodes != nil && j.JobResources.Nodes.Count != nil {
+			spec.Nodes = int(*j.JobResources.Nodes.Count)
+		}
+	}
+	if spec.Nodes == 0 {
+		if names, err := j.NodeNames(); err == nil {
+			spec.Nodes = len(names)
+		}
+	}
+	return spec
+}
+
+// RequestedNodes returns the number of nodes the job asked for. Every API
+// version reports node_count, but once a job has started it holds the
+// number of nodes allocated, so the node count in the TRES request string
+// is preferred when there is one.
+func (j *Job) RequestedNodes() int {
+	if j == nil {
+		return 0
+	}
+	if j.TRESReqStr != nil {
+		if n := ParseTRESSpec(*j.TRESReqStr).Nodes; n > 0 {
+			return n
+		}
+	}
+	if j.NodeCount != nil {
+		return int(*j.NodeCount)
+	}
+	return 0
+}
+
+// AllocatedNodes returns the number of nodes the job was given, or 0 for a
+// job that has not started. It reads job_resources.nodes.count
+// (allocated_hosts before v0.0.41), then the TRES allocation string, then
+// the length of the job's node list.
+func (j *Job) AllocatedNodes() int {
+	if j == nil {
+		return 0
+	}
+	if j.JobResources != nil && j.JobResources.Nodes != nil && j.JobResources.Nodes.Count != nil && *j.JobResources.Nodes.Count > 0 {
+		return int(*j.JobResources.Nodes.Count)
+	}
+	if j.TRESAllocStr != nil {
+		if n := ParseTRESSpec(*j.TRESAllocStr).Nodes; n > 0 {
+			return n
+		}
+	}
+	if names, err := j.NodeNames(); err == nil {
+		return len(names)
+	}
+	return 0
+}
+
+// addGRES records n units of a generic resource. A typed entry such as
+// "gpu:a100" does not add to the untyped "gpu" total, which TRES strings
+// report as an entry of its own.
+func (s *ResourceSpec) addGRES(name string, n int64) {
+	if name == "" {
+		return
+	}
+	if s.GRES == nil {
+		s.GRES = make(map[string]int64)
+	}
+	s.GRES[name] += n
+}
+
+// parseMemoryMB converts a TRES memory value such as "32G" or "4000M" to
+// megabytes; a value without a unit is already in megabytes
+func parseMemoryMB(value string) int64 {
+	number := strings.TrimRight(value, "KMGTPkmgtp")
+	v, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0
+	}
+	switch strings.ToUpper(strings.TrimPrefix(value, number)) {
+	case "K":
+		v /= 1024
+	case "G":
+		v *= 1024
+	case "T":
+		v *= 1024 * 1024
+	case "P":
+		v *= 1024 * 1024 * 1024
+	}
+	return int64(v)
+}
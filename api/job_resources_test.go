@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTRESSpec(t *testing.T) {
+	spec := ParseTRESSpec("cpu=8,mem=4000M,node=1,billing=8,gres/gpu=2,gres/gpu:v100=2,energy=12")
+	assert.Equal(t, ResourceSpec{
+		CPUs:     8,
+		MemoryMB: 4000,
+		Nodes:    1,
+		GRES:     map[string]int64{"gpu": 2, "gpu:v100": 2},
+	}, spec)
+
+	assert.Equal(t, int64(1536), ParseTRESSpec("mem=1.5G").MemoryMB)
+	assert.Equal(t, int64(2*1024*1024), ParseTRESSpec("mem=2T").MemoryMB)
+	assert.Equal(t, int64(512), ParseTRESSpec("mem=512").MemoryMB)
+	assert.True(t, ParseTRESSpec("").IsZero())
+}
+
+func TestJob_Requested_Fallback(t *testing.T) {
+	cpus, nodes := uint32(8), uint32(2)
+	memPerNode := uint64(16000)
+	perNode := "gres/gpu:a100:2"
+	job := &Job{CPUs: &cpus, NodeCount: &nodes, MemoryPerNode: &memPerNode, TRESPerNode: &perNode}
+
+	assert.Equal(t, ResourceSpec{
+		CPUs:     8,
+		MemoryMB: 32000,
+		Nodes:    2,
+		GRES:     map[string]int64{"gpu": 4, "gpu:a100": 4},
+	}, job.Requested())
+
+	memPerCPU := uint64(1000)
+	job = &Job{CPUs: &cpus, MemoryPerCPU: &memPerCPU}
+	assert.Equal(t, int64(8000), job.Requested().MemoryMB)
+}
+
+func TestJob_Allocated_Fallback(t *testing.T) {
+	list := "node[1-3]"
+	job := &Job{Nodes: &list, JobResources: &JobResources{CPUs: 24}}
+	assert.Equal(t, ResourceSpec{CPUs: 24, Nodes: 3}, job.Allocated())
+
+	assert.True(t, (&Job{}).Allocated().IsZero())
+	var none *Job
+	assert.True(t, none.Requested().IsZero())
+}
+
+func TestJob_RequestedAndAllocatedNodes(t *testing.T) {
+	// node_count of a running job is its allocation, not its request
+	count := uint32(3)
+	req, alloc := "cpu=8,node=2", "cpu=12,node=3"
+	job := &Job{NodeCount: &count, TRESReqStr: &req, TRESAllocStr: &alloc}
+	assert.Equal(t, 2, job.RequestedNodes())
+	assert.Equal(t, 3, job.AllocatedNodes())
+
+	list := "node[1-4]"
+	assert.Equal(t, 4, (&Job{Nodes: &list}).AllocatedNodes())
+	assert.Equal(t, 3, (&Job{NodeCount: &count}).RequestedNodes())
+	assert.Equal(t, 0, (&Job{NodeCount: &count}).AllocatedNodes())
+	var none *Job
+	assert.Equal(t, 0, none.RequestedNodes())
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Job tags are key=value labels kept in a job's AdminComment, written as a
+// comma-separated list such as "project=atlas,stage=train". Characters that
+// would break the list ("%", "," and "=") are percent-encoded. Free text in
+// the comment (anything that is not key=value) is left alone when tags are
+// read or merged.
+
+// ParseJobTags extracts the key=value tags from a comment
+func ParseJobTags(comment string) map[string]string {
+	tags := make(map[string]string)
+	for _, token := range strings.Split(comment, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(token), "=")
+		if !ok || key == "" {
+			continue
+		}
+		k, kerr := url.PathUnescape(key)
+		v, verr := url.PathUnescape(value)
+		if kerr != nil || verr != nil {
+			continue
+		}
+		tags[k] = v
+	}
+	return tags
+}
+
+// FormatJobTags encodes tags as a comment, with keys in sorted order
+func FormatJobTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, escapeTag(k)+"="+escapeTag(tags[k]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// MergeJobTags returns comment with tags added or replaced. Existing tags and
+// free text are kept; a tag with an empty value is removed. The result is
+// meant to be sent as the AdminComment of a JobUpdate.
+func MergeJobTags(comment string, tags map[string]string) string {
+	merged := ParseJobTags(comment)
+	for k, v := range tags {
+		if v == "" {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+
+	var text []string
+	for _, token := range strings.Split(comment, ",") {
+		token = strings.TrimSpace(token)
+		if key, _, ok := strings.Cut(token, "="); token != "" && (!ok || key == "") {
+			text = append(text, token)
+		}
+	}
+	if encoded := FormatJobTags(merged); encoded != "" {
+		text = append(text, encoded)
+	}
+	return strings.Join(text, ",")
+}
+
+// escapeTag percent-encodes the characters used as tag delimiters
+func escapeTag(s string) string {
+	return strings.NewReplacer("%", "%25", ",", "%2C", "=", "%3D").Replace(s)
+}
+
+// Tags returns the tags stored in the job's AdminComment
+func (j *Job) Tags() map[string]string {
+	if j == nil || j.AdminComment == nil {
+		return map[string]string{}
+	}
+	return ParseJobTags(*j.AdminComment)
+}
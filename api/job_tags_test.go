@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobTags_RoundTrip(t *testing.T) {
+	tags := map[string]string{
+		"project": "atlas",
+		"stage":   "train,eval",
+		"expr":    "a=b",
+	}
+
+	comment := FormatJobTags(tags)
+	assert.Equal(t, "expr=a%3Db,project=atlas,stage=train%2Ceval", comment)
+	assert.Equal(t, tags, ParseJobTags(comment))
+}
+
+func TestMergeJobTags(t *testing.T) {
+	comment := "migrated from old cluster,project=atlas,stage=train"
+
+	merged := MergeJobTags(comment, map[string]string{"stage": "eval", "owner": "ml-team"})
+	assert.Equal(t, "migrated from old cluster,owner=ml-team,project=atlas,stage=eval", merged)
+
+	// An empty value removes the tag
+	merged = MergeJobTags(merged, map[string]string{"owner": ""})
+	assert.Equal(t, "migrated from old cluster,project=atlas,stage=eval", merged)
+
+	assert.Equal(t, "project=atlas", MergeJobTags("", map[string]string{"project": "atlas"}))
+}
+
+func TestJob_Tags(t *testing.T) {
+	comment := "project=atlas, note"
+	job := &Job{AdminComment: &comment}
+	assert.Equal(t, map[string]string{"project": "atlas"}, job.Tags())
+	assert.Empty(t, (&Job{}).Tags())
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_NodeNames(t *testing.T) {
+	nodes := "gpu[08-10],login1"
+	job := &Job{Nodes: &nodes}
+
+	names, err := job.NodeNames()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gpu08", "gpu09", "gpu10", "login1"}, names)
+
+	pending := &Job{}
+	names, err = pending.NodeNames()
+	require.NoError(t, err)
+	assert.Nil(t, names)
+}
+
+func TestExplainReason(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"Resources", "The job is waiting for resources to become available"},
+		{"Priority", "One or more higher priority jobs are queued ahead of this job"},
+		{"QOSMaxCpuPerUserLimit", "The user has reached the maximum number of CPUs allowed per user by the QOS"},
+		{"Dependency", "The job is waiting for a job it depends on to complete"},
+		{"jobhelduser", "The job is held by its owner"},
+		{"SomeFutureReason", "SomeFutureReason"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExplainReason(tt.code))
+		})
+	}
+}
+
+func TestJob_ReasonExplanation(t *testing.T) {
+	reason := "PartitionTimeLimit"
+	job := &Job{StateReason: &reason}
+	assert.Equal(t, "The job's time limit exceeds the partition's limit", job.ReasonExplanation())
+
+	assert.Empty(t, (&Job{}).ReasonExplanation())
+}
+
+func TestJobListOptions_MatchesTimeWindows(t *testing.T) {
+	now := time.Now()
+	hoursAgo := func(h int) time.Time { return now.Add(-time.Duration(h) * time.Hour) }
+	stalePending := &Job{
+		SubmitTime: hoursAgo(48),
+		StartTime:  now.Add(time.Hour), // estimated
+		JobState:   []JobState{JobStatePending},
+	}
+	running := &Job{
+		SubmitTime: hoursAgo(3),
+		StartTime:  hoursAgo(2),
+		EndTime:    now.Add(time.Hour), // time limit
+		JobState:   []JobState{JobStateRunning},
+	}
+	completed := &Job{
+		SubmitTime: hoursAgo(10),
+		StartTime:  hoursAgo(9),
+		EndTime:    hoursAgo(8),
+		JobState:   []JobState{JobStateCompleted},
+	}
+	jobs := map[string]*Job{"pending": stalePending, "running": running, "completed": completed}
+
+	dayAgo := hoursAgo(24)
+	fiveHoursAgo := hoursAgo(5)
+	tests := []struct {
+		name    string
+		opts    *JobListOptions
+		matches []string
+	}{
+		{"no windows", &JobListOptions{}, []string{"completed", "pending", "running"}},
+		{"nil options", nil, []string{"completed", "pending", "running"}},
+		{"submitted after", &JobListOptions{SubmittedAfter: &dayAgo}, []string{"completed", "running"}},
+		{"started after", &JobListOptions{StartedAfter: &fiveHoursAgo}, []string{"running"}},
+		{"end before", &JobListOptions{EndBefore: &now}, []string{"completed"}},
+		{"combined", &JobListOptions{SubmittedAfter: &dayAgo, EndBefore: &fiveHoursAgo}, []string{"completed"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matched []string
+			for _, name := range []string{"completed", "pending", "running"} {
+				if tt.opts.MatchesTimeWindows(jobs[name]) {
+					matched = append(matched, name)
+				}
+			}
+			assert.Equal(t, tt.matches, matched)
+		})
+	}
+
+	// Jobs without a timestamp never match its window
+	assert.False(t, (&JobListOptions{SubmittedAfter: &dayAgo}).MatchesTimeWindows(&Job{}))
+	assert.False(t, (&JobListOptions{EndBefore: &now}).MatchesTimeWindows(&Job{}))
+}
+
+func TestJobListOptions_MatchesArrayJobIDs(t *testing.T) {
+	id := func(v int64) *int64 { return &v }
+	arrayID := func(v uint32) *uint32 { return &v }
+	pending := "3-5"
+
+	opts := &JobListOptions{ArrayJobIDs: []int64{100}}
+	assert.True(t, opts.MatchesArrayJobIDs(&Job{JobID: id(103), ArrayJobID: arrayID(100)}), "started task")
+	assert.True(t, opts.MatchesArrayJobIDs(&Job{JobID: id(100), ArrayTaskString: &pending}), "pending tasks without ArrayJobID")
+	assert.False(t, opts.MatchesArrayJobIDs(&Job{JobID: id(100)}), "a plain job with the same ID")
+	assert.False(t, opts.MatchesArrayJobIDs(&Job{JobID: id(201), ArrayJobID: arrayID(200)}), "another array")
+
+	var none *JobListOptions
+	assert.True(t, none.MatchesArrayJobIDs(&Job{JobID: id(1)}))
+	assert.True(t, (&JobListOptions{}).MatchesArrayJobIDs(&Job{JobID: id(1)}))
+}
@@ -45,7 +45,7 @@ type JobCreate struct {
 	HetjobGroup *int32 `json:"hetjob_group,omitempty"` // Unique sequence number applied to this component of the heterogeneous job
 	Hold *bool `json:"hold,omitempty"` // Hold (true) or release (false) job (Job held)
 	Immediate *bool `json:"immediate,omitempty"` // If true, exit if resources are not available within the time period specified
-	JobID *int32 `json:"job_id,omitempty"` // Job ID
+	JobID *int64 `json:"job_id,omitempty"` // Job ID
 	KillOnNodeFail *bool `json:"kill_on_node_fail,omitempty"` // If true, kill job on node failure
 	KillWarningDelay *uint16 `json:"kill_warning_delay,omitempty"` // Number of seconds before end time to send the warning signal (16 bit integer...
 	KillWarningFlags []KillWarningFlagsValue `json:"kill_warning_flags,omitempty"` // Flags related to job signals
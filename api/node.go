@@ -7,6 +7,7 @@
 package api
 
 import (
+	"strings"
 	"time"
 )
 
@@ -30,6 +31,9 @@ type NodeListOptions struct {
 	// Offset specifies the number of nodes to skip before returning results.
 	// WARNING: This is CLIENT-SIDE pagination - see Limit field documentation.
 	Offset int `json:"offset,omitempty"`
+
+	// Flags are passed to slurmrestd as the "flags" query parameter
+	Flags []QueryFlag `json:"flags,omitempty"`
 }
 
 // NodeList represents a list of nodes
@@ -41,7 +45,7 @@ type NodeList struct {
 // NodeAllocation represents the current allocation status of a node
 type NodeAllocation struct {
 	Name        string    `json:"name"`
-	JobId       int32     `json:"job_id,omitempty"`
+	JobId       int64     `json:"job_id,omitempty"`
 	JobName     string    `json:"job_name,omitempty"`
 	UserName    string    `json:"user_name,omitempty"`
 	AllocCpus         int32     `json:"alloc_cpus"`
@@ -68,11 +72,110 @@ type NodePowerRequest struct {
 	Force        bool           `json:"force,omitempty"`
 }
 
-// NodePowerState represents node power states
+// NodePowerState represents node power states. NodePowerUp and
+// NodePowerDown are the transitions Nodes().SetPowerState accepts; a node's
+// current status, as read by Node.PowerState, is NodePowerUp for a node that
+// is on, or one of NodePoweringUp, NodePoweringDown and NodePoweredDown.
 type NodePowerState string
 
 const (
 	NodePowerDown NodePowerState = "POWER_DOWN"
 	NodePowerUp   NodePowerState = "POWER_UP"
 	NodePowerSave NodePowerState = "POWER_SAVE"
+
+	NodePoweringUp   NodePowerState = "POWERING_UP"
+	NodePoweringDown NodePowerState = "POWERING_DOWN"
+	NodePoweredDown  NodePowerState = "POWERED_DOWN"
 )
+
+// PowerStats is a node's power draw and energy use as last sampled by the
+// acct_gather_energy plugin
+type PowerStats struct {
+	// CurrentWatts is the power draw at the last sample
+	CurrentWatts uint32 `json:"current_watts"`
+	// AverageWatts is the average power draw since slurmd registered
+	AverageWatts uint32 `json:"average_watts"`
+	// ConsumedEnergy is the energy used since slurmd registered, in joules
+	ConsumedEnergy int64 `json:"consumed_energy"`
+	// LastCollected is when the sample was taken; zero if not reported
+	LastCollected time.Time `json:"last_collected,omitempty"`
+}
+
+// PowerStats returns the node's energy readings. It reports false when the
+// node has no energy data, as on clusters without an energy plugin, which
+// leave every reading at zero.
+func (n *Node) PowerStats() (PowerStats, bool) {
+	if n == nil || n.Energy == nil {
+		return PowerStats{}, false
+	}
+	var stats PowerStats
+	if n.Energy.CurrentWatts != nil {
+		stats.CurrentWatts = *n.Energy.CurrentWatts
+	}
+	if n.Energy.AverageWatts != nil && *n.Energy.AverageWatts > 0 {
+		stats.AverageWatts = uint32(*n.Energy.AverageWatts)
+	}
+	if n.Energy.ConsumedEnergy != nil && *n.Energy.ConsumedEnergy > 0 {
+		stats.ConsumedEnergy = *n.Energy.ConsumedEnergy
+	}
+	if n.Energy.LastCollected != nil && *n.Energy.LastCollected > 0 {
+		stats.LastCollected = time.Unix(*n.Energy.LastCollected, 0)
+	}
+	if stats.CurrentWatts == 0 && stats.AverageWatts == 0 && stats.ConsumedEnergy == 0 {
+		return PowerStats{}, false
+	}
+	return stats, true
+}
+
+// PowerState returns the node's power status from its state flags. A node
+// that slurmctld has been asked to power down, but has not yet started on,
+// counts as powering down. Nodes without power saving are always
+// NodePowerUp.
+func (n *Node) PowerState() NodePowerState {
+	if n == nil {
+		return ""
+	}
+	flags := make(map[NodeState]bool, len(n.State))
+	for _, state := range n.State {
+		flags[NodeState(strings.ToUpper(string(state)))] = true
+	}
+	switch {
+	case flags[NodeStatePoweringUp]:
+		return NodePoweringUp
+	case flags[NodeStatePoweringDown], flags[NodeStatePowerDown]:
+		return NodePoweringDown
+	case flags[NodeStatePoweredDown]:
+		return NodePoweredDown
+	default:
+		return NodePowerUp
+	}
+}
+
+// CoresPerSocket returns the number of cores in each socket of the node,
+// as reported in its Cores field; zero if not reported
+func (n *Node) CoresPerSocket() int {
+	if n == nil || n.Cores == nil {
+		return 0
+	}
+	return int(*n.Cores)
+}
+
+// ThreadsPerCore returns the number of hardware threads of each core, as
+// reported in its Threads field; zero if not reported
+func (n *Node) ThreadsPerCore() int {
+	if n == nil || n.Threads == nil {
+		return 0
+	}
+	return int(*n.Threads)
+}
+
+// TotalThreads returns the number of hardware threads of the node, which
+// is sockets × cores per socket × threads per core. Slurm reports Sockets
+// as the total across all boards. It returns zero when the sockets, cores
+// or threads are not reported.
+func (n *Node) TotalThreads() int {
+	if n == nil || n.Sockets == nil {
+		return 0
+	}
+	return int(*n.Sockets) * n.CoresPerSocket() * n.ThreadsPerCore()
+}
@@ -7,6 +7,8 @@
 package api
 
 import (
+	"math"
+	"strings"
 	"time"
 )
 
@@ -21,7 +23,18 @@ const (
 	PartitionStateInactive PartitionState = StateInactive
 )
 
-// PartitionCreate represents the data needed to create a new partition
+// OverTimeLimitUnlimited is the over time limit, in minutes, of a partition
+// whose jobs may overrun their time limit indefinitely. It equals Slurm's
+// 16-bit INFINITE.
+const OverTimeLimitUnlimited uint16 = math.MaxUint16
+
+// PartitionCreate represents the data needed to create a new partition.
+// Fields left at their zero value take slurmctld's defaults. DefaultTime and
+// MaxTime are in minutes.
+//
+// slurmrestd up to v0.0.44 has no endpoint for creating or deleting
+// partitions either; the client does both through scontrol when the CLI
+// fallback is configured.
 type PartitionCreate struct {
 	Name                 string            `json:"name"`
 	AllocNodes           string            `json:"alloc_nodes,omitempty"`
@@ -65,7 +78,15 @@ type PartitionCreate struct {
 	PowerDownOnIdle      bool              `json:"power_down_on_idle,omitempty"`
 }
 
-// PartitionUpdate represents the data needed to update a partition
+// PartitionUpdate represents the data needed to update a partition. Every
+// field is optional: nil pointers and slices are omitted from the request and
+// leave the partition's current value unchanged, so a field can be set to its
+// zero value explicitly. GraceTime is in seconds and OverTimeLimit in
+// minutes; an OverTimeLimit of OverTimeLimitUnlimited lets jobs overrun their
+// time limit indefinitely.
+//
+// Note that slurmrestd up to v0.0.44 exposes no partition update endpoint, so
+// the version adapters report the operation as unsupported.
 type PartitionUpdate struct {
 	AllocNodes           *string           `json:"alloc_nodes,omitempty"`
 	AllowAccounts        []string          `json:"allow_accounts,omitempty"`
@@ -106,6 +127,10 @@ type PartitionUpdate struct {
 	RootOnly             *bool             `json:"root_only,omitempty"`
 	ReqResv              *bool             `json:"req_resv,omitempty"`
 	PowerDownOnIdle      *bool             `json:"power_down_on_idle,omitempty"`
+
+	// OverSubscribe sets the partition's OverSubscribe policy (the number of
+	// jobs that may share a resource and whether sharing is forced)
+	OverSubscribe *PartitionMaximumsOversubscribe `json:"oversubscribe,omitempty"`
 }
 
 // PartitionCreateResponse represents the response from partition creation
@@ -128,6 +153,9 @@ type PartitionListOptions struct {
 	// Offset specifies the number of partitions to skip before returning results.
 	// WARNING: This is CLIENT-SIDE pagination - see Limit field documentation.
 	Offset int `json:"offset,omitempty"`
+
+	// Flags are passed to slurmrestd as the "flags" query parameter
+	Flags []QueryFlag `json:"flags,omitempty"`
 }
 
 // PartitionList represents a list of partitions
@@ -151,3 +179,84 @@ type PartitionStatistics struct {
 	SuspendedJobs  int32     `json:"suspended_jobs"`
 	LastUpdateTime time.Time `json:"last_update_time"`
 }
+
+// AssignedQoS returns the partition QOS, whose limits apply to every job in
+// the partition on top of the job's own QOS; empty if none is set
+func (p *Partition) AssignedQoS() string {
+	if p == nil || p.QoS == nil || p.QoS.Assigned == nil {
+		return ""
+	}
+	return *p.QoS.Assigned
+}
+
+// AllowedQoS returns the QOS that may run jobs in the partition (AllowQos).
+// It is nil when every QOS is allowed.
+func (p *Partition) AllowedQoS() []string {
+	if p == nil || p.QoS == nil || p.QoS.Allowed == nil {
+		return nil
+	}
+	allowed := splitQoSList(*p.QoS.Allowed)
+	for _, qos := range allowed {
+		if strings.EqualFold(qos, "ALL") {
+			return nil
+		}
+	}
+	return allowed
+}
+
+// DeniedQoS returns the QOS that may not run jobs in the partition (DenyQos)
+func (p *Partition) DeniedQoS() []string {
+	if p == nil || p.QoS == nil || p.QoS.Deny == nil {
+		return nil
+	}
+	return splitQoSList(*p.QoS.Deny)
+}
+
+// PermitsQoS reports whether jobs with the given QOS may run in the
+// partition according to its AllowQos and DenyQos lists
+func (p *Partition) PermitsQoS(qos string) bool {
+	for _, denied := range p.DeniedQoS() {
+		if denied == qos {
+			return false
+		}
+	}
+	allowed := p.AllowedQoS()
+	if allowed == nil {
+		return true
+	}
+	for _, a := range allowed {
+		if a == qos {
+			return true
+		}
+	}
+	return false
+}
+
+// OverTimeLimit returns the number of minutes a job in the partition may run
+// past its time limit before it is cancelled, or nil if none is set. A value
+// of OverTimeLimitUnlimited means jobs are never cancelled for overrunning.
+func (p *Partition) OverTimeLimit() *uint16 {
+	if p == nil || p.Maximums == nil {
+		return nil
+	}
+	return p.Maximums.OverTimeLimit
+}
+
+// GracePeriod returns the partition's GraceTime, the time a job selected for
+// preemption is given before it is cancelled
+func (p *Partition) GracePeriod() time.Duration {
+	if p == nil || p.GraceTime == nil {
+		return 0
+	}
+	return time.Duration(*p.GraceTime) * time.Second
+}
+
+func splitQoSList(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionUpdate_OmitsUnsetFields(t *testing.T) {
+	state := PartitionStateUp
+	maxNodes := int32(16)
+	tier := int32(0)
+	update := PartitionUpdate{
+		State:         &state,
+		AllowAccounts: []string{"physics"},
+		MaxNodes:      &maxNodes,
+		PriorityTier:  &tier,
+	}
+
+	body, err := json.Marshal(update)
+	require.NoError(t, err)
+
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &fields))
+	assert.Len(t, fields, 4)
+	assert.Equal(t, "UP", fields["state"])
+	assert.Equal(t, []interface{}{"physics"}, fields["allow_accounts"])
+	assert.EqualValues(t, 16, fields["max_nodes"])
+	// A zero set through a pointer is sent, not dropped
+	assert.EqualValues(t, 0, fields["priority_tier"])
+	assert.NotContains(t, fields, "max_time")
+	assert.NotContains(t, fields, "oversubscribe")
+}
+
+func TestPartition_QoSLists(t *testing.T) {
+	all := "ALL"
+	deny := "scavenger, low"
+	partition := &Partition{QoS: &PartitionQoS{Allowed: &all, Deny: &deny}}
+
+	// AllowQos=ALL leaves only the deny list to check
+	assert.Nil(t, partition.AllowedQoS())
+	assert.Equal(t, []string{"scavenger", "low"}, partition.DeniedQoS())
+	assert.True(t, partition.PermitsQoS("normal"))
+	assert.False(t, partition.PermitsQoS("low"))
+
+	// A partition without QOS settings accepts any QOS
+	assert.True(t, (&Partition{}).PermitsQoS("normal"))
+	assert.Equal(t, "", (&Partition{}).AssignedQoS())
+}
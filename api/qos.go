@@ -11,7 +11,7 @@ type QoSCreate struct {
 	Name              string
 	Description       string
 	Priority          int
-	Flags             []string
+	Flags             []QoSFlag
 	PreemptMode       []string
 	PreemptList       []string
 	PreemptExemptTime *int
@@ -25,22 +25,41 @@ type QoSCreate struct {
 	Limits            *QoSLimits
 }
 
-// QoSUpdate represents fields that can be updated on a QoS
+// QoSUpdate represents fields that can be updated on a QoS. Every field is
+// optional: a nil field is not sent and keeps its current value. To clear a
+// field, set it to its empty value: "" for a string, an empty slice for a
+// list, and math.MaxUint32 (TimeLimitUnlimited for the wall clock limits) for
+// a numeric limit, which slurmdbd stores as unlimited.
 type QoSUpdate struct {
 	Description       *string
 	Priority          *int
-	Flags             *[]string
+	Flags             *[]QoSFlag
 	PreemptMode       *[]string
-	PreemptList       []string
+	PreemptList       *[]string
 	PreemptExemptTime *int
 	GraceTime         *int
 	UsageFactor       *float64
 	UsageThreshold    *float64
-	ParentQoS         *string
+	// Deprecated: slurmdbd has no parent QoS, so QoS().Update rejects any
+	// update that sets ParentQoS. It will be removed in a future release.
+	ParentQoS *string
+	// MaxTRESPerUser, MaxTRESPerAccount and MaxTRESPerJob are TRES strings
+	// such as "cpu=4,gres/gpu=2"; see ParseTRESList
 	MaxTRESPerUser    *string
 	MaxTRESPerAccount *string
 	MaxTRESPerJob     *string
-	Limits            *QoSLimits
+	// GrpTRESMins, GrpTRESRunMins, MaxTRESMinsPerJob, MaxTRESRunMinsPerUser
+	// and MaxTRESRunMinsPerAccount are TRES-minute limits keyed by TRES
+	// type, such as "cpu" or "gres/gpu". Only the TRES listed are changed;
+	// a count of -1 removes the limit for that TRES. v0.0.41 has no QoS
+	// GrpTRESMins.
+	GrpTRESMins              map[string]int64
+	GrpTRESRunMins           map[string]int64
+	MaxTRESMinsPerJob        map[string]int64
+	MaxTRESRunMinsPerUser    map[string]int64
+	MaxTRESRunMinsPerAccount map[string]int64
+	// Limits holds the remaining limits; only the fields set in it are sent
+	Limits *QoSLimits
 }
 
 // QoSListOptions represents options for listing QoS entries
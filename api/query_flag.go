@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import "strings"
+
+// QueryFlag is a value of the "flags" query parameter that slurmrestd accepts
+// when listing jobs, nodes and partitions. The values are the SHOW_* flags of
+// squeue, sinfo and scontrol without their prefix.
+type QueryFlag string
+
+// QueryFlag constants.
+const (
+	// QueryFlagAll includes hidden partitions and the jobs and nodes in
+	// them (SHOW_ALL); without it slurmrestd omits them
+	QueryFlagAll QueryFlag = "ALL"
+	// QueryFlagDetail reports the detailed record (SHOW_DETAIL)
+	QueryFlagDetail QueryFlag = "DETAIL"
+	// QueryFlagLocal limits the answer to the local cluster (SHOW_LOCAL)
+	QueryFlagLocal QueryFlag = "LOCAL"
+	// QueryFlagFederation reports every cluster of a federation (SHOW_FEDERATION)
+	QueryFlagFederation QueryFlag = "FEDERATION"
+	// QueryFlagSibling reports the sibling jobs of a federation (SHOW_SIBLING)
+	QueryFlagSibling QueryFlag = "SIBLING"
+	// QueryFlagFuture includes nodes in the FUTURE state (SHOW_FUTURE)
+	QueryFlagFuture QueryFlag = "FUTURE"
+	// QueryFlagMixed reports nodes with some CPUs allocated as MIXED (SHOW_MIXED)
+	QueryFlagMixed QueryFlag = "MIXED"
+)
+
+// JoinQueryFlags formats flags as the value of the "flags" query parameter.
+// It returns "" when there are no flags, and drops duplicates.
+func JoinQueryFlags(flags []QueryFlag) string {
+	seen := make(map[QueryFlag]bool, len(flags))
+	values := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		if flag == "" || seen[flag] {
+			continue
+		}
+		seen[flag] = true
+		values = append(values, string(flag))
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinQueryFlags(t *testing.T) {
+	assert.Equal(t, "", JoinQueryFlags(nil))
+	assert.Equal(t, "ALL", JoinQueryFlags([]QueryFlag{QueryFlagAll}))
+	assert.Equal(t, "DETAIL,ALL", JoinQueryFlags([]QueryFlag{QueryFlagDetail, QueryFlagAll, "", QueryFlagDetail}))
+}
@@ -65,6 +65,28 @@ type ReservationCreateResponse struct {
 	ReservationName string `json:"reservation_name"`
 }
 
+// DrainReservationJobsOptions controls Reservations().DrainJobs.
+type DrainReservationJobsOptions struct {
+	// Requeue puts running jobs back in the queue instead of cancelling
+	// them. Pending jobs cannot be requeued and are left alone.
+	Requeue bool `json:"requeue,omitempty"`
+	// Signal is sent instead of SIGKILL when cancelling, e.g. "SIGTERM"
+	Signal string `json:"signal,omitempty"`
+}
+
+// ExportICalOptions selects the reservations in Reservations().ExportICal.
+type ExportICalOptions struct {
+	// Names limits the feed to these reservations
+	Names []string `json:"names,omitempty"`
+	// Start and End bound the feed to reservations that overlap the window;
+	// a zero time leaves that side open
+	Start time.Time `json:"start,omitempty"`
+	End   time.Time `json:"end,omitempty"`
+	// CalendarName is the name calendar apps show for the feed
+	// (default "Slurm reservations")
+	CalendarName string `json:"calendar_name,omitempty"`
+}
+
 // ReservationListOptions represents options for listing reservations
 type ReservationListOptions struct {
 	Names      []string           `json:"names,omitempty"`
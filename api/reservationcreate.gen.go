@@ -15,7 +15,7 @@ type ReservationCreate struct {
 	Duration *uint32 `json:"duration,omitempty"` // The length of a reservation in minutes (32 bit integer number with flags)
 	EndTime time.Time `json:"end_time,omitempty"` // EndTime (UNIX timestamp) (UNIX timestamp or time string recognized by Slurm...
 	Features *string `json:"features,omitempty"` // Requested node features. Multiple values may be "&" separated if all features...
-	Flags []FlagsValue `json:"flags,omitempty"` // Flags associated with this reservation. Note, to remove flags use "NO_"...
+	Flags []ReservationFlagsValue `json:"flags,omitempty"` // Flags associated with this reservation. Note, to remove flags use "NO_"...
 	Groups []string `json:"groups,omitempty"` // List of groups permitted to use the reservation. This is mutually exclusive...
 	Licenses []string `json:"licenses,omitempty"` // List of license names
 	MaxStartDelay *uint32 `json:"max_start_delay,omitempty"` // MaxStartDelay in seconds (32 bit integer number with flags)
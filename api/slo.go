@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import "time"
+
+// DefaultSLOProbes is the number of probe rounds CheckSLO runs by default
+const DefaultSLOProbes = 10
+
+// SLOConfig is the latency and error budget CheckSLO measures a cluster
+// against.
+type SLOConfig struct {
+	// MaxP95 is the highest acceptable 95th percentile request latency
+	MaxP95 time.Duration
+	// MaxErrorRate is the highest acceptable fraction of failed requests,
+	// between 0 and 1
+	MaxErrorRate float64
+	// Probes is the number of rounds to run; each round pings the
+	// controller and lists one job (default DefaultSLOProbes)
+	Probes int
+}
+
+// SLOResult reports what CheckSLO measured.
+type SLOResult struct {
+	Requests  int
+	Errors    int
+	ErrorRate float64
+	P50       time.Duration
+	P95       time.Duration
+	Max       time.Duration
+	// Met is true when both the latency and error budgets were kept
+	Met bool
+	// Violations describes each budget that was exceeded
+	Violations []string
+}
@@ -23,6 +23,24 @@ type LicenseList struct {
 	Meta     map[string]interface{} `json:"meta,omitempty"`
 }
 
+// LicenseReservation is a hold on floating licenses made by
+// Reservations().ReserveLicenses. Jobs that should use the licenses are
+// submitted with Token as their reservation.
+type LicenseReservation struct {
+	// Token names the reservation holding the licenses and releases them
+	Token   string    `json:"token"`
+	License string    `json:"license"`
+	Count   int       `json:"count"`
+	Expires time.Time `json:"expires"`
+}
+
+// ReserveLicensesOptions configures Reservations().ReserveLicenses.
+type ReserveLicensesOptions struct {
+	// User may use the licenses; by default the user the client
+	// authenticates as, or else the user running the client
+	User string `json:"user,omitempty"`
+}
+
 // === Share Types ===
 
 // Share represents fairshare information
@@ -185,6 +203,57 @@ type Diagnostics struct {
 	RPCsCompleted        int                    `json:"rpcs_completed"`
 	RPCsQueued2          int                    `json:"rpcs_queued2"`
 	Meta                 map[string]interface{} `json:"meta,omitempty"`
+	// Backfill holds the backfill scheduler statistics, when reported
+	Backfill *BackfillStats `json:"backfill,omitempty"`
+}
+
+// BackfillStats reports the backfill scheduler's activity, as shown in the
+// "Backfilling stats" section of sdiag. Counters run from the last
+// statistics reset unless noted otherwise.
+type BackfillStats struct {
+	Active bool `json:"active"`
+	// BackfilledJobs counts jobs started by backfill since slurmctld started
+	BackfilledJobs int `json:"backfilled_jobs"`
+	// LastBackfilledJobs counts jobs started by backfill since the last reset
+	LastBackfilledJobs int `json:"last_backfilled_jobs"`
+	BackfilledHetJobs  int `json:"backfilled_het_jobs"`
+
+	// Cycles is the number of backfill cycles run
+	Cycles      int           `json:"cycles"`
+	LastCycle   time.Duration `json:"last_cycle"`
+	MaxCycle    time.Duration `json:"max_cycle"`
+	MeanCycle   time.Duration `json:"mean_cycle"`
+	LastCycleAt time.Time     `json:"last_cycle_at"`
+
+	// LastDepth is the number of jobs considered in the last cycle, and
+	// LastDepthTried the number whose start time was actually tested
+	LastDepth      int `json:"last_depth"`
+	LastDepthTried int `json:"last_depth_tried"`
+	MeanDepth      int `json:"mean_depth"`
+	MeanDepthTried int `json:"mean_depth_tried"`
+
+	// QueueLen is the number of pending jobs at the start of the last cycle
+	QueueLen     int `json:"queue_len"`
+	MeanQueueLen int `json:"mean_queue_len"`
+	// TableSize is the number of time slots tested in the last cycle
+	TableSize     int `json:"table_size"`
+	MeanTableSize int `json:"mean_table_size"`
+}
+
+// QueueDepthSamples is the number of samples QueueDepthHistory takes, spread
+// evenly over its window with one at each end
+const QueueDepthSamples = 10
+
+// QueueDepthSample is one reading of the scheduler's backlog, taken from the
+// controller diagnostics
+type QueueDepthSample struct {
+	Time        time.Time `json:"time"`
+	PendingJobs int       `json:"pending_jobs"`
+	RunningJobs int       `json:"running_jobs"`
+	// BackfillDepth is the number of jobs the last backfill cycle considered,
+	// and BackfillQueueLen the number pending when that cycle started
+	BackfillDepth    int `json:"backfill_depth"`
+	BackfillQueueLen int `json:"backfill_queue_len"`
 }
 
 // === Instance Types ===
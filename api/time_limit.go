@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// TimeLimitUnlimited is the time limit value, in minutes, of a job or
+// partition without a time limit. It equals Slurm's INFINITE, so it can't be
+// confused with a limit of zero or with an unset (nil) limit.
+const TimeLimitUnlimited uint32 = math.MaxUint32
+
+// IsUnlimitedTimeLimit reports whether a time limit is UNLIMITED
+func IsUnlimitedTimeLimit(minutes *uint32) bool {
+	return minutes != nil && *minutes == TimeLimitUnlimited
+}
+
+// FormatTimeLimit formats a time limit in minutes the way squeue and sinfo
+// display it: "UNLIMITED", "MM:SS", "H:MM:SS" or "D-HH:MM:SS". It returns ""
+// for an unset limit.
+func FormatTimeLimit(minutes *uint32) string {
+	switch {
+	case minutes == nil:
+		return ""
+	case *minutes == TimeLimitUnlimited:
+		return "UNLIMITED"
+	}
+	days := *minutes / (24 * 60)
+	hours := (*minutes / 60) % 24
+	mins := *minutes % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%d-%02d:%02d:00", days, hours, mins)
+	case hours > 0:
+		return fmt.Sprintf("%d:%02d:00", hours, mins)
+	default:
+		return fmt.Sprintf("%d:00", mins)
+	}
+}
+
+// ParseTimeLimit parses a time limit in any of the formats sbatch accepts
+// ("minutes", "minutes:seconds", "hours:minutes:seconds", "days-hours",
+// "days-hours:minutes" and "days-hours:minutes:seconds") or the keywords
+// "UNLIMITED" and "INFINITE". Seconds are rounded up to the next minute, as
+// Slurm does. An empty string yields a nil (unset) limit.
+func ParseTimeLimit(s string) (*uint32, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	if strings.EqualFold(s, "UNLIMITED") || strings.EqualFold(s, "INFINITE") {
+		unlimited := TimeLimitUnlimited
+		return &unlimited, nil
+	}
+
+	var days, hours, mins, secs uint64
+	clock := s
+	if dayPart, rest, found := strings.Cut(s, "-"); found {
+		d, err := parseTimeField(dayPart, s)
+		if err != nil {
+			return nil, err
+		}
+		days = d
+		clock = rest
+	}
+
+	fields := strings.Split(clock, ":")
+	values := make([]uint64, len(fields))
+	for i, f := range fields {
+		v, err := parseTimeField(f, s)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	switch {
+	case len(values) > 3:
+		return nil, fmt.Errorf("invalid time limit %q", s)
+	case clock != s: // days-hours[:minutes[:seconds]]
+		hours = values[0]
+		if len(values) > 1 {
+			mins = values[1]
+		}
+		if len(values) > 2 {
+			secs = values[2]
+		}
+	case len(values) == 3:
+		hours, mins, secs = values[0], values[1], values[2]
+	case len(values) == 2:
+		mins, secs = values[0], values[1]
+	default:
+		mins = values[0]
+	}
+
+	total := days*24*60 + hours*60 + mins
+	if secs > 0 {
+		total += (secs + 59) / 60
+	}
+	if total >= uint64(TimeLimitUnlimited) {
+		return nil, fmt.Errorf("time limit %q out of range", s)
+	}
+	limit := uint32(total)
+	return &limit, nil
+}
+
+// parseTimeField parses one numeric component of a time limit
+func parseTimeField(field, limit string) (uint64, error) {
+	v, err := strconv.ParseUint(field, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time limit %q", limit)
+	}
+	return v, nil
+}
+
+// HasUnlimitedTimeLimit reports whether the job runs without a time limit
+func (j *Job) HasUnlimitedTimeLimit() bool {
+	return IsUnlimitedTimeLimit(j.TimeLimit)
+}
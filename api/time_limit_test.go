@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeLimit(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected uint32
+	}{
+		{"30", 30},
+		{"30:00", 30},
+		{"30:01", 31},
+		{"2:30:00", 150},
+		{"1-00", 1440},
+		{"1-02:30", 1590},
+		{"1-02:30:00", 1590},
+		{"UNLIMITED", TimeLimitUnlimited},
+		{"infinite", TimeLimitUnlimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			limit, err := ParseTimeLimit(tt.input)
+			require.NoError(t, err)
+			require.NotNil(t, limit)
+			assert.Equal(t, tt.expected, *limit)
+		})
+	}
+
+	limit, err := ParseTimeLimit("")
+	require.NoError(t, err)
+	assert.Nil(t, limit)
+
+	for _, bad := range []string{"abc", "1:2:3:4", "-5", "1-"} {
+		_, err := ParseTimeLimit(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestFormatTimeLimit(t *testing.T) {
+	value := func(v uint32) *uint32 { return &v }
+
+	assert.Equal(t, "", FormatTimeLimit(nil))
+	assert.Equal(t, "0:00", FormatTimeLimit(value(0)))
+	assert.Equal(t, "45:00", FormatTimeLimit(value(45)))
+	assert.Equal(t, "2:30:00", FormatTimeLimit(value(150)))
+	assert.Equal(t, "1-02:30:00", FormatTimeLimit(value(1590)))
+	assert.Equal(t, "UNLIMITED", FormatTimeLimit(value(TimeLimitUnlimited)))
+}
+
+func TestTimeLimit_UnlimitedRoundTrip(t *testing.T) {
+	limit, err := ParseTimeLimit("UNLIMITED")
+	require.NoError(t, err)
+	assert.Equal(t, "UNLIMITED", FormatTimeLimit(limit))
+
+	// The sentinel survives JSON encoding and is distinct from a zero limit
+	body, err := json.Marshal(Job{TimeLimit: limit})
+	require.NoError(t, err)
+	var decoded Job
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	require.NotNil(t, decoded.TimeLimit)
+	assert.True(t, decoded.HasUnlimitedTimeLimit())
+	assert.NotEqual(t, uint32(0), *decoded.TimeLimit)
+
+	zero := uint32(0)
+	assert.False(t, (&Job{TimeLimit: &zero}).HasUnlimitedTimeLimit())
+	assert.False(t, (&Job{}).HasUnlimitedTimeLimit())
+}
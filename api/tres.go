@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseTRESList reads a TRES string such as "cpu=4,mem=16G,gres/gpu=2" into
+// TRES entries, in the order given. The part of a type after a slash becomes
+// the TRES name, and memory sizes are converted to megabytes, the unit
+// slurmdbd stores them in. An empty string gives an empty list.
+func ParseTRESList(tres string) ([]TRES, error) {
+	list := make([]TRES, 0)
+	if strings.TrimSpace(tres) == "" {
+		return list, nil
+	}
+	for _, item := range strings.Split(tres, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid TRES %q: expected type=count", item)
+		}
+		entry := TRES{Type: key}
+		if typ, name, ok := strings.Cut(key, "/"); ok {
+			entry.Type = typ
+			entry.Name = &name
+		}
+
+		var count int64
+		var err error
+		if entry.Type == "mem" {
+			if _, err = strconv.ParseFloat(strings.TrimRight(value, "KMGTPkmgtp"), 64); err == nil {
+				count = parseMemoryMB(value)
+			}
+		} else {
+			count, err = strconv.ParseInt(value, 10, 64)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRES %q: %w", item, err)
+		}
+		entry.Count = &count
+		list = append(list, entry)
+	}
+	return list, nil
+}
+
+// TRESListFromMap converts a limit map keyed by TRES type, such as
+// {"cpu": 4, "gres/gpu": 2}, to TRES entries sorted by key. As in
+// ParseTRESList, the part of a key after a slash becomes the TRES name.
+func TRESListFromMap(limits map[string]int64) []TRES {
+	keys := make([]string, 0, len(limits))
+	for key := range limits {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	list := make([]TRES, 0, len(keys))
+	for _, key := range keys {
+		count := limits[key]
+		entry := TRES{Type: key, Count: &count}
+		if typ, name, ok := strings.Cut(key, "/"); ok {
+			entry.Type = typ
+			entry.Name = &name
+		}
+		list = append(list, entry)
+	}
+	return list
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTRESList(t *testing.T) {
+	list, err := ParseTRESList("cpu=4, mem=16G,gres/gpu=2")
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+
+	assert.Equal(t, "cpu", list[0].Type)
+	assert.Nil(t, list[0].Name)
+	assert.Equal(t, int64(4), *list[0].Count)
+	assert.Equal(t, "mem", list[1].Type)
+	assert.Equal(t, int64(16384), *list[1].Count)
+	assert.Equal(t, "gres", list[2].Type)
+	assert.Equal(t, "gpu", *list[2].Name)
+	assert.Equal(t, int64(2), *list[2].Count)
+
+	list, err = ParseTRESList("")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	for _, invalid := range []string{"cpu", "=4", "cpu=four", "mem=lots"} {
+		_, err := ParseTRESList(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
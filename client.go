@@ -101,6 +101,15 @@ func WithRetryPolicy(policy retry.Policy) ClientOption {
 	}
 }
 
+// WithNoRetry returns a context whose requests are sent once, without the
+// retries the client's policy would otherwise make. Jobs().Submit and
+// SubmitRaw always behave this way, since a retried submission whose first
+// attempt reached slurmctld would queue the job twice, unless the client
+// was built with WithIdempotentSubmit.
+func WithNoRetry(ctx context.Context) context.Context {
+	return retry.WithNoRetry(ctx)
+}
+
 // WithBaseURL sets the base URL for the Slurm REST API
 func WithBaseURL(baseURL string) ClientOption {
 	return func(f *factory.ClientFactory) error {
@@ -147,6 +156,10 @@ func GetVersionCompatibility() *versioning.VersionCompatibilityMatrix {
 // retryability, and underlying cause.
 type SlurmError = errors.SlurmError
 
+// LimitExceededError is returned by job submission when Slurm rejects the
+// job for an association or QoS limit; it names the limit that was hit.
+type LimitExceededError = errors.LimitExceededError
+
 // Error code and category types for error handling
 type (
 	ErrorCode     = errors.ErrorCode
@@ -155,15 +168,21 @@ type (
 
 // Common error codes - re-exported for convenience
 const (
-	ErrorCodeUnknown          = errors.ErrorCodeUnknown
-	ErrorCodeNetworkTimeout   = errors.ErrorCodeNetworkTimeout
-	ErrorCodeConnectionRefused = errors.ErrorCodeConnectionRefused
-	ErrorCodeUnauthorized     = errors.ErrorCodeUnauthorized
-	ErrorCodePermissionDenied = errors.ErrorCodePermissionDenied
-	ErrorCodeResourceNotFound = errors.ErrorCodeResourceNotFound
-	ErrorCodeValidationFailed = errors.ErrorCodeValidationFailed
-	ErrorCodeServerInternal   = errors.ErrorCodeServerInternal
-	ErrorCodeRateLimited      = errors.ErrorCodeRateLimited
+	ErrorCodeUnknown            = errors.ErrorCodeUnknown
+	ErrorCodeNetworkTimeout     = errors.ErrorCodeNetworkTimeout
+	ErrorCodeConnectionRefused  = errors.ErrorCodeConnectionRefused
+	ErrorCodeUnauthorized       = errors.ErrorCodeUnauthorized
+	ErrorCodePermissionDenied   = errors.ErrorCodePermissionDenied
+	ErrorCodeAuthPluginMismatch = errors.ErrorCodeAuthPluginMismatch
+	ErrorCodeResourceNotFound   = errors.ErrorCodeResourceNotFound
+	ErrorCodeJobNotFound        = errors.ErrorCodeJobNotFound
+	ErrorCodeNodeNotFound       = errors.ErrorCodeNodeNotFound
+	ErrorCodePartitionNotFound  = errors.ErrorCodePartitionNotFound
+	ErrorCodeAccountNotFound    = errors.ErrorCodeAccountNotFound
+	ErrorCodeValidationFailed   = errors.ErrorCodeValidationFailed
+	ErrorCodeServerInternal     = errors.ErrorCodeServerInternal
+	ErrorCodeRateLimited        = errors.ErrorCodeRateLimited
+	ErrorCodeLimitExceeded      = errors.ErrorCodeLimitExceeded
 )
 
 // VersionError represents a version-related error
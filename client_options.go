@@ -10,7 +10,11 @@ import (
 	"time"
 
 	"github.com/jontk/slurm-client/internal/factory"
+	"github.com/jontk/slurm-client/pkg/audit"
 	"github.com/jontk/slurm-client/pkg/auth"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/codec"
+	"github.com/jontk/slurm-client/pkg/config"
 )
 
 // Additional client options that aren't in client.go
@@ -63,6 +67,10 @@ func (u *userTokenAuth) Type() string {
 	return "user-token"
 }
 
+func (u *userTokenAuth) Username() string {
+	return u.username
+}
+
 // WithHTTPClient sets a custom HTTP client
 func WithHTTPClient(client *http.Client) ClientOption {
 	return func(f *factory.ClientFactory) error {
@@ -112,3 +120,133 @@ func WithTimeout(timeout time.Duration) ClientOption {
 		return f.SetTimeout(timeout)
 	}
 }
+
+// WithOperationTimeout sets the default deadline of one class of operation,
+// such as a longer one for job submissions (config.OperationWrite) than for
+// reads, overriding Config.Timeouts. It applies only when the caller's
+// context has no deadline of its own. Setting one for config.OperationList,
+// OperationGet or OperationWrite replaces the HTTP client's timeout, which
+// stays the deadline of the classes left unset; see config.Timeouts.
+func WithOperationTimeout(class config.OperationClass, timeout time.Duration) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithOperationTimeout(class, timeout)
+	}
+}
+
+// WithValidateOnCreate controls whether NewClient verifies connectivity and
+// credentials before returning. When enabled, the client pings the cluster
+// during construction and returns an *errors.AuthenticationError if the
+// credentials are rejected. By default validation is lazy and auth failures
+// surface on the first API call.
+func WithValidateOnCreate(enabled bool) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithValidateOnCreate(enabled)
+	}
+}
+
+// WithStrictDecoding checks every successful response against the API schema
+// the client was built from and logs a warning naming any fields it does not
+// define. Such fields are otherwise silently dropped, so the warning is an
+// early sign that the cluster runs a newer Slurm than the client expects.
+// Responses are never rejected because of unknown fields.
+func WithStrictDecoding(enabled bool) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithStrictDecoding(enabled)
+	}
+}
+
+// WithMaxConcurrentRequests caps the number of requests the client sends to
+// slurmrestd at the same time. Once n requests are in flight, further calls
+// block until one completes or their context is cancelled. Unlike rate
+// limiting this bounds concurrency, not requests per second. Zero disables
+// the limit.
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithMaxConcurrentRequests(n)
+	}
+}
+
+// CLIConfig locates the scontrol and sacct binaries used by WithCLIFallback
+type CLIConfig = cli.Config
+
+// WithCLIFallback lets the client run scontrol and sacct on the local host
+// for operations slurmrestd does not expose. It is used only when the REST
+// API reports an operation as unsupported, and only for:
+//
+//   - Partitions().Update, through "scontrol update PartitionName=..."
+//   - Jobs().Script, through "scontrol write batch_script"
+//   - Jobs().GetWithOptions with IncludeAccounting, through "sacct"
+//
+// The commands run as the calling process's user with its Slurm
+// configuration, not with the REST credentials.
+func WithCLIFallback(cfg CLIConfig) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithCLIFallback(cfg)
+	}
+}
+
+// WithScriptSizeWarning logs a warning when a job is submitted with a batch
+// script larger than the given number of bytes. The default is 4 MiB,
+// slurmctld's default max_script_size, above which submissions fail unless
+// the cluster raises it; zero turns the warning off. Independently, any
+// request over the 50 MiB slurmrestd reads is logged before it is sent.
+func WithScriptSizeWarning(bytes int) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithScriptSizeWarning(bytes)
+	}
+}
+
+// WithIdempotentSubmit lets Jobs().Submit and SubmitRaw be retried under the
+// client's retry policy, which they otherwise never are: a retried
+// submission whose first attempt reached slurmctld queues the job twice.
+// Enable it only when a duplicate job is harmless, for instance because the
+// job script exits early when its work is already done.
+func WithIdempotentSubmit(enabled bool) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithIdempotentSubmit(enabled)
+	}
+}
+
+// WithDryRun turns on dry-run mode: every operation that would change the
+// cluster (Submit, Cancel, Create, Update, Delete and the like) logs the
+// method, URL and body of the request it would send, at info level, and
+// succeeds without sending it. Dry-run submissions return job ID 0. Reads
+// are sent as usual. The CLI fallback is not used in dry-run mode.
+func WithDryRun(enabled bool) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithDryRun(enabled)
+	}
+}
+
+// AuditSink receives the audit records of WithAuditLog; see package audit
+type AuditSink = audit.Sink
+
+// AuditRecord describes one audited request
+type AuditRecord = audit.Record
+
+// WithAuditLog records every operation that changes the cluster (Submit,
+// Cancel, Create, Update, Delete and the like) to sink: the Slurm user it
+// was made as, the method and path naming the operation, the request and
+// response bodies, the HTTP status and the time taken. Reads are not
+// recorded. Bodies are recorded as sent, so wrap the sink with
+// audit.Redact to keep fields such as job environments out of the log.
+// In dry-run mode the held-back requests are recorded with DryRun set.
+// Commands the CLI fallback runs to change the cluster, such as scancel or
+// scontrol reboot, are recorded too, with Method audit.MethodExec.
+func WithAuditLog(sink AuditSink) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithAuditLog(sink)
+	}
+}
+
+// Codec encodes request and decodes response bodies; see package codec
+type Codec = codec.Codec
+
+// WithCodec replaces encoding/json for the request and response bodies of
+// the API clients, for example with a faster JSON implementation. Each body
+// is encoded or decoded once, by the codec; bodies stay JSON on the wire.
+func WithCodec(c Codec) ClientOption {
+	return func(f *factory.ClientFactory) error {
+		return f.WithCodec(c)
+	}
+}
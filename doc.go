@@ -177,6 +177,15 @@ Info Manager:
 	info, _ := infoMgr.Get(ctx)
 	stats, _ := infoMgr.Stats(ctx)
 
+# Empty Lists
+
+Depending on the version, slurmrestd reports an empty list either as null or
+as []. The managers' List and Get methods normalize both to an empty, non-nil
+slice, in the list itself and in every list field of the objects returned
+(Node.Partitions, Job.JobState, Account.Coordinators and so on), so a nil
+check is never needed before ranging over or encoding them. Optional values
+held by pointer stay nil when slurmrestd does not report them.
+
 # Best Practices
 
 1. Always use context for cancellation support
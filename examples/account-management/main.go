@@ -630,12 +630,21 @@ func demonstrateResourceAllocation(ctx context.Context, cfg *config.Config, auth
 			"mem": 10240000, // 10TB
 			"gpu": 25,
 		},
-		// Time-based limits (monthly quota)
+		SharesRaw: 500, // High priority for burst
+	}
+	// Time-based limits (monthly quota) live on the account's associations,
+	// so they are set with Update once the account is associated with a cluster
+	burstQuota := &slurm.AccountUpdate{
 		GrpTRESMins: map[string]int64{
-			"cpu": 30000000, // Limited monthly CPU-minutes
-			"gpu": 1000000,  // Limited monthly GPU-minutes
+			"cpu":      30000000, // Limited monthly CPU-minutes
+			"gres/gpu": 1000000,  // Limited monthly GPU-minutes
+		},
+		GrpTRESRunMins: map[string]int64{
+			"cpu": 2000000, // CPU-minutes committed to running jobs
+		},
+		MaxTRESMinsPerJob: map[string]int64{
+			"cpu": 500000,
 		},
-		SharesRaw: 500, // High priority for burst
 	}
 
 	resp, err := client.Accounts().Create(ctx, burstAccount)
@@ -644,9 +653,13 @@ func demonstrateResourceAllocation(ctx context.Context, cfg *config.Config, auth
 	} else {
 		fmt.Printf("Created burst account: %s\n", resp.AccountName)
 		fmt.Println("  - High resource limits for urgent work")
-		fmt.Println("  - Monthly quotas to prevent overuse")
 		fmt.Println("  - Requires association to track usage")
 	}
+	if err := client.Accounts().Update(ctx, burstAccount.Name, burstQuota); err != nil {
+		log.Printf("Failed to set burst account quota: %v", err)
+	} else {
+		fmt.Println("  - Monthly quotas to prevent overuse")
+	}
 
 	// Example 3: Resource allocation summary
 	fmt.Println("\nResource Allocation Summary:")
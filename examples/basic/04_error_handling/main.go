@@ -118,7 +118,7 @@ func handleAPIErrors(ctx context.Context) {
 		var slurmErr *slurmErrors.SlurmError
 		if errors.As(err, &slurmErr) {
 			switch slurmErr.Code {
-			case slurmErrors.ErrorCodeResourceNotFound:
+			case slurmErrors.ErrorCodeJobNotFound:
 				fmt.Printf("Job %s not found\n", jobID)
 			case slurmErrors.ErrorCodeRateLimited:
 				fmt.Printf("Rate limit exceeded\n")
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jontk/slurm-client/pkg/analytics"
 	"github.com/jontk/slurm-client/tests/mocks"
 )
 
@@ -622,7 +623,7 @@ func (em *EfficiencyMonitor) GenerateEfficiencyReport(jobIDs []string) *Efficien
 	report.OverallSummary.ResourceWasteAnalysis = resourceWaste
 
 	// Generate potential savings
-	report.OverallSummary.PotentialSavings = em.calculatePotentialSavings(report.JobAnalysis)
+	report.OverallSummary.PotentialSavings = em.calculatePotentialSavings(report.JobAnalysis, resourceWaste)
 
 	// Identify top issues
 	report.OverallSummary.TopIssues = em.identifyTopSystemIssues(report.JobAnalysis)
@@ -637,8 +638,11 @@ func (em *EfficiencyMonitor) GenerateEfficiencyReport(jobIDs []string) *Efficien
 	return report
 }
 
+// costRates are example prices per resource-hour used to value wasted resources
+var costRates = analytics.CostRates{CPUHour: 0.05, GPUHour: 2.50, MemoryGBHour: 0.01}
+
 // calculatePotentialSavings estimates system-wide savings potential
-func (em *EfficiencyMonitor) calculatePotentialSavings(jobAnalyses []JobEfficiencyAnalysis) PotentialSavings {
+func (em *EfficiencyMonitor) calculatePotentialSavings(jobAnalyses []JobEfficiencyAnalysis, waste ResourceWasteAnalysis) PotentialSavings {
 	var totalOptimizationPotential float64
 	var cpuSavings, memorySavings float64
 
@@ -655,7 +659,7 @@ func (em *EfficiencyMonitor) calculatePotentialSavings(jobAnalyses []JobEfficien
 	}
 
 	return PotentialSavings{
-		EstimatedCostSavings:  totalOptimizationPotential * 50, // Estimate $50 per efficiency point
+		EstimatedCostSavings:  costRates.Cost(waste.TotalCPUWasteHours, waste.TotalGPUWasteHours, waste.TotalMemoryWasteGB),
 		CPUCoreSavings:        int(cpuSavings),
 		MemoryGBSavings:       memorySavings,
 		GPUSavings:            0, // No GPU jobs in current analysis
@@ -357,7 +357,7 @@ func demonstrateGracefulDegradation(ctx context.Context, cfg *config.Config, aut
 			fmt.Printf("Retrieved %d jobs from list\n", len(jobs.Jobs))
 			// Look for our job in the list
 			for _, j := range jobs.Jobs {
-				// Convert JobID (int32) to string for comparison
+				// Convert JobID (int64) to string for comparison
 				if j.JobID != nil && fmt.Sprintf("%d", *j.JobID) == jobID {
 					fmt.Println("Found job in list!")
 					displayJobInfo(&j)
@@ -577,8 +577,8 @@ type cachedJob struct {
 
 func getCachedJobData(jobID string) *cachedJob {
 	// Simulate cached data retrieval
-	// Convert jobID string to int32
-	var jobIDInt int32
+	// Convert jobID string to int64
+	var jobIDInt int64
 	_, _ = fmt.Sscanf(jobID, "%d", &jobIDInt)
 
 	name := "cached-job"
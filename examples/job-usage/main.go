@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main reports the measured resource usage of a finished job from
+// the Slurm accounting database.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/jontk/slurm-client"
+	"github.com/jontk/slurm-client/pkg/auth"
+	"github.com/jontk/slurm-client/pkg/config"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalf("usage: %s <job id>", os.Args[0])
+	}
+	jobID := os.Args[1]
+
+	// Create configuration
+	cfg := config.NewDefault()
+	if url := os.Getenv("SLURM_REST_URL"); url != "" {
+		cfg.BaseURL = url
+	}
+
+	// Create authentication provider
+	var authProvider auth.Provider
+	if token := os.Getenv("SLURM_JWT"); token != "" {
+		authProvider = auth.NewTokenAuth(token)
+	} else {
+		authProvider = auth.NewNoAuth()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := slurm.NewClient(ctx,
+		slurm.WithConfig(cfg),
+		slurm.WithAuth(authProvider),
+	)
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	usage, err := client.Jobs().TRESUsage(ctx, jobID)
+	if err != nil {
+		log.Fatalf("Failed to get usage of job %s: %v", jobID, err)
+	}
+
+	fmt.Printf("Job %s\n", usage.JobID)
+	fmt.Printf("  Allocated:  %s\n", formatTRES(usage.Allocated))
+	fmt.Printf("  Max RSS:    %s\n", formatBytes(usage.MaxRSS))
+	fmt.Printf("  CPU time:   %s\n", usage.CPUTime.Round(time.Second))
+	if usage.EnergyConsumed > 0 {
+		fmt.Printf("  Energy:     %d J\n", usage.EnergyConsumed)
+	}
+
+	// CPU efficiency needs the elapsed time, which comes from the job record
+	if job, err := client.Jobs().Get(ctx, jobID); err == nil && !job.StartTime.IsZero() && !job.EndTime.IsZero() {
+		cores := usage.Allocated["cpu"]
+		elapsed := job.EndTime.Sub(job.StartTime)
+		if cores > 0 && elapsed > 0 {
+			efficiency := usage.CPUTime.Seconds() / (elapsed.Seconds() * float64(cores)) * 100
+			fmt.Printf("  CPU efficiency: %.1f%% of %d cores over %s\n", efficiency, cores, elapsed.Round(time.Second))
+		}
+	}
+
+	fmt.Println("\nSteps:")
+	for _, step := range usage.Steps {
+		fmt.Printf("  %-8s %-16s max RSS %-10s CPU %-10s on %v\n",
+			step.StepID, step.Name, formatBytes(step.MaxRSS), step.CPUTime.Round(time.Second), step.Nodes)
+	}
+
+	fmt.Println("\nNodes:")
+	for _, node := range usage.Nodes {
+		fmt.Printf("  %-12s max RSS %-10s CPU %-10s steps %v\n",
+			node.Node, formatBytes(node.MaxRSS), node.CPUTime.Round(time.Second), node.Steps)
+	}
+}
+
+func formatTRES(tres map[string]int64) string {
+	keys := make([]string, 0, len(tres))
+	for key := range tres {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	s := ""
+	for i, key := range keys {
+		if i > 0 {
+			s += ","
+		}
+		s += fmt.Sprintf("%s=%d", key, tres[key])
+	}
+	return s
+}
+
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -163,7 +163,7 @@ func createQoSLevels(ctx context.Context, baseURL, jwtToken string) {
 		PreemptMode: []string{"REQUEUE"},
 		GraceTime:   300, // 5 minutes
 		UsageFactor: 2.0, // Double charge for priority
-		Flags:       []string{"DENY_LIMIT", "REQUIRE_ASSOC"},
+		Flags:       []slurm.QoSFlag{"DENY_LIMIT", "REQUIRE_ASSOC"},
 		// Note: Detailed limit configuration would require setting Limits struct
 		// MaxJobs, MaxCPUs, MaxWallTime, MaxNodes are configured via the Limits field
 		// AllowedAccounts would be managed separately via account-QoS associations
@@ -186,7 +186,7 @@ func createQoSLevels(ctx context.Context, baseURL, jwtToken string) {
 		PreemptMode: []string{"SUSPEND"},
 		GraceTime:   600, // 10 minutes
 		UsageFactor: 1.0,
-		Flags:       []string{"DENY_LIMIT"},
+		Flags:       []slurm.QoSFlag{"DENY_LIMIT"},
 		// Note: Detailed limit configuration would require setting Limits struct
 	}
 
@@ -206,7 +206,7 @@ func createQoSLevels(ctx context.Context, baseURL, jwtToken string) {
 		Priority:    100,
 		PreemptMode: []string{"CANCEL"},
 		UsageFactor: 0.1, // 10% charge - incentivize usage
-		Flags:       []string{"NO_RESERVE"},
+		Flags:       []slurm.QoSFlag{"NO_RESERVE"},
 		// Note: Detailed limit configuration would require setting Limits struct
 		// Account restrictions would be managed separately
 	}
@@ -313,7 +313,7 @@ func demonstrateQoSHierarchy(ctx context.Context, baseURL, jwtToken string) {
 		Priority:    100000,
 		PreemptMode: []string{"REQUEUE"},
 		GraceTime:   60, // 1 minute grace
-		Flags: []string{
+		Flags: []slurm.QoSFlag{
 			// Note: PreemptExempt is not directly available as a string flag
 			// instead, use PreemptList and PreemptExemptTime to control preemption behavior
 		},
@@ -334,7 +334,7 @@ func demonstrateQoSHierarchy(ctx context.Context, baseURL, jwtToken string) {
 		Priority:    50000,
 		PreemptMode: []string{"SUSPEND"},
 		GraceTime:   300, // 5 minutes
-		Flags:       []string{"DENY_LIMIT"},
+		Flags:       []slurm.QoSFlag{"DENY_LIMIT"},
 		// Note: Account restrictions would be managed separately via account-QoS associations
 	}
 
@@ -351,7 +351,7 @@ func demonstrateQoSHierarchy(ctx context.Context, baseURL, jwtToken string) {
 		Description: "Interactive jobs - quick turnaround",
 		Priority:    5000,
 		PreemptMode: []string{"DISABLED"},
-		Flags:       []string{"NO_RESERVE"}, // Don't make reservations
+		Flags:       []slurm.QoSFlag{"NO_RESERVE"}, // Don't make reservations
 		// Note: Job count and CPU limits would be configured via Limits struct
 	}
 
@@ -397,7 +397,7 @@ func demonstrateResourceLimits(ctx context.Context, baseURL, jwtToken string) {
 		Priority:        1000,
 		UsageFactor:     1.5, // 50% surcharge
 		UsageThreshold:  0.8, // Start limiting at 80% usage
-		Flags: []string{
+		Flags: []slurm.QoSFlag{
 			"DENY_LIMIT",               // Deny when limits reached
 			"ENFORCE_USAGE_THRESHOLD",  // Enforce the usage threshold
 			"NO_DECAY",                 // Don't decay priority
@@ -420,7 +420,7 @@ func demonstrateResourceLimits(ctx context.Context, baseURL, jwtToken string) {
 		Description: "QoS for GPU-accelerated jobs",
 		Priority:    2000,
 		UsageFactor: 3.0, // 3x charge for GPU resources
-		Flags:       []string{"REQUIRE_ASSOC", "PARTITION_QOS"},
+		Flags:       []slurm.QoSFlag{"REQUIRE_ASSOC", "PARTITION_QOS"},
 		// Note: Actual GPU limits would be set via GRES in job submission
 		// Job and CPU limits would be configured via Limits struct
 		// Account restrictions would be managed separately via account-QoS associations
@@ -442,7 +442,7 @@ func demonstrateResourceLimits(ctx context.Context, baseURL, jwtToken string) {
 		Priority:        1000,
 		UsageFactor:     1.0,
 		UsageThreshold:  0.5, // Start reducing priority at 50% share
-		Flags: []string{
+		Flags: []slurm.QoSFlag{
 			"NO_DECAY",            // Maintain priority decay
 			"USAGE_FACTOR_SAFE",   // Protected from usage factor changes
 			"PARTITION_TIME_LIMIT", // Use partition time limits
@@ -194,7 +194,7 @@ func createReservation(ctx context.Context, cfg *config.Config, auth auth.Provid
 		Duration:  uint32Ptr(4 * 60), // 4 hours in minutes
 		NodeList:  []string{"node001", "node002", "node003"},
 		Users:     []string{"admin", "maintenance"},
-		Flags:     []types.FlagsValue{"MAINT", "IGNORE_JOBS"},
+		Flags:     []types.ReservationFlag{types.ReservationFlagMaintenance, types.ReservationFlagIgnoreJobs},
 	}
 
 	resp, err := client.Reservations().Create(ctx, nodeReservation)
@@ -339,7 +339,7 @@ func complexReservationScenarios(ctx context.Context, cfg *config.Config, auth a
 			NodeList:  []string{"gpu001", "gpu002"},
 			Accounts:  []string{"ml-research"},
 			Features:  stringPtr("gpu"),
-			Flags:     []types.FlagsValue{"DAILY_9_5"}, // Custom flag
+			Flags:     []types.ReservationFlag{"DAILY_9_5"}, // Custom flag
 		}
 
 		resp, err := client.Reservations().Create(ctx, reservation)
@@ -403,7 +403,7 @@ func complexReservationScenarios(ctx context.Context, cfg *config.Config, auth a
 		Duration:  uint32Ptr(1 * 60),              // 1 hour in minutes
 		NodeList:  []string{"node001", "node002", "node003", "node004"},
 		Users:     []string{"root"},
-		Flags:     []types.FlagsValue{"NO_HOLD_JOBS_AFTER_END"},
+		Flags:     []types.ReservationFlag{types.ReservationFlagsNoHoldJobsAfterEnd},
 	}
 
 	_, err = client.Reservations().Create(ctx, preMaintenance)
@@ -418,7 +418,7 @@ func complexReservationScenarios(ctx context.Context, cfg *config.Config, auth a
 		Duration:  uint32Ptr(4 * 60), // 4 hours in minutes
 		NodeList:  []string{"node001", "node002", "node003", "node004"},
 		Users:     []string{"root", "admin"},
-		Flags:     []types.FlagsValue{"MAINT", "IGNORE_JOBS"},
+		Flags:     []types.ReservationFlag{types.ReservationFlagMaintenance, types.ReservationFlagIgnoreJobs},
 	}
 
 	_, err = client.Reservations().Create(ctx, maintenance)
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/jontk/slurm-client"
+	"github.com/jontk/slurm-client/api"
 	"github.com/jontk/slurm-client/pkg/auth"
 	"github.com/jontk/slurm-client/pkg/config"
 )
@@ -95,7 +96,7 @@ func watchJobs(ctx context.Context, jobManager slurm.JobManager, watchOpts *slur
 
 			// Handle different event types
 			switch event.EventType {
-			case "job_new":
+			case api.JobEventAdded:
 				fmt.Printf("[%s] New job detected: ID=%d, State=%s\n",
 					event.EventTime.Format(time.RFC3339),
 					event.JobId,
@@ -104,7 +105,7 @@ func watchJobs(ctx context.Context, jobManager slurm.JobManager, watchOpts *slur
 					fmt.Printf("  User: %v, Partition: %v\n", event.Job.UserID, event.Job.Partition)
 				}
 
-			case "job_state_change":
+			case api.JobEventModified:
 				fmt.Printf("[%s] Job state changed: ID=%d, %s -> %s\n",
 					event.EventTime.Format(time.RFC3339),
 					event.JobId,
@@ -114,8 +115,8 @@ func watchJobs(ctx context.Context, jobManager slurm.JobManager, watchOpts *slur
 					fmt.Printf("  User: %v, Partition: %v\n", event.Job.UserID, event.Job.Partition)
 				}
 
-			case "job_completed":
-				fmt.Printf("[%s] Job completed: ID=%d (was %s)\n",
+			case api.JobEventDeleted:
+				fmt.Printf("[%s] Job left the queue: ID=%d (was %s)\n",
 					event.EventTime.Format(time.RFC3339),
 					event.JobId,
 					event.PreviousState)
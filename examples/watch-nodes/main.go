@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/jontk/slurm-client"
+	"github.com/jontk/slurm-client/api"
 	"github.com/jontk/slurm-client/pkg/auth"
 	"github.com/jontk/slurm-client/pkg/config"
 )
@@ -95,8 +96,8 @@ func watchNodes(ctx context.Context, nodeManager slurm.NodeManager, watchOpts *s
 
 			// Handle different event types
 			switch event.EventType {
-			case "node_new":
-				fmt.Printf("[%s] New node detected: %s, State=%s\n",
+			case api.NodeEventAdded:
+				fmt.Printf("[%s] Node: %s, State=%s\n",
 					event.EventTime.Format(time.RFC3339),
 					event.NodeName,
 					event.NewState)
@@ -105,7 +106,13 @@ func watchNodes(ctx context.Context, nodeManager slurm.NodeManager, watchOpts *s
 						event.Node.CPUs, event.Node.RealMemory, event.Node.Partitions)
 				}
 
-			case "node_state_change":
+			case api.NodeEventSynced:
+				// Every existing node has been reported; the events from
+				// here on are changes
+				fmt.Printf("[%s] Initial node list complete\n",
+					event.EventTime.Format(time.RFC3339))
+
+			case api.NodeEventModified:
 				fmt.Printf("[%s] Node state changed: %s, %s -> %s\n",
 					event.EventTime.Format(time.RFC3339),
 					event.NodeName,
@@ -113,18 +120,19 @@ func watchNodes(ctx context.Context, nodeManager slurm.NodeManager, watchOpts *s
 					event.NewState)
 
 				// Show additional details for state changes
+				if event.Reason != "" {
+					fmt.Printf("  Reason: %s\n", event.Reason)
+				}
 				if event.Node != nil {
-					if event.NewState == "DOWN" || event.NewState == "DRAINING" {
-						fmt.Printf("  Reason: %v\n", event.Node.Reason)
-					}
 					fmt.Printf("  CPUs: %d, Memory: %d MB\n",
 						event.Node.CPUs, event.Node.RealMemory)
 				}
 
-			case "error":
-				fmt.Printf("[%s] Error event: %s\n",
+			case api.NodeEventDeleted:
+				fmt.Printf("[%s] Node left the listing: %s (was %s)\n",
 					event.EventTime.Format(time.RFC3339),
-					event.Reason)
+					event.NodeName,
+					event.PreviousState)
 
 			default:
 				fmt.Printf("[%s] Unknown event type: %s\n",
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/config"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// HealthSweepConcurrency is the most endpoints HealthSweep checks at once
+const HealthSweepConcurrency = 16
+
+// HealthStatus is the outcome of checking one cluster in a HealthSweep.
+type HealthStatus struct {
+	BaseURL string
+	// Healthy is true when the cluster answered a ping
+	Healthy bool
+	// Latency is how long the ping took, including a failed one
+	Latency time.Duration
+	// APIVersion is the REST API version the cluster was spoken to with
+	APIVersion string
+	// Version is the Slurm version the cluster reports; empty when
+	// unhealthy or not reported
+	Version     string
+	ClusterName string
+	CheckedAt   time.Time
+	// Error is why the cluster is unhealthy
+	Error error
+}
+
+// HealthSweep health-checks every endpoint concurrently and returns the
+// status of each, in the order of endpoints, so endpoints sharing a base
+// URL are each reported. Each endpoint gets a client with version
+// detection, a ping, and on success a cluster info request for the Slurm
+// version, honouring the endpoint's Debug, Timeouts and Codec; the whole
+// check runs under the endpoint's Get timeout. Authentication must come
+// from the endpoint's HTTPClient. A failed endpoint is reported in its
+// status and does not affect the others; cancelling ctx marks the
+// endpoints not yet checked as unhealthy.
+func HealthSweep(ctx context.Context, endpoints []ClientConfig) []*HealthStatus {
+	statuses := make([]*HealthStatus, len(endpoints))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, HealthSweepConcurrency)
+
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint ClientConfig) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				statuses[i] = checkHealth(ctx, endpoint)
+				<-sem
+			case <-ctx.Done():
+				statuses[i] = &HealthStatus{BaseURL: endpoint.BaseURL, CheckedAt: time.Now(), Error: ctx.Err()}
+			}
+		}(i, endpoint)
+	}
+	wg.Wait()
+	return statuses
+}
+
+// checkHealth pings one endpoint
+func checkHealth(ctx context.Context, endpoint ClientConfig) *HealthStatus {
+	status := &HealthStatus{BaseURL: endpoint.BaseURL, CheckedAt: time.Now()}
+	if endpoint.BaseURL == "" {
+		status.Error = errors.NewValidationErrorf("BaseURL", endpoint.BaseURL, "base URL is required")
+		return status
+	}
+
+	cfg := config.NewDefault()
+	cfg.BaseURL = endpoint.BaseURL
+	cfg.Debug = endpoint.Debug
+	cfg.Timeouts = endpoint.Timeouts
+	timeout := endpoint.Timeouts.For(config.OperationGet)
+	if timeout <= 0 {
+		timeout = cfg.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	options := []ClientOption{WithConfig(cfg), WithBaseURL(endpoint.BaseURL)}
+	if endpoint.HTTPClient != nil {
+		options = append(options, WithHTTPClient(sweepHTTPClient(endpoint.HTTPClient)))
+	}
+	if endpoint.Codec != nil {
+		options = append(options, WithCodec(endpoint.Codec))
+	}
+	client, err := NewClient(ctx, options...)
+	if err != nil {
+		status.Error = err
+		return status
+	}
+	defer func() { _ = client.Close() }()
+	status.APIVersion = client.Version()
+
+	start := time.Now()
+	err = client.Info().Ping(ctx)
+	status.Latency = time.Since(start)
+	if err != nil {
+		status.Error = err
+		return status
+	}
+	status.Healthy = true
+
+	// The version is informational; a cluster that pings is healthy
+	// whether or not it also answers this
+	if info, err := client.Info().Get(ctx); err == nil {
+		status.Version = info.Version
+		status.ClusterName = info.ClusterName
+	}
+	return status
+}
+
+// sweepHTTPClient returns doer as an *http.Client, wrapping it when it is
+// some other HTTPDoer
+func sweepHTTPClient(doer HTTPDoer) *http.Client {
+	if client, ok := doer.(*http.Client); ok {
+		return client
+	}
+	return &http.Client{Transport: doerTransport{doer}}
+}
+
+// doerTransport sends requests through an HTTPDoer
+type doerTransport struct {
+	doer HTTPDoer
+}
+
+func (t doerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.doer.Do(req)
+}
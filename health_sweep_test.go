@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client"
+	"github.com/jontk/slurm-client/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHealthServer returns a v0.0.44 cluster that announces its version and
+// answers pings with its Slurm release
+func newHealthServer(t *testing.T, cluster string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/openapi/v3":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"info": map[string]interface{}{"version": "v0.0.44"},
+			})
+		case "/slurm/v0.0.44/ping/":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"meta": map[string]interface{}{"slurm": map[string]interface{}{
+					"cluster": cluster,
+					"release": "24.11.1",
+					"version": map[string]interface{}{"major": "24", "minor": "11", "micro": "1"},
+				}},
+				"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP", "responding": true}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHealthSweep(t *testing.T) {
+	healthy := newHealthServer(t, "alpha")
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(broken.Close)
+	gone := httptest.NewServer(http.NotFoundHandler())
+	gone.Close()
+
+	// Never answers within the endpoint's timeout
+	stalled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	t.Cleanup(stalled.Close)
+
+	start := time.Now()
+	statuses := slurm.HealthSweep(context.Background(), []slurm.ClientConfig{
+		{BaseURL: healthy.URL, HTTPClient: healthy.Client()},
+		{BaseURL: broken.URL},
+		{BaseURL: gone.URL},
+		{},
+		{BaseURL: stalled.URL, Timeouts: config.Timeouts{Get: 200 * time.Millisecond}},
+		// The same cluster again is reported separately
+		{BaseURL: healthy.URL, HTTPClient: healthy.Client()},
+	})
+	require.Len(t, statuses, 6)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	for _, i := range []int{0, 5} {
+		ok := statuses[i]
+		require.NotNil(t, ok)
+		assert.Equal(t, healthy.URL, ok.BaseURL)
+		assert.True(t, ok.Healthy)
+		assert.NoError(t, ok.Error)
+		assert.Equal(t, "v0.0.44", ok.APIVersion)
+		assert.Equal(t, "24.11.1", ok.Version)
+		assert.Equal(t, "alpha", ok.ClusterName)
+		assert.Positive(t, ok.Latency)
+	}
+
+	for i, url := range []string{broken.URL, gone.URL, "", stalled.URL} {
+		status := statuses[i+1]
+		require.NotNil(t, status, url)
+		assert.Equal(t, url, status.BaseURL)
+		assert.False(t, status.Healthy, url)
+		assert.Error(t, status.Error, url)
+		assert.Empty(t, status.Version, url)
+	}
+}
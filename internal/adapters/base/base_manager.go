@@ -156,10 +156,22 @@ func (b *BaseManager) HandleHTTPResponse(resp_iface interface{}, body []byte) er
 		return nil // Success
 	}
 	var slurmErr struct {
-		Errors []errors.SlurmAPIErrorDetail `json:"errors"`
+		Errors []struct {
+			errors.SlurmAPIErrorDetail
+			// Error is Slurm's text for the error number, such as
+			// "Invalid job id specified"
+			Error string `json:"error"`
+		} `json:"errors"`
 	}
 	if err := json.Unmarshal(body, &slurmErr); err == nil && len(slurmErr.Errors) > 0 {
-		return errors.NewSlurmAPIError(resp.StatusCode, b.version, slurmErr.Errors)
+		details := make([]errors.SlurmAPIErrorDetail, len(slurmErr.Errors))
+		for i, detail := range slurmErr.Errors {
+			details[i] = detail.SlurmAPIErrorDetail
+			if details[i].ErrorCode == "" {
+				details[i].ErrorCode = detail.Error
+			}
+		}
+		return errors.NewSlurmAPIError(resp.StatusCode, b.version, details)
 	}
 	details := []errors.SlurmAPIErrorDetail{
 		{Description: string(body)},
@@ -25,7 +25,7 @@ func derefBool(b *bool) bool {
 
 // Job field helpers
 // getJobID safely extracts the job ID from a Job
-func getJobID(job *types.Job) int32 {
+func getJobID(job *types.Job) int64 {
 	if job == nil || job.JobID == nil {
 		return 0
 	}
@@ -354,15 +354,19 @@ func (m *JobBaseManager) FilterJobList(jobs []types.Job, opts *types.JobListOpti
 // matchesJobFilters checks if a job matches the given filters
 func (m *JobBaseManager) matchesJobFilters(job types.Job, opts *types.JobListOptions) bool {
 	return m.checkJobIDFilter(opts.JobIDs, getJobID(&job)) &&
+		opts.MatchesArrayJobIDs(&job) &&
 		m.checkStringFilter(opts.JobNames, derefString(job.Name)) &&
 		m.checkStringFilter(opts.Accounts, derefString(job.Account)) &&
 		m.checkStringFilter(opts.Users, derefString(job.UserName)) &&
 		m.checkStateFilter(opts.States, getJobState(&job)) &&
 		m.checkStringFilter(opts.Partitions, derefString(job.Partition)) &&
 		m.checkStringFilter(opts.QoS, derefString(job.QoS)) &&
-		m.checkTimeRange(&job.SubmitTime, opts.StartTime, opts.EndTime)
+		m.checkStringFilter(opts.Reasons, derefString(job.StateReason)) &&
+		(!opts.HeldOnly || job.HeldBy() != "") &&
+		m.checkTimeRange(&job.SubmitTime, opts.StartTime, opts.EndTime) &&
+		opts.MatchesTimeWindows(&job)
 }
-func (m *JobBaseManager) checkJobIDFilter(filterIDs []int32, jobID int32) bool {
+func (m *JobBaseManager) checkJobIDFilter(filterIDs []int64, jobID int64) bool {
 	if len(filterIDs) == 0 {
 		return true
 	}
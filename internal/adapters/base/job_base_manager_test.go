@@ -4,6 +4,7 @@ package base
 
 import (
 	"testing"
+	"time"
 
 	types "github.com/jontk/slurm-client/api"
 	"github.com/stretchr/testify/assert"
@@ -122,10 +123,17 @@ func TestJobBaseManager_ApplyJobDefaults(t *testing.T) {
 func TestJobBaseManager_FilterJobList(t *testing.T) {
 	manager := NewJobBaseManager("v0.0.43")
 	jobs := []types.Job{
-		{JobID: int32Ptr(1), Name: stringPtr("job1"), Account: stringPtr("account1"), JobState: []types.JobState{types.JobStatePending}},
-		{JobID: int32Ptr(2), Name: stringPtr("job2"), Account: stringPtr("account2"), JobState: []types.JobState{types.JobStateRunning}},
-		{JobID: int32Ptr(3), Name: stringPtr("job3"), Account: stringPtr("account1"), JobState: []types.JobState{types.JobStateCompleted}},
+		{JobID: int64Ptr(1), Name: stringPtr("job1"), Account: stringPtr("account1"), JobState: []types.JobState{types.JobStatePending}},
+		{JobID: int64Ptr(2), Name: stringPtr("job2"), Account: stringPtr("account2"), JobState: []types.JobState{types.JobStateRunning}},
+		{JobID: int64Ptr(3), Name: stringPtr("job3"), Account: stringPtr("account1"), JobState: []types.JobState{types.JobStateCompleted}},
+		{JobID: int64Ptr(4), Name: stringPtr("job4"), Account: stringPtr("account2"), JobState: []types.JobState{types.JobStatePending}, StateReason: stringPtr("Priority")},
+		{JobID: int64Ptr(5), Name: stringPtr("job5"), Account: stringPtr("account1"), JobState: []types.JobState{types.JobStatePending}, StateReason: stringPtr("QOSMaxJobsPerUserLimit")},
 	}
+	// job2 was submitted and started recently; job3 ran and ended earlier
+	now := time.Now()
+	jobs[1].SubmitTime, jobs[1].StartTime = now.Add(-time.Hour), now.Add(-time.Hour)
+	jobs[2].SubmitTime, jobs[2].StartTime, jobs[2].EndTime = now.Add(-5*time.Hour), now.Add(-4*time.Hour), now.Add(-3*time.Hour)
+	cutoff := now.Add(-2 * time.Hour)
 	tests := []struct {
 		name     string
 		opts     *types.JobListOptions
@@ -134,20 +142,55 @@ func TestJobBaseManager_FilterJobList(t *testing.T) {
 		{
 			name:     "no filters",
 			opts:     nil,
-			expected: 3,
+			expected: 5,
 		},
 		{
 			name: "filter by account",
 			opts: &types.JobListOptions{
 				Accounts: []string{"account1"},
 			},
-			expected: 2,
+			expected: 3,
 		},
 		{
 			name: "filter by state",
 			opts: &types.JobListOptions{
 				States: []types.JobState{types.JobStatePending},
 			},
+			expected: 3,
+		},
+		{
+			name: "filter by reason",
+			opts: &types.JobListOptions{
+				Reasons: []string{"priority"},
+			},
+			expected: 1,
+		},
+		{
+			name: "filter by several reasons",
+			opts: &types.JobListOptions{
+				Reasons: []string{"Priority", "QOSMaxJobsPerUserLimit"},
+			},
+			expected: 2,
+		},
+		{
+			name: "filter by submit time",
+			opts: &types.JobListOptions{
+				SubmittedAfter: &cutoff,
+			},
+			expected: 1,
+		},
+		{
+			name: "filter by start time",
+			opts: &types.JobListOptions{
+				StartedAfter: &cutoff,
+			},
+			expected: 1,
+		},
+		{
+			name: "filter by end time",
+			opts: &types.JobListOptions{
+				EndBefore: &cutoff,
+			},
 			expected: 1,
 		},
 	}
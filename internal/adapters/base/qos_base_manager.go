@@ -164,7 +164,7 @@ func (m *QoSBaseManager) ApplyQoSDefaults(qos *types.QoSCreate) *types.QoSCreate
 	}
 	// Ensure flags array is initialized
 	if qos.Flags == nil {
-		qos.Flags = []string{}
+		qos.Flags = []types.QoSFlag{}
 	}
 	// Ensure preempt mode array is initialized
 	if qos.PreemptMode == nil {
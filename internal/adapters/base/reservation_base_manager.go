@@ -176,7 +176,7 @@ func (m *ReservationBaseManager) ApplyReservationDefaults(reservation *types.Res
 		reservation.Groups = []string{}
 	}
 	if reservation.Flags == nil {
-		reservation.Flags = []types.FlagsValue{}
+		reservation.Flags = []types.ReservationFlag{}
 	}
 	if reservation.TRES == nil {
 		reservation.TRES = []types.TRES{}
@@ -6,6 +6,9 @@ package base
 func int32Ptr(i int32) *int32 {
 	return &i
 }
+func int64Ptr(i int64) *int64 {
+	return &i
+}
 func uint32Ptr(i uint32) *uint32 {
 	return &i
 }
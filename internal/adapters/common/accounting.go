@@ -0,0 +1,339 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// AccountingJob is the part of a slurmdbd job record read by the accounting,
+// history, step and TRES usage lookups. The record has the same JSON shape from
+// v0.0.40 to v0.0.44, so every adapter decodes its generated job type into
+// it with DecodeAccountingJob and shares the conversions below.
+type AccountingJob struct {
+	JobID     int64   `json:"job_id"`
+	Name      *string `json:"name"`
+	User      *string `json:"user"`
+	Account   *string `json:"account"`
+	Partition *string `json:"partition"`
+	QoS       *string `json:"qos"`
+	Nodes     *string `json:"nodes"`
+	// AllocationNodes is the number of nodes allocated to the job
+	AllocationNodes *int64 `json:"allocation_nodes"`
+	Required        *struct {
+		CPUs *int64 `json:"CPUs"`
+	} `json:"required"`
+	State *struct {
+		Current []string `json:"current"`
+	} `json:"state"`
+	Time *struct {
+		Submission int64             `json:"submission"`
+		Eligible   int64             `json:"eligible"`
+		Start      int64             `json:"start"`
+		End        int64             `json:"end"`
+		Limit      *accountingNumber `json:"limit"`
+	} `json:"time"`
+	ExitCode        *accountingExitCode `json:"exit_code"`
+	DerivedExitCode *accountingExitCode `json:"derived_exit_code"`
+	TRES            *struct {
+		Allocated []types.TRES `json:"allocated"`
+		Requested []types.TRES `json:"requested"`
+	} `json:"tres"`
+	Steps []AccountingStep `json:"steps"`
+}
+
+// AccountingStep is a step of a slurmdbd job record
+type AccountingStep struct {
+	Step *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"step"`
+	State []string `json:"state"`
+	Nodes *struct {
+		Range string   `json:"range"`
+		List  []string `json:"list"`
+	} `json:"nodes"`
+	Time *struct {
+		Start *accountingNumber `json:"start"`
+		End   *accountingNumber `json:"end"`
+		Total *struct {
+			Seconds      int64 `json:"seconds"`
+			Microseconds int64 `json:"microseconds"`
+		} `json:"total"`
+	} `json:"time"`
+	Statistics *struct {
+		Energy *struct {
+			Consumed *accountingNumber `json:"consumed"`
+		} `json:"energy"`
+	} `json:"statistics"`
+	TRES *struct {
+		Allocated []types.TRES `json:"allocated"`
+		Consumed  *struct {
+			Max   []types.TRES `json:"max"`
+			Total []types.TRES `json:"total"`
+		} `json:"consumed"`
+	} `json:"tres"`
+	ExitCode *accountingExitCode `json:"exit_code"`
+}
+
+// accountingNumber is a slurmdbd number with its set flag
+type accountingNumber struct {
+	Set    bool  `json:"set"`
+	Number int64 `json:"number"`
+}
+
+// value returns the number when it is set
+func (n *accountingNumber) value() (int64, bool) {
+	if n == nil || !n.Set {
+		return 0, false
+	}
+	return n.Number, true
+}
+
+// accountingExitCode is a slurmdbd process exit code
+type accountingExitCode struct {
+	Status     []string          `json:"status"`
+	ReturnCode *accountingNumber `json:"return_code"`
+	Signal     *struct {
+		ID   *accountingNumber `json:"id"`
+		Name *string           `json:"name"`
+	} `json:"signal"`
+}
+
+// DecodeAccountingJob decodes a generated slurmdbd job record of any API
+// version into an AccountingJob
+func DecodeAccountingJob(apiJob interface{}) (*AccountingJob, error) {
+	data, err := json.Marshal(apiJob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode accounting record: %w", err)
+	}
+	var job AccountingJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("failed to decode accounting record: %w", err)
+	}
+	return &job, nil
+}
+
+// DecodeAccountingJobs decodes a list of generated slurmdbd job records and
+// converts each to the common Job type
+func DecodeAccountingJobs[T any](apiJobs []T) ([]types.Job, error) {
+	jobs := make([]types.Job, 0, len(apiJobs))
+	for i := range apiJobs {
+		record, err := DecodeAccountingJob(apiJobs[i])
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *record.ToJob())
+	}
+	return jobs, nil
+}
+
+// UnixTimeParam formats t as the UNIX timestamp slurmdbd query parameters
+// take, or returns nil for the zero time
+func UnixTimeParam(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	s := strconv.FormatInt(t.Unix(), 10)
+	return &s
+}
+
+// ToJob converts the record to the common Job type. Besides the accounting
+// fields it carries the job's name, owner, account, partition, QoS and size,
+// so a job slurmctld has purged is still identifiable.
+func (r *AccountingJob) ToJob() *types.Job {
+	jobID := r.JobID
+	job := &types.Job{
+		JobID:           &jobID,
+		Name:            r.Name,
+		UserName:        r.User,
+		Account:         r.Account,
+		Partition:       r.Partition,
+		QoS:             r.QoS,
+		Nodes:           r.Nodes,
+		ExitCode:        r.ExitCode.toExitCode(),
+		DerivedExitCode: r.DerivedExitCode.toExitCode(),
+	}
+	if r.State != nil {
+		for _, s := range r.State.Current {
+			job.JobState = append(job.JobState, types.JobState(s))
+		}
+	}
+	if r.Time != nil {
+		job.SubmitTime = unixTime(r.Time.Submission)
+		job.EligibleTime = unixTime(r.Time.Eligible)
+		job.StartTime = unixTime(r.Time.Start)
+		job.EndTime = unixTime(r.Time.End)
+		if limit, ok := r.Time.Limit.value(); ok {
+			v := uint32(limit)
+			job.TimeLimit = &v
+		}
+	}
+	if r.Required != nil && r.Required.CPUs != nil && *r.Required.CPUs > 0 {
+		v := uint32(*r.Required.CPUs)
+		job.CPUs = &v
+	}
+	if r.AllocationNodes != nil && *r.AllocationNodes > 0 {
+		v := uint32(*r.AllocationNodes)
+		job.NodeCount = &v
+	}
+	if r.TRES != nil {
+		if s := FormatTRES(r.TRES.Allocated); s != "" {
+			job.TRESAllocStr = &s
+		}
+		if s := FormatTRES(r.TRES.Requested); s != "" {
+			job.TRESReqStr = &s
+		}
+	}
+	return job
+}
+
+// JobSteps converts the steps of the record to the common JobStep type
+func (r *AccountingJob) JobSteps() []types.JobStep {
+	steps := make([]types.JobStep, 0, len(r.Steps))
+	for i := range r.Steps {
+		steps = append(steps, r.Steps[i].toJobStep(r.JobID))
+	}
+	return steps
+}
+
+// TRESUsage builds the TRES usage report of the record from its steps
+func (r *AccountingJob) TRESUsage() *types.TRESUsageReport {
+	report := &types.TRESUsageReport{
+		JobID: strconv.FormatInt(r.JobID, 10),
+		Steps: make([]types.StepTRESUsage, 0, len(r.Steps)),
+	}
+	if r.TRES != nil {
+		report.Allocated = TRESMap(r.TRES.Allocated)
+	}
+	for i := range r.Steps {
+		report.Steps = append(report.Steps, r.Steps[i].toTRESUsage())
+	}
+	SummarizeTRESUsage(report)
+	return report
+}
+
+// toJobStep converts a step record to the common JobStep type
+func (s *AccountingStep) toJobStep(jobID int64) types.JobStep {
+	step := types.JobStep{
+		JobID: strconv.FormatInt(jobID, 10),
+		State: strings.Join(s.State, ","),
+	}
+	step.ID, step.Name = s.id()
+	if s.Nodes != nil {
+		step.Nodes = s.Nodes.Range
+	}
+	if s.Time != nil {
+		step.StartTime = stepTime(s.Time.Start)
+		step.EndTime = stepTime(s.Time.End)
+	}
+	if s.TRES != nil && len(s.TRES.Allocated) > 0 {
+		step.TRESAllocStr = FormatTRES(s.TRES.Allocated)
+		alloc := TRESMap(s.TRES.Allocated)
+		step.CPUs = int(alloc["cpu"])
+		step.Memory = int(alloc["mem"])
+	}
+	if exitCode := s.ExitCode.toExitCode(); exitCode != nil && exitCode.ReturnCode != nil {
+		step.ExitCode = int(*exitCode.ReturnCode)
+	}
+	return step
+}
+
+// toTRESUsage converts the usage fields of a step record
+func (s *AccountingStep) toTRESUsage() types.StepTRESUsage {
+	usage := types.StepTRESUsage{}
+	usage.StepID, usage.Name = s.id()
+	if s.Nodes != nil {
+		usage.Nodes = s.Nodes.List
+	}
+	if s.Time != nil && s.Time.Total != nil {
+		usage.CPUTime = time.Duration(s.Time.Total.Seconds)*time.Second +
+			time.Duration(s.Time.Total.Microseconds)*time.Microsecond
+	}
+	if s.Statistics != nil && s.Statistics.Energy != nil {
+		if consumed, ok := s.Statistics.Energy.Consumed.value(); ok {
+			usage.EnergyConsumed = consumed
+		}
+	}
+	if s.TRES != nil && s.TRES.Consumed != nil {
+		usage.ConsumedMax = TRESMap(s.TRES.Consumed.Max)
+		usage.MaxRSS = usage.ConsumedMax["mem"]
+		usage.ConsumedTotal = TRESMap(s.TRES.Consumed.Total)
+	}
+	return usage
+}
+
+// id returns the short step ID, the part after the job ID in "123.batch",
+// and the step name
+func (s *AccountingStep) id() (string, string) {
+	if s.Step == nil {
+		return "", ""
+	}
+	return s.Step.ID[strings.LastIndex(s.Step.ID, ".")+1:], s.Step.Name
+}
+
+// toExitCode converts a process exit code to the common type
+func (e *accountingExitCode) toExitCode() *types.ExitCode {
+	if e == nil {
+		return nil
+	}
+	exitCode := &types.ExitCode{}
+	if rc, ok := e.ReturnCode.value(); ok {
+		v := uint32(rc)
+		exitCode.ReturnCode = &v
+	}
+	if e.Signal != nil {
+		exitCode.Signal = &types.ExitCodeSignal{Name: e.Signal.Name}
+		if id, ok := e.Signal.ID.value(); ok {
+			v := uint16(id)
+			exitCode.Signal.ID = &v
+		}
+	}
+	for _, s := range e.Status {
+		exitCode.Status = append(exitCode.Status, types.StatusValue(s))
+	}
+	return exitCode
+}
+
+// FormatTRES formats a TRES list as a Slurm TRES string such as
+// "cpu=4,mem=8192,gpu=2"
+func FormatTRES(list []types.TRES) string {
+	parts := make([]string, 0, len(list))
+	for _, tres := range list {
+		key := tres.Type
+		if tres.Name != nil && *tres.Name != "" {
+			key = *tres.Name
+		}
+		var count int64
+		if tres.Count != nil {
+			count = *tres.Count
+		}
+		parts = append(parts, fmt.Sprintf("%s=%d", key, count))
+	}
+	return strings.Join(parts, ",")
+}
+
+// stepTime converts a step timestamp, which is unset or zero until the step
+// starts or ends
+func stepTime(n *accountingNumber) *time.Time {
+	v, ok := n.value()
+	if !ok || v <= 0 {
+		return nil
+	}
+	t := time.Unix(v, 0)
+	return &t
+}
+
+// unixTime converts a job timestamp, which is zero while unset
+func unixTime(v int64) time.Time {
+	if v <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(v, 0)
+}
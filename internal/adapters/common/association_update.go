@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package common
+
+import (
+	types "github.com/jontk/slurm-client/api"
+)
+
+// AssociationUpdateFields returns the identifying fields of update and the
+// QOS and TRES-minute limits it sets, shaped like the slurmdbd association
+// object the v0.0.41 and later APIs share, so each adapter can decode them
+// into its own request type. slurmdbd finds the association to change by its
+// account, user, cluster and partition, so those are sent whenever they are
+// set. QoSList replaces the association's whole list; a QoSDelta has to be
+// resolved into QoSList before it gets here.
+func AssociationUpdateFields(update *types.AssociationUpdate) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if update == nil {
+		return fields
+	}
+
+	identity := map[string]*string{
+		"account":   update.Account,
+		"user":      update.User,
+		"cluster":   update.Cluster,
+		"partition": update.Partition,
+	}
+	for key, value := range identity {
+		if value != nil {
+			fields[key] = *value
+		}
+	}
+
+	if update.QoSList != nil {
+		fields["qos"] = update.QoSList
+	}
+	if update.DefaultQoS != nil {
+		fields["default"] = map[string]interface{}{"qos": *update.DefaultQoS}
+	}
+
+	limits := make(map[string]interface{})
+	if len(update.GrpTRESMins) > 0 {
+		nestedMap(limits, "tres", "group")["minutes"] = types.TRESListFromMap(update.GrpTRESMins)
+	}
+	if len(update.GrpTRESRunMins) > 0 {
+		nestedMap(limits, "tres", "group")["active"] = types.TRESListFromMap(update.GrpTRESRunMins)
+	}
+	if len(update.MaxTRESMins) > 0 {
+		nestedMap(limits, "tres", "minutes", "per")["job"] = types.TRESListFromMap(update.MaxTRESMins)
+	}
+	if len(limits) > 0 {
+		fields["max"] = limits
+	}
+	return fields
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package common
+
+import (
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// BackfillCounters holds the bf_* fields of a diag statistics message. The
+// fields have the same meaning in every API version, so each adapter only
+// copies them across and the conversion is shared.
+type BackfillCounters struct {
+	Active             *bool
+	BackfilledJobs     *int32
+	LastBackfilledJobs *int32
+	BackfilledHetJobs  *int32
+	CycleCounter       *int32
+	CycleLast          *int32 // microseconds
+	CycleMax           *int32 // microseconds
+	CycleMean          *int64 // microseconds
+	CycleSum           *int64 // microseconds
+	LastDepth          *int32
+	LastDepthTry       *int32
+	DepthMean          *int64
+	DepthMeanTry       *int64
+	DepthSum           *int32
+	QueueLen           *int32
+	QueueLenMean       *int64
+	QueueLenSum        *int32
+	TableSize          *int32
+	TableSizeMean      *int64
+	TableSizeSum       *int32
+	WhenLastCycle      *int64 // UNIX timestamp, nil when unset
+}
+
+// Stats converts the counters to BackfillStats
+func (c BackfillCounters) Stats() *types.BackfillStats {
+	stats := &types.BackfillStats{
+		Active:             c.Active != nil && *c.Active,
+		BackfilledJobs:     int32Value(c.BackfilledJobs),
+		LastBackfilledJobs: int32Value(c.LastBackfilledJobs),
+		BackfilledHetJobs:  int32Value(c.BackfilledHetJobs),
+		Cycles:             int32Value(c.CycleCounter),
+		LastCycle:          microseconds(int64(int32Value(c.CycleLast))),
+		MaxCycle:           microseconds(int64(int32Value(c.CycleMax))),
+		MeanCycle:          microseconds(int64Value(c.CycleMean)),
+		LastDepth:          int32Value(c.LastDepth),
+		LastDepthTried:     int32Value(c.LastDepthTry),
+		MeanDepth:          int(int64Value(c.DepthMean)),
+		MeanDepthTried:     int(int64Value(c.DepthMeanTry)),
+		QueueLen:           int32Value(c.QueueLen),
+		MeanQueueLen:       int(int64Value(c.QueueLenMean)),
+		TableSize:          int32Value(c.TableSize),
+		MeanTableSize:      int(int64Value(c.TableSizeMean)),
+	}
+	if c.WhenLastCycle != nil && *c.WhenLastCycle > 0 {
+		stats.LastCycleAt = time.Unix(*c.WhenLastCycle, 0)
+	}
+	return stats
+}
+
+// Apply sets diag.Backfill and the flat BF* fields of diag
+func (c BackfillCounters) Apply(diag *types.Diagnostics) {
+	stats := c.Stats()
+	diag.Backfill = stats
+	diag.BFActive = stats.Active
+	diag.BFBackfilledJobs = stats.BackfilledJobs
+	diag.BFCycle = stats.Cycles
+	diag.BFCycleMean = int64Value(c.CycleMean)
+	diag.BFCycleMax = int64(int32Value(c.CycleMax))
+	diag.BFDepth = stats.LastDepth
+	diag.BFDepthMean = stats.MeanDepth
+	diag.BFDepthSum = int32Value(c.DepthSum)
+	diag.BFQueueLen = stats.QueueLen
+	diag.BFQueueLenMean = stats.MeanQueueLen
+	diag.BFQueueLenSum = int32Value(c.QueueLenSum)
+	diag.BFTableSize = stats.TableSize
+	diag.BFTableSizeMean = stats.MeanTableSize
+	diag.BFTableSizeSum = int32Value(c.TableSizeSum)
+	diag.BFWhenLastCycle = stats.LastCycleAt
+}
+
+func int32Value(v *int32) int {
+	if v == nil {
+		return 0
+	}
+	return int(*v)
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func microseconds(v int64) time.Duration {
+	return time.Duration(v) * time.Microsecond
+}
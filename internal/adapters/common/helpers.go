@@ -3,7 +3,9 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -81,38 +83,19 @@ func FormatDurationForSlurm(d time.Duration) string {
 	return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds)
 }
 
-// ParseNumberField parses a number field that could be various numeric types
+// ParseNumberField parses a number field that could be various numeric types.
+// Values outside the int32 range are rejected rather than silently truncated.
 func ParseNumberField(field interface{}) (int32, bool) {
-	switch v := field.(type) {
-	case int32:
-		return v, true
-	case *int32:
-		if v != nil {
-			return *v, true
-		}
-	case int64:
-		return int32(v), true
-	case *int64:
-		if v != nil {
-			return int32(*v), true
-		}
-	case int:
-		return int32(v), true
-	case *int:
-		if v != nil {
-			return int32(*v), true
-		}
-	case float64:
-		return int32(v), true
-	case *float64:
-		if v != nil {
-			return int32(*v), true
-		}
+	v, ok := ParseNumberField64(field)
+	if !ok || v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, false
 	}
-	return 0, false
+	return int32(v), true
 }
 
-// ParseNumberField64 parses a number field to int64
+// ParseNumberField64 parses a number field to int64. json.Number values (as
+// produced by a json.Decoder with UseNumber) are parsed without going through
+// float64, so large IDs keep their exact value.
 func ParseNumberField64(field interface{}) (int64, bool) {
 	switch v := field.(type) {
 	case int64:
@@ -127,6 +110,20 @@ func ParseNumberField64(field interface{}) (int64, bool) {
 		if v != nil {
 			return int64(*v), true
 		}
+	case uint32:
+		return int64(v), true
+	case *uint32:
+		if v != nil {
+			return int64(*v), true
+		}
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v), true
+		}
+	case *uint64:
+		if v != nil && *v <= math.MaxInt64 {
+			return int64(*v), true
+		}
 	case int:
 		return int64(v), true
 	case *int:
@@ -139,6 +136,25 @@ func ParseNumberField64(field interface{}) (int64, bool) {
 		if v != nil {
 			return int64(*v), true
 		}
+	case json.Number:
+		return parseJSONNumber(v)
+	case *json.Number:
+		if v != nil {
+			return parseJSONNumber(*v)
+		}
 	}
 	return 0, false
 }
+
+// parseJSONNumber parses an integral json.Number, falling back to float
+// parsing for values written with a fraction or exponent
+func parseJSONNumber(n json.Number) (int64, bool) {
+	if i, err := n.Int64(); err == nil {
+		return i, true
+	}
+	f, err := n.Float64()
+	if err != nil || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
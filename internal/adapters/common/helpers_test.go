@@ -3,10 +3,14 @@
 package common
 
 import (
+	"encoding/json"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Tests for ConvertTRESListToString
@@ -271,6 +275,24 @@ func TestParseNumberField(t *testing.T) {
 			expected: 75,
 			expectOK: true,
 		},
+		{
+			name:     "max int32 json.Number",
+			input:    json.Number("2147483647"),
+			expected: 2147483647,
+			expectOK: true,
+		},
+		{
+			name:     "int64 above int32 range",
+			input:    int64(2147483648),
+			expected: 0,
+			expectOK: false,
+		},
+		{
+			name:     "uint32 above int32 range",
+			input:    uint32(4294967295),
+			expected: 0,
+			expectOK: false,
+		},
 		{
 			name:     "nil int32 pointer",
 			input:    (*int32)(nil),
@@ -367,6 +389,36 @@ func TestParseNumberField64(t *testing.T) {
 			expected: 9223372036854775807,
 			expectOK: true,
 		},
+		{
+			name:     "json.Number beyond float64 precision",
+			input:    json.Number("9007199254740993"),
+			expected: 9007199254740993,
+			expectOK: true,
+		},
+		{
+			name:     "json.Number with exponent",
+			input:    json.Number("1e3"),
+			expected: 1000,
+			expectOK: true,
+		},
+		{
+			name:     "invalid json.Number",
+			input:    json.Number("abc"),
+			expected: 0,
+			expectOK: false,
+		},
+		{
+			name:     "max uint32",
+			input:    uint32(4294967295),
+			expected: 4294967295,
+			expectOK: true,
+		},
+		{
+			name:     "uint64 above int64 range",
+			input:    uint64(18446744073709551615),
+			expected: 0,
+			expectOK: false,
+		},
 		{
 			name:     "nil int64 pointer",
 			input:    (*int64)(nil),
@@ -397,6 +449,34 @@ func TestParseNumberField64(t *testing.T) {
 	}
 }
 
+// Decoding job IDs around the int32 boundary must not overflow or lose precision
+func TestParseNumberField_JobIDBoundary(t *testing.T) {
+	payload := `{"jobs":[{"job_id":2147483646},{"job_id":2147483647},{"job_id":2147483648}]}`
+
+	var resp struct {
+		Jobs []map[string]interface{} `json:"jobs"`
+	}
+	decoder := json.NewDecoder(strings.NewReader(payload))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&resp))
+	require.Len(t, resp.Jobs, 3)
+
+	expected := []int64{2147483646, 2147483647, 2147483648}
+	for i, job := range resp.Jobs {
+		id64, ok := ParseNumberField64(job["job_id"])
+		require.True(t, ok)
+		assert.Equal(t, expected[i], id64)
+
+		id32, ok := ParseNumberField(job["job_id"])
+		if expected[i] > math.MaxInt32 {
+			assert.False(t, ok, "job ID %d must not wrap into int32", expected[i])
+			continue
+		}
+		require.True(t, ok)
+		assert.Equal(t, int32(expected[i]), id32)
+	}
+}
+
 // Benchmark tests
 func BenchmarkConvertTRESListToString(b *testing.B) {
 	strPtr := func(s string) *string { return &s }
@@ -44,18 +44,59 @@ type QoSAdapter interface {
 // JobAdapter defines the interface for Job management across versions
 type JobAdapter interface {
 	List(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error)
-	Get(ctx context.Context, jobID int32) (*types.Job, error)
+	Get(ctx context.Context, jobID int64) (*types.Job, error)
 	Submit(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error)
-	Update(ctx context.Context, jobID int32, update *types.JobUpdate) error
-	Cancel(ctx context.Context, jobID int32, opts *types.JobCancelRequest) error
+	Update(ctx context.Context, jobID int64, update *types.JobUpdate) error
+	Cancel(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error
 	Signal(ctx context.Context, req *types.JobSignalRequest) error
 	Hold(ctx context.Context, req *types.JobHoldRequest) error
 	Notify(ctx context.Context, req *types.JobNotifyRequest) error
-	Requeue(ctx context.Context, jobID int32) error
-	Watch(ctx context.Context, opts *types.JobWatchOptions) (<-chan types.JobWatchEvent, error)
+	Requeue(ctx context.Context, jobID int64) error
 	Allocate(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error)
 }
 
+// JobAccountingAdapter is implemented by job adapters that can read the
+// slurmdbd accounting record of a job
+type JobAccountingAdapter interface {
+	GetAccounting(ctx context.Context, jobID int64) (*types.Job, error)
+}
+
+// JobHistoryAdapter is implemented by job adapters that can list job
+// records from slurmdbd
+type JobHistoryAdapter interface {
+	ListHistory(ctx context.Context, opts *types.JobHistoryOptions) ([]types.Job, error)
+}
+
+// JobCancelPendingAdapter is implemented by job adapters that can cancel a
+// job only while it is pending, in one request
+type JobCancelPendingAdapter interface {
+	CancelIfPending(ctx context.Context, jobID int64) (bool, error)
+}
+
+// JobScriptAdapter is implemented by job adapters that can retrieve the
+// stored batch script of a job
+type JobScriptAdapter interface {
+	GetScript(ctx context.Context, jobID int64) (string, error)
+}
+
+// HetJobAdapter is implemented by job adapters that can submit a
+// heterogeneous job, one JobCreate per component
+type HetJobAdapter interface {
+	SubmitHetJob(ctx context.Context, components []*types.JobCreate) (*types.JobSubmitResponse, error)
+}
+
+// JobStepsAdapter is implemented by job adapters that can read the steps of
+// a job from the accounting database
+type JobStepsAdapter interface {
+	ListSteps(ctx context.Context, jobID int64) ([]types.JobStep, error)
+}
+
+// JobTRESUsageAdapter is implemented by job adapters that can read the
+// resource usage of a job from the accounting database
+type JobTRESUsageAdapter interface {
+	GetTRESUsage(ctx context.Context, jobID int64) (*types.TRESUsageReport, error)
+}
+
 // PartitionAdapter defines the interface for Partition management across versions
 type PartitionAdapter interface {
 	List(ctx context.Context, opts *types.PartitionListOptions) (*types.PartitionList, error)
@@ -73,7 +114,6 @@ type NodeAdapter interface {
 	Delete(ctx context.Context, nodeName string) error
 	Drain(ctx context.Context, nodeName string, reason string) error
 	Resume(ctx context.Context, nodeName string) error
-	Watch(ctx context.Context, opts *types.NodeWatchOptions) (<-chan types.NodeWatchEvent, error)
 }
 
 // AccountAdapter defines the interface for Account management across versions
@@ -162,10 +202,14 @@ type InfoAdapter interface {
 	Get(ctx context.Context) (*types.ClusterInfo, error)
 	// Ping tests connectivity to the cluster
 	Ping(ctx context.Context) error
+	// PingControllers returns the ping result of each controller
+	PingControllers(ctx context.Context) ([]types.PingResult, error)
 	// PingDatabase tests connectivity to the SLURM database
 	PingDatabase(ctx context.Context) error
 	// Stats retrieves cluster statistics
 	Stats(ctx context.Context) (*types.ClusterStats, error)
 	// Version retrieves API version information
 	Version(ctx context.Context) (*types.APIVersion, error)
+	// Versions retrieves the slurmrestd version and the REST plugin version
+	Versions(ctx context.Context) (*types.VersionInfo, error)
 }
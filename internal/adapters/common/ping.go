@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package common
+
+import (
+	"strings"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// ControllerPing holds one entry of a ping response. v0.0.40 and v0.0.41
+// only report Pinged ("UP" or "DOWN") and Mode; later versions add the
+// Responding and Primary flags, which take precedence when set.
+type ControllerPing struct {
+	Hostname   *string
+	Pinged     *string
+	Mode       *string
+	Latency    *int64 // microseconds
+	Responding *bool
+	Primary    *bool
+}
+
+// Result converts the entry to a PingResult
+func (p ControllerPing) Result() types.PingResult {
+	result := types.PingResult{
+		Latency: microseconds(int64Value(p.Latency)),
+	}
+	if p.Hostname != nil {
+		result.Hostname = *p.Hostname
+	}
+	if p.Mode != nil {
+		result.Mode = *p.Mode
+	}
+	if p.Responding != nil {
+		result.Pinged = *p.Responding
+	} else {
+		result.Pinged = p.Pinged != nil && strings.EqualFold(*p.Pinged, "UP")
+	}
+	if p.Primary != nil {
+		result.Primary = *p.Primary
+	} else {
+		result.Primary = strings.EqualFold(result.Mode, "primary")
+	}
+	return result
+}
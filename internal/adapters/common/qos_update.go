@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// QoSUpdateFields returns the fields of update that are set, shaped like the
+// slurmdbd QOS object the v0.0.41 and later APIs share, so each adapter can
+// decode them into its own request type. Fields left nil are not included,
+// and so are left unchanged by slurmdbd. Numbers that slurmdbd stores with
+// flags are sent as {"set": true, "number": n}; a limit of math.MaxUint32
+// (api.TimeLimitUnlimited for the time limits) is sent as infinite, which
+// clears it. MaxTRESPer* entries that do not parse are skipped; callers are
+// expected to have validated them with api.ParseTRESList. The TRES-minute
+// maps are sent as TRES lists.
+func QoSUpdateFields(name string, update *types.QoSUpdate) map[string]interface{} {
+	fields := map[string]interface{}{"name": name}
+	if update == nil {
+		return fields
+	}
+
+	if update.Description != nil {
+		fields["description"] = *update.Description
+	}
+	if update.Flags != nil {
+		fields["flags"] = *update.Flags
+	}
+	if update.Priority != nil {
+		fields["priority"] = noValNumber(json.Number(strconv.Itoa(*update.Priority)))
+	}
+	if update.UsageFactor != nil {
+		fields["usage_factor"] = map[string]interface{}{"set": true, "number": *update.UsageFactor}
+	}
+	if update.UsageThreshold != nil {
+		fields["usage_threshold"] = map[string]interface{}{"set": true, "number": *update.UsageThreshold}
+	}
+
+	preempt := make(map[string]interface{})
+	if update.PreemptMode != nil {
+		preempt["mode"] = *update.PreemptMode
+	}
+	if update.PreemptList != nil {
+		preempt["list"] = *update.PreemptList
+	}
+	if update.PreemptExemptTime != nil {
+		preempt["exempt_time"] = noValNumber(json.Number(strconv.Itoa(*update.PreemptExemptTime)))
+	}
+	if len(preempt) > 0 {
+		fields["preempt"] = preempt
+	}
+
+	limits := qosLimitsFields(update.Limits)
+	if update.GraceTime != nil {
+		limits["grace_time"] = *update.GraceTime
+	}
+	perTRES := map[string]*string{
+		"user":    update.MaxTRESPerUser,
+		"account": update.MaxTRESPerAccount,
+		"job":     update.MaxTRESPerJob,
+	}
+	for key, value := range perTRES {
+		if value == nil {
+			continue
+		}
+		list, err := types.ParseTRESList(*value)
+		if err != nil {
+			continue
+		}
+		per := nestedMap(limits, "max", "tres", "per")
+		per[key] = list
+	}
+	if len(update.GrpTRESMins) > 0 {
+		nestedMap(limits, "max", "tres", "minutes")["total"] = types.TRESListFromMap(update.GrpTRESMins)
+	}
+	minutesPer := map[string]map[string]int64{
+		"qos":     update.GrpTRESRunMins,
+		"job":     update.MaxTRESMinsPerJob,
+		"user":    update.MaxTRESRunMinsPerUser,
+		"account": update.MaxTRESRunMinsPerAccount,
+	}
+	for key, value := range minutesPer {
+		if len(value) > 0 {
+			nestedMap(limits, "max", "tres", "minutes", "per")[key] = types.TRESListFromMap(value)
+		}
+	}
+	if len(limits) > 0 {
+		fields["limits"] = limits
+	}
+	return fields
+}
+
+// qosLimitsFields converts the set fields of limits to the API shape. The
+// common type mirrors the API schema, so only the numbers need wrapping;
+// grace_time is a plain integer and the TRES lists are passed as they are.
+func qosLimitsFields(limits *types.QoSLimits) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if limits == nil {
+		return fields
+	}
+	data, err := json.Marshal(limits)
+	if err != nil {
+		return fields
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return make(map[string]interface{})
+	}
+	wrapNoValNumbers(fields)
+	return fields
+}
+
+func wrapNoValNumbers(fields map[string]interface{}) {
+	for key, value := range fields {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			wrapNoValNumbers(v)
+		case json.Number:
+			if key != "grace_time" {
+				fields[key] = noValNumber(v)
+			}
+		}
+	}
+}
+
+// noValNumber wraps n in the API's number-with-flags object
+func noValNumber(n json.Number) map[string]interface{} {
+	if i, err := n.Int64(); err == nil && i == math.MaxUint32 {
+		return map[string]interface{}{"set": true, "infinite": true}
+	}
+	return map[string]interface{}{"set": true, "infinite": false, "number": n}
+}
+
+// nestedMap returns the map at path below m, creating it where missing
+func nestedMap(m map[string]interface{}, path ...string) map[string]interface{} {
+	for _, key := range path {
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			m[key] = next
+		}
+		m = next
+	}
+	return m
+}
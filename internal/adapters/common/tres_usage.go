@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package common
+
+import (
+	"sort"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// TRESMap converts a TRES list to a map keyed by TRES type, with the name
+// appended after a slash when there is one, as in "gres/gpu". It returns nil
+// for an empty list.
+func TRESMap(list []types.TRES) map[string]int64 {
+	if len(list) == 0 {
+		return nil
+	}
+	m := make(map[string]int64, len(list))
+	for _, tres := range list {
+		key := tres.Type
+		if tres.Name != nil && *tres.Name != "" {
+			key += "/" + *tres.Name
+		}
+		if tres.Count != nil {
+			m[key] = *tres.Count
+		}
+	}
+	return m
+}
+
+// SummarizeTRESUsage fills in the job totals and the per-node usage of
+// report from its steps
+func SummarizeTRESUsage(report *types.TRESUsageReport) {
+	report.MaxRSS, report.CPUTime, report.EnergyConsumed = 0, 0, 0
+	nodes := make(map[string]*types.NodeTRESUsage)
+	for _, step := range report.Steps {
+		report.MaxRSS = max(report.MaxRSS, step.MaxRSS)
+		report.CPUTime += step.CPUTime
+		report.EnergyConsumed += step.EnergyConsumed
+		if len(step.Nodes) == 0 {
+			continue
+		}
+		share := int64(len(step.Nodes))
+		for _, name := range step.Nodes {
+			node, ok := nodes[name]
+			if !ok {
+				node = &types.NodeTRESUsage{Node: name}
+				nodes[name] = node
+			}
+			node.Steps = append(node.Steps, step.StepID)
+			node.MaxRSS = max(node.MaxRSS, step.MaxRSS)
+			node.CPUTime += step.CPUTime / time.Duration(share)
+			node.EnergyConsumed += step.EnergyConsumed / share
+		}
+	}
+
+	report.Nodes = make([]types.NodeTRESUsage, 0, len(nodes))
+	for _, node := range nodes {
+		report.Nodes = append(report.Nodes, *node)
+	}
+	sort.Slice(report.Nodes, func(i, j int) bool {
+		return report.Nodes[i].Node < report.Nodes[j].Node
+	})
+}
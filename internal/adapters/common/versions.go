@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package common
+
+// SlurmVersion returns the Slurm version in the meta block of a response:
+// its release, e.g. "24.11.1", or major.minor.micro when no release is
+// reported. It is empty when neither is.
+func SlurmVersion(release, major, minor, micro *string) string {
+	if release != nil && *release != "" {
+		return *release
+	}
+	if major == nil || minor == nil || micro == nil {
+		return ""
+	}
+	return *major + "." + *minor + "." + *micro
+}
@@ -43,6 +43,17 @@ func ConvertUint32NoVal(source *api.V0040Uint32NoVal) *uint32 {
 	return &val
 }
 
+// ConvertTimeLimitNoVal converts a V0040Uint32NoVal holding a time limit
+// to *uint32, mapping an infinite limit to types.TimeLimitUnlimited instead
+// of dropping it.
+func ConvertTimeLimitNoVal(source *api.V0040Uint32NoVal) *uint32 {
+	if source != nil && source.Infinite != nil && *source.Infinite {
+		unlimited := types.TimeLimitUnlimited
+		return &unlimited
+	}
+	return ConvertUint32NoVal(source)
+}
+
 // ConvertUint16NoVal converts a V0040Uint16NoVal to *uint16.
 // Returns nil if source is nil or Set is false.
 func ConvertUint16NoVal(source *api.V0040Uint16NoVal) *uint16 {
@@ -788,7 +799,7 @@ func ConvertPartitionDefaults(source *struct {
 		result.PartitionMemoryPerNode = ConvertUint64NoVal(source.PartitionMemoryPerNode)
 	}
 	if source.Time != nil {
-		result.Time = ConvertUint32NoVal(source.Time)
+		result.Time = ConvertTimeLimitNoVal(source.Time)
 	}
 	return result
 }
@@ -838,7 +849,12 @@ func ConvertPartitionMaximums(source *struct {
 		result.Nodes = ConvertUint32NoVal(source.Nodes)
 	}
 	if source.OverTimeLimit != nil {
-		result.OverTimeLimit = ConvertUint16NoVal(source.OverTimeLimit)
+		if source.OverTimeLimit.Infinite != nil && *source.OverTimeLimit.Infinite {
+			v := types.OverTimeLimitUnlimited
+			result.OverTimeLimit = &v
+		} else {
+			result.OverTimeLimit = ConvertUint16NoVal(source.OverTimeLimit)
+		}
 	}
 	if source.Oversubscribe != nil {
 		result.Oversubscribe = &types.PartitionMaximumsOversubscribe{
@@ -859,7 +875,7 @@ func ConvertPartitionMaximums(source *struct {
 		result.PartitionMemoryPerNode = ConvertUint64NoVal(source.PartitionMemoryPerNode)
 	}
 	if source.Time != nil {
-		result.Time = ConvertUint32NoVal(source.Time)
+		result.Time = ConvertTimeLimitNoVal(source.Time)
 	}
 	return result
 }
@@ -1309,6 +1325,10 @@ func ConvertJobResources(source *api.V0040JobRes) *types.JobResources {
 	// We attempt to parse it if possible, otherwise create a basic structure
 	if source.AllocatedNodes != nil && len(*source.AllocatedNodes) > 0 {
 		result.Nodes = convertJobResourcesNodesV40(source.AllocatedNodes, source.AllocatedHosts, source.Nodes)
+	} else if source.AllocatedHosts != nil || source.Nodes != nil {
+		// Without the per-node detail, still carry the node count and list,
+		// which later versions report as nodes.count and nodes.list
+		result.Nodes = &types.JobResourcesNodes{Count: source.AllocatedHosts, List: source.Nodes}
 	}
 
 	return result
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_40
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfoAdapter_PingControllers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/slurm/v0.0.40/ping/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"pings": [
+			{"hostname": "ctld1", "pinged": "UP", "latency": 850, "mode": "primary"},
+			{"hostname": "ctld2", "pinged": "DOWN", "latency": 2000000, "mode": "backup"}
+		]}`))
+	}))
+	defer server.Close()
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	results, err := NewInfoAdapter(client).PingControllers(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []types.PingResult{
+		{Hostname: "ctld1", Pinged: true, Mode: "primary", Primary: true, Latency: 850 * time.Microsecond},
+		{Hostname: "ctld2", Pinged: false, Mode: "backup", Latency: 2 * time.Second},
+	}, results)
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	types "github.com/jontk/slurm-client/api"
 	adapterbase "github.com/jontk/slurm-client/internal/adapters/base"
@@ -47,9 +48,13 @@ func (a *JobAdapter) List(ctx context.Context, opts *types.JobListOptions) (*typ
 		// The v0.0.40 API has limited parameter support for job listing
 		// We'll need to filter results client-side after retrieval
 		// Only set flags for now to get detailed job information
+		requested := opts.Flags
 		if len(opts.JobIDs) > 0 || len(opts.States) > 0 || len(opts.Accounts) > 0 {
 			// Use DETAIL flag to get comprehensive job information for filtering
-			flags := api.SlurmV0040GetJobsParamsFlagsDETAIL
+			requested = append([]types.QueryFlag{types.QueryFlagDetail}, requested...)
+		}
+		if len(requested) > 0 {
+			flags := api.SlurmV0040GetJobsParamsFlags(types.JoinQueryFlags(requested))
 			params.Flags = &flags
 		}
 		// Set update time if available
@@ -80,6 +85,15 @@ func (a *JobAdapter) List(ctx context.Context, opts *types.JobListOptions) (*typ
 	jobList := make([]types.Job, 0, len(resp.JSON200.Jobs))
 	for _, apiJob := range resp.JSON200.Jobs {
 		job := a.convertAPIJobToCommon(apiJob)
+		if opts != nil && len(opts.Reasons) > 0 && !a.jobReasonMatches(job.StateReason, opts.Reasons) {
+			continue
+		}
+		if opts != nil && opts.HeldOnly && job.HeldBy() == "" {
+			continue
+		}
+		if !opts.MatchesTimeWindows(job) || !opts.MatchesArrayJobIDs(job) {
+			continue
+		}
 		jobList = append(jobList, *job)
 	}
 	// Apply pagination
@@ -112,8 +126,21 @@ func (a *JobAdapter) List(ctx context.Context, opts *types.JobListOptions) (*typ
 	}, nil
 }
 
+// jobReasonMatches checks if a job state reason is in the filter list
+func (a *JobAdapter) jobReasonMatches(reason *string, reasons []string) bool {
+	if reason == nil {
+		return false
+	}
+	for _, r := range reasons {
+		if strings.EqualFold(r, *reason) {
+			return true
+		}
+	}
+	return false
+}
+
 // Get retrieves a specific job by ID
-func (a *JobAdapter) Get(ctx context.Context, jobID int32) (*types.Job, error) {
+func (a *JobAdapter) Get(ctx context.Context, jobID int64) (*types.Job, error) {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
 		return nil, err
@@ -135,6 +162,8 @@ func (a *JobAdapter) Get(ctx context.Context, jobID int32) (*types.Job, error) {
 	var apiErrors *api.V0040OpenapiErrors
 	if resp.JSON200 != nil {
 		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
 	}
 	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
 	if err := common.HandleAPIResponse(responseAdapter, "v0.0.40"); err != nil {
@@ -149,7 +178,7 @@ func (a *JobAdapter) Get(ctx context.Context, jobID int32) (*types.Job, error) {
 	}
 	// Check if we got any job entries
 	if len(resp.JSON200.Jobs) == 0 {
-		return nil, fmt.Errorf("job %d not found", jobID)
+		return nil, common.NewResourceNotFoundError("Job", jobID)
 	}
 	// Convert the first job (should be the only one)
 	job := a.convertAPIJobToCommon(resp.JSON200.Jobs[0])
@@ -168,6 +197,31 @@ func (a *JobAdapter) setBasicJobProperties(jobDesc *api.V0040JobDescMsg, job *ty
 	if job.Partition != nil {
 		jobDesc.Partition = job.Partition
 	}
+	if job.QoS != nil {
+		jobDesc.Qos = job.QoS
+	}
+	if job.Constraints != nil {
+		jobDesc.Constraints = job.Constraints
+	}
+	if job.Array != nil {
+		jobDesc.Array = job.Array
+	}
+	if job.Dependency != nil {
+		jobDesc.Dependency = job.Dependency
+	}
+	if job.Nice != nil {
+		jobDesc.Nice = job.Nice
+	}
+	if job.Reboot != nil {
+		jobDesc.Reboot = job.Reboot
+	}
+	if len(job.Flags) > 0 {
+		flags := make(api.V0040JobFlags, len(job.Flags))
+		for i, flag := range job.Flags {
+			flags[i] = string(flag)
+		}
+		jobDesc.Flags = &flags
+	}
 }
 
 // setJobIOProperties sets I/O properties (working directory, standard streams)
@@ -184,11 +238,24 @@ func (a *JobAdapter) setJobIOProperties(jobDesc *api.V0040JobDescMsg, job *types
 	if job.StandardInput != nil {
 		jobDesc.StandardInput = job.StandardInput
 	}
+	if len(job.OpenMode) > 0 {
+		openMode := make(api.V0040OpenMode, len(job.OpenMode))
+		for i, mode := range job.OpenMode {
+			openMode[i] = string(mode)
+		}
+		jobDesc.OpenMode = &openMode
+	}
 }
 
-// setJobResources sets resource properties (time limit, nodes)
+// setJobResources sets resource properties (time limit, nodes, CPUs,
+// memory and generic resources)
 func (a *JobAdapter) setJobResources(jobDesc *api.V0040JobDescMsg, job *types.JobCreate) {
-	if job.TimeLimit != nil && *job.TimeLimit > 0 {
+	if types.IsUnlimitedTimeLimit(job.TimeLimit) {
+		infinite := true
+		jobDesc.TimeLimit = &api.V0040Uint32NoVal{
+			Infinite: &infinite,
+		}
+	} else if job.TimeLimit != nil && *job.TimeLimit > 0 {
 		timeLimit := int64(*job.TimeLimit)
 		setTrue := true
 		jobDesc.TimeLimit = &api.V0040Uint32NoVal{
@@ -201,6 +268,50 @@ func (a *JobAdapter) setJobResources(jobDesc *api.V0040JobDescMsg, job *types.Jo
 		jobDesc.MinimumNodes = &nodes
 		jobDesc.MaximumNodes = &nodes
 	}
+	if job.MinimumCPUs != nil && *job.MinimumCPUs > 0 {
+		jobDesc.MinimumCpus = job.MinimumCPUs
+	}
+	if job.MemoryPerNode != nil {
+		memory := int64(*job.MemoryPerNode)
+		setTrue := true
+		jobDesc.MemoryPerNode = &api.V0040Uint64NoVal{
+			Set:    &setTrue,
+			Number: &memory,
+		}
+	}
+	if job.TRESPerNode != nil {
+		jobDesc.TresPerNode = job.TRESPerNode
+	}
+}
+
+// setJobSharing sets node sharing and CPU binding properties
+func (a *JobAdapter) setJobSharing(jobDesc *api.V0040JobDescMsg, job *types.JobCreate) {
+	if len(job.Shared) > 0 {
+		shared := make(api.V0040JobShared, len(job.Shared))
+		for i, v := range job.Shared {
+			shared[i] = string(v)
+		}
+		jobDesc.Shared = &shared
+	}
+	if job.CPUBinding != nil {
+		jobDesc.CpuBinding = job.CPUBinding
+	}
+}
+
+// newJobDescMsg creates and populates the job description of a submission
+func (a *JobAdapter) newJobDescMsg(job *types.JobCreate) *api.V0040JobDescMsg {
+	jobDesc := &api.V0040JobDescMsg{
+		Script: job.Script,
+	}
+	// Use helper methods to set fields
+	a.setBasicJobProperties(jobDesc, job)
+	a.setJobIOProperties(jobDesc, job)
+	a.setJobResources(jobDesc, job)
+	a.setJobSharing(jobDesc, job)
+	// Set environment variables with defaults
+	envList := a.buildEnvironmentList(job.Environment)
+	jobDesc.Environment = &envList
+	return jobDesc
 }
 
 // buildEnvironmentList builds the environment variable list with defaults
@@ -233,20 +344,9 @@ func (a *JobAdapter) Submit(ctx context.Context, job *types.JobCreate) (*types.J
 	if err := a.CheckClientInitialized(a.client); err != nil {
 		return nil, err
 	}
-	// Create and populate job submission structure
-	jobDesc := &api.V0040JobDescMsg{
-		Script: job.Script,
-	}
-	// Use helper methods to set fields
-	a.setBasicJobProperties(jobDesc, job)
-	a.setJobIOProperties(jobDesc, job)
-	a.setJobResources(jobDesc, job)
-	// Set environment variables with defaults
-	envList := a.buildEnvironmentList(job.Environment)
-	jobDesc.Environment = &envList
 	// Create request body
 	submitReq := api.V0040JobSubmitReq{
-		Job: jobDesc,
+		Job: a.newJobDescMsg(job),
 	}
 	// Make the API call
 	resp, err := a.client.SlurmV0040PostJobSubmitWithResponse(ctx, submitReq)
@@ -298,7 +398,7 @@ func (a *JobAdapter) Submit(ctx context.Context, job *types.JobCreate) (*types.J
 }
 
 // Update updates an existing job
-func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobUpdate) error {
+func (a *JobAdapter) Update(ctx context.Context, jobID int64, update *types.JobUpdate) error {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -323,7 +423,7 @@ func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobU
 }
 
 // Cancel cancels a job
-func (a *JobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCancelRequest) error {
+func (a *JobAdapter) Cancel(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -381,6 +481,10 @@ func (a *JobAdapter) Signal(ctx context.Context, req *types.JobSignalRequest) er
 	cancelReq := &types.JobCancelRequest{
 		Signal: req.Signal,
 	}
+	if req.BatchOnly {
+		// Cancel passes Message on as the signalling flags
+		cancelReq.Message = string(api.BATCHJOB)
+	}
 	return a.Cancel(ctx, req.JobId, cancelReq)
 }
 
@@ -435,7 +539,7 @@ func (a *JobAdapter) Notify(ctx context.Context, req *types.JobNotifyRequest) er
 }
 
 // Requeue requeues a job (not available in v0.0.40)
-func (a *JobAdapter) Requeue(ctx context.Context, jobID int32) error {
+func (a *JobAdapter) Requeue(ctx context.Context, jobID int64) error {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -511,11 +615,6 @@ func (a *JobAdapter) validateJobNotifyRequest(req *types.JobNotifyRequest) error
 	return nil
 }
 
-// Watch provides real-time job status updates (not supported in v0.0.40)
-func (a *JobAdapter) Watch(ctx context.Context, opts *types.JobWatchOptions) (<-chan types.JobWatchEvent, error) {
-	return nil, fmt.Errorf("watch functionality not supported in API v0.0.40")
-}
-
 // Allocate allocates resources for a job (not supported in v0.0.40)
 func (a *JobAdapter) Allocate(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error) {
 	return nil, errors.NewNotImplementedError("Allocate", a.GetVersion())
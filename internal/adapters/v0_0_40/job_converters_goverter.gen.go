@@ -308,7 +308,7 @@ func (c *JobConverterGoverterImpl) ConvertAPIJobToCommon(source v0040.V0040JobIn
 		apiJob.ThreadSpec = &xint329
 	}
 	apiJob.ThreadsPerCore = ConvertUint16NoVal(source.ThreadsPerCore)
-	apiJob.TimeLimit = ConvertUint32NoVal(source.TimeLimit)
+	apiJob.TimeLimit = ConvertTimeLimitNoVal(source.TimeLimit)
 	apiJob.TimeMinimum = ConvertUint32NoVal(source.TimeMinimum)
 	if source.TresAllocStr != nil {
 		xstring48 := *source.TresAllocStr
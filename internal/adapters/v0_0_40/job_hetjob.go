@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_40
+
+import (
+	"context"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/common"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// SubmitHetJob submits a heterogeneous job, sending one job description per
+// component in the jobs array of the submit request. The batch script is
+// taken from the first component.
+func (a *JobAdapter) SubmitHetJob(ctx context.Context, components []*types.JobCreate) (*types.JobSubmitResponse, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if len(components) < 2 {
+		return nil, errors.NewValidationError(errors.ErrorCodeValidationFailed,
+			"a heterogeneous job needs at least two components", "components", len(components), nil)
+	}
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+
+	// Convert each component to a job description
+	jobs := make(api.V0040JobDescMsgList, 0, len(components))
+	for _, component := range components {
+		if component == nil {
+			return nil, errors.NewValidationError(errors.ErrorCodeValidationFailed,
+				"heterogeneous job component is required", "components", nil, nil)
+		}
+		jobs = append(jobs, *a.newJobDescMsg(component))
+	}
+	submitReq := api.V0040JobSubmitReq{
+		Jobs:   &jobs,
+		Script: jobs[0].Script,
+	}
+
+	// Make the API call
+	resp, err := a.client.SlurmV0040PostJobSubmitWithResponse(ctx, submitReq)
+	if err != nil {
+		return nil, a.HandleAPIError(err)
+	}
+	// Use common response error handling
+	var apiErrors *api.V0040OpenapiErrors
+	if resp.JSON200 != nil {
+		apiErrors = resp.JSON200.Errors
+	}
+	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
+	if err := common.HandleAPIResponse(responseAdapter, "v0.0.40"); err != nil {
+		return nil, err
+	}
+	if err := a.CheckNilResponse(resp.JSON200, "Submit Het Job"); err != nil {
+		return nil, err
+	}
+
+	// The het job ID is that of the first component
+	submitResp := &types.JobSubmitResponse{}
+	if resp.JSON200.JobId != nil {
+		submitResp.JobId = *resp.JSON200.JobId
+	}
+	return submitResp, nil
+}
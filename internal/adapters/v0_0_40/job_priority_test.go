@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package v0_0_40
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobAdapter_ConvertPriorityComponents(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+
+	var apiJob api.V0040JobInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"job_id": 7,
+		"priority": {"set": true, "infinite": false, "number": 4200},
+		"nice": 100
+	}`), &apiJob))
+
+	components := adapter.convertAPIJobToCommon(apiJob).PriorityComponents()
+	require.NotNil(t, components)
+	assert.Equal(t, uint32(4200), components.Priority)
+	assert.Equal(t, int32(100), components.Nice)
+	assert.Nil(t, components.ByPartition)
+
+	assert.Nil(t, adapter.convertAPIJobToCommon(api.V0040JobInfo{}).PriorityComponents())
+}
@@ -47,6 +47,10 @@ func (a *NodeAdapter) List(ctx context.Context, opts *types.NodeListOptions) (*t
 			updateTimeStr := strconv.FormatInt(opts.UpdateTime.Unix(), 10)
 			params.UpdateTime = &updateTimeStr
 		}
+		if len(opts.Flags) > 0 {
+			flags := api.SlurmV0040GetNodesParamsFlags(types.JoinQueryFlags(opts.Flags))
+			params.Flags = &flags
+		}
 	}
 	// Call the generated OpenAPI client
 	resp, err := a.client.SlurmV0040GetNodesWithResponse(ctx, params)
@@ -132,6 +136,8 @@ func (a *NodeAdapter) Get(ctx context.Context, nodeName string) (*types.Node, er
 	var apiErrors *api.V0040OpenapiErrors
 	if resp.JSON200 != nil {
 		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
 	}
 	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
 	if err := common.HandleAPIResponse(responseAdapter, "v0.0.40"); err != nil {
@@ -270,14 +276,43 @@ func (a *NodeAdapter) Resume(ctx context.Context, nodeName string) error {
 	return fmt.Errorf("resume functionality not supported in API v0.0.40")
 }
 
-// Watch provides real-time node status updates (not supported in v0.0.40)
-func (a *NodeAdapter) Watch(ctx context.Context, opts *types.NodeWatchOptions) (<-chan types.NodeWatchEvent, error) {
-	return nil, fmt.Errorf("watch functionality not supported in API v0.0.40")
-}
-
 // convertCommonNodeUpdateToAPI converts a common NodeUpdate to v0.0.40 API format
 func (a *NodeAdapter) convertCommonNodeUpdateToAPI(nodeName string, update *types.NodeUpdate) *api.V0040UpdateNodeMsg {
-	apiNode := &api.V0040UpdateNodeMsg{}
-	// Minimal implementation for v0.0.40
+	apiNode := &api.V0040UpdateNodeMsg{
+		Comment:   update.Comment,
+		CpuBind:   update.CPUBind,
+		Extra:     update.Extra,
+		Gres:      update.GRES,
+		Reason:    update.Reason,
+		ReasonUid: update.ReasonUID,
+	}
+	if len(update.Address) > 0 {
+		apiNode.Address = &update.Address
+	}
+	if len(update.Features) > 0 {
+		apiNode.Features = &update.Features
+	}
+	if len(update.FeaturesAct) > 0 {
+		apiNode.FeaturesAct = &update.FeaturesAct
+	}
+	if len(update.Hostname) > 0 {
+		apiNode.Hostname = &update.Hostname
+	}
+	if len(update.State) > 0 {
+		states := make(api.V0040NodeStates, len(update.State))
+		for i, state := range update.State {
+			states[i] = string(state)
+		}
+		apiNode.State = &states
+	}
+	set := true
+	if update.Weight != nil {
+		weight := int64(*update.Weight)
+		apiNode.Weight = &api.V0040Uint32NoVal{Set: &set, Number: &weight}
+	}
+	if update.ResumeAfter != nil {
+		after := int64(*update.ResumeAfter)
+		apiNode.ResumeAfter = &api.V0040Uint32NoVal{Set: &set, Number: &after}
+	}
 	return apiNode
 }
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_40
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeAdapter_ConvertEnergy(t *testing.T) {
+	adapter := NewNodeAdapter(&api.ClientWithResponses{})
+
+	name := "gpu01"
+	set := true
+	watts := int64(350)
+	average := int32(300)
+	consumed := int64(7200000)
+	collected := int64(1700000000)
+	node := adapter.convertAPINodeToCommon(api.V0040Node{
+		Name: &name,
+		Energy: &api.V0040AcctGatherEnergy{
+			AverageWatts:   &average,
+			ConsumedEnergy: &consumed,
+			CurrentWatts:   &api.V0040Uint32NoVal{Set: &set, Number: &watts},
+			LastCollected:  &collected,
+		},
+	})
+	require.NotNil(t, node)
+
+	stats, ok := node.PowerStats()
+	require.True(t, ok)
+	assert.Equal(t, uint32(350), stats.CurrentWatts)
+	assert.Equal(t, uint32(300), stats.AverageWatts)
+	assert.Equal(t, int64(7200000), stats.ConsumedEnergy)
+	assert.Equal(t, time.Unix(collected, 0), stats.LastCollected)
+
+	// Without an energy plugin the node reports no readings
+	_, ok = adapter.convertAPINodeToCommon(api.V0040Node{Name: &name}).PowerStats()
+	assert.False(t, ok)
+}
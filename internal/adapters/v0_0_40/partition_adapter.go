@@ -47,6 +47,10 @@ func (a *PartitionAdapter) List(ctx context.Context, opts *types.PartitionListOp
 			updateTimeStr := strconv.FormatInt(opts.UpdateTime.Unix(), 10)
 			params.UpdateTime = &updateTimeStr
 		}
+		if len(opts.Flags) > 0 {
+			flags := api.SlurmV0040GetPartitionsParamsFlags(types.JoinQueryFlags(opts.Flags))
+			params.Flags = &flags
+		}
 	}
 	// Call the generated OpenAPI client
 	resp, err := a.client.SlurmV0040GetPartitionsWithResponse(ctx, params)
@@ -132,6 +136,8 @@ func (a *PartitionAdapter) Get(ctx context.Context, partitionName string) (*type
 	var apiErrors *api.V0040OpenapiErrors
 	if resp.JSON200 != nil {
 		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
 	}
 	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
 	if err := common.HandleAPIResponse(responseAdapter, "v0.0.40"); err != nil {
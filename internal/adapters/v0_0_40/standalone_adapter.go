@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
 	"github.com/jontk/slurm-client/pkg/errors"
 )
@@ -182,11 +183,40 @@ func (a *StandaloneAdapter) GetDiagnostics(ctx context.Context) (*types.Diagnost
 	if stats.JobsRunning != nil {
 		diag.JobsRunning = int(*stats.JobsRunning)
 	}
+	backfillCounters(stats).Apply(diag)
 	// RPC statistics
 	// Note: v0.0.40 doesn't have RPC statistics in the same structure
 	return diag, nil
 }
 
+// backfillCounters picks the backfill fields out of the diag statistics
+func backfillCounters(stats api.V0040StatsMsg) common.BackfillCounters {
+	counters := common.BackfillCounters{
+		Active:             stats.BfActive,
+		BackfilledJobs:     stats.BfBackfilledJobs,
+		LastBackfilledJobs: stats.BfLastBackfilledJobs,
+		BackfilledHetJobs:  stats.BfBackfilledHetJobs,
+		CycleCounter:       stats.BfCycleCounter,
+		CycleLast:          stats.BfCycleLast,
+		CycleMean:          stats.BfCycleMean,
+		CycleSum:           stats.BfCycleSum,
+		LastDepth:          stats.BfLastDepth,
+		LastDepthTry:       stats.BfLastDepthTry,
+		DepthMean:          stats.BfDepthMean,
+		DepthMeanTry:       stats.BfDepthMeanTry,
+		DepthSum:           stats.BfDepthSum,
+		QueueLen:           stats.BfQueueLen,
+		QueueLenMean:       stats.BfQueueLenMean,
+		QueueLenSum:        stats.BfQueueLenSum,
+		TableSize:          stats.BfTableSize,
+		TableSizeMean:      stats.BfTableSizeMean,
+	}
+	if when := stats.BfWhenLastCycle; when != nil && when.Set != nil && *when.Set {
+		counters.WhenLastCycle = when.Number
+	}
+	return counters
+}
+
 // GetDBDiagnostics retrieves SLURM database diagnostics information
 func (a *StandaloneAdapter) GetDBDiagnostics(ctx context.Context) (*types.Diagnostics, error) {
 	// Note: v0.0.40 DB diagnostics return a different structure (V0040StatsRec with RPCs/Users)
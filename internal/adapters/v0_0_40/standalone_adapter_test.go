@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_40
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandaloneAdapter_GetDiagnostics_Backfill(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/slurm/v0.0.40/diag/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"statistics": {
+			"bf_active": true,
+			"bf_backfilled_jobs": 120,
+			"bf_last_backfilled_jobs": 7,
+			"bf_cycle_counter": 30,
+			"bf_cycle_last": 2500,
+			"bf_cycle_mean": 1500,
+			"bf_last_depth": 42,
+			"bf_last_depth_try": 40,
+			"bf_queue_len": 55,
+			"bf_table_size": 12,
+			"bf_when_last_cycle": {"set": true, "infinite": false, "number": 1700000000}
+		}}`))
+	}))
+	defer server.Close()
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	diag, err := NewStandaloneAdapter(client).GetDiagnostics(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, diag.Backfill)
+	bf := diag.Backfill
+	assert.True(t, bf.Active)
+	assert.Equal(t, 120, bf.BackfilledJobs)
+	assert.Equal(t, 7, bf.LastBackfilledJobs)
+	assert.Equal(t, 30, bf.Cycles)
+	assert.Equal(t, 2500*time.Microsecond, bf.LastCycle)
+	assert.Equal(t, time.Duration(0), bf.MaxCycle)
+	assert.Equal(t, 1500*time.Microsecond, bf.MeanCycle)
+	assert.Equal(t, 42, bf.LastDepth)
+	assert.Equal(t, 40, bf.LastDepthTried)
+	assert.Equal(t, 55, bf.QueueLen)
+	assert.Equal(t, 12, bf.TableSize)
+	assert.Equal(t, time.Unix(1700000000, 0), bf.LastCycleAt)
+	assert.Equal(t, 30, diag.BFCycle)
+	assert.Equal(t, int64(1500), diag.BFCycleMean)
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package v0_0_40
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/jontk/slurm-client/api"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
+)
+
+func TestJobAdapter_TimeLimitUnlimited(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+
+	var apiJob api.V0040JobInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"job_id": 7,
+		"time_limit": {"set": false, "infinite": true, "number": 0}
+	}`), &apiJob))
+
+	job := adapter.convertAPIJobToCommon(apiJob)
+	require.NotNil(t, job)
+	require.NotNil(t, job.TimeLimit)
+	assert.Equal(t, types.TimeLimitUnlimited, *job.TimeLimit)
+
+	// Write the limit back and check it is sent as infinite, not as 4294967295
+	jobDesc := &api.V0040JobDescMsg{}
+	adapter.setJobResources(jobDesc, &types.JobCreate{TimeLimit: job.TimeLimit})
+	require.NotNil(t, jobDesc.TimeLimit)
+	require.NotNil(t, jobDesc.TimeLimit.Infinite)
+	assert.True(t, *jobDesc.TimeLimit.Infinite)
+	assert.Nil(t, jobDesc.TimeLimit.Number)
+
+	// And read it back again
+	apiJob.TimeLimit = jobDesc.TimeLimit
+	job = adapter.convertAPIJobToCommon(apiJob)
+	assert.True(t, types.IsUnlimitedTimeLimit(job.TimeLimit))
+
+	// Finite limits are unaffected
+	limit := uint32(90)
+	jobDesc = &api.V0040JobDescMsg{}
+	adapter.setJobResources(jobDesc, &types.JobCreate{TimeLimit: &limit})
+	require.NotNil(t, jobDesc.TimeLimit)
+	assert.Nil(t, jobDesc.TimeLimit.Infinite)
+	assert.Equal(t, int64(90), *jobDesc.TimeLimit.Number)
+}
+
+func TestPartitionAdapter_TimeLimitUnlimited(t *testing.T) {
+	adapter := NewPartitionAdapter(&api.ClientWithResponses{})
+
+	var apiPartition api.V0040PartitionInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "long",
+		"defaults": {"time": {"set": true, "infinite": false, "number": 60}},
+		"maximums": {"time": {"set": false, "infinite": true, "number": 0}}
+	}`), &apiPartition))
+
+	partition := adapter.convertAPIPartitionToCommon(apiPartition)
+	require.NotNil(t, partition)
+	require.NotNil(t, partition.Maximums)
+	require.NotNil(t, partition.Maximums.Time)
+	assert.Equal(t, types.TimeLimitUnlimited, *partition.Maximums.Time)
+	assert.Equal(t, "UNLIMITED", types.FormatTimeLimit(partition.Maximums.Time))
+	require.NotNil(t, partition.Defaults)
+	assert.Equal(t, uint32(60), *partition.Defaults.Time)
+}
@@ -546,6 +546,39 @@ func ConvertUint32NoVal(source *NoValInt32) *uint32 {
 	return &val
 }
 
+// ConvertTimeLimitValue converts a time limit decoded from JSON, either a
+// plain number or a set/number/infinite struct, to *uint32, mapping an
+// infinite limit to types.TimeLimitUnlimited instead of dropping it.
+func ConvertTimeLimitValue(source interface{}) *uint32 {
+	switch v := source.(type) {
+	case map[string]interface{}:
+		if infinite, ok := v["infinite"].(bool); ok && infinite {
+			unlimited := types.TimeLimitUnlimited
+			return &unlimited
+		}
+		if number, ok := v["number"].(float64); ok {
+			val := uint32(number)
+			return &val
+		}
+	case float64:
+		val := uint32(v)
+		return &val
+	}
+	return nil
+}
+
+// ConvertTimeLimitToNoVal converts a time limit to the set/number/infinite
+// struct v0.0.41 expects, sending types.TimeLimitUnlimited as infinite.
+func ConvertTimeLimitToNoVal(minutes uint32) map[string]interface{} {
+	if minutes == types.TimeLimitUnlimited {
+		return map[string]interface{}{"infinite": true}
+	}
+	return map[string]interface{}{
+		"set":    true,
+		"number": int32(minutes),
+	}
+}
+
 // ConvertUint16NoVal converts a NoValInt32 to *uint16.
 // Returns nil if source is nil or Set is false.
 func ConvertUint16NoVal(source *NoValInt32) *uint16 {
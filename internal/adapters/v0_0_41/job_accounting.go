@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+	adaptercommon "github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/internal/common"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+)
+
+// GetAccounting retrieves the slurmdbd accounting record for a job. Only the
+// fields that slurmctld drops once a job leaves the queue are populated.
+func (a *JobAdapter) GetAccounting(ctx context.Context, jobID int64) (*types.Job, error) {
+	record, err := a.getAccountingRecord(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return record.ToJob(), nil
+}
+
+// ListSteps retrieves the steps of a job from its slurmdbd accounting
+// record, which slurmctld keeps up to date while the job runs
+func (a *JobAdapter) ListSteps(ctx context.Context, jobID int64) ([]types.JobStep, error) {
+	record, err := a.getAccountingRecord(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return record.JobSteps(), nil
+}
+
+// GetTRESUsage retrieves the resource usage of a job's steps from its
+// slurmdbd accounting record
+func (a *JobAdapter) GetTRESUsage(ctx context.Context, jobID int64) (*types.TRESUsageReport, error) {
+	record, err := a.getAccountingRecord(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return record.TRESUsage(), nil
+}
+
+// getAccountingRecord fetches the slurmdbd record of a job
+func (a *JobAdapter) getAccountingRecord(ctx context.Context, jobID int64) (*adaptercommon.AccountingJob, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	// Validate job ID
+	if jobID <= 0 {
+		return nil, common.NewValidationError("job ID must be positive", "jobID", jobID)
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+	// Make the API call
+	resp, err := a.client.SlurmdbV0041GetJobWithResponse(ctx, strconv.FormatInt(jobID, 10))
+	if err != nil {
+		return nil, a.WrapError(err, fmt.Sprintf("failed to get accounting record of job %d", jobID))
+	}
+	// Handle response
+	if err := a.HandleHTTPResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || len(resp.JSON200.Jobs) == 0 {
+		return nil, a.HandleNotFound(fmt.Sprintf("accounting record for job %d", jobID))
+	}
+	return adaptercommon.DecodeAccountingJob(resp.JSON200.Jobs[0])
+}
+
+// ListHistory retrieves the slurmdbd records of the jobs eligible or running
+// in the requested period, filtered by partition and QoS
+func (a *JobAdapter) ListHistory(ctx context.Context, opts *types.JobHistoryOptions) ([]types.Job, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+	params := &api.SlurmdbV0041GetJobsParams{}
+	if opts != nil {
+		params.StartTime = adaptercommon.UnixTimeParam(opts.Since)
+		params.EndTime = adaptercommon.UnixTimeParam(opts.Until)
+		if opts.Partition != "" {
+			params.Partition = &opts.Partition
+		}
+		if opts.QoS != "" {
+			params.Qos = &opts.QoS
+		}
+	}
+	// Make the API call
+	resp, err := a.client.SlurmdbV0041GetJobsWithResponse(ctx, params)
+	if err != nil {
+		return nil, a.WrapError(err, "failed to list job history")
+	}
+	// Handle response
+	if err := a.HandleHTTPResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected nil response from job history")
+	}
+	return adaptercommon.DecodeAccountingJobs(resp.JSON200.Jobs)
+}
@@ -6,12 +6,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	types "github.com/jontk/slurm-client/api"
 	adapterbase "github.com/jontk/slurm-client/internal/adapters/base"
 	"github.com/jontk/slurm-client/internal/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
-	"github.com/jontk/slurm-client/pkg/errors"
 )
 
 // JobAdapter implements the JobAdapter interface for v0.0.41
@@ -43,9 +43,12 @@ func (a *JobAdapter) List(ctx context.Context, opts *types.JobListOptions) (*typ
 	// Apply filters from options
 	// Note: v0.0.41 GetJobs doesn't support filtering parameters
 	// We'll need to filter the results after fetching all jobs
-	_ = opts
-	// Set flags to get detailed job information
-	flags := api.SlurmV0041GetJobsParamsFlagsDETAIL
+	// Set flags to get detailed job information, along with any requested
+	requested := []types.QueryFlag{types.QueryFlagDetail}
+	if opts != nil {
+		requested = append(requested, opts.Flags...)
+	}
+	flags := api.SlurmV0041GetJobsParamsFlags(types.JoinQueryFlags(requested))
 	params.Flags = &flags
 	// Make the API call
 	resp, err := a.client.SlurmV0041GetJobsWithResponse(ctx, params)
@@ -102,7 +105,33 @@ func (a *JobAdapter) jobPassesFilters(job *types.Job, opts *types.JobListOptions
 	if len(opts.States) > 0 && !a.jobStateMatches(job.JobState, opts.States) {
 		return false
 	}
-	return true
+	// Filter by state reason
+	if len(opts.Reasons) > 0 && !a.jobReasonMatches(job.StateReason, opts.Reasons) {
+		return false
+	}
+	// Filter to held jobs
+	if opts.HeldOnly && job.HeldBy() == "" {
+		return false
+	}
+	// Filter to the tasks of job arrays
+	if !opts.MatchesArrayJobIDs(job) {
+		return false
+	}
+	// Filter by submit, start and end time
+	return opts.MatchesTimeWindows(job)
+}
+
+// jobReasonMatches checks if a job state reason is in the filter list
+func (a *JobAdapter) jobReasonMatches(reason *string, reasons []string) bool {
+	if reason == nil {
+		return false
+	}
+	for _, r := range reasons {
+		if strings.EqualFold(r, *reason) {
+			return true
+		}
+	}
+	return false
 }
 
 // jobAccountMatches checks if a job account is in the filter list
@@ -144,7 +173,7 @@ func (a *JobAdapter) jobStateMatches(jobStates []types.JobState, states []types.
 }
 
 // Get retrieves a specific job by ID
-func (a *JobAdapter) Get(ctx context.Context, jobID int32) (*types.Job, error) {
+func (a *JobAdapter) Get(ctx context.Context, jobID int64) (*types.Job, error) {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
 		return nil, err
@@ -241,7 +270,7 @@ func (a *JobAdapter) Submit(ctx context.Context, job *types.JobCreate) (*types.J
 	return response, nil
 }
 // Cancel cancels a job
-func (a *JobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCancelRequest) error {
+func (a *JobAdapter) Cancel(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -269,7 +298,7 @@ func (a *JobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCan
 }
 
 // Update updates job properties
-func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobUpdate) error {
+func (a *JobAdapter) Update(ctx context.Context, jobID int64, update *types.JobUpdate) error {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -321,14 +350,40 @@ func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobU
 	return nil
 }
 
-// Watch watches for job state changes (not implemented in v0.0.41)
-func (a *JobAdapter) Watch(ctx context.Context, opts *types.JobWatchOptions) (<-chan types.JobEvent, error) {
-	return nil, errors.NewNotImplementedError("Watch Jobs", "v0.0.41")
-}
-
-// Signal sends a signal to a job (not implemented in v0.0.41)
+// Signal sends a signal to a job
 func (a *JobAdapter) Signal(ctx context.Context, req *types.JobSignalRequest) error {
-	return a.HandleNotImplemented("Signal", "v0.0.41")
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return err
+	}
+	if req == nil || req.JobId <= 0 {
+		return a.HandleValidationError("jobID must be positive")
+	}
+	if req.Signal == "" {
+		return a.HandleValidationError("signal is required")
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return err
+	}
+	// Signals are sent with the job DELETE endpoint and its signal parameter
+	params := &api.SlurmV0041DeleteJobParams{
+		Signal: &req.Signal,
+	}
+	if req.BatchOnly {
+		flags := api.SlurmV0041DeleteJobParamsFlags("BATCH_JOB")
+		params.Flags = &flags
+	}
+	jobIDStr := strconv.FormatInt(int64(req.JobId), 10)
+	resp, err := a.client.SlurmV0041DeleteJobWithResponse(ctx, jobIDStr, params)
+	if err != nil {
+		return a.WrapError(err, fmt.Sprintf("failed to signal job %d", req.JobId))
+	}
+	// Handle response
+	if err := a.HandleHTTPResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Hold holds or releases a job (not implemented in v0.0.41)
@@ -342,7 +397,7 @@ func (a *JobAdapter) Notify(ctx context.Context, req *types.JobNotifyRequest) er
 }
 
 // Requeue requeues a job (not available in v0.0.41)
-func (a *JobAdapter) Requeue(ctx context.Context, jobID int32) error {
+func (a *JobAdapter) Requeue(ctx context.Context, jobID int64) error {
 	return a.HandleNotImplemented("Requeue", "v0.0.41")
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"testing"
 
+	types "github.com/jontk/slurm-client/api"
 	adapterbase "github.com/jontk/slurm-client/internal/adapters/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -51,3 +52,21 @@ func TestJobAdapter_ValidateContext(t *testing.T) {
 
 // All other job adapter tests removed as the methods and types are not implemented
 // in the current interface. Only ValidateContext is tested above.
+
+func TestJobAdapter_ConvertCommonJobCreateToAPI_Sharing(t *testing.T) {
+	adapter := &JobAdapter{
+		BaseManager: adapterbase.NewBaseManager("v0.0.41", "Job"),
+	}
+	cpuBind := "cores"
+	body, err := adapter.convertCommonJobCreateToAPI(&types.JobCreate{
+		Shared:     []types.SharedValue{types.SharedNone},
+		CPUBinding: &cpuBind,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, body.Job)
+	require.NotNil(t, body.Job.Shared)
+	assert.Len(t, *body.Job.Shared, 1)
+	assert.Equal(t, "none", string((*body.Job.Shared)[0]))
+	require.NotNil(t, body.Job.CpuBinding)
+	assert.Equal(t, "cores", *body.Job.CpuBinding)
+}
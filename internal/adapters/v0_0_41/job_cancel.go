@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// CancelIfPending cancels a job only while it is pending and reports whether
+// it was cancelled. The state filter is applied by slurmctld as it signals
+// the job, so a job that has just started running is left alone. With the
+// VERBOSE flag slurmctld reports every job it signalled; a job the filter
+// excluded gets no entry.
+func (a *JobAdapter) CancelIfPending(ctx context.Context, jobID int64) (bool, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return false, err
+	}
+	// Validate job ID
+	if jobID <= 0 {
+		return false, a.HandleValidationError("jobID must be positive")
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return false, err
+	}
+
+	// Make the API call
+	id := strconv.FormatInt(jobID, 10)
+	body := api.SlurmV0041DeleteJobsJSONRequestBody{
+		Jobs:     &[]string{id},
+		JobState: &[]api.SlurmV0041DeleteJobsJSONBodyJobState{api.SlurmV0041DeleteJobsJSONBodyJobStatePENDING},
+		Flags:    &[]api.SlurmV0041DeleteJobsJSONBodyFlags{api.SlurmV0041DeleteJobsJSONBodyFlagsVERBOSE},
+	}
+	resp, err := a.client.SlurmV0041DeleteJobsWithResponse(ctx, body)
+	if err != nil {
+		return false, a.WrapError(err, fmt.Sprintf("failed to cancel job %d", jobID))
+	}
+
+	// Handle response
+	if err := a.HandleHTTPResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return false, err
+	}
+	if resp.JSON200 == nil {
+		return false, fmt.Errorf("unexpected nil response from job cancel")
+	}
+
+	for _, status := range resp.JSON200.Status {
+		if status.StepId != id && (status.JobId.Number == nil || int64(*status.JobId.Number) != jobID) {
+			continue
+		}
+		if status.Error != nil && status.Error.Code != nil && *status.Error.Code != 0 {
+			message := ""
+			if status.Error.Message != nil {
+				message = *status.Error.Message
+			}
+			return false, errors.NewSlurmError(errors.ErrorCodeServerInternal,
+				fmt.Sprintf("Failed to cancel job %d: %s", jobID, message))
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 )
 
 // convertAPIJobToCommon converts a v0.0.41 API Job to common Job type
@@ -28,8 +29,7 @@ func (a *JobAdapter) convertAPIJobToCommon(apiJob interface{}) (*types.Job, erro
 	job := &types.Job{}
 	// Basic fields - using safe type assertions
 	if v, ok := jobData["job_id"]; ok {
-		if jobID, ok := v.(float64); ok {
-			id := int32(jobID)
+		if id, ok := common.ParseNumberField64(v); ok {
 			job.JobID = &id
 		}
 	}
@@ -81,6 +81,12 @@ func (a *JobAdapter) convertAPIJobToCommon(apiJob interface{}) (*types.Job, erro
 			job.StateReason = &r
 		}
 	}
+	if v, ok := jobData["state_description"].(string); ok {
+		job.StateDescription = &v
+	}
+	if v, ok := jobData["hold"].(bool); ok {
+		job.Hold = &v
+	}
 	// Time fields - handle both direct numbers and structured time objects
 	if v, ok := jobData["submit_time"]; ok {
 		if timeStruct, ok := v.(map[string]interface{}); ok {
@@ -105,6 +111,30 @@ func (a *JobAdapter) convertAPIJobToCommon(apiJob interface{}) (*types.Job, erro
 			}
 		}
 	}
+	// Job array membership; the numbers are unset for jobs outside an array
+	arrayFields := map[string]**uint32{
+		"array_job_id":    &job.ArrayJobID,
+		"array_task_id":   &job.ArrayTaskID,
+		"array_max_tasks": &job.ArrayMaxTasks,
+	}
+	for key, field := range arrayFields {
+		if numStruct, ok := jobData[key].(map[string]interface{}); ok {
+			if set, _ := numStruct["set"].(bool); !set {
+				continue
+			}
+			if number, ok := numStruct["number"].(float64); ok {
+				n := uint32(number)
+				*field = &n
+			}
+		}
+	}
+	if v, ok := jobData["array_task_string"].(string); ok && v != "" {
+		job.ArrayTaskString = &v
+	}
+	if v, ok := jobData["restart_cnt"].(float64); ok {
+		restarts := int32(v)
+		job.RestartCnt = &restarts
+	}
 	// Resource requirements
 	if v, ok := jobData["node_count"]; ok {
 		if nodeStruct, ok := v.(map[string]interface{}); ok {
@@ -122,15 +152,54 @@ func (a *JobAdapter) convertAPIJobToCommon(apiJob interface{}) (*types.Job, erro
 			}
 		}
 	}
-	// Time limit
-	if v, ok := jobData["time_limit"]; ok {
-		if timeStruct, ok := v.(map[string]interface{}); ok {
-			if number, ok := timeStruct["number"].(float64); ok {
-				tl := uint32(number)
-				job.TimeLimit = &tl
+	if v, ok := jobData["memory_per_node"]; ok {
+		if memStruct, ok := v.(map[string]interface{}); ok {
+			if number, ok := memStruct["number"].(float64); ok {
+				mem := uint64(number)
+				job.MemoryPerNode = &mem
+			}
+		}
+	}
+	if v, ok := jobData["memory_per_cpu"]; ok {
+		if memStruct, ok := v.(map[string]interface{}); ok {
+			if number, ok := memStruct["number"].(float64); ok {
+				mem := uint64(number)
+				job.MemoryPerCPU = &mem
+			}
+		}
+	}
+	// TRES strings carry the requested and allocated totals
+	if v, ok := jobData["tres_req_str"]; ok {
+		if tres, ok := v.(string); ok {
+			t := tres
+			job.TRESReqStr = &t
+		}
+	}
+	if v, ok := jobData["tres_alloc_str"]; ok {
+		if tres, ok := v.(string); ok {
+			t := tres
+			job.TRESAllocStr = &t
+		}
+	}
+	if v, ok := jobData["tres_per_node"]; ok {
+		if tres, ok := v.(string); ok {
+			t := tres
+			job.TRESPerNode = &t
+		}
+	}
+	if v, ok := jobData["gres_detail"]; ok {
+		if details, ok := v.([]interface{}); ok {
+			for _, d := range details {
+				if detail, ok := d.(string); ok {
+					job.GRESDetail = append(job.GRESDetail, detail)
+				}
 			}
 		}
 	}
+	// Time limit
+	if v, ok := jobData["time_limit"].(map[string]interface{}); ok {
+		job.TimeLimit = ConvertTimeLimitValue(v)
+	}
 	// Priority
 	if v, ok := jobData["priority"]; ok {
 		if priorityStruct, ok := v.(map[string]interface{}); ok {
@@ -140,6 +209,10 @@ func (a *JobAdapter) convertAPIJobToCommon(apiJob interface{}) (*types.Job, erro
 			}
 		}
 	}
+	if v, ok := jobData["nice"].(float64); ok {
+		nice := int32(v)
+		job.Nice = &nice
+	}
 	// Node information
 	if v, ok := jobData["nodes"]; ok {
 		if nodes, ok := v.(string); ok {
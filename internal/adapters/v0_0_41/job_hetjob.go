@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// SubmitHetJob submits a heterogeneous job, sending one job description per
+// component in the jobs array of the submit request. The batch script is
+// taken from the first component.
+func (a *JobAdapter) SubmitHetJob(ctx context.Context, components []*types.JobCreate) (*types.JobSubmitResponse, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if len(components) < 2 {
+		return nil, a.HandleValidationError("a heterogeneous job needs at least two components")
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+
+	// Convert to API request
+	jobs := make([]map[string]interface{}, 0, len(components))
+	for _, component := range components {
+		if component == nil {
+			return nil, a.HandleValidationError("heterogeneous job component cannot be nil")
+		}
+		jobs = append(jobs, convertJobCreateToMap(component))
+	}
+	bodyMap := map[string]interface{}{
+		"jobs": jobs,
+	}
+	if components[0].Script != nil {
+		bodyMap["script"] = *components[0].Script
+	}
+	reqBody, err := convertSubmitBody(bodyMap)
+	if err != nil {
+		return nil, a.WrapError(err, "failed to convert job request")
+	}
+
+	// Make the API call
+	resp, err := a.client.SlurmV0041PostJobSubmitWithResponse(ctx, reqBody)
+	if err != nil {
+		return nil, a.WrapError(err, "failed to submit heterogeneous job")
+	}
+
+	// Handle response
+	if err := a.HandleHTTPResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected nil response from job submit")
+	}
+
+	// Check for errors in response
+	if resp.JSON200.Errors != nil && len(*resp.JSON200.Errors) > 0 {
+		errMsgs := make([]string, 0, len(*resp.JSON200.Errors))
+		for _, apiErr := range *resp.JSON200.Errors {
+			if apiErr.Error != nil {
+				errMsgs = append(errMsgs, *apiErr.Error)
+			}
+		}
+		if len(errMsgs) > 0 {
+			return nil, fmt.Errorf("job submission failed: %v", errMsgs)
+		}
+	}
+
+	// The het job ID is that of the first component
+	response := &types.JobSubmitResponse{}
+	if resp.JSON200.JobId != nil {
+		response.JobId = *resp.JSON200.JobId
+	}
+	return response, nil
+}
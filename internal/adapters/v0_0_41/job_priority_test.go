@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"testing"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobAdapter_ConvertPriorityComponents(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+
+	job, err := adapter.convertAPIJobToCommon(map[string]interface{}{
+		"job_id":   float64(7),
+		"priority": map[string]interface{}{"set": true, "number": float64(4200)},
+		"nice":     float64(100),
+	})
+	require.NoError(t, err)
+
+	components := job.PriorityComponents()
+	require.NotNil(t, components)
+	assert.Equal(t, uint32(4200), components.Priority)
+	assert.Equal(t, int32(100), components.Nice)
+	assert.Nil(t, components.ByPartition)
+
+	job, err = adapter.convertAPIJobToCommon(map[string]interface{}{"job_id": float64(8)})
+	require.NoError(t, err)
+	assert.Nil(t, job.PriorityComponents())
+}
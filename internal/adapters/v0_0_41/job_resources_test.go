@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"testing"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobAdapter_ConvertResources(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+
+	job, err := adapter.convertAPIJobToCommon(map[string]interface{}{
+		"job_id":          float64(7),
+		"tres_req_str":    "cpu=16,mem=64G,node=2,billing=16,gres/gpu=4",
+		"tres_alloc_str":  "cpu=16,mem=64G,node=2,billing=16,gres/gpu=4,gres/gpu:a100=4",
+		"memory_per_node": map[string]interface{}{"set": true, "number": float64(32768)},
+	})
+	require.NoError(t, err)
+
+	req := job.Requested()
+	assert.Equal(t, 16, req.CPUs)
+	assert.Equal(t, int64(64*1024), req.MemoryMB)
+	assert.Equal(t, 2, req.Nodes)
+	assert.Equal(t, int64(4), req.GPUs())
+
+	alloc := job.Allocated()
+	assert.Equal(t, 16, alloc.CPUs)
+	assert.Equal(t, 2, alloc.Nodes)
+	assert.Equal(t, map[string]int64{"gpu": 4, "gpu:a100": 4}, alloc.GRES)
+	require.NotNil(t, job.MemoryPerNode)
+	assert.Equal(t, uint64(32768), *job.MemoryPerNode)
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jontk/slurm-client/internal/common"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// GetScript retrieves the batch script of a job. slurmctld does not return
+// scripts over REST, so this reads the copy slurmdbd keeps when
+// AccountingStoreFlags includes job_script.
+func (a *JobAdapter) GetScript(ctx context.Context, jobID int64) (string, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return "", err
+	}
+	// Validate job ID
+	if jobID <= 0 {
+		return "", common.NewValidationError("job ID must be positive", "jobID", jobID)
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return "", err
+	}
+	// Make the API call
+	step := strconv.FormatInt(jobID, 10)
+	showScript := "true"
+	params := &api.SlurmdbV0041GetJobsParams{
+		Step:            &step,
+		ShowBatchScript: &showScript,
+	}
+	resp, err := a.client.SlurmdbV0041GetJobsWithResponse(ctx, params)
+	if err != nil {
+		return "", a.WrapError(err, fmt.Sprintf("failed to get batch script of job %d", jobID))
+	}
+	// Handle response
+	if err := a.HandleHTTPResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return "", err
+	}
+	if resp.JSON200 != nil {
+		for _, job := range resp.JSON200.Jobs {
+			if job.JobId == nil || int64(*job.JobId) != jobID {
+				continue
+			}
+			if job.Script == nil || *job.Script == "" {
+				return "", errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+					fmt.Sprintf("No batch script stored for job %d (requires AccountingStoreFlags=job_script)", jobID))
+			}
+			return *job.Script, nil
+		}
+	}
+	return "", errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+		fmt.Sprintf("Accounting record for job %d not found", jobID))
+}
@@ -17,6 +17,19 @@ func (a *JobAdapter) convertCommonJobCreateToAPI(input *types.JobCreate) (api.Sl
 		return api.SlurmV0041PostJobSubmitJSONRequestBody{}, nil
 	}
 
+	// Build the request body structure
+	bodyMap := map[string]interface{}{
+		"job": convertJobCreateToMap(input),
+	}
+	if input.Script != nil {
+		bodyMap["script"] = *input.Script
+	}
+	return convertSubmitBody(bodyMap)
+}
+
+// convertJobCreateToMap builds the job description of a submission as a map
+// in the shape of the v0.0.41 API
+func convertJobCreateToMap(input *types.JobCreate) map[string]interface{} {
 	// Build an intermediate representation that matches the API structure
 	// Using a generic map allows us to bypass Go's type system limitations
 	// with the anonymous structs in v0.0.41
@@ -62,12 +75,28 @@ func (a *JobAdapter) convertCommonJobCreateToAPI(input *types.JobCreate) (api.Sl
 	if input.StandardInput != nil {
 		jobMap["standard_input"] = *input.StandardInput
 	}
+	if len(input.OpenMode) > 0 {
+		openMode := make([]string, len(input.OpenMode))
+		for i, mode := range input.OpenMode {
+			openMode[i] = string(mode)
+		}
+		jobMap["open_mode"] = openMode
+	}
 	if input.CurrentWorkingDirectory != nil {
 		jobMap["current_working_directory"] = *input.CurrentWorkingDirectory
 	}
 	if input.Nodes != nil {
 		jobMap["nodes"] = *input.Nodes
 	}
+	if input.CPUBinding != nil {
+		jobMap["cpu_binding"] = *input.CPUBinding
+	}
+	if input.TRESPerNode != nil {
+		jobMap["tres_per_node"] = *input.TRESPerNode
+	}
+	if len(input.Shared) > 0 {
+		jobMap["shared"] = input.Shared
+	}
 
 	// Set numeric fields
 	if input.MinimumNodes != nil {
@@ -88,18 +117,28 @@ func (a *JobAdapter) convertCommonJobCreateToAPI(input *types.JobCreate) (api.Sl
 	if input.TasksPerNode != nil {
 		jobMap["tasks_per_node"] = *input.TasksPerNode
 	}
+	if input.Nice != nil {
+		jobMap["nice"] = *input.Nice
+	}
 
 	// Set boolean fields
 	if input.Hold != nil {
 		jobMap["hold"] = *input.Hold
 	}
+	if input.Reboot != nil {
+		jobMap["reboot"] = *input.Reboot
+	}
+	if len(input.Flags) > 0 {
+		flags := make([]string, len(input.Flags))
+		for i, flag := range input.Flags {
+			flags[i] = string(flag)
+		}
+		jobMap["flags"] = flags
+	}
 
 	// Set complex fields with number wrappers (v0.0.41 uses set/number/infinite structs)
 	if input.TimeLimit != nil {
-		jobMap["time_limit"] = map[string]interface{}{
-			"set":    true,
-			"number": int32(*input.TimeLimit),
-		}
+		jobMap["time_limit"] = ConvertTimeLimitToNoVal(*input.TimeLimit)
 	}
 
 	if input.MemoryPerNode != nil {
@@ -121,14 +160,11 @@ func (a *JobAdapter) convertCommonJobCreateToAPI(input *types.JobCreate) (api.Sl
 		jobMap["environment"] = input.Environment
 	}
 
-	// Build the request body structure
-	bodyMap := map[string]interface{}{
-		"job": jobMap,
-	}
-	if input.Script != nil {
-		bodyMap["script"] = *input.Script
-	}
+	return jobMap
+}
 
+// convertSubmitBody converts a request body built as a map to the API type
+func convertSubmitBody(bodyMap map[string]interface{}) (api.SlurmV0041PostJobSubmitJSONRequestBody, error) {
 	// Marshal to JSON and unmarshal to the API type
 	jsonBytes, err := json.Marshal(bodyMap)
 	if err != nil {
@@ -42,7 +42,10 @@ func (a *NodeAdapter) List(ctx context.Context, opts *types.NodeListOptions) (*t
 	if opts != nil {
 		// v0.0.41 GetNodes doesn't support filtering parameters like NodeName or State
 		// We'll filter results after fetching
-		_ = opts
+		if len(opts.Flags) > 0 {
+			flags := api.SlurmV0041GetNodesParamsFlags(types.JoinQueryFlags(opts.Flags))
+			params.Flags = &flags
+		}
 	}
 	// Make the API call
 	resp, err := a.client.SlurmV0041GetNodesWithResponse(ctx, params)
@@ -254,9 +257,3 @@ func (a *NodeAdapter) Delete(ctx context.Context, name string) error {
 	}
 	return nil
 }
-
-// Watch provides real-time node status updates (not fully implemented in v0.0.41)
-func (a *NodeAdapter) Watch(ctx context.Context, opts *types.NodeWatchOptions) (<-chan types.NodeWatchEvent, error) {
-	// For now, return not implemented error to satisfy interface
-	return nil, fmt.Errorf("watch functionality not fully implemented in v0.0.41 adapter")
-}
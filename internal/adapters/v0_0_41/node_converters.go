@@ -101,7 +101,7 @@ func (a *NodeAdapter) convertAPINodeToCommon(apiNode interface{}) (*types.Node,
 			node.Cores = &c
 		}
 	}
-	if v, ok := nodeData["threads_per_core"]; ok {
+	if v, ok := nodeData["threads"]; ok {
 		if threads, ok := v.(float64); ok {
 			t := int32(threads)
 			node.Threads = &t
@@ -230,6 +230,12 @@ func (a *NodeAdapter) convertAPINodeToCommon(apiNode interface{}) (*types.Node,
 			}
 		}
 	}
+	// Energy
+	if v, ok := nodeData["energy"]; ok {
+		if energyData, ok := v.(map[string]interface{}); ok {
+			node.Energy = convertNodeEnergy(energyData)
+		}
+	}
 	// Version
 	if v, ok := nodeData["version"]; ok {
 		if version, ok := v.(string); ok {
@@ -240,14 +246,87 @@ func (a *NodeAdapter) convertAPINodeToCommon(apiNode interface{}) (*types.Node,
 	return node, nil
 }
 
+// convertNodeEnergy converts the energy block of a v0.0.41 node, where
+// current_watts is a {set, number} struct
+func convertNodeEnergy(energyData map[string]interface{}) *types.NodeEnergy {
+	energy := &types.NodeEnergy{}
+	if v, ok := energyData["average_watts"].(float64); ok {
+		w := int32(v)
+		energy.AverageWatts = &w
+	}
+	if v, ok := energyData["base_consumed_energy"].(float64); ok {
+		e := int64(v)
+		energy.BaseConsumedEnergy = &e
+	}
+	if v, ok := energyData["consumed_energy"].(float64); ok {
+		e := int64(v)
+		energy.ConsumedEnergy = &e
+	}
+	if current, ok := energyData["current_watts"].(map[string]interface{}); ok {
+		if set, ok := current["set"].(bool); ok && set {
+			if number, ok := current["number"].(float64); ok {
+				w := uint32(number)
+				energy.CurrentWatts = &w
+			}
+		}
+	}
+	if v, ok := energyData["last_collected"].(float64); ok {
+		t := int64(v)
+		energy.LastCollected = &t
+	}
+	if v, ok := energyData["previous_consumed_energy"].(float64); ok {
+		e := int64(v)
+		energy.PreviousConsumedEnergy = &e
+	}
+	return energy
+}
+
 // convertCommonToAPINodeUpdate converts common node update request to v0.0.41 API format
 func (a *NodeAdapter) convertCommonToAPINodeUpdate(update *types.NodeUpdate) *api.SlurmV0041PostNodeJSONRequestBody {
-	// Create a basic update request structure
-	updateReq := &api.SlurmV0041PostNodeJSONRequestBody{}
-	// Note: The exact structure for node updates in v0.0.41 may be different
-	// This is a placeholder implementation that would need to be adjusted
-	// based on the actual API structure
-	// For now, return an empty request
-	_ = update
+	updateReq := &api.SlurmV0041PostNodeJSONRequestBody{
+		Comment:   update.Comment,
+		Extra:     update.Extra,
+		Gres:      update.GRES,
+		Reason:    update.Reason,
+		ReasonUid: update.ReasonUID,
+		CpuBind:   update.CPUBind,
+	}
+	if len(update.Address) > 0 {
+		updateReq.Address = &update.Address
+	}
+	if len(update.Features) > 0 {
+		updateReq.Features = &update.Features
+	}
+	if len(update.FeaturesAct) > 0 {
+		updateReq.FeaturesAct = &update.FeaturesAct
+	}
+	if len(update.Hostname) > 0 {
+		updateReq.Hostname = &update.Hostname
+	}
+	if len(update.State) > 0 {
+		states := make([]api.V0041UpdateNodeMsgState, len(update.State))
+		for i, state := range update.State {
+			states[i] = api.V0041UpdateNodeMsgState(state)
+		}
+		updateReq.State = &states
+	}
+	if update.Weight != nil {
+		set := true
+		weight := int32(*update.Weight)
+		updateReq.Weight = &struct {
+			Infinite *bool  `json:"infinite,omitempty"`
+			Number   *int32 `json:"number,omitempty"`
+			Set      *bool  `json:"set,omitempty"`
+		}{Number: &weight, Set: &set}
+	}
+	if update.ResumeAfter != nil {
+		set := true
+		after := int32(*update.ResumeAfter)
+		updateReq.ResumeAfter = &struct {
+			Infinite *bool  `json:"infinite,omitempty"`
+			Number   *int32 `json:"number,omitempty"`
+			Set      *bool  `json:"set,omitempty"`
+		}{Number: &after, Set: &set}
+	}
 	return updateReq
 }
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeAdapter_ConvertEnergy(t *testing.T) {
+	adapter := &NodeAdapter{}
+
+	node, err := adapter.convertAPINodeToCommon(map[string]interface{}{
+		"name": "gpu01",
+		"energy": map[string]interface{}{
+			"average_watts":   float64(300),
+			"consumed_energy": float64(7200000),
+			"current_watts":   map[string]interface{}{"set": true, "number": float64(350)},
+			"last_collected":  float64(1700000000),
+		},
+	})
+	require.NoError(t, err)
+
+	stats, ok := node.PowerStats()
+	require.True(t, ok)
+	assert.Equal(t, uint32(350), stats.CurrentWatts)
+	assert.Equal(t, uint32(300), stats.AverageWatts)
+	assert.Equal(t, int64(7200000), stats.ConsumedEnergy)
+	assert.Equal(t, time.Unix(1700000000, 0), stats.LastCollected)
+
+	// An unset current_watts is left out rather than read as zero
+	node, err = adapter.convertAPINodeToCommon(map[string]interface{}{
+		"name": "cpu01",
+		"energy": map[string]interface{}{
+			"current_watts": map[string]interface{}{"set": false, "number": float64(0)},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, node.Energy)
+	assert.Nil(t, node.Energy.CurrentWatts)
+	_, ok = node.PowerStats()
+	assert.False(t, ok)
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeAdapter_ConvertTopology(t *testing.T) {
+	adapter := &NodeAdapter{}
+
+	node, err := adapter.convertAPINodeToCommon(map[string]interface{}{
+		"name":    "cpu01",
+		"boards":  float64(1),
+		"sockets": float64(2),
+		"cores":   float64(16),
+		"threads": float64(2),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), *node.Sockets)
+	assert.Equal(t, 16, node.CoresPerSocket())
+	assert.Equal(t, 2, node.ThreadsPerCore())
+	assert.Equal(t, 64, node.TotalThreads())
+
+	// A node that has not registered reports no topology
+	node, err = adapter.convertAPINodeToCommon(map[string]interface{}{"name": "cpu02"})
+	require.NoError(t, err)
+	assert.Zero(t, node.TotalThreads())
+}
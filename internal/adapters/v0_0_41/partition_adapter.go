@@ -44,7 +44,10 @@ func (a *PartitionAdapter) List(ctx context.Context, opts *types.PartitionListOp
 	if opts != nil {
 		// v0.0.41 GetPartitions doesn't support filtering parameters like PartitionName
 		// We'll filter results after fetching
-		_ = opts
+		if len(opts.Flags) > 0 {
+			flags := api.SlurmV0041GetPartitionsParamsFlags(types.JoinQueryFlags(opts.Flags))
+			params.Flags = &flags
+		}
 	}
 	// Make the API call
 	resp, err := a.client.SlurmV0041GetPartitionsWithResponse(ctx, params)
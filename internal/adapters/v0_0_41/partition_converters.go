@@ -87,15 +87,8 @@ func (a *PartitionAdapter) convertAPIPartitionToCommon(apiPartition interface{})
 		}
 	}
 	if v, ok := partitionData["max_time"]; ok {
-		if timeStruct, ok := v.(map[string]interface{}); ok {
-			if number, ok := timeStruct["number"].(float64); ok {
-				mt := uint32(number)
-				maximums.Time = &mt
-				hasMaximums = true
-			}
-		} else if maxTime, ok := v.(float64); ok {
-			mt := uint32(maxTime)
-			maximums.Time = &mt
+		if maxTime := ConvertTimeLimitValue(v); maxTime != nil {
+			maximums.Time = maxTime
 			hasMaximums = true
 		}
 	}
@@ -106,6 +99,23 @@ func (a *PartitionAdapter) convertAPIPartitionToCommon(apiPartition interface{})
 			hasMaximums = true
 		}
 	}
+	if v, ok := partitionData["maximums"].(map[string]interface{}); ok {
+		if maxTime := ConvertTimeLimitValue(v["time"]); maxTime != nil {
+			maximums.Time = maxTime
+			hasMaximums = true
+		}
+		if overTime, ok := v["over_time_limit"].(map[string]interface{}); ok {
+			if infinite, ok := overTime["infinite"].(bool); ok && infinite {
+				ot := types.OverTimeLimitUnlimited
+				maximums.OverTimeLimit = &ot
+				hasMaximums = true
+			} else if number, ok := overTime["number"].(float64); ok {
+				ot := uint16(number)
+				maximums.OverTimeLimit = &ot
+				hasMaximums = true
+			}
+		}
+	}
 	if hasMaximums {
 		partition.Maximums = maximums
 	}
@@ -119,19 +129,15 @@ func (a *PartitionAdapter) convertAPIPartitionToCommon(apiPartition interface{})
 		}
 	}
 	// Defaults - nested structure
-	if v, ok := partitionData["default_time"]; ok {
-		var defaultTime uint32
-		if timeStruct, ok := v.(map[string]interface{}); ok {
-			if number, ok := timeStruct["number"].(float64); ok {
-				defaultTime = uint32(number)
-			}
-		} else if dt, ok := v.(float64); ok {
-			defaultTime = uint32(dt)
+	defaultTime := ConvertTimeLimitValue(partitionData["default_time"])
+	if v, ok := partitionData["defaults"].(map[string]interface{}); ok {
+		if dt := ConvertTimeLimitValue(v["time"]); dt != nil {
+			defaultTime = dt
 		}
-		if defaultTime > 0 {
-			partition.Defaults = &types.PartitionDefaults{
-				Time: &defaultTime,
-			}
+	}
+	if defaultTime != nil && *defaultTime > 0 {
+		partition.Defaults = &types.PartitionDefaults{
+			Time: defaultTime,
 		}
 	}
 	// Priority - nested structure
@@ -170,12 +176,20 @@ func (a *PartitionAdapter) convertAPIPartitionToCommon(apiPartition interface{})
 			}
 		}
 	}
-	// QoS - nested structure
-	if v, ok := partitionData["allowed_qos"]; ok {
-		if qos, ok := v.(string); ok {
-			partition.QoS = &types.PartitionQoS{
-				Allowed: &qos,
+	// QoS - nested structure with the partition QOS and its allow/deny lists
+	if v, ok := partitionData["qos"]; ok {
+		if qosData, ok := v.(map[string]interface{}); ok {
+			qos := &types.PartitionQoS{}
+			if allowed, ok := qosData["allowed"].(string); ok {
+				qos.Allowed = &allowed
+			}
+			if assigned, ok := qosData["assigned"].(string); ok {
+				qos.Assigned = &assigned
+			}
+			if deny, ok := qosData["deny"].(string); ok {
+				qos.Deny = &deny
 			}
+			partition.QoS = qos
 		}
 	}
 	// Grace time
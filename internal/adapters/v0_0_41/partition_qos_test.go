@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionAdapter_ConvertQoS(t *testing.T) {
+	adapter := &PartitionAdapter{}
+
+	partition, err := adapter.convertAPIPartitionToCommon(map[string]interface{}{
+		"name": "gpu",
+		"qos": map[string]interface{}{
+			"allowed":  "normal,high",
+			"assigned": "gpu",
+			"deny":     "scavenger",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gpu", partition.AssignedQoS())
+	assert.Equal(t, []string{"normal", "high"}, partition.AllowedQoS())
+	assert.Equal(t, []string{"scavenger"}, partition.DeniedQoS())
+	assert.True(t, partition.PermitsQoS("high"))
+	assert.False(t, partition.PermitsQoS("scavenger"))
+}
@@ -10,6 +10,7 @@ import (
 	types "github.com/jontk/slurm-client/api"
 	adapterbase "github.com/jontk/slurm-client/internal/adapters/base"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+	"github.com/jontk/slurm-client/pkg/errors"
 )
 
 // QoSAdapter implements the QoSAdapter interface for v0.0.41
@@ -204,45 +205,16 @@ func (a *QoSAdapter) Update(ctx context.Context, name string, update *types.QoSU
 	if update == nil {
 		return a.HandleValidationError("QoS update cannot be nil")
 	}
+	// The v0.0.41 QOS object has no GrpTRESMins limit
+	if len(update.GrpTRESMins) > 0 {
+		return errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation, "QoS GrpTRESMins cannot be set through v0.0.41")
+	}
 	// Check client initialization
 	if err := a.CheckClientInitialized(a.client); err != nil {
 		return err
 	}
-	// Get the existing QoS first
-	existingQoS, err := a.Get(ctx, name)
-	if err != nil {
-		return err
-	}
-	// Apply updates
-	if update.Description != nil {
-		existingQoS.Description = update.Description
-	}
-	if update.Priority != nil {
-		priority := uint32(*update.Priority)
-		existingQoS.Priority = &priority
-	}
-	if update.PreemptMode != nil && len(*update.PreemptMode) > 0 {
-		if existingQoS.Preempt == nil {
-			existingQoS.Preempt = &types.QoSPreempt{}
-		}
-		modes := make([]types.ModeValue, 0, len(*update.PreemptMode))
-		for _, m := range *update.PreemptMode {
-			modes = append(modes, types.ModeValue(m))
-		}
-		existingQoS.Preempt.Mode = modes
-	}
-	if update.GraceTime != nil {
-		if existingQoS.Limits == nil {
-			existingQoS.Limits = &types.QoSLimits{}
-		}
-		gt := int32(*update.GraceTime)
-		existingQoS.Limits.GraceTime = &gt
-	}
-	// MaxWall field doesn't exist in QoSUpdate type
-	// Skip MaxWall update
-
 	// Convert to API request using JSON marshaling workaround
-	reqBody, err := a.convertQoSUpdateToAPI(existingQoS)
+	reqBody, err := a.convertQoSUpdateToAPI(name, update)
 	if err != nil {
 		return a.WrapError(err, "failed to convert QoS update request")
 	}
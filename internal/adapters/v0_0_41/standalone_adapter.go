@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
 	"github.com/jontk/slurm-client/pkg/errors"
 )
@@ -202,6 +203,33 @@ func (a *StandaloneAdapter) GetDiagnostics(ctx context.Context) (*types.Diagnost
 	if stats.JobsRunning != nil {
 		diag.JobsRunning = int(*stats.JobsRunning)
 	}
+	// The statistics struct is anonymous in v0.0.41, so map it in place
+	counters := common.BackfillCounters{
+		Active:             stats.BfActive,
+		BackfilledJobs:     stats.BfBackfilledJobs,
+		LastBackfilledJobs: stats.BfLastBackfilledJobs,
+		BackfilledHetJobs:  stats.BfBackfilledHetJobs,
+		CycleCounter:       stats.BfCycleCounter,
+		CycleLast:          stats.BfCycleLast,
+		CycleMax:           stats.BfCycleMax,
+		CycleMean:          stats.BfCycleMean,
+		CycleSum:           stats.BfCycleSum,
+		LastDepth:          stats.BfLastDepth,
+		LastDepthTry:       stats.BfLastDepthTry,
+		DepthMean:          stats.BfDepthMean,
+		DepthMeanTry:       stats.BfDepthMeanTry,
+		DepthSum:           stats.BfDepthSum,
+		QueueLen:           stats.BfQueueLen,
+		QueueLenMean:       stats.BfQueueLenMean,
+		QueueLenSum:        stats.BfQueueLenSum,
+		TableSize:          stats.BfTableSize,
+		TableSizeMean:      stats.BfTableSizeMean,
+		TableSizeSum:       stats.BfTableSizeSum,
+	}
+	if when := stats.BfWhenLastCycle; when != nil && when.Set != nil && *when.Set {
+		counters.WhenLastCycle = when.Number
+	}
+	counters.Apply(diag)
 	// Note: v0.0.41 doesn't have RPC statistics fields at the top level
 	// They might be in RpcsByMessageType instead
 	return diag, nil
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_41
+
+import (
+	"encoding/json"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sentTimeLimit returns the time_limit of a job request body as sent
+func sentTimeLimit(t *testing.T, body interface{}) map[string]interface{} {
+	t.Helper()
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	var sent struct {
+		Job map[string]interface{} `json:"job"`
+	}
+	require.NoError(t, json.Unmarshal(data, &sent))
+	limit, _ := sent.Job["time_limit"].(map[string]interface{})
+	return limit
+}
+
+func TestJobAdapter_TimeLimitUnlimited(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+
+	job, err := adapter.convertAPIJobToCommon(map[string]interface{}{
+		"job_id":     float64(7),
+		"time_limit": map[string]interface{}{"set": false, "infinite": true, "number": float64(0)},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, job.TimeLimit)
+	assert.Equal(t, types.TimeLimitUnlimited, *job.TimeLimit)
+
+	// Write the limit back and check it is sent as infinite, not as -1
+	body, err := adapter.convertCommonJobCreateToAPI(&types.JobCreate{TimeLimit: job.TimeLimit})
+	require.NoError(t, err)
+	sent := sentTimeLimit(t, body)
+	assert.Equal(t, true, sent["infinite"])
+	assert.NotContains(t, sent, "number")
+
+	// And read it back again
+	job, err = adapter.convertAPIJobToCommon(map[string]interface{}{"job_id": float64(7), "time_limit": sent})
+	require.NoError(t, err)
+	assert.True(t, types.IsUnlimitedTimeLimit(job.TimeLimit))
+
+	// Updates send the job description unwrapped
+	update, err := adapter.convertJobUpdateToAPI(&types.JobUpdate{TimeLimit: job.TimeLimit})
+	require.NoError(t, err)
+	assert.Equal(t, true, sentTimeLimit(t, map[string]interface{}{"job": update})["infinite"])
+
+	// Finite limits are unaffected
+	limit := uint32(90)
+	body, err = adapter.convertCommonJobCreateToAPI(&types.JobCreate{TimeLimit: &limit})
+	require.NoError(t, err)
+	sent = sentTimeLimit(t, body)
+	assert.Equal(t, float64(90), sent["number"])
+	assert.NotContains(t, sent, "infinite")
+}
+
+func TestPartitionAdapter_TimeLimitUnlimited(t *testing.T) {
+	adapter := NewPartitionAdapter(&api.ClientWithResponses{})
+
+	partition, err := adapter.convertAPIPartitionToCommon(map[string]interface{}{
+		"name":     "long",
+		"defaults": map[string]interface{}{"time": map[string]interface{}{"set": true, "infinite": false, "number": float64(60)}},
+		"maximums": map[string]interface{}{"time": map[string]interface{}{"set": false, "infinite": true, "number": float64(0)}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, partition.Maximums)
+	require.NotNil(t, partition.Maximums.Time)
+	assert.Equal(t, types.TimeLimitUnlimited, *partition.Maximums.Time)
+	assert.Equal(t, "UNLIMITED", types.FormatTimeLimit(partition.Maximums.Time))
+	require.NotNil(t, partition.Defaults)
+	assert.Equal(t, uint32(60), *partition.Defaults.Time)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
 )
 
@@ -147,42 +148,12 @@ func (a *QoSAdapter) convertQoSCreateToAPI(input *types.QoSCreate) (api.SlurmdbV
 	return body, nil
 }
 
-// convertQoSUpdateToAPI converts QoS to the v0.0.41 API request body for updates.
-func (a *QoSAdapter) convertQoSUpdateToAPI(qos *types.QoS) (api.SlurmdbV0041PostQosJSONRequestBody, error) {
-	if qos == nil {
-		return api.SlurmdbV0041PostQosJSONRequestBody{}, nil
-	}
-
-	// Build QoS structure
-	qosMap := make(map[string]interface{})
-	if qos.Name != nil {
-		qosMap["name"] = *qos.Name
-	}
-	if qos.Description != nil {
-		qosMap["description"] = *qos.Description
-	}
-	if qos.Priority != nil {
-		qosMap["priority"] = map[string]interface{}{
-			"set":    true,
-			"number": int32(*qos.Priority),
-		}
-	}
-	if qos.Limits != nil && qos.Limits.GraceTime != nil {
-		qosMap["grace_time"] = *qos.Limits.GraceTime
-	}
-	if qos.Preempt != nil && len(qos.Preempt.Mode) > 0 {
-		modes := make([]string, len(qos.Preempt.Mode))
-		for i, m := range qos.Preempt.Mode {
-			modes[i] = string(m)
-		}
-		qosMap["preempt"] = map[string]interface{}{
-			"mode": modes,
-		}
-	}
-
+// convertQoSUpdateToAPI converts a QoSUpdate to the v0.0.41 API request body,
+// with only the fields set in the update.
+func (a *QoSAdapter) convertQoSUpdateToAPI(name string, update *types.QoSUpdate) (api.SlurmdbV0041PostQosJSONRequestBody, error) {
 	// Build request body
 	bodyMap := map[string]interface{}{
-		"qos": []interface{}{qosMap},
+		"qos": []interface{}{common.QoSUpdateFields(name, update)},
 	}
 
 	// Marshal and unmarshal to API type
@@ -258,18 +229,10 @@ func (a *AssociationAdapter) convertAssociationUpdateToAPI(id string, update *ty
 		return api.SlurmdbV0041PostAssociationsJSONRequestBody{}, nil
 	}
 
-	// Build association structure with ID info
-	assocMap := make(map[string]interface{})
-
-	// Parse ID to get account/user/cluster/partition
-	// ID format: "account:user:cluster[:partition]"
-	// We need these to identify the association to update
+	// Build association structure with the account/user/cluster/partition
+	// that identify the association to update, its QOS and TRES-minute limits
+	assocMap := common.AssociationUpdateFields(update)
 
-	if update.DefaultQoS != nil {
-		assocMap["default"] = map[string]interface{}{
-			"qos": *update.DefaultQoS,
-		}
-	}
 	if update.SharesRaw != nil {
 		assocMap["shares_raw"] = *update.SharesRaw
 	}
@@ -331,10 +294,7 @@ func (a *JobAdapter) convertJobUpdateToAPI(update *types.JobUpdate) (api.SlurmV0
 		}
 	}
 	if update.TimeLimit != nil {
-		jobMap["time_limit"] = map[string]interface{}{
-			"set":    true,
-			"number": int32(*update.TimeLimit),
-		}
+		jobMap["time_limit"] = ConvertTimeLimitToNoVal(*update.TimeLimit)
 	}
 
 	// Boolean fields
@@ -3,7 +3,10 @@
 package v0_0_42
 
 import (
+	"encoding/json"
+
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
 )
 
@@ -176,3 +179,17 @@ func convertAPIAssocMinToCommon(apiMin *struct {
 	}
 	return result
 }
+
+// enhanceAssociationUpdateWithSkippedFields adds the fields the generated
+// converter skips: the account, user, cluster and partition slurmdbd
+// matches the association by, its QOS, and the TRES-minute limits
+func (a *AssociationAdapter) enhanceAssociationUpdateWithSkippedFields(result *api.V0042Assoc, update *types.AssociationUpdate) {
+	if result == nil || update == nil {
+		return
+	}
+	data, err := json.Marshal(common.AssociationUpdateFields(update))
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(data, result)
+}
@@ -61,7 +61,9 @@ func (a *AssociationAdapter) convertCommonAssociationCreateToAPI(input *types.As
 	return associationWriteConverter.ConvertCommonAssociationCreateToAPI(input)
 }
 func (a *AssociationAdapter) convertCommonAssociationUpdateToAPI(input *types.AssociationUpdate) *api.V0042Assoc {
-	return associationWriteConverter.ConvertCommonAssociationUpdateToAPI(input)
+	result := associationWriteConverter.ConvertCommonAssociationUpdateToAPI(input)
+	a.enhanceAssociationUpdateWithSkippedFields(result, input)
+	return result
 }
 
 // =============================================================================
@@ -113,7 +115,9 @@ func (a *QoSAdapter) convertCommonQoSCreateToAPI(input *types.QoSCreate) *api.V0
 	return qosWriteConverter.ConvertCommonQoSCreateToAPI(input)
 }
 func (a *QoSAdapter) convertCommonQoSUpdateToAPI(input *types.QoSUpdate) *api.V0042Qos {
-	return qosWriteConverter.ConvertCommonQoSUpdateToAPI(input)
+	result := qosWriteConverter.ConvertCommonQoSUpdateToAPI(input)
+	a.enhanceQoSUpdateWithSkippedFields(result, input)
+	return result
 }
 
 // =============================================================================
@@ -65,7 +65,7 @@ type JobConverterGoverter interface {
 	// goverter:map NodeCount | ConvertUint32NoVal
 	// goverter:map Priority | ConvertUint32NoVal
 	// goverter:map Tasks | ConvertUint32NoVal
-	// goverter:map TimeLimit | ConvertUint32NoVal
+	// goverter:map TimeLimit | ConvertTimeLimitNoVal
 	// goverter:map TimeMinimum | ConvertUint32NoVal
 	//
 	// NoValNumber Uint16 fields (use ConvertUint16NoVal):
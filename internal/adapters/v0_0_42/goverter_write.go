@@ -141,13 +141,13 @@ type NodeWriteConverterGoverter interface {
 // goverter:converter
 // goverter:output:file write_converters_goverter.gen.go
 // goverter:output:package github.com/jontk/slurm-client/internal/adapters/v0_0_42
-// goverter:extend ConvertStringSliceToQosFlags
+// goverter:extend ConvertQoSFlagSliceToQosFlags
 // goverter:extend ConvertIntToUint32NoValStruct
 // goverter:extend ConvertFloat64ToFloat64NoValStruct
 type QoSWriteConverterGoverter interface {
 	// ConvertCommonQoSCreateToAPI converts common QoSCreate to API V0042Qos type
 	// goverter:map Description Description
-	// goverter:map Flags | ConvertStringSliceToQosFlags
+	// goverter:map Flags | ConvertQoSFlagSliceToQosFlags
 	// goverter:map Name Name
 	// goverter:map Priority | ConvertIntToUint32NoValStruct
 	// goverter:map UsageFactor | ConvertFloat64ToFloat64NoValStruct
@@ -158,7 +158,7 @@ type QoSWriteConverterGoverter interface {
 	ConvertCommonQoSCreateToAPI(source *types.QoSCreate) *api.V0042Qos
 	// ConvertCommonQoSUpdateToAPI converts common QoSUpdate to API V0042Qos type
 	// goverter:map Description Description
-	// goverter:map Flags | ConvertStringSlicePtrToQosFlags
+	// goverter:map Flags | ConvertQoSFlagSlicePtrToQosFlags
 	// goverter:map Priority | ConvertIntPtrToUint32NoValStruct
 	// goverter:map UsageFactor | ConvertFloat64PtrToFloat64NoValStruct
 	// goverter:map UsageThreshold | ConvertFloat64PtrToFloat64NoValStruct
@@ -209,7 +209,7 @@ type JobWriteConverterGoverter interface {
 	// ConvertCommonJobCreateToAPI converts common JobCreate to API V0042JobDescMsg type
 	// Direct field mappings (same name, needs type conversion)
 	// goverter:map BeginTime BeginTime | ConvertUint64PtrToNoValStruct
-	// goverter:map TimeLimit TimeLimit | ConvertUint32PtrToNoValStruct
+	// goverter:map TimeLimit TimeLimit | ConvertTimeLimitToNoValStruct
 	// goverter:map TimeMinimum TimeMinimum | ConvertUint32PtrToNoValStruct
 	// goverter:map Priority Priority | ConvertUint32PtrToNoValStruct
 	// goverter:map RequiredSwitches RequiredSwitches | ConvertUint32PtrToNoValStruct
@@ -388,23 +388,29 @@ func ConvertStringSliceToClusterRecFlags(source []string) *api.V0042ClusterRecFl
 	return &flags
 }
 
-// ConvertStringSliceToQosFlags converts []string to API V0042QosFlags.
+// ConvertQoSFlagSliceToQosFlags converts []QoSFlag to API V0042QosFlags.
 // Used for Flags in QoSCreate.
-func ConvertStringSliceToQosFlags(source []string) *api.V0042QosFlags {
+func ConvertQoSFlagSliceToQosFlags(source []types.QoSFlag) *api.V0042QosFlags {
 	if len(source) == 0 {
 		return nil
 	}
-	flags := api.V0042QosFlags(source)
+	flags := make(api.V0042QosFlags, len(source))
+	for i, f := range source {
+		flags[i] = string(f)
+	}
 	return &flags
 }
 
-// ConvertStringSlicePtrToQosFlags converts *[]string to API V0042QosFlags.
+// ConvertQoSFlagSlicePtrToQosFlags converts *[]QoSFlag to API V0042QosFlags.
 // Used for Flags in QoSUpdate.
-func ConvertStringSlicePtrToQosFlags(source *[]string) *api.V0042QosFlags {
+func ConvertQoSFlagSlicePtrToQosFlags(source *[]types.QoSFlag) *api.V0042QosFlags {
 	if source == nil || len(*source) == 0 {
 		return nil
 	}
-	flags := api.V0042QosFlags(*source)
+	flags := make(api.V0042QosFlags, len(*source))
+	for i, f := range *source {
+		flags[i] = string(f)
+	}
 	return &flags
 }
 
@@ -544,6 +550,18 @@ func ConvertUint64PtrToNoValStruct(source *uint64) *api.V0042Uint64NoValStruct {
 	}
 }
 
+// ConvertTimeLimitToNoValStruct converts a *uint32 time limit to
+// *V0042Uint32NoValStruct, sending types.TimeLimitUnlimited as infinite.
+func ConvertTimeLimitToNoValStruct(source *uint32) *api.V0042Uint32NoValStruct {
+	if source != nil && *source == types.TimeLimitUnlimited {
+		infinite := true
+		return &api.V0042Uint32NoValStruct{
+			Infinite: &infinite,
+		}
+	}
+	return ConvertUint32PtrToNoValStruct(source)
+}
+
 // ConvertUint16PtrToNoValStruct converts *uint16 to *V0042Uint16NoValStruct.
 // Used for fields like DistributionPlaneSize, KillWarningDelay, SegmentSize.
 func ConvertUint16PtrToNoValStruct(source *uint16) *api.V0042Uint16NoValStruct {
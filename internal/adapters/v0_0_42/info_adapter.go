@@ -10,6 +10,7 @@ import (
 
 	types "github.com/jontk/slurm-client/api"
 	adapterbase "github.com/jontk/slurm-client/internal/adapters/base"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
 )
 
@@ -87,28 +88,45 @@ func (a *InfoAdapter) Get(ctx context.Context) (*types.ClusterInfo, error) {
 
 // Ping tests connectivity to the cluster
 func (a *InfoAdapter) Ping(ctx context.Context) error {
+	_, err := a.PingControllers(ctx)
+	return err
+}
+
+// PingControllers pings the cluster and returns the answer of each controller
+func (a *InfoAdapter) PingControllers(ctx context.Context) ([]types.PingResult, error) {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
-		return err
+		return nil, err
 	}
 	// Check client initialization
 	if err := a.CheckClientInitialized(a.client); err != nil {
-		return err
+		return nil, err
 	}
 	// Call the ping endpoint
 	resp, err := a.client.SlurmV0042GetPingWithResponse(ctx)
 	if err != nil {
-		return a.HandleAPIError(err)
+		return nil, a.HandleAPIError(err)
 	}
 	// Check response status
 	if resp.StatusCode() != 200 {
-		return a.HandleAPIError(fmt.Errorf("API error: status %d", resp.StatusCode()))
+		return nil, a.HandleAPIError(fmt.Errorf("API error: status %d", resp.StatusCode()))
 	}
 	// Check for unexpected response format
 	if err := a.CheckNilResponse(resp.JSON200, "Ping Cluster"); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	results := make([]types.PingResult, 0, len(resp.JSON200.Pings))
+	for _, ping := range resp.JSON200.Pings {
+		results = append(results, common.ControllerPing{
+			Hostname:   ping.Hostname,
+			Pinged:     ping.Pinged,
+			Mode:       ping.Mode,
+			Latency:    ping.Latency,
+			Responding: &ping.Responding,
+			Primary:    &ping.Primary,
+		}.Result())
+	}
+	return results, nil
 }
 
 // PingDatabase tests connectivity to the SLURM database
@@ -290,3 +308,48 @@ func (a *InfoAdapter) Version(ctx context.Context) (*types.APIVersion, error) {
 	}
 	return apiVersion, nil
 }
+
+// Versions reads the Slurm version in the meta block of the ping answer,
+// and the data parser plugin that answered. The meta block carries the
+// build of slurmrestd itself, not of slurmctld or slurmdbd.
+func (a *InfoAdapter) Versions(ctx context.Context) (*types.VersionInfo, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+	// The ping endpoint is served through slurmctld
+	resp, err := a.client.SlurmV0042GetPingWithResponse(ctx)
+	if err != nil {
+		return nil, a.HandleAPIError(err)
+	}
+	// Check response status
+	if resp.StatusCode() != 200 {
+		return nil, a.HandleAPIError(fmt.Errorf("API error: status %d", resp.StatusCode()))
+	}
+	// Check for unexpected response format
+	if err := a.CheckNilResponse(resp.JSON200, "Get Versions"); err != nil {
+		return nil, err
+	}
+	info := &types.VersionInfo{ClientVersion: "v0.0.42"}
+	if meta := resp.JSON200.Meta; meta != nil && meta.Plugin != nil && meta.Plugin.DataParser != nil {
+		info.PluginVersion = *meta.Plugin.DataParser
+	}
+	info.RestdVersion = metaSlurmVersion(resp.JSON200.Meta)
+	return info, nil
+}
+
+// metaSlurmVersion returns the Slurm version in a response's meta block
+func metaSlurmVersion(meta *api.V0042OpenapiMeta) string {
+	if meta == nil || meta.Slurm == nil {
+		return ""
+	}
+	var major, minor, micro *string
+	if meta.Slurm.Version != nil {
+		major, minor, micro = meta.Slurm.Version.Major, meta.Slurm.Version.Minor, meta.Slurm.Version.Micro
+	}
+	return common.SlurmVersion(meta.Slurm.Release, major, minor, micro)
+}
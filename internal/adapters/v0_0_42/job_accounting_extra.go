@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_42
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+	adaptercommon "github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/internal/common"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// GetAccounting retrieves the slurmdbd accounting record for a job. Only the
+// fields that slurmctld drops once a job leaves the queue are populated.
+func (a *JobAdapter) GetAccounting(ctx context.Context, jobID int64) (*types.Job, error) {
+	record, err := a.getAccountingRecord(ctx, jobID, "Get Job Accounting")
+	if err != nil {
+		return nil, err
+	}
+	return record.ToJob(), nil
+}
+
+// ListSteps retrieves the steps of a job from its slurmdbd accounting
+// record, which slurmctld keeps up to date while the job runs
+func (a *JobAdapter) ListSteps(ctx context.Context, jobID int64) ([]types.JobStep, error) {
+	record, err := a.getAccountingRecord(ctx, jobID, "List Job Steps")
+	if err != nil {
+		return nil, err
+	}
+	return record.JobSteps(), nil
+}
+
+// GetTRESUsage retrieves the resource usage of a job's steps from its
+// slurmdbd accounting record
+func (a *JobAdapter) GetTRESUsage(ctx context.Context, jobID int64) (*types.TRESUsageReport, error) {
+	record, err := a.getAccountingRecord(ctx, jobID, "Get Job TRES Usage")
+	if err != nil {
+		return nil, err
+	}
+	return record.TRESUsage(), nil
+}
+
+// getAccountingRecord fetches the slurmdbd record of a job
+func (a *JobAdapter) getAccountingRecord(ctx context.Context, jobID int64, operation string) (*adaptercommon.AccountingJob, error) {
+	// Validate context
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.ValidateResourceID(jobID, "jobID"); err != nil {
+		return nil, err
+	}
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+
+	// Call the API
+	resp, err := a.client.SlurmdbV0042GetJobWithResponse(ctx, strconv.FormatInt(jobID, 10))
+	if err != nil {
+		return nil, a.HandleAPIError(err)
+	}
+
+	// Handle response errors
+	var apiErrors *api.V0042OpenapiErrors
+	if resp.JSON200 != nil {
+		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
+	}
+	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
+	if err := common.HandleAPIResponse(responseAdapter, "v0.0.42"); err != nil {
+		return nil, err
+	}
+
+	// Check for nil response
+	if err := a.CheckNilResponse(resp.JSON200, operation); err != nil {
+		return nil, err
+	}
+
+	if len(resp.JSON200.Jobs) == 0 {
+		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+			fmt.Sprintf("Accounting record for job %d not found", jobID))
+	}
+	return adaptercommon.DecodeAccountingJob(resp.JSON200.Jobs[0])
+}
+
+// ListHistory retrieves the slurmdbd records of the jobs eligible or running
+// in the requested period, filtered by partition and QoS
+func (a *JobAdapter) ListHistory(ctx context.Context, opts *types.JobHistoryOptions) ([]types.Job, error) {
+	// Validate context
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+
+	params := &api.SlurmdbV0042GetJobsParams{}
+	if opts != nil {
+		params.StartTime = adaptercommon.UnixTimeParam(opts.Since)
+		params.EndTime = adaptercommon.UnixTimeParam(opts.Until)
+		if opts.Partition != "" {
+			params.Partition = &opts.Partition
+		}
+		if opts.QoS != "" {
+			params.Qos = &opts.QoS
+		}
+	}
+
+	// Call the API
+	resp, err := a.client.SlurmdbV0042GetJobsWithResponse(ctx, params)
+	if err != nil {
+		return nil, a.HandleAPIError(err)
+	}
+
+	// Handle response errors
+	var apiErrors *api.V0042OpenapiErrors
+	if resp.JSON200 != nil {
+		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
+	}
+	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
+	if err := common.HandleAPIResponse(responseAdapter, "v0.0.42"); err != nil {
+		return nil, err
+	}
+
+	// Check for nil response
+	if err := a.CheckNilResponse(resp.JSON200, "List Job History"); err != nil {
+		return nil, err
+	}
+	return adaptercommon.DecodeAccountingJobs(resp.JSON200.Jobs)
+}
@@ -313,7 +313,7 @@ func (c *JobConverterGoverterImpl) ConvertAPIJobToCommon(source v0042.V0042JobIn
 		apiJob.ThreadSpec = &xint3210
 	}
 	apiJob.ThreadsPerCore = ConvertUint16NoVal(source.ThreadsPerCore)
-	apiJob.TimeLimit = ConvertUint32NoVal(source.TimeLimit)
+	apiJob.TimeLimit = ConvertTimeLimitNoVal(source.TimeLimit)
 	apiJob.TimeMinimum = ConvertUint32NoVal(source.TimeMinimum)
 	if source.TresAllocStr != nil {
 		xstring48 := *source.TresAllocStr
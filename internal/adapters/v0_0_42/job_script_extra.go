@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_42
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jontk/slurm-client/internal/common"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// GetScript retrieves the batch script of a job. slurmctld does not return
+// scripts over REST, so this reads the copy slurmdbd keeps when
+// AccountingStoreFlags includes job_script.
+func (a *JobAdapter) GetScript(ctx context.Context, jobID int64) (string, error) {
+	// Validate context
+	if err := a.ValidateContext(ctx); err != nil {
+		return "", err
+	}
+	if err := a.ValidateResourceID(jobID, "jobID"); err != nil {
+		return "", err
+	}
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return "", err
+	}
+
+	// Call the API
+	step := strconv.Itoa(int(jobID))
+	showScript := "true"
+	params := &api.SlurmdbV0042GetJobsParams{
+		Step:            &step,
+		ShowBatchScript: &showScript,
+	}
+	resp, err := a.client.SlurmdbV0042GetJobsWithResponse(ctx, params)
+	if err != nil {
+		return "", a.HandleAPIError(err)
+	}
+
+	// Handle response errors
+	var apiErrors *api.V0042OpenapiErrors
+	if resp.JSON200 != nil {
+		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
+	}
+	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
+	if err := common.HandleAPIResponse(responseAdapter, "v0.0.42"); err != nil {
+		return "", err
+	}
+
+	// Check for nil response
+	if err := a.CheckNilResponse(resp.JSON200, "Get Job Script"); err != nil {
+		return "", err
+	}
+
+	for _, job := range resp.JSON200.Jobs {
+		if job.JobId == nil || *job.JobId != jobID {
+			continue
+		}
+		if job.Script == nil || *job.Script == "" {
+			return "", errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+				fmt.Sprintf("No batch script stored for job %d (requires AccountingStoreFlags=job_script)", jobID))
+		}
+		return *job.Script, nil
+	}
+	return "", errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+		fmt.Sprintf("Accounting record for job %d not found", jobID))
+}
@@ -3,7 +3,10 @@
 package v0_0_42
 
 import (
+	"encoding/json"
+
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
 )
 
@@ -28,6 +31,22 @@ func (a *QoSAdapter) enhanceQoSWithSkippedFields(result *types.QoS, apiObj api.V
 	}
 }
 
+// enhanceQoSUpdateWithSkippedFields adds the update fields the generated
+// converter skips (preemption, grace time, the TRES and other limits), and
+// sends lists set to empty so that they are cleared
+func (a *QoSAdapter) enhanceQoSUpdateWithSkippedFields(result *api.V0042Qos, update *types.QoSUpdate) {
+	if result == nil || update == nil {
+		return
+	}
+	fields := common.QoSUpdateFields("", update)
+	delete(fields, "name")
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(data, result)
+}
+
 // convertAPIQoSLimitsToCommon converts API QoS Limits to common type
 func convertAPIQoSLimitsToCommon(apiLimits *struct {
 	Factor    *api.V0042Float64NoValStruct `json:"factor,omitempty"`
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
 	"github.com/jontk/slurm-client/pkg/errors"
 )
@@ -189,7 +190,7 @@ func (a *StandaloneAdapter) GetDiagnostics(ctx context.Context) (*types.Diagnost
 	// Map statistics fields using helper functions
 	stats := resp.JSON200.Statistics
 	a.setJobCountMetrics(diag, stats)
-	a.setBackfillMetrics(diag, stats)
+	backfillCounters(stats).Apply(diag)
 	a.setScheduleCycleMetrics(diag, stats)
 	a.setAgentThreadMetrics(diag, stats)
 	// RPC statistics
@@ -222,20 +223,34 @@ func (a *StandaloneAdapter) setJobCountMetrics(diag *types.Diagnostics, stats ap
 	}
 }
 
-// setBackfillMetrics sets backfill scheduler metrics
-func (a *StandaloneAdapter) setBackfillMetrics(diag *types.Diagnostics, stats api.V0042StatsMsg) {
-	if stats.BfCycleCounter != nil {
-		diag.BFCycle = int(*stats.BfCycleCounter)
-	}
-	if stats.BfCycleMean != nil {
-		diag.BFCycleMean = *stats.BfCycleMean
-	}
-	if stats.BfCycleMax != nil {
-		diag.BFCycleMax = int64(*stats.BfCycleMax)
-	}
-	if stats.BfCycleLast != nil {
-		diag.BFCycleMean = int64(*stats.BfCycleLast) // Store last in a mean-like field
-	}
+// backfillCounters picks the backfill fields out of the diag statistics
+func backfillCounters(stats api.V0042StatsMsg) common.BackfillCounters {
+	counters := common.BackfillCounters{
+		Active:             stats.BfActive,
+		BackfilledJobs:     stats.BfBackfilledJobs,
+		LastBackfilledJobs: stats.BfLastBackfilledJobs,
+		BackfilledHetJobs:  stats.BfBackfilledHetJobs,
+		CycleCounter:       stats.BfCycleCounter,
+		CycleLast:          stats.BfCycleLast,
+		CycleMax:           stats.BfCycleMax,
+		CycleMean:          stats.BfCycleMean,
+		CycleSum:           stats.BfCycleSum,
+		LastDepth:          stats.BfLastDepth,
+		LastDepthTry:       stats.BfLastDepthTry,
+		DepthMean:          stats.BfDepthMean,
+		DepthMeanTry:       stats.BfDepthMeanTry,
+		DepthSum:           stats.BfDepthSum,
+		QueueLen:           stats.BfQueueLen,
+		QueueLenMean:       stats.BfQueueLenMean,
+		QueueLenSum:        stats.BfQueueLenSum,
+		TableSize:          stats.BfTableSize,
+		TableSizeMean:      stats.BfTableSizeMean,
+		TableSizeSum:       stats.BfTableSizeSum,
+	}
+	if when := stats.BfWhenLastCycle; when != nil && when.Set != nil && *when.Set {
+		counters.WhenLastCycle = when.Number
+	}
+	return counters
 }
 
 // setScheduleCycleMetrics sets schedule cycle metrics
@@ -442,7 +442,7 @@ func (c *JobWriteConverterGoverterImpl) ConvertCommonJobCreateToAPI(source *api.
 			xint3227 := *(*source).ThreadsPerCore
 			v0_0_42V0042JobDescMsg.ThreadsPerCore = &xint3227
 		}
-		v0_0_42V0042JobDescMsg.TimeLimit = ConvertUint32PtrToNoValStruct((*source).TimeLimit)
+		v0_0_42V0042JobDescMsg.TimeLimit = ConvertTimeLimitToNoValStruct((*source).TimeLimit)
 		v0_0_42V0042JobDescMsg.TimeMinimum = ConvertUint32PtrToNoValStruct((*source).TimeMinimum)
 		if (*source).TRESBind != nil {
 			xstring40 := *(*source).TRESBind
@@ -553,7 +553,7 @@ func (c *QoSWriteConverterGoverterImpl) ConvertCommonQoSCreateToAPI(source *api.
 		var v0_0_42V0042Qos v0042.V0042Qos
 		pString := (*source).Description
 		v0_0_42V0042Qos.Description = &pString
-		v0_0_42V0042Qos.Flags = ConvertStringSliceToQosFlags((*source).Flags)
+		v0_0_42V0042Qos.Flags = ConvertQoSFlagSliceToQosFlags((*source).Flags)
 		pString2 := (*source).Name
 		v0_0_42V0042Qos.Name = &pString2
 		v0_0_42V0042Qos.Priority = ConvertIntToUint32NoValStruct((*source).Priority)
@@ -571,7 +571,7 @@ func (c *QoSWriteConverterGoverterImpl) ConvertCommonQoSUpdateToAPI(source *api.
 			xstring := *(*source).Description
 			v0_0_42V0042Qos.Description = &xstring
 		}
-		v0_0_42V0042Qos.Flags = ConvertStringSlicePtrToQosFlags((*source).Flags)
+		v0_0_42V0042Qos.Flags = ConvertQoSFlagSlicePtrToQosFlags((*source).Flags)
 		v0_0_42V0042Qos.Priority = ConvertIntPtrToUint32NoValStruct((*source).Priority)
 		v0_0_42V0042Qos.UsageFactor = ConvertFloat64PtrToFloat64NoValStruct((*source).UsageFactor)
 		v0_0_42V0042Qos.UsageThreshold = ConvertFloat64PtrToFloat64NoValStruct((*source).UsageThreshold)
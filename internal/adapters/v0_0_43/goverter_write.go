@@ -144,13 +144,13 @@ type NodeWriteConverterGoverter interface {
 // goverter:converter
 // goverter:output:file write_converters_goverter.gen.go
 // goverter:output:package github.com/jontk/slurm-client/internal/adapters/v0_0_43
-// goverter:extend ConvertStringSliceToQosFlags
+// goverter:extend ConvertQoSFlagSliceToQosFlags
 // goverter:extend ConvertIntToUint32NoValStruct
 // goverter:extend ConvertFloat64ToFloat64NoValStruct
 type QoSWriteConverterGoverter interface {
 	// ConvertCommonQoSCreateToAPI converts common QoSCreate to API V0043Qos type
 	// goverter:map Description Description
-	// goverter:map Flags | ConvertStringSliceToQosFlags
+	// goverter:map Flags | ConvertQoSFlagSliceToQosFlags
 	// goverter:map Name Name
 	// goverter:map Priority | ConvertIntToUint32NoValStruct
 	// goverter:map UsageFactor | ConvertFloat64ToFloat64NoValStruct
@@ -161,7 +161,7 @@ type QoSWriteConverterGoverter interface {
 	ConvertCommonQoSCreateToAPI(source *types.QoSCreate) *api.V0043Qos
 	// ConvertCommonQoSUpdateToAPI converts common QoSUpdate to API V0043Qos type
 	// goverter:map Description Description
-	// goverter:map Flags | ConvertStringSlicePtrToQosFlags
+	// goverter:map Flags | ConvertQoSFlagSlicePtrToQosFlags
 	// goverter:map Priority | ConvertIntPtrToUint32NoValStruct
 	// goverter:map UsageFactor | ConvertFloat64PtrToFloat64NoValStruct
 	// goverter:map UsageThreshold | ConvertFloat64PtrToFloat64NoValStruct
@@ -212,7 +212,7 @@ type JobWriteConverterGoverter interface {
 	// ConvertCommonJobCreateToAPI converts common JobCreate to API V0043JobDescMsg type
 	// Direct field mappings (same name, needs type conversion)
 	// goverter:map BeginTime BeginTime | ConvertUint64PtrToNoValStruct
-	// goverter:map TimeLimit TimeLimit | ConvertUint32PtrToNoValStruct
+	// goverter:map TimeLimit TimeLimit | ConvertTimeLimitToNoValStruct
 	// goverter:map TimeMinimum TimeMinimum | ConvertUint32PtrToNoValStruct
 	// goverter:map Priority Priority | ConvertUint32PtrToNoValStruct
 	// goverter:map RequiredSwitches RequiredSwitches | ConvertUint32PtrToNoValStruct
@@ -304,7 +304,6 @@ type UserWriteConverterGoverter interface {
 // goverter:extend ConvertUint32PtrToNoValStruct
 // goverter:extend ConvertStringSliceToCsvString
 // goverter:extend ConvertStringSliceToHostlistString
-// goverter:extend ConvertFlagsValueSliceToReservationDescMsgFlags
 // goverter:extend ConvertReservationFlagSliceToReservationDescMsgFlags
 // goverter:extend ConvertStringSliceToJoinedString
 // goverter:extend ConvertStringPtrToHostlistString
@@ -325,7 +324,7 @@ type ReservationWriteConverterGoverter interface {
 	// goverter:map Licenses Licenses | ConvertStringSliceToCsvString
 	// goverter:map NodeList NodeList | ConvertStringSliceToHostlistString
 	// Flags:
-	// goverter:map Flags Flags | ConvertFlagsValueSliceToReservationDescMsgFlags
+	// goverter:map Flags Flags | ConvertReservationFlagSliceToReservationDescMsgFlags
 	// Ignore complex/unsupported fields:
 	// goverter:ignore PurgeCompleted
 	// goverter:ignore Tres
@@ -455,9 +454,9 @@ func ConvertStringSliceToClusterRecFlags(source []string) *[]api.V0043ClusterRec
 	return &flags
 }
 
-// ConvertStringSliceToQosFlags converts []string to *[]V0043QosFlags.
+// ConvertQoSFlagSliceToQosFlags converts []QoSFlag to *[]V0043QosFlags.
 // Used for Flags in QoSCreate.
-func ConvertStringSliceToQosFlags(source []string) *[]api.V0043QosFlags {
+func ConvertQoSFlagSliceToQosFlags(source []types.QoSFlag) *[]api.V0043QosFlags {
 	if len(source) == 0 {
 		return nil
 	}
@@ -468,9 +467,9 @@ func ConvertStringSliceToQosFlags(source []string) *[]api.V0043QosFlags {
 	return &flags
 }
 
-// ConvertStringSlicePtrToQosFlags converts *[]string to *[]V0043QosFlags.
+// ConvertQoSFlagSlicePtrToQosFlags converts *[]QoSFlag to *[]V0043QosFlags.
 // Used for Flags in QoSUpdate.
-func ConvertStringSlicePtrToQosFlags(source *[]string) *[]api.V0043QosFlags {
+func ConvertQoSFlagSlicePtrToQosFlags(source *[]types.QoSFlag) *[]api.V0043QosFlags {
 	if source == nil || len(*source) == 0 {
 		return nil
 	}
@@ -606,6 +605,18 @@ func ConvertUint64PtrToNoValStruct(source *uint64) *api.V0043Uint64NoValStruct {
 	}
 }
 
+// ConvertTimeLimitToNoValStruct converts a *uint32 time limit to
+// *V0043Uint32NoValStruct, sending types.TimeLimitUnlimited as infinite.
+func ConvertTimeLimitToNoValStruct(source *uint32) *api.V0043Uint32NoValStruct {
+	if source != nil && *source == types.TimeLimitUnlimited {
+		infinite := true
+		return &api.V0043Uint32NoValStruct{
+			Infinite: &infinite,
+		}
+	}
+	return ConvertUint32PtrToNoValStruct(source)
+}
+
 // ConvertUint16PtrToNoValStruct converts *uint16 to *V0043Uint16NoValStruct.
 // Used for fields like DistributionPlaneSize, KillWarningDelay, SegmentSize.
 func ConvertUint16PtrToNoValStruct(source *uint16) *api.V0043Uint16NoValStruct {
@@ -805,21 +816,8 @@ func ConvertTimePtrToUint64NoValStruct(source *time.Time) *api.V0043Uint64NoValS
 	}
 }
 
-// ConvertFlagsValueSliceToReservationDescMsgFlags converts []FlagsValue to *[]V0043ReservationDescMsgFlags.
-// Used for Flags in ReservationCreate.
-func ConvertFlagsValueSliceToReservationDescMsgFlags(source []types.FlagsValue) *[]api.V0043ReservationDescMsgFlags {
-	if len(source) == 0 {
-		return nil
-	}
-	result := make([]api.V0043ReservationDescMsgFlags, len(source))
-	for i, f := range source {
-		result[i] = api.V0043ReservationDescMsgFlags(f)
-	}
-	return &result
-}
-
 // ConvertReservationFlagSliceToReservationDescMsgFlags converts []ReservationFlag to *[]V0043ReservationDescMsgFlags.
-// Used for Flags in ReservationUpdate.
+// Used for Flags in ReservationCreate and ReservationUpdate.
 func ConvertReservationFlagSliceToReservationDescMsgFlags(source []types.ReservationFlag) *[]api.V0043ReservationDescMsgFlags {
 	if len(source) == 0 {
 		return nil
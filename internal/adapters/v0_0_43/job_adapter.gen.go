@@ -44,6 +44,10 @@ func (a *JobAdapter) List(ctx context.Context, opts *types.JobListOptions) (*typ
 
 	// Prepare parameters
 	params := &api.SlurmV0043GetJobsParams{}
+	if opts != nil && len(opts.Flags) > 0 {
+		flags := api.SlurmV0043GetJobsParamsFlags(types.JoinQueryFlags(opts.Flags))
+		params.Flags = &flags
+	}
 
 	// Call the API
 	resp, err := a.client.SlurmV0043GetJobsWithResponse(ctx, params)
@@ -120,7 +124,7 @@ func (a *JobAdapter) List(ctx context.Context, opts *types.JobListOptions) (*typ
 }
 
 // Get retrieves a specific job by jobID
-func (a *JobAdapter) Get(ctx context.Context, jobID int32) (*types.Job, error) {
+func (a *JobAdapter) Get(ctx context.Context, jobID int64) (*types.Job, error) {
 	// Validate context
 	if err := a.ValidateContext(ctx); err != nil {
 		return nil, err
@@ -136,7 +140,7 @@ func (a *JobAdapter) Get(ctx context.Context, jobID int32) (*types.Job, error) {
 	params := &api.SlurmV0043GetJobParams{}
 
 	// Call the API
-	resp, err := a.client.SlurmV0043GetJobWithResponse(ctx, strconv.Itoa(int(jobID)), params)
+	resp, err := a.client.SlurmV0043GetJobWithResponse(ctx, strconv.FormatInt(jobID, 10), params)
 	if err != nil {
 		return nil, a.HandleAPIError(err)
 	}
@@ -216,7 +220,7 @@ func (a *JobAdapter) Submit(ctx context.Context, job *types.JobCreate) (*types.J
 }
 
 // Update updates an existing job
-func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobUpdate) error {
+func (a *JobAdapter) Update(ctx context.Context, jobID int64, update *types.JobUpdate) error {
 	// Validate context
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -235,7 +239,7 @@ func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobU
 	reqBody := a.convertCommonJobUpdateToAPIRequestBody(update)
 
 	// Call the API with job ID in path
-	resp, err := a.client.SlurmV0043PostJobWithResponse(ctx, strconv.Itoa(int(jobID)), reqBody)
+	resp, err := a.client.SlurmV0043PostJobWithResponse(ctx, strconv.FormatInt(jobID, 10), reqBody)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -252,7 +256,7 @@ func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobU
 }
 
 // Cancel cancels a job
-func (a *JobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCancelRequest) error {
+func (a *JobAdapter) Cancel(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
 	// Validate context
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -265,7 +269,7 @@ func (a *JobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCan
 	}
 
 	// Call the delete/cancel API
-	resp, err := a.client.SlurmV0043DeleteJobWithResponse(ctx, strconv.Itoa(int(jobID)), nil)
+	resp, err := a.client.SlurmV0043DeleteJobWithResponse(ctx, strconv.FormatInt(jobID, 10), nil)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -303,15 +307,10 @@ func (a *JobAdapter) Notify(ctx context.Context, req *types.JobNotifyRequest) er
 }
 
 // Requeue requeues a job
-func (a *JobAdapter) Requeue(ctx context.Context, jobID int32) error {
+func (a *JobAdapter) Requeue(ctx context.Context, jobID int64) error {
 	return a.requeueJobImpl(ctx, jobID)
 }
 
-// Watch watches for job events
-func (a *JobAdapter) Watch(ctx context.Context, opts *types.JobWatchOptions) (<-chan types.JobWatchEvent, error) {
-	return a.watchJobsImpl(ctx, opts)
-}
-
 // Allocate allocates resources for a job
 func (a *JobAdapter) Allocate(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error) {
 	return a.allocateJobImpl(ctx, req)
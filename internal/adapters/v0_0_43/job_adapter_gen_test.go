@@ -149,7 +149,7 @@ func TestJobAdapter_ReadConverter(t *testing.T) {
 	adapter := NewJobAdapter(&api.ClientWithResponses{})
 
 	// Create test API object with known values
-	testID := int32(12345)
+	testID := int64(12345)
 	testName := "test-job"
 	testUserName := "testuser"
 	apiObj := api.V0043JobInfo{
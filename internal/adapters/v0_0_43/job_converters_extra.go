@@ -91,10 +91,16 @@ func (a *JobAdapter) convertCommonJobCreateToAPI(input *types.JobCreate) api.Slu
 	if input.StandardInput != nil {
 		jobDesc.StandardInput = input.StandardInput
 	}
+	jobDesc.OpenMode = ConvertOpenModeSliceToAPIV43(input.OpenMode)
 	// Working directory
 	if input.CurrentWorkingDirectory != nil {
 		jobDesc.CurrentWorkingDirectory = input.CurrentWorkingDirectory
 	}
+	// Node sharing and generic resources
+	jobDesc.Shared = ConvertSharedSliceToAPIV43(input.Shared)
+	if input.TRESPerNode != nil {
+		jobDesc.TresPerNode = input.TRESPerNode
+	}
 	// Constraints and dependencies
 	if input.Constraints != nil {
 		jobDesc.Constraints = input.Constraints
@@ -110,6 +116,15 @@ func (a *JobAdapter) convertCommonJobCreateToAPI(input *types.JobCreate) api.Slu
 	if input.Hold != nil {
 		jobDesc.Hold = input.Hold
 	}
+	// Scheduling priority adjustment and node reboot
+	if input.Nice != nil {
+		jobDesc.Nice = input.Nice
+	}
+	if input.Reboot != nil {
+		jobDesc.Reboot = input.Reboot
+	}
+	// Job flags, such as GRES binding
+	jobDesc.Flags = ConvertFlagsSliceToAPIV43(input.Flags)
 	// Reservation
 	if input.Reservation != nil {
 		jobDesc.Reservation = input.Reservation
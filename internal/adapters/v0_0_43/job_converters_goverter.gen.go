@@ -333,7 +333,7 @@ func (c *JobConverterGoverterImpl) ConvertAPIJobToCommon(source v0043.V0043JobIn
 		apiJob.ThreadSpec = &xint3211
 	}
 	apiJob.ThreadsPerCore = ConvertUint16NoVal(source.ThreadsPerCore)
-	apiJob.TimeLimit = ConvertUint32NoVal(source.TimeLimit)
+	apiJob.TimeLimit = ConvertTimeLimitNoVal(source.TimeLimit)
 	apiJob.TimeMinimum = ConvertUint32NoVal(source.TimeMinimum)
 	if source.TresAllocStr != nil {
 		xstring52 := *source.TresAllocStr
@@ -46,7 +46,7 @@ func (a *JobAdapter) holdJobImpl(ctx context.Context, req *types.JobHoldRequest)
 	}
 
 	// Call the API to update the job
-	resp, err := a.client.SlurmV0043PostJobWithResponse(ctx, strconv.Itoa(int(req.JobId)), updateReq)
+	resp, err := a.client.SlurmV0043PostJobWithResponse(ctx, strconv.FormatInt(req.JobId, 10), updateReq)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -92,9 +92,13 @@ func (a *JobAdapter) signalJobImpl(ctx context.Context, req *types.JobSignalRequ
 	params := &api.SlurmV0043DeleteJobParams{
 		Signal: &req.Signal,
 	}
+	if req.BatchOnly {
+		flags := api.SlurmV0043DeleteJobParamsFlags("BATCH_JOB")
+		params.Flags = &flags
+	}
 
 	// Call the API to signal the job
-	resp, err := a.client.SlurmV0043DeleteJobWithResponse(ctx, strconv.Itoa(int(req.JobId)), params)
+	resp, err := a.client.SlurmV0043DeleteJobWithResponse(ctx, strconv.FormatInt(req.JobId, 10), params)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -140,7 +144,7 @@ func (a *JobAdapter) notifyJobImpl(ctx context.Context, req *types.JobNotifyRequ
 }
 
 // requeueJobImpl implements the Requeue method
-func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int32) error {
+func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int64) error {
 	// Validate context
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -163,7 +167,7 @@ func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int32) error {
 	}
 
 	// Call the API to requeue the job
-	resp, err := a.client.SlurmV0043DeleteJobWithResponse(ctx, strconv.Itoa(int(jobID)), params)
+	resp, err := a.client.SlurmV0043DeleteJobWithResponse(ctx, strconv.FormatInt(jobID, 10), params)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -183,9 +187,6 @@ func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int32) error {
 	return nil
 }
 
-// NOTE: watchJobsImpl is intentionally NOT generated here.
-// The real implementation is in job_watch_extra.go which uses polling.
-
 // allocateJobImpl implements the Allocate method
 func (a *JobAdapter) allocateJobImpl(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error) {
 	// Validate context
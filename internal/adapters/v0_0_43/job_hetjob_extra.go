@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_43
+
+import (
+	"context"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/common"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_43"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// SubmitHetJob submits a heterogeneous job, sending one job description per
+// component in the jobs array of the submit request. The batch script is
+// taken from the first component.
+func (a *JobAdapter) SubmitHetJob(ctx context.Context, components []*types.JobCreate) (*types.JobSubmitResponse, error) {
+	// Validate context
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if len(components) < 2 {
+		return nil, errors.NewValidationError(errors.ErrorCodeValidationFailed,
+			"a heterogeneous job needs at least two components", "components", len(components), nil)
+	}
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+
+	// Convert to API type
+	jobs := make(api.V0043JobDescMsgList, 0, len(components))
+	for _, component := range components {
+		if component == nil {
+			return nil, errors.NewValidationError(errors.ErrorCodeValidationFailed,
+				"heterogeneous job component is required", "components", nil, nil)
+		}
+		jobs = append(jobs, *a.convertCommonJobCreateToAPI(component).Job)
+	}
+	reqBody := api.SlurmV0043PostJobSubmitJSONRequestBody{
+		Jobs:   &jobs,
+		Script: jobs[0].Script,
+	}
+
+	// Call the API
+	resp, err := a.client.SlurmV0043PostJobSubmitWithResponse(ctx, reqBody)
+	if err != nil {
+		return nil, a.HandleAPIError(err)
+	}
+
+	// Handle response errors
+	var apiErrors *api.V0043OpenapiErrors
+	if resp.JSON200 != nil {
+		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
+	}
+	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
+	if err := common.HandleAPIResponse(responseAdapter, "v0.0.43"); err != nil {
+		return nil, err
+	}
+
+	// Extract the het job ID, which is that of the first component
+	return a.convertAPIJobSubmitResponseToCommon(resp.JSON200), nil
+}
@@ -104,9 +104,6 @@ func (a *NodeAdapter) resumeNodeImpl(ctx context.Context, nodeName string) error
 	return nil
 }
 
-// NOTE: watchNodesImpl is intentionally NOT generated here.
-// The real implementation is in node_watch_extra.go which uses polling.
-
 // convertCommonNodeUpdateToAPIRequestBody converts NodeUpdate to the API request body type
 func (a *NodeAdapter) convertCommonNodeUpdateToAPIRequestBody(update *types.NodeUpdate) api.SlurmV0043PostNodeJSONRequestBody {
 	if update == nil {
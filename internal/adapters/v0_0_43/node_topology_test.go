@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_43
+
+import (
+	"testing"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_43"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeAdapter_ConvertTopology(t *testing.T) {
+	adapter := NewNodeAdapter(&api.ClientWithResponses{})
+
+	name := "cpu01"
+	boards, sockets, cores, threads := int32(1), int32(2), int32(16), int32(2)
+	node := adapter.convertAPINodeToCommon(api.V0043Node{
+		Name:    &name,
+		Boards:  &boards,
+		Sockets: &sockets,
+		Cores:   &cores,
+		Threads: &threads,
+	})
+	require.NotNil(t, node)
+
+	assert.Equal(t, int32(2), *node.Sockets)
+	assert.Equal(t, 16, node.CoresPerSocket())
+	assert.Equal(t, 2, node.ThreadsPerCore())
+	assert.Equal(t, 64, node.TotalThreads())
+
+	// A node that has not registered reports no topology
+	assert.Zero(t, adapter.convertAPINodeToCommon(api.V0043Node{Name: &name}).TotalThreads())
+}
@@ -43,6 +43,10 @@ func (a *PartitionAdapter) List(ctx context.Context, opts *types.PartitionListOp
 
 	// Prepare parameters
 	params := &api.SlurmV0043GetPartitionsParams{}
+	if opts != nil && len(opts.Flags) > 0 {
+		flags := api.SlurmV0043GetPartitionsParamsFlags(types.JoinQueryFlags(opts.Flags))
+		params.Flags = &flags
+	}
 
 	// Call the API
 	resp, err := a.client.SlurmV0043GetPartitionsWithResponse(ctx, params)
@@ -442,7 +442,7 @@ func (c *JobWriteConverterGoverterImpl) ConvertCommonJobCreateToAPI(source *api.
 			xint3227 := *(*source).ThreadsPerCore
 			v0_0_43V0043JobDescMsg.ThreadsPerCore = &xint3227
 		}
-		v0_0_43V0043JobDescMsg.TimeLimit = ConvertUint32PtrToNoValStruct((*source).TimeLimit)
+		v0_0_43V0043JobDescMsg.TimeLimit = ConvertTimeLimitToNoValStruct((*source).TimeLimit)
 		v0_0_43V0043JobDescMsg.TimeMinimum = ConvertUint32PtrToNoValStruct((*source).TimeMinimum)
 		if (*source).TRESBind != nil {
 			xstring40 := *(*source).TRESBind
@@ -557,7 +557,7 @@ func (c *QoSWriteConverterGoverterImpl) ConvertCommonQoSCreateToAPI(source *api.
 		var v0_0_43V0043Qos v0043.V0043Qos
 		pString := (*source).Description
 		v0_0_43V0043Qos.Description = &pString
-		v0_0_43V0043Qos.Flags = ConvertStringSliceToQosFlags((*source).Flags)
+		v0_0_43V0043Qos.Flags = ConvertQoSFlagSliceToQosFlags((*source).Flags)
 		pString2 := (*source).Name
 		v0_0_43V0043Qos.Name = &pString2
 		v0_0_43V0043Qos.Priority = ConvertIntToUint32NoValStruct((*source).Priority)
@@ -575,7 +575,7 @@ func (c *QoSWriteConverterGoverterImpl) ConvertCommonQoSUpdateToAPI(source *api.
 			xstring := *(*source).Description
 			v0_0_43V0043Qos.Description = &xstring
 		}
-		v0_0_43V0043Qos.Flags = ConvertStringSlicePtrToQosFlags((*source).Flags)
+		v0_0_43V0043Qos.Flags = ConvertQoSFlagSlicePtrToQosFlags((*source).Flags)
 		v0_0_43V0043Qos.Priority = ConvertIntPtrToUint32NoValStruct((*source).Priority)
 		v0_0_43V0043Qos.UsageFactor = ConvertFloat64PtrToFloat64NoValStruct((*source).UsageFactor)
 		v0_0_43V0043Qos.UsageThreshold = ConvertFloat64PtrToFloat64NoValStruct((*source).UsageThreshold)
@@ -606,7 +606,7 @@ func (c *ReservationWriteConverterGoverterImpl) ConvertCommonReservationCreateTo
 			xstring3 := *(*source).Features
 			v0_0_43V0043ReservationDescMsg.Features = &xstring3
 		}
-		v0_0_43V0043ReservationDescMsg.Flags = ConvertFlagsValueSliceToReservationDescMsgFlags((*source).Flags)
+		v0_0_43V0043ReservationDescMsg.Flags = ConvertReservationFlagSliceToReservationDescMsgFlags((*source).Flags)
 		v0_0_43V0043ReservationDescMsg.Groups = ConvertStringSliceToCsvString((*source).Groups)
 		v0_0_43V0043ReservationDescMsg.Licenses = ConvertStringSliceToCsvString((*source).Licenses)
 		v0_0_43V0043ReservationDescMsg.MaxStartDelay = ConvertUint32PtrToNoValStruct((*source).MaxStartDelay)
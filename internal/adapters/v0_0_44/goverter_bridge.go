@@ -54,7 +54,9 @@ func (a *AssociationAdapter) convertCommonAssociationCreateToAPI(input *types.As
 	return associationWriteConverter.ConvertCommonAssociationCreateToAPI(input)
 }
 func (a *AssociationAdapter) convertCommonAssociationUpdateToAPI(input *types.AssociationUpdate) *api.V0044Assoc {
-	return associationWriteConverter.ConvertCommonAssociationUpdateToAPI(input)
+	result := associationWriteConverter.ConvertCommonAssociationUpdateToAPI(input)
+	a.enhanceAssociationUpdateWithSkippedFields(result, input)
+	return result
 }
 func (a *ClusterAdapter) convertAPIClusterToCommon(apiObj api.V0044ClusterRec) *types.Cluster {
 	return clusterConverter.ConvertAPIClusterToCommon(apiObj)
@@ -94,7 +96,9 @@ func (a *QoSAdapter) convertCommonQoSCreateToAPI(input *types.QoSCreate) *api.V0
 	return qosWriteConverter.ConvertCommonQoSCreateToAPI(input)
 }
 func (a *QoSAdapter) convertCommonQoSUpdateToAPI(input *types.QoSUpdate) *api.V0044Qos {
-	return qosWriteConverter.ConvertCommonQoSUpdateToAPI(input)
+	result := qosWriteConverter.ConvertCommonQoSUpdateToAPI(input)
+	a.enhanceQoSUpdateWithSkippedFields(result, input)
+	return result
 }
 func (a *ReservationAdapter) convertAPIReservationToCommon(apiObj api.V0044ReservationInfo) *types.Reservation {
 	return reservationConverter.ConvertAPIReservationToCommon(apiObj)
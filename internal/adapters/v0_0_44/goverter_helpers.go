@@ -104,6 +104,17 @@ func ConvertUint32NoVal(source *api.V0044Uint32NoValStruct) *uint32 {
 	return &val
 }
 
+// ConvertTimeLimitNoVal converts a V0044Uint32NoValStruct holding a time
+// limit to *uint32, mapping an infinite limit to types.TimeLimitUnlimited
+// instead of dropping it.
+func ConvertTimeLimitNoVal(source *api.V0044Uint32NoValStruct) *uint32 {
+	if source != nil && source.Infinite != nil && *source.Infinite {
+		unlimited := types.TimeLimitUnlimited
+		return &unlimited
+	}
+	return ConvertUint32NoVal(source)
+}
+
 // ConvertUint16NoVal converts a V0044Uint16NoValStruct to *uint16.
 // Returns nil if source is nil or Set is false.
 func ConvertUint16NoVal(source *api.V0044Uint16NoValStruct) *uint16 {
@@ -798,7 +809,7 @@ func ConvertPartitionDefaults(source *struct {
 		result.PartitionMemoryPerNode = ConvertUint64NoVal(source.PartitionMemoryPerNode)
 	}
 	if source.Time != nil {
-		result.Time = ConvertUint32NoVal(source.Time)
+		result.Time = ConvertTimeLimitNoVal(source.Time)
 	}
 	return result
 }
@@ -850,7 +861,12 @@ func ConvertPartitionMaximums(source *struct {
 		result.Nodes = ConvertUint32NoVal(source.Nodes)
 	}
 	if source.OverTimeLimit != nil {
-		result.OverTimeLimit = ConvertUint16NoVal(source.OverTimeLimit)
+		if source.OverTimeLimit.Infinite != nil && *source.OverTimeLimit.Infinite {
+			v := types.OverTimeLimitUnlimited
+			result.OverTimeLimit = &v
+		} else {
+			result.OverTimeLimit = ConvertUint16NoVal(source.OverTimeLimit)
+		}
 	}
 	if source.Oversubscribe != nil {
 		result.Oversubscribe = &types.PartitionMaximumsOversubscribe{
@@ -871,7 +887,7 @@ func ConvertPartitionMaximums(source *struct {
 		result.PartitionMemoryPerNode = ConvertUint64NoVal(source.PartitionMemoryPerNode)
 	}
 	if source.Time != nil {
-		result.Time = ConvertUint32NoVal(source.Time)
+		result.Time = ConvertTimeLimitNoVal(source.Time)
 	}
 	return result
 }
@@ -144,13 +144,13 @@ type NodeWriteConverterGoverter interface {
 // goverter:converter
 // goverter:output:file write_converters_goverter.gen.go
 // goverter:output:package github.com/jontk/slurm-client/internal/adapters/v0_0_44
-// goverter:extend ConvertStringSliceToQosFlags
+// goverter:extend ConvertQoSFlagSliceToQosFlags
 // goverter:extend ConvertIntToUint32NoValStruct
 // goverter:extend ConvertFloat64ToFloat64NoValStruct
 type QoSWriteConverterGoverter interface {
 	// ConvertCommonQoSCreateToAPI converts common QoSCreate to API V0044Qos type
 	// goverter:map Description Description
-	// goverter:map Flags | ConvertStringSliceToQosFlags
+	// goverter:map Flags | ConvertQoSFlagSliceToQosFlags
 	// goverter:map Name Name
 	// goverter:map Priority | ConvertIntToUint32NoValStruct
 	// goverter:map UsageFactor | ConvertFloat64ToFloat64NoValStruct
@@ -161,7 +161,7 @@ type QoSWriteConverterGoverter interface {
 	ConvertCommonQoSCreateToAPI(source *types.QoSCreate) *api.V0044Qos
 	// ConvertCommonQoSUpdateToAPI converts common QoSUpdate to API V0044Qos type
 	// goverter:map Description Description
-	// goverter:map Flags | ConvertStringSlicePtrToQosFlags
+	// goverter:map Flags | ConvertQoSFlagSlicePtrToQosFlags
 	// goverter:map Priority | ConvertIntPtrToUint32NoValStruct
 	// goverter:map UsageFactor | ConvertFloat64PtrToFloat64NoValStruct
 	// goverter:map UsageThreshold | ConvertFloat64PtrToFloat64NoValStruct
@@ -212,7 +212,7 @@ type JobWriteConverterGoverter interface {
 	// ConvertCommonJobCreateToAPI converts common JobCreate to API V0044JobDescMsg type
 	// Direct field mappings (same name, needs type conversion)
 	// goverter:map BeginTime BeginTime | ConvertUint64PtrToNoValStruct
-	// goverter:map TimeLimit TimeLimit | ConvertUint32PtrToNoValStruct
+	// goverter:map TimeLimit TimeLimit | ConvertTimeLimitToNoValStruct
 	// goverter:map TimeMinimum TimeMinimum | ConvertUint32PtrToNoValStruct
 	// goverter:map Priority Priority | ConvertUint32PtrToNoValStruct
 	// goverter:map RequiredSwitches RequiredSwitches | ConvertUint32PtrToNoValStruct
@@ -306,7 +306,6 @@ type UserWriteConverterGoverter interface {
 // goverter:extend ConvertUint32PtrToNoValStruct
 // goverter:extend ConvertStringSliceToCsvString
 // goverter:extend ConvertStringSliceToHostlistString
-// goverter:extend ConvertFlagsValueSliceToReservationDescMsgFlags
 // goverter:extend ConvertReservationFlagSliceToReservationDescMsgFlags
 // goverter:extend ConvertStringSliceToJoinedString
 // goverter:extend ConvertStringPtrToHostlistString
@@ -327,7 +326,7 @@ type ReservationWriteConverterGoverter interface {
 	// goverter:map Licenses Licenses | ConvertStringSliceToCsvString
 	// goverter:map NodeList NodeList | ConvertStringSliceToHostlistString
 	// Flags:
-	// goverter:map Flags Flags | ConvertFlagsValueSliceToReservationDescMsgFlags
+	// goverter:map Flags Flags | ConvertReservationFlagSliceToReservationDescMsgFlags
 	// Ignore complex/unsupported fields:
 	// goverter:ignore PurgeCompleted
 	// goverter:ignore Tres
@@ -457,9 +456,9 @@ func ConvertStringSliceToClusterRecFlags(source []string) *[]api.V0044ClusterRec
 	return &flags
 }
 
-// ConvertStringSliceToQosFlags converts []string to *[]V0044QosFlags.
+// ConvertQoSFlagSliceToQosFlags converts []QoSFlag to *[]V0044QosFlags.
 // Used for Flags in QoSCreate.
-func ConvertStringSliceToQosFlags(source []string) *[]api.V0044QosFlags {
+func ConvertQoSFlagSliceToQosFlags(source []types.QoSFlag) *[]api.V0044QosFlags {
 	if len(source) == 0 {
 		return nil
 	}
@@ -470,9 +469,9 @@ func ConvertStringSliceToQosFlags(source []string) *[]api.V0044QosFlags {
 	return &flags
 }
 
-// ConvertStringSlicePtrToQosFlags converts *[]string to *[]V0044QosFlags.
+// ConvertQoSFlagSlicePtrToQosFlags converts *[]QoSFlag to *[]V0044QosFlags.
 // Used for Flags in QoSUpdate.
-func ConvertStringSlicePtrToQosFlags(source *[]string) *[]api.V0044QosFlags {
+func ConvertQoSFlagSlicePtrToQosFlags(source *[]types.QoSFlag) *[]api.V0044QosFlags {
 	if source == nil || len(*source) == 0 {
 		return nil
 	}
@@ -579,6 +578,18 @@ func ConvertUint32PtrToNoValStruct(source *uint32) *api.V0044Uint32NoValStruct {
 	}
 }
 
+// ConvertTimeLimitToNoValStruct converts a *uint32 time limit to
+// *V0044Uint32NoValStruct, sending types.TimeLimitUnlimited as infinite.
+func ConvertTimeLimitToNoValStruct(source *uint32) *api.V0044Uint32NoValStruct {
+	if source != nil && *source == types.TimeLimitUnlimited {
+		infinite := true
+		return &api.V0044Uint32NoValStruct{
+			Infinite: &infinite,
+		}
+	}
+	return ConvertUint32PtrToNoValStruct(source)
+}
+
 // ConvertUint16PtrToNoValStruct converts *uint16 to *V0044Uint16NoValStruct.
 // Used for fields like DistributionPlaneSize, KillWarningDelay, SegmentSize.
 func ConvertUint16PtrToNoValStruct(source *uint16) *api.V0044Uint16NoValStruct {
@@ -813,21 +824,8 @@ func ConvertTimePtrToUint64NoValStruct(source *time.Time) *api.V0044Uint64NoValS
 	}
 }
 
-// ConvertFlagsValueSliceToReservationDescMsgFlags converts []FlagsValue to *[]V0044ReservationDescMsgFlags.
-// Used for Flags in ReservationCreate.
-func ConvertFlagsValueSliceToReservationDescMsgFlags(source []types.FlagsValue) *[]api.V0044ReservationDescMsgFlags {
-	if len(source) == 0 {
-		return nil
-	}
-	result := make([]api.V0044ReservationDescMsgFlags, len(source))
-	for i, f := range source {
-		result[i] = api.V0044ReservationDescMsgFlags(f)
-	}
-	return &result
-}
-
 // ConvertReservationFlagSliceToReservationDescMsgFlags converts []ReservationFlag to *[]V0044ReservationDescMsgFlags.
-// Used for Flags in ReservationUpdate.
+// Used for Flags in ReservationCreate and ReservationUpdate.
 func ConvertReservationFlagSliceToReservationDescMsgFlags(source []types.ReservationFlag) *[]api.V0044ReservationDescMsgFlags {
 	if len(source) == 0 {
 		return nil
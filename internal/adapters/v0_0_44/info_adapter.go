@@ -10,6 +10,7 @@ import (
 
 	types "github.com/jontk/slurm-client/api"
 	adapterbase "github.com/jontk/slurm-client/internal/adapters/base"
+	adaptercommon "github.com/jontk/slurm-client/internal/adapters/common"
 	"github.com/jontk/slurm-client/internal/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
 )
@@ -104,18 +105,24 @@ func (a *InfoAdapter) addDiagnosticInfo(ctx context.Context, clusterInfo *types.
 
 // Ping tests connectivity to the cluster
 func (a *InfoAdapter) Ping(ctx context.Context) error {
+	_, err := a.PingControllers(ctx)
+	return err
+}
+
+// PingControllers pings the cluster and returns the answer of each controller
+func (a *InfoAdapter) PingControllers(ctx context.Context) ([]types.PingResult, error) {
 	// Use base validation
 	if err := a.ValidateContext(ctx); err != nil {
-		return err
+		return nil, err
 	}
 	// Check client initialization
 	if err := a.CheckClientInitialized(a.client); err != nil {
-		return err
+		return nil, err
 	}
 	// Call the ping endpoint
 	resp, err := a.client.SlurmV0044GetPingWithResponse(ctx)
 	if err != nil {
-		return a.HandleAPIError(err)
+		return nil, a.HandleAPIError(err)
 	}
 	// Use common response error handling
 	var apiErrors *api.V0044OpenapiErrors
@@ -124,13 +131,24 @@ func (a *InfoAdapter) Ping(ctx context.Context) error {
 	}
 	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
 	if err := common.HandleAPIResponse(responseAdapter, "v0.0.44"); err != nil {
-		return err
+		return nil, err
 	}
 	// Check for unexpected response format
 	if err := a.CheckNilResponse(resp.JSON200, "Ping Cluster"); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	results := make([]types.PingResult, 0, len(resp.JSON200.Pings))
+	for _, ping := range resp.JSON200.Pings {
+		results = append(results, adaptercommon.ControllerPing{
+			Hostname:   ping.Hostname,
+			Pinged:     ping.Pinged,
+			Mode:       ping.Mode,
+			Latency:    ping.Latency,
+			Responding: &ping.Responding,
+			Primary:    &ping.Primary,
+		}.Result())
+	}
+	return results, nil
 }
 
 // PingDatabase tests connectivity to the SLURM database
@@ -323,3 +341,53 @@ func (a *InfoAdapter) Version(ctx context.Context) (*types.APIVersion, error) {
 	}
 	return apiVersion, nil
 }
+
+// Versions reads the Slurm version in the meta block of the ping answer,
+// and the data parser plugin that answered. The meta block carries the
+// build of slurmrestd itself, not of slurmctld or slurmdbd.
+func (a *InfoAdapter) Versions(ctx context.Context) (*types.VersionInfo, error) {
+	// Use base validation
+	if err := a.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	// Check client initialization
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return nil, err
+	}
+	// The ping endpoint is served through slurmctld
+	resp, err := a.client.SlurmV0044GetPingWithResponse(ctx)
+	if err != nil {
+		return nil, a.HandleAPIError(err)
+	}
+	// Use common response error handling
+	var apiErrors *api.V0044OpenapiErrors
+	if resp.JSON200 != nil {
+		apiErrors = resp.JSON200.Errors
+	}
+	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
+	if err := common.HandleAPIResponse(responseAdapter, "v0.0.44"); err != nil {
+		return nil, err
+	}
+	// Check for unexpected response format
+	if err := a.CheckNilResponse(resp.JSON200, "Get Versions"); err != nil {
+		return nil, err
+	}
+	info := &types.VersionInfo{ClientVersion: "v0.0.44"}
+	if meta := resp.JSON200.Meta; meta != nil && meta.Plugin != nil && meta.Plugin.DataParser != nil {
+		info.PluginVersion = *meta.Plugin.DataParser
+	}
+	info.RestdVersion = metaSlurmVersion(resp.JSON200.Meta)
+	return info, nil
+}
+
+// metaSlurmVersion returns the Slurm version in a response's meta block
+func metaSlurmVersion(meta *api.V0044OpenapiMeta) string {
+	if meta == nil || meta.Slurm == nil {
+		return ""
+	}
+	var major, minor, micro *string
+	if meta.Slurm.Version != nil {
+		major, minor, micro = meta.Slurm.Version.Major, meta.Slurm.Version.Minor, meta.Slurm.Version.Micro
+	}
+	return adaptercommon.SlurmVersion(meta.Slurm.Release, major, minor, micro)
+}
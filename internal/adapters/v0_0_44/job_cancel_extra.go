@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_44
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jontk/slurm-client/internal/common"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// CancelIfPending cancels a job only while it is pending and reports whether
+// it was cancelled. The state filter is applied by slurmctld as it signals
+// the job, so a job that has just started running is left alone. With the
+// VERBOSE flag slurmctld reports every job it signalled; a job the filter
+// excluded gets no entry.
+func (a *JobAdapter) CancelIfPending(ctx context.Context, jobID int64) (bool, error) {
+	// Validate context
+	if err := a.ValidateContext(ctx); err != nil {
+		return false, err
+	}
+	if err := a.ValidateResourceID(jobID, "jobID"); err != nil {
+		return false, err
+	}
+	if err := a.CheckClientInitialized(a.client); err != nil {
+		return false, err
+	}
+
+	// Call the API
+	id := strconv.FormatInt(jobID, 10)
+	body := api.V0044KillJobsMsg{
+		Jobs:     &api.V0044KillJobsMsgJobsArray{id},
+		JobState: &[]api.V0044KillJobsMsgJobState{api.V0044KillJobsMsgJobStatePENDING},
+		Flags:    &[]api.V0044KillJobsMsgFlags{api.V0044KillJobsMsgFlagsVERBOSE},
+	}
+	resp, err := a.client.SlurmV0044DeleteJobsWithResponse(ctx, body)
+	if err != nil {
+		return false, a.HandleAPIError(err)
+	}
+
+	// Handle response errors
+	var apiErrors *api.V0044OpenapiErrors
+	if resp.JSON200 != nil {
+		apiErrors = resp.JSON200.Errors
+	} else if resp.JSONDefault != nil {
+		apiErrors = resp.JSONDefault.Errors
+	}
+	responseAdapter := api.NewResponseAdapter(resp.StatusCode(), apiErrors)
+	if err := common.HandleAPIResponse(responseAdapter, "v0.0.44"); err != nil {
+		return false, err
+	}
+
+	// Check for nil response
+	if err := a.CheckNilResponse(resp.JSON200, "Cancel Pending Job"); err != nil {
+		return false, err
+	}
+
+	for _, status := range resp.JSON200.Status {
+		if status.StepId != id && (status.JobId.Number == nil || int64(*status.JobId.Number) != jobID) {
+			continue
+		}
+		if status.Error != nil && status.Error.Code != nil && *status.Error.Code != 0 {
+			message := ""
+			if status.Error.Message != nil {
+				message = *status.Error.Message
+			}
+			return false, errors.NewSlurmError(errors.ErrorCodeServerInternal,
+				fmt.Sprintf("Failed to cancel job %d: %s", jobID, message))
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -202,7 +202,7 @@ func TestJobAdapter_Requeue_Validation(t *testing.T) {
 	tests := []struct {
 		name    string
 		ctx     context.Context
-		jobID   int32
+		jobID   int64
 		wantErr bool
 		errCode errors.ErrorCode
 	}{
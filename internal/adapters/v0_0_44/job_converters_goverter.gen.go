@@ -338,7 +338,7 @@ func (c *JobConverterGoverterImpl) ConvertAPIJobToCommon(source v0044.V0044JobIn
 		apiJob.ThreadSpec = &xint3211
 	}
 	apiJob.ThreadsPerCore = ConvertUint16NoVal(source.ThreadsPerCore)
-	apiJob.TimeLimit = ConvertUint32NoVal(source.TimeLimit)
+	apiJob.TimeLimit = ConvertTimeLimitNoVal(source.TimeLimit)
 	apiJob.TimeMinimum = ConvertUint32NoVal(source.TimeMinimum)
 	if source.TresAllocStr != nil {
 		xstring53 := *source.TresAllocStr
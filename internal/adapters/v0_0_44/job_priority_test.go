@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package v0_0_44
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobAdapter_ConvertPriorityComponents(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+
+	var apiJob api.V0044JobInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"job_id": 7,
+		"priority": {"set": true, "infinite": false, "number": 4200},
+		"nice": 100,
+		"priority_by_partition": [
+			{"partition": "gpu", "priority": 4200},
+			{"partition": "preempt", "priority": 1050}
+		]
+	}`), &apiJob))
+
+	components := adapter.convertAPIJobToCommon(apiJob).PriorityComponents()
+	require.NotNil(t, components)
+	assert.Equal(t, uint32(4200), components.Priority)
+	assert.Equal(t, int32(100), components.Nice)
+	assert.Equal(t, map[string]int32{"gpu": 4200, "preempt": 1050}, components.ByPartition)
+
+	assert.Nil(t, adapter.convertAPIJobToCommon(api.V0044JobInfo{}).PriorityComponents())
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_44
+
+import (
+	"testing"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobAdapter_ConvertResources(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+	requested := "cpu=16,mem=64G,node=2,billing=16,gres/gpu=4"
+	allocated := "cpu=16,mem=64G,node=2,billing=16,gres/gpu=4,gres/gpu:a100=4"
+
+	job := adapter.convertAPIJobToCommon(api.V0044JobInfo{
+		TresReqStr:   &requested,
+		TresAllocStr: &allocated,
+	})
+
+	req := job.Requested()
+	assert.Equal(t, 16, req.CPUs)
+	assert.Equal(t, int64(64*1024), req.MemoryMB)
+	assert.Equal(t, 2, req.Nodes)
+	assert.Equal(t, int64(4), req.GPUs())
+
+	alloc := job.Allocated()
+	assert.Equal(t, 16, alloc.CPUs)
+	assert.Equal(t, 2, alloc.Nodes)
+	assert.Equal(t, map[string]int64{"gpu": 4, "gpu:a100": 4}, alloc.GRES)
+}
@@ -43,6 +43,10 @@ func (a *NodeAdapter) List(ctx context.Context, opts *types.NodeListOptions) (*t
 
 	// Prepare parameters
 	params := &api.SlurmV0044GetNodesParams{}
+	if opts != nil && len(opts.Flags) > 0 {
+		flags := api.SlurmV0044GetNodesParamsFlags(types.JoinQueryFlags(opts.Flags))
+		params.Flags = &flags
+	}
 
 	// Call the API
 	resp, err := a.client.SlurmV0044GetNodesWithResponse(ctx, params)
@@ -252,8 +256,3 @@ func (a *NodeAdapter) Drain(ctx context.Context, nodeName string, reason string)
 func (a *NodeAdapter) Resume(ctx context.Context, nodeName string) error {
 	return a.resumeNodeImpl(ctx, nodeName)
 }
-
-// Watch watches for node events
-func (a *NodeAdapter) Watch(ctx context.Context, opts *types.NodeWatchOptions) (<-chan types.NodeWatchEvent, error) {
-	return a.watchNodesImpl(ctx, opts)
-}
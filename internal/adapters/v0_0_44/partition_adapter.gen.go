@@ -43,6 +43,10 @@ func (a *PartitionAdapter) List(ctx context.Context, opts *types.PartitionListOp
 
 	// Prepare parameters
 	params := &api.SlurmV0044GetPartitionsParams{}
+	if opts != nil && len(opts.Flags) > 0 {
+		flags := api.SlurmV0044GetPartitionsParamsFlags(types.JoinQueryFlags(opts.Flags))
+		params.Flags = &flags
+	}
 
 	// Call the API
 	resp, err := a.client.SlurmV0044GetPartitionsWithResponse(ctx, params)
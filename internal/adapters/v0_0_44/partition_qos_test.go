@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package v0_0_44
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionAdapter_ConvertQoS(t *testing.T) {
+	adapter := NewPartitionAdapter(&api.ClientWithResponses{})
+
+	var apiObj api.V0044PartitionInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "gpu",
+		"qos": {"allowed": "normal,high", "assigned": "gpu", "deny": "scavenger"}
+	}`), &apiObj))
+
+	partition := adapter.convertAPIPartitionToCommon(apiObj)
+	require.NotNil(t, partition)
+	assert.Equal(t, "gpu", partition.AssignedQoS())
+	assert.Equal(t, []string{"normal", "high"}, partition.AllowedQoS())
+	assert.Equal(t, []string{"scavenger"}, partition.DeniedQoS())
+	assert.True(t, partition.PermitsQoS("high"))
+	assert.False(t, partition.PermitsQoS("scavenger"))
+}
@@ -252,7 +252,7 @@ func (a *StandaloneAdapter) GetDiagnostics(ctx context.Context) (*types.Diagnost
 		GittosCount:       0,
 	}
 	// v0.0.44 response has different structure, use basic conversion
-	// resp.JSON200.Statistics contains different data than expected
+	backfillCounters(resp.JSON200.Statistics).Apply(diag)
 	return diag, nil
 }
 
@@ -4,6 +4,7 @@ package v0_0_44
 
 import (
 	types "github.com/jontk/slurm-client/api"
+	adaptercommon "github.com/jontk/slurm-client/internal/adapters/common"
 	api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
 )
 
@@ -67,3 +68,33 @@ func (a *StandaloneAdapter) convertAPIShareToCommon(apiShare api.V0044AssocShare
 	}
 	return result
 }
+
+// backfillCounters picks the backfill fields out of the diag statistics
+func backfillCounters(stats api.V0044StatsMsg) adaptercommon.BackfillCounters {
+	counters := adaptercommon.BackfillCounters{
+		Active:             stats.BfActive,
+		BackfilledJobs:     stats.BfBackfilledJobs,
+		LastBackfilledJobs: stats.BfLastBackfilledJobs,
+		BackfilledHetJobs:  stats.BfBackfilledHetJobs,
+		CycleCounter:       stats.BfCycleCounter,
+		CycleLast:          stats.BfCycleLast,
+		CycleMax:           stats.BfCycleMax,
+		CycleMean:          stats.BfCycleMean,
+		CycleSum:           stats.BfCycleSum,
+		LastDepth:          stats.BfLastDepth,
+		LastDepthTry:       stats.BfLastDepthTry,
+		DepthMean:          stats.BfDepthMean,
+		DepthMeanTry:       stats.BfDepthMeanTry,
+		DepthSum:           stats.BfDepthSum,
+		QueueLen:           stats.BfQueueLen,
+		QueueLenMean:       stats.BfQueueLenMean,
+		QueueLenSum:        stats.BfQueueLenSum,
+		TableSize:          stats.BfTableSize,
+		TableSizeMean:      stats.BfTableSizeMean,
+		TableSizeSum:       stats.BfTableSizeSum,
+	}
+	if when := stats.BfWhenLastCycle; when != nil && when.Set != nil && *when.Set {
+		counters.WhenLastCycle = when.Number
+	}
+	return counters
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package v0_0_44
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/jontk/slurm-client/api"
+	api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
+)
+
+func TestJobAdapter_TimeLimitUnlimited(t *testing.T) {
+	adapter := NewJobAdapter(&api.ClientWithResponses{})
+
+	var apiJob api.V0044JobInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"job_id": 7,
+		"time_limit": {"set": false, "infinite": true, "number": 0}
+	}`), &apiJob))
+
+	job := adapter.convertAPIJobToCommon(apiJob)
+	require.NotNil(t, job)
+	require.NotNil(t, job.TimeLimit)
+	assert.Equal(t, types.TimeLimitUnlimited, *job.TimeLimit)
+	assert.True(t, job.HasUnlimitedTimeLimit())
+
+	// Write the limit back and check it is sent as infinite, not as 0
+	body := adapter.convertCommonJobCreateToAPI(&types.JobCreate{TimeLimit: job.TimeLimit})
+	require.NotNil(t, body.Job)
+	require.NotNil(t, body.Job.TimeLimit)
+	require.NotNil(t, body.Job.TimeLimit.Infinite)
+	assert.True(t, *body.Job.TimeLimit.Infinite)
+	assert.Nil(t, body.Job.TimeLimit.Number)
+
+	// Finite limits are unaffected
+	limit := uint32(90)
+	body = adapter.convertCommonJobCreateToAPI(&types.JobCreate{TimeLimit: &limit})
+	require.NotNil(t, body.Job.TimeLimit)
+	assert.Nil(t, body.Job.TimeLimit.Infinite)
+	assert.Equal(t, int32(90), *body.Job.TimeLimit.Number)
+}
+
+func TestPartitionAdapter_TimeLimitUnlimited(t *testing.T) {
+	adapter := NewPartitionAdapter(&api.ClientWithResponses{})
+
+	var apiPartition api.V0044PartitionInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "long",
+		"defaults": {"time": {"set": true, "infinite": false, "number": 60}},
+		"maximums": {"time": {"set": false, "infinite": true, "number": 0}}
+	}`), &apiPartition))
+
+	partition := adapter.convertAPIPartitionToCommon(apiPartition)
+	require.NotNil(t, partition)
+	require.NotNil(t, partition.Maximums)
+	require.NotNil(t, partition.Maximums.Time)
+	assert.Equal(t, types.TimeLimitUnlimited, *partition.Maximums.Time)
+	assert.Equal(t, "UNLIMITED", types.FormatTimeLimit(partition.Maximums.Time))
+	require.NotNil(t, partition.Defaults)
+	assert.Equal(t, uint32(60), *partition.Defaults.Time)
+}
@@ -442,7 +442,7 @@ func (c *JobWriteConverterGoverterImpl) ConvertCommonJobCreateToAPI(source *api.
 			xint3227 := *(*source).ThreadsPerCore
 			v0_0_44V0044JobDescMsg.ThreadsPerCore = &xint3227
 		}
-		v0_0_44V0044JobDescMsg.TimeLimit = ConvertUint32PtrToNoValStruct((*source).TimeLimit)
+		v0_0_44V0044JobDescMsg.TimeLimit = ConvertTimeLimitToNoValStruct((*source).TimeLimit)
 		v0_0_44V0044JobDescMsg.TimeMinimum = ConvertUint32PtrToNoValStruct((*source).TimeMinimum)
 		if (*source).TRESBind != nil {
 			xstring40 := *(*source).TRESBind
@@ -557,7 +557,7 @@ func (c *QoSWriteConverterGoverterImpl) ConvertCommonQoSCreateToAPI(source *api.
 		var v0_0_44V0044Qos v0044.V0044Qos
 		pString := (*source).Description
 		v0_0_44V0044Qos.Description = &pString
-		v0_0_44V0044Qos.Flags = ConvertStringSliceToQosFlags((*source).Flags)
+		v0_0_44V0044Qos.Flags = ConvertQoSFlagSliceToQosFlags((*source).Flags)
 		pString2 := (*source).Name
 		v0_0_44V0044Qos.Name = &pString2
 		v0_0_44V0044Qos.Priority = ConvertIntToUint32NoValStruct((*source).Priority)
@@ -575,7 +575,7 @@ func (c *QoSWriteConverterGoverterImpl) ConvertCommonQoSUpdateToAPI(source *api.
 			xstring := *(*source).Description
 			v0_0_44V0044Qos.Description = &xstring
 		}
-		v0_0_44V0044Qos.Flags = ConvertStringSlicePtrToQosFlags((*source).Flags)
+		v0_0_44V0044Qos.Flags = ConvertQoSFlagSlicePtrToQosFlags((*source).Flags)
 		v0_0_44V0044Qos.Priority = ConvertIntPtrToUint32NoValStruct((*source).Priority)
 		v0_0_44V0044Qos.UsageFactor = ConvertFloat64PtrToFloat64NoValStruct((*source).UsageFactor)
 		v0_0_44V0044Qos.UsageThreshold = ConvertFloat64PtrToFloat64NoValStruct((*source).UsageThreshold)
@@ -606,7 +606,7 @@ func (c *ReservationWriteConverterGoverterImpl) ConvertCommonReservationCreateTo
 			xstring3 := *(*source).Features
 			v0_0_44V0044ReservationDescMsg.Features = &xstring3
 		}
-		v0_0_44V0044ReservationDescMsg.Flags = ConvertFlagsValueSliceToReservationDescMsgFlags((*source).Flags)
+		v0_0_44V0044ReservationDescMsg.Flags = ConvertReservationFlagSliceToReservationDescMsgFlags((*source).Flags)
 		v0_0_44V0044ReservationDescMsg.Groups = ConvertStringSliceToCsvString((*source).Groups)
 		v0_0_44V0044ReservationDescMsg.Licenses = ConvertStringSliceToCsvString((*source).Licenses)
 		v0_0_44V0044ReservationDescMsg.MaxStartDelay = ConvertUint32PtrToNoValStruct((*source).MaxStartDelay)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/flags"
 )
 
 // QoSBuilder provides a fluent interface for building QoS objects
@@ -21,11 +22,11 @@ func NewQoSBuilder(name string) *QoSBuilder {
 	return &QoSBuilder{
 		qos: &types.QoSCreate{
 			Name:           name,
-			Priority:       0,          // Default priority
-			UsageFactor:    1.0,        // Default usage factor
-			UsageThreshold: 0,          // Default usage threshold
-			Flags:          []string{}, // Initialize empty
-			PreemptMode:    []string{}, // Initialize empty
+			Priority:       0,                 // Default priority
+			UsageFactor:    1.0,               // Default usage factor
+			UsageThreshold: 0,                 // Default usage threshold
+			Flags:          []types.QoSFlag{}, // Initialize empty
+			PreemptMode:    []string{},        // Initialize empty
 		},
 		errors: []error{},
 	}
@@ -47,9 +48,9 @@ func (b *QoSBuilder) WithPriority(priority int) *QoSBuilder {
 	return b
 }
 
-// WithFlags sets the QoS flags
-func (b *QoSBuilder) WithFlags(flags ...string) *QoSBuilder {
-	b.qos.Flags = append(b.qos.Flags, flags...)
+// WithFlags adds QoS flags, skipping those already set
+func (b *QoSBuilder) WithFlags(values ...types.QoSFlag) *QoSBuilder {
+	b.qos.Flags = flags.Add(b.qos.Flags, values...)
 	return b
 }
 
@@ -116,7 +117,7 @@ func (b *QoSBuilder) AsHighPriority() *QoSBuilder {
 		WithPriority(1000).
 		WithUsageFactor(2.0).
 		WithUsageThreshold(0.95).
-		WithFlags("DenyOnLimit", "RequiresReservation").
+		WithFlags(types.QoSFlagsDenyLimit, types.QoSFlagsRequiredReservation).
 		WithPreemptMode("cluster")
 }
 
@@ -125,7 +126,7 @@ func (b *QoSBuilder) AsBatchQueue() *QoSBuilder {
 	return b.
 		WithPriority(10).
 		WithUsageFactor(0.5).
-		WithFlags("NoReserve").
+		WithFlags(types.QoSFlagsNoReserve).
 		WithGraceTime(3600) // 1 hour grace time
 }
 
@@ -134,7 +135,7 @@ func (b *QoSBuilder) AsInteractive() *QoSBuilder {
 	return b.
 		WithPriority(500).
 		WithUsageFactor(1.5).
-		WithFlags("DenyOnLimit").
+		WithFlags(types.QoSFlagsDenyLimit).
 		WithPreemptMode("suspend")
 }
 
@@ -208,7 +209,7 @@ func (b *QoSBuilder) Clone() *QoSBuilder {
 			Priority:       b.qos.Priority,
 			UsageFactor:    b.qos.UsageFactor,
 			UsageThreshold: b.qos.UsageThreshold,
-			Flags:          append([]string{}, b.qos.Flags...),
+			Flags:          append([]types.QoSFlag{}, b.qos.Flags...),
 			PreemptMode:    append([]string{}, b.qos.PreemptMode...),
 		},
 		errors: append([]error{}, b.errors...),
@@ -235,8 +236,8 @@ func (b *QoSBuilder) addError(err error) {
 // validateBusinessRules applies business logic validation
 func (b *QoSBuilder) validateBusinessRules() error {
 	// Example business rules
-	if b.qos.Priority > 1000 && !b.hasFlag("RequiresReservation") {
-		return fmt.Errorf("QoS with priority > 1000 must have RequiresReservation flag")
+	if b.qos.Priority > 1000 && !b.hasFlag(types.QoSFlagsRequiredReservation) {
+		return fmt.Errorf("QoS with priority > 1000 must have %s flag", types.QoSFlagsRequiredReservation)
 	}
 
 	if b.qos.UsageFactor > 3.0 {
@@ -244,21 +245,16 @@ func (b *QoSBuilder) validateBusinessRules() error {
 	}
 
 	// Check for conflicting flags
-	if b.hasFlag("NoReserve") && b.hasFlag("RequiresReservation") {
-		return fmt.Errorf("conflicting flags: NoReserve and RequiresReservation")
+	if b.hasFlag(types.QoSFlagsNoReserve) && b.hasFlag(types.QoSFlagsRequiredReservation) {
+		return fmt.Errorf("conflicting flags: %s and %s", types.QoSFlagsNoReserve, types.QoSFlagsRequiredReservation)
 	}
 
 	return nil
 }
 
 // hasFlag checks if a flag is set
-func (b *QoSBuilder) hasFlag(flag string) bool {
-	for _, f := range b.qos.Flags {
-		if f == flag {
-			return true
-		}
-	}
-	return false
+func (b *QoSBuilder) hasFlag(flag types.QoSFlag) bool {
+	return flags.Has(b.qos.Flags, flag)
 }
 
 // cloneLimits creates a deep copy of QoS limits
@@ -4,11 +4,13 @@
 package common
 
 import (
+	stderrors "errors"
 	"fmt"
 	"reflect"
 	"strconv"
 
 	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/pkg/retry"
 )
 
 // ErrorResponse represents a generic interface for API error responses
@@ -74,9 +76,13 @@ func extractErrorDetail(err ErrorDetail) errors.SlurmAPIErrorDetail {
 			detail.Description = GetErrorDescription(int32(*num))
 		}
 
-		// Add error category
+		// Add error category, keeping Slurm's own error text for numbers
+		// not in the table, such as "Invalid job id specified"
 		if info := GetErrorInfo(int32(*num)); info != nil {
 			detail.ErrorCode = info.Name
+			if code := err.GetError(); code != nil && *code != "" && info.Name == "UNKNOWN_ERROR" {
+				detail.ErrorCode = *code
+			}
 		}
 	} else {
 		// No error number, use original description
@@ -135,7 +141,15 @@ func WrapAndEnhanceError(err error, version string) error {
 		return nil
 	}
 	wrappedErr := errors.WrapError(err)
-	return errors.EnhanceErrorWithVersion(wrappedErr, version)
+	enhancedErr := errors.EnhanceErrorWithVersion(wrappedErr, version)
+
+	// Keep the attempt count of a request the retry middleware gave up on;
+	// it embeds the SlurmError just enhanced
+	var exhausted *retry.RetryExhaustedError
+	if stderrors.As(err, &exhausted) {
+		return exhausted
+	}
+	return enhancedErr
 }
 
 // HandleConversionError creates a standardized conversion error
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// hasTRESMinuteLimits reports whether any of the TRES-minute limit maps of
+// an account create or update is set
+func hasTRESMinuteLimits(limits ...map[string]int64) bool {
+	for _, limit := range limits {
+		if len(limit) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// accountTRESMinuteUpdates builds the updates that apply the TRES-minute
+// limits of update to the associations of the account itself, the ones
+// without a user. slurmdbd keeps these limits on associations rather than
+// on the account record. It only reads, so Update can fail before its
+// first write when the account has no such association.
+func (m *adapterAccountManager) accountTRESMinuteUpdates(ctx context.Context, accountName string, update *types.AccountUpdate) ([]*types.AssociationUpdate, error) {
+	result, err := m.associationAdapter.List(ctx, &types.AssociationListOptions{Accounts: []string{accountName}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list associations of account %s: %w", accountName, err)
+	}
+
+	noUser := ""
+	var updates []*types.AssociationUpdate
+	if result != nil {
+		for i := range result.Associations {
+			assoc := &result.Associations[i]
+			if assoc.User != "" || assoc.Account == nil || *assoc.Account != accountName || assoc.ID == nil {
+				continue
+			}
+			updates = append(updates, &types.AssociationUpdate{
+				ID:             assoc.ID,
+				Account:        assoc.Account,
+				User:           &noUser,
+				Cluster:        assoc.Cluster,
+				Partition:      assoc.Partition,
+				GrpTRESMins:    update.GrpTRESMins,
+				GrpTRESRunMins: update.GrpTRESRunMins,
+				MaxTRESMins:    update.MaxTRESMinsPerJob,
+			})
+		}
+	}
+	if len(updates) == 0 {
+		return nil, errors.NewValidationErrorf("account", accountName,
+			"account %s has no association to hold TRES-minute limits", accountName)
+	}
+	return updates, nil
+}
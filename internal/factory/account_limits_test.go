@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accountLimitsServer serves the physics account and its associations, and
+// records the body of every association update and the path of every write
+// it receives
+func accountLimitsServer(t *testing.T) (*httptest.Server, *[]string, *[]string) {
+	t.Helper()
+	var bodies, writes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet {
+			writes = append(writes, r.URL.Path)
+		}
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/account/physics") {
+			_, _ = w.Write([]byte(`{"accounts": [{"name": "physics", "description": "Physics", "organization": "science"}]}`))
+			return
+		}
+		if !strings.HasSuffix(r.URL.Path, "/associations/") {
+			_, _ = w.Write([]byte(`{"errors": [], "warnings": []}`))
+			return
+		}
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+			_, _ = w.Write([]byte(`{"errors": [], "warnings": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"associations": [
+			{"id": 7, "account": "physics", "cluster": "local", "user": ""},
+			{"id": 8, "account": "physics", "cluster": "local", "user": "alice"}
+		], "errors": [], "warnings": []}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &bodies, &writes
+}
+
+func TestAdapterAccountManager_Update_TRESMinuteLimits(t *testing.T) {
+	for _, version := range []string{"v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server, bodies, _ := accountLimitsServer(t)
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			err = client.Accounts().Update(ctx, "physics", &types.AccountUpdate{
+				GrpTRESMins:       map[string]int64{"cpu": 30000000, "gres/gpu": 1000000},
+				GrpTRESRunMins:    map[string]int64{"cpu": 500000},
+				MaxTRESMinsPerJob: map[string]int64{"cpu": 10000},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, *bodies, 1)
+			var body struct {
+				Associations []map[string]interface{} `json:"associations"`
+			}
+			require.NoError(t, json.Unmarshal([]byte((*bodies)[0]), &body))
+			require.Len(t, body.Associations, 1)
+			assoc := body.Associations[0]
+			assert.Equal(t, "physics", assoc["account"])
+			assert.Equal(t, "local", assoc["cluster"])
+			assert.Equal(t, "", assoc["user"])
+
+			limits, err := json.Marshal(assoc["max"])
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"tres": {
+				"group": {
+					"minutes": [
+						{"type": "cpu", "count": 30000000},
+						{"type": "gres", "name": "gpu", "count": 1000000}
+					],
+					"active": [{"type": "cpu", "count": 500000}]
+				},
+				"minutes": {"per": {"job": [{"type": "cpu", "count": 10000}]}}
+			}}`, string(limits))
+		})
+	}
+}
+
+func TestAdapterAccountManager_Update_TRESMinuteLimitsWithoutAssociation(t *testing.T) {
+	server, _, writes := accountLimitsServer(t)
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	// chemistry has no association of its own, so nothing is written
+	description := "Chemistry"
+	err = client.Accounts().Update(ctx, "chemistry", &types.AccountUpdate{
+		Description: &description,
+		GrpTRESMins: map[string]int64{"cpu": 30000000},
+	})
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Empty(t, *writes)
+}
+
+func TestAdapterAccountManager_Create_TRESMinuteLimitsRejected(t *testing.T) {
+	server, bodies, _ := accountLimitsServer(t)
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	_, err = client.Accounts().Create(ctx, &types.AccountCreate{
+		Name:        "burst",
+		GrpTRESMins: map[string]int64{"cpu": 30000000},
+	})
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "account", validationErr.Field)
+	assert.Empty(t, *bodies)
+}
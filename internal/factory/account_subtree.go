@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// Subtree returns the account hierarchy below root. The parent links come
+// from one association List and the account details from one account List,
+// so the number of requests does not depend on the depth of the tree.
+func (m *adapterAccountManager) Subtree(ctx context.Context, root string) (*types.AccountTree, error) {
+	if root == "" {
+		return nil, errors.NewValidationErrorf("root", root, "account name required")
+	}
+
+	associations, err := getAllAssociations(ctx, m.associationAdapter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get associations: %w", err)
+	}
+	accounts, err := m.adapter.List(ctx, &types.AccountListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	index := newAccountIndex(associations, accounts)
+	if !index.known[root] {
+		return nil, errors.NewSlurmError(errors.ErrorCodeAccountNotFound, fmt.Sprintf("account %s not found", root))
+	}
+	return index.subtree(root, 0, make(map[string]bool)), nil
+}
+
+// accountIndex holds the account hierarchy keyed by account name
+type accountIndex struct {
+	known    map[string]bool
+	details  map[string]*types.Account
+	children map[string][]string
+	users    map[string][]string
+}
+
+func newAccountIndex(associations []types.Association, accounts *types.AccountList) *accountIndex {
+	index := &accountIndex{
+		known:    make(map[string]bool),
+		details:  make(map[string]*types.Account),
+		children: make(map[string][]string),
+		users:    make(map[string][]string),
+	}
+	if accounts != nil {
+		for i := range accounts.Accounts {
+			account := &accounts.Accounts[i]
+			index.known[account.Name] = true
+			index.details[account.Name] = account
+		}
+	}
+
+	// The same edge is reported once per cluster, so deduplicate
+	seen := make(map[[2]string]bool)
+	for _, assoc := range associations {
+		name := derefString(assoc.Account)
+		if name == "" {
+			continue
+		}
+		index.known[name] = true
+		if assoc.User != "" {
+			if key := [2]string{name, "user:" + assoc.User}; !seen[key] {
+				seen[key] = true
+				index.users[name] = append(index.users[name], assoc.User)
+			}
+			continue
+		}
+		parent := derefString(assoc.ParentAccount)
+		if parent == "" || parent == name {
+			continue
+		}
+		if key := [2]string{parent, name}; !seen[key] {
+			seen[key] = true
+			index.known[parent] = true
+			index.children[parent] = append(index.children[parent], name)
+		}
+	}
+
+	for _, names := range index.children {
+		sort.Strings(names)
+	}
+	for _, names := range index.users {
+		sort.Strings(names)
+	}
+	return index
+}
+
+// subtree builds the tree below name, skipping accounts already on the path
+// so that a malformed lineage cannot recurse forever
+func (x *accountIndex) subtree(name string, depth int, visiting map[string]bool) *types.AccountTree {
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	tree := &types.AccountTree{
+		Name:    name,
+		Account: x.details[name],
+		Depth:   depth,
+		Users:   x.users[name],
+	}
+	for _, child := range x.children[name] {
+		if visiting[child] {
+			continue
+		}
+		tree.Children = append(tree.Children, x.subtree(child, depth+1, visiting))
+	}
+	return tree
+}
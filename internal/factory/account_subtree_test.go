@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAccountAdapter implements common.AccountAdapter for testing
+type mockAccountAdapter struct {
+	listFunc func(ctx context.Context, opts *types.AccountListOptions) (*types.AccountList, error)
+	lists    int
+}
+
+func (m *mockAccountAdapter) List(ctx context.Context, opts *types.AccountListOptions) (*types.AccountList, error) {
+	m.lists++
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
+	return &types.AccountList{Accounts: []types.Account{}}, nil
+}
+
+func (m *mockAccountAdapter) Get(ctx context.Context, accountName string) (*types.Account, error) {
+	return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation, "Get not expected")
+}
+
+func (m *mockAccountAdapter) Create(ctx context.Context, account *types.AccountCreate) (*types.AccountCreateResponse, error) {
+	return nil, nil
+}
+
+func (m *mockAccountAdapter) Update(ctx context.Context, accountName string, update *types.AccountUpdate) error {
+	return nil
+}
+
+func (m *mockAccountAdapter) Delete(ctx context.Context, accountName string) error {
+	return nil
+}
+
+func (m *mockAccountAdapter) CreateAssociation(ctx context.Context, req *types.AccountAssociationRequest) (*types.AssociationCreateResponse, error) {
+	return nil, nil
+}
+
+func TestAdapterAccountManager_Subtree(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	associations := []types.Association{
+		{Account: ptrString("root"), Cluster: ptrString("a")},
+		{Account: ptrString("science"), ParentAccount: ptrString("root"), Cluster: ptrString("a")},
+		{Account: ptrString("science"), ParentAccount: ptrString("root"), Cluster: ptrString("b")},
+		{Account: ptrString("ops"), ParentAccount: ptrString("root"), Cluster: ptrString("a")},
+		{Account: ptrString("physics"), ParentAccount: ptrString("science"), Cluster: ptrString("a")},
+		{Account: ptrString("chemistry"), ParentAccount: ptrString("science"), Cluster: ptrString("a")},
+		{User: "bob", Account: ptrString("physics"), Cluster: ptrString("a")},
+		{User: "alice", Account: ptrString("physics"), Cluster: ptrString("a")},
+		{User: "alice", Account: ptrString("physics"), Cluster: ptrString("b")},
+		{User: "carol", Account: ptrString("ops"), Cluster: ptrString("a")},
+	}
+	var associationLists int
+	accountAdapter := &mockAccountAdapter{
+		listFunc: func(ctx context.Context, opts *types.AccountListOptions) (*types.AccountList, error) {
+			return &types.AccountList{Accounts: []types.Account{
+				{Name: "root", Description: "default root account"},
+				{Name: "science", Description: "Science division"},
+				{Name: "physics", Description: "Physics department"},
+				{Name: "chemistry", Description: "Chemistry department"},
+				{Name: "ops", Description: "Operations"},
+			}}, nil
+		},
+	}
+	listAssociations := newAssociationListFunc(associations)
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:        "v0.0.44",
+			accountAdapter: accountAdapter,
+			associationAdapter: &mockAssociationAdapter{
+				listFunc: func(ctx context.Context, opts *types.AssociationListOptions) (*types.AssociationList, error) {
+					associationLists++
+					return listAssociations(ctx, opts)
+				},
+			},
+		},
+	}
+
+	t.Run("three levels", func(t *testing.T) {
+		accountAdapter.lists, associationLists = 0, 0
+
+		tree, err := client.Accounts().Subtree(ctx, "root")
+		require.NoError(t, err)
+		assert.Equal(t, 1, accountAdapter.lists)
+		assert.Equal(t, 1, associationLists)
+
+		assert.Equal(t, "root", tree.Name)
+		assert.Equal(t, 0, tree.Depth)
+		require.NotNil(t, tree.Account)
+		assert.Equal(t, "default root account", tree.Account.Description)
+		require.Len(t, tree.Children, 2)
+
+		ops, science := tree.Children[0], tree.Children[1]
+		assert.Equal(t, "ops", ops.Name)
+		assert.Equal(t, 1, ops.Depth)
+		assert.Equal(t, []string{"carol"}, ops.Users)
+		assert.Empty(t, ops.Children)
+
+		assert.Equal(t, "science", science.Name)
+		assert.Equal(t, 1, science.Depth)
+		require.Len(t, science.Children, 2)
+		assert.Equal(t, "chemistry", science.Children[0].Name)
+		assert.Equal(t, "physics", science.Children[1].Name)
+		assert.Equal(t, 2, science.Children[1].Depth)
+		assert.Equal(t, []string{"alice", "bob"}, science.Children[1].Users)
+		assert.Equal(t, "Physics department", science.Children[1].Account.Description)
+	})
+
+	t.Run("inner root", func(t *testing.T) {
+		tree, err := client.Accounts().Subtree(ctx, "science")
+		require.NoError(t, err)
+		assert.Equal(t, 0, tree.Depth)
+		require.Len(t, tree.Children, 2)
+		assert.Equal(t, 1, tree.Children[0].Depth)
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		_, err := client.Accounts().Subtree(ctx, "biology")
+		require.Error(t, err)
+		var slurmErr *errors.SlurmError
+		require.ErrorAs(t, err, &slurmErr)
+		assert.Equal(t, errors.ErrorCodeAccountNotFound, slurmErr.Code)
+	})
+
+	t.Run("empty root", func(t *testing.T) {
+		_, err := client.Accounts().Subtree(ctx, "")
+		assert.True(t, errors.IsValidationError(err))
+	})
+}
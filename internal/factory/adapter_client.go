@@ -6,7 +6,10 @@ package factory
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	types "github.com/jontk/slurm-client/api"
@@ -21,8 +24,11 @@ import (
 	v042api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
 	v043api "github.com/jontk/slurm-client/internal/openapi/v0_0_43"
 	v044api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
+	"github.com/jontk/slurm-client/pkg/cli"
 	"github.com/jontk/slurm-client/pkg/errors"
 	"github.com/jontk/slurm-client/pkg/pool"
+	"github.com/jontk/slurm-client/pkg/retry"
+	"github.com/jontk/slurm-client/pkg/watch"
 )
 
 // AdapterClient wraps a version-specific adapter to implement the SlurmClient interface
@@ -30,64 +36,103 @@ type AdapterClient struct {
 	adapter common.VersionAdapter
 	version string
 	pool    *pool.HTTPClientPool // optional connection pool for cleanup
+
+	baseURL    string
+	httpClient types.HTTPDoer // the client the adapter sends requests with, for Warmup
+
+	lifeOnce sync.Once
+	life     *clientLifecycle // background goroutines and requests stopped by Shutdown
+
+	cli *cli.Runner // optional Slurm CLI fallback
+
+	retrySubmit bool // retry job submissions under the client's policy
+
+	username string // the user the auth provider authenticates as, if known
+
+	watchTimeout time.Duration // default deadline of Watch calls
+
+	tres tresCache // the cluster's TRES table, once read by Info().TRESList
 }
 
 // NewAdapterClient creates a new adapter-based client for the specified version
 func NewAdapterClient(version string, config *types.ClientConfig) (SlurmClient, error) {
+	life := newClientLifecycle()
+	httpClient := newCodecDoer(life.trackRequests(newEventDoer(newTimeoutDoer(config.HTTPClient, config.Timeouts), life.bus)), config.Codec)
+
 	switch version {
 	case "v0.0.40":
-		client, err := v040api.NewClientWithResponses(config.BaseURL, v040api.WithHTTPClient(config.HTTPClient))
+		client, err := v040api.NewClientWithResponses(config.BaseURL, v040api.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create v0.0.40 client: %w", err)
 		}
 		adapter := v040adapter.NewAdapter(client)
 		return &AdapterClient{
-			adapter: adapter,
-			version: version,
+			adapter:      adapter,
+			version:      version,
+			life:         life,
+			baseURL:      config.BaseURL,
+			httpClient:   httpClient,
+			watchTimeout: config.Timeouts.Watch,
 		}, nil
 
 	case "v0.0.41":
-		client, err := v041api.NewClientWithResponses(config.BaseURL, v041api.WithHTTPClient(config.HTTPClient))
+		client, err := v041api.NewClientWithResponses(config.BaseURL, v041api.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create v0.0.41 client: %w", err)
 		}
 		adapter := v041adapter.NewAdapter(client)
 		return &AdapterClient{
-			adapter: adapter,
-			version: version,
+			adapter:      adapter,
+			version:      version,
+			life:         life,
+			baseURL:      config.BaseURL,
+			httpClient:   httpClient,
+			watchTimeout: config.Timeouts.Watch,
 		}, nil
 
 	case "v0.0.42":
-		client, err := v042api.NewClientWithResponses(config.BaseURL, v042api.WithHTTPClient(config.HTTPClient))
+		client, err := v042api.NewClientWithResponses(config.BaseURL, v042api.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create v0.0.42 client: %w", err)
 		}
 		adapter := v042adapter.NewAdapter(client)
 		return &AdapterClient{
-			adapter: adapter,
-			version: version,
+			adapter:      adapter,
+			version:      version,
+			life:         life,
+			baseURL:      config.BaseURL,
+			httpClient:   httpClient,
+			watchTimeout: config.Timeouts.Watch,
 		}, nil
 
 	case "v0.0.43":
-		client, err := v043api.NewClientWithResponses(config.BaseURL, v043api.WithHTTPClient(config.HTTPClient))
+		client, err := v043api.NewClientWithResponses(config.BaseURL, v043api.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create %s client: %w", version, err)
 		}
 		adapter := v043adapter.NewAdapter(client)
 		return &AdapterClient{
-			adapter: adapter,
-			version: version,
+			adapter:      adapter,
+			version:      version,
+			life:         life,
+			baseURL:      config.BaseURL,
+			httpClient:   httpClient,
+			watchTimeout: config.Timeouts.Watch,
 		}, nil
 
 	case "v0.0.44":
-		client, err := v044api.NewClientWithResponses(config.BaseURL, v044api.WithHTTPClient(config.HTTPClient))
+		client, err := v044api.NewClientWithResponses(config.BaseURL, v044api.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create v0.0.44 client: %w", err)
 		}
 		adapter := v044adapter.NewAdapter(client)
 		return &AdapterClient{
-			adapter: adapter,
-			version: version,
+			adapter:      adapter,
+			version:      version,
+			life:         life,
+			baseURL:      config.BaseURL,
+			httpClient:   httpClient,
+			watchTimeout: config.Timeouts.Watch,
 		}, nil
 
 	default:
@@ -107,30 +152,55 @@ func (c *AdapterClient) Capabilities() types.ClientCapabilities {
 
 // Jobs returns the JobManager
 func (c *AdapterClient) Jobs() types.JobManager {
-	return &adapterJobManager{adapter: c.adapter.GetJobManager()}
+	return &adapterJobManager{
+		adapter:      c.adapter.GetJobManager(),
+		partitions:   c.adapter.GetPartitionManager(),
+		life:         c.lifecycle(),
+		cli:          c.cli,
+		watchTimeout: c.watchTimeout,
+		retrySubmit:  c.retrySubmit,
+		username:     c.username,
+	}
 }
 
 // Nodes returns the NodeManager
 func (c *AdapterClient) Nodes() types.NodeManager {
-	return &adapterNodeManager{adapter: c.adapter.GetNodeManager()}
+	return &adapterNodeManager{
+		adapter:      c.adapter.GetNodeManager(),
+		life:         c.lifecycle(),
+		cli:          c.cli,
+		watchTimeout: c.watchTimeout,
+	}
 }
 
 // Partitions returns the PartitionManager
 func (c *AdapterClient) Partitions() types.PartitionManager {
-	return &adapterPartitionManager{adapter: c.adapter.GetPartitionManager()}
+	return &adapterPartitionManager{
+		adapter:      c.adapter.GetPartitionManager(),
+		life:         c.lifecycle(),
+		cli:          c.cli,
+		watchTimeout: c.watchTimeout,
+	}
 }
 
 // Info returns the InfoManager
 func (c *AdapterClient) Info() types.InfoManager {
 	return &adapterInfoManager{
-		adapter: c.adapter.GetInfoManager(),
-		version: c.version,
+		adapter:    c.adapter.GetInfoManager(),
+		standalone: c.adapter.GetStandaloneManager(),
+		version:    c.version,
+		tres:       &c.tres,
 	}
 }
 
 // Reservations returns the ReservationManager
 func (c *AdapterClient) Reservations() types.ReservationManager {
-	return &adapterReservationManager{adapter: c.adapter.GetReservationManager()}
+	return &adapterReservationManager{
+		adapter:  c.adapter.GetReservationManager(),
+		jobs:     c.adapter.GetJobManager(),
+		info:     c.adapter.GetInfoManager(),
+		username: c.username,
+	}
 }
 
 // QoS returns the QoSManager
@@ -143,6 +213,7 @@ func (c *AdapterClient) Accounts() types.AccountManager {
 	return &adapterAccountManager{
 		adapter:            c.adapter.GetAccountManager(),
 		associationAdapter: c.adapter.GetAssociationManager(),
+		jobAdapter:         c.adapter.GetJobManager(),
 	}
 }
 
@@ -152,6 +223,8 @@ func (c *AdapterClient) Users() types.UserManager {
 		adapter:            c.adapter.GetUserManager(),
 		accountAdapter:     c.adapter.GetAccountManager(),
 		associationAdapter: c.adapter.GetAssociationManager(),
+		qosAdapter:         c.adapter.GetQoSManager(),
+		partitionAdapter:   c.adapter.GetPartitionManager(),
 	}
 }
 
@@ -162,7 +235,12 @@ func (c *AdapterClient) Clusters() types.ClusterManager {
 
 // Associations returns the AssociationManager
 func (c *AdapterClient) Associations() types.AssociationManager {
-	return &adapterAssociationManager{adapter: c.adapter.GetAssociationManager()}
+	return &adapterAssociationManager{
+		adapter:          c.adapter.GetAssociationManager(),
+		accountAdapter:   c.adapter.GetAccountManager(),
+		userAdapter:      c.adapter.GetUserManager(),
+		partitionAdapter: c.adapter.GetPartitionManager(),
+	}
 }
 
 // WCKeys returns the WCKeyManager
@@ -179,6 +257,7 @@ func (c *AdapterClient) Analytics() types.AnalyticsManager {
 
 // Close closes the client and releases any resources
 func (c *AdapterClient) Close() error {
+	c.lifecycle().bus.Close()
 	if c.pool != nil {
 		return c.pool.Close()
 	}
@@ -190,6 +269,22 @@ func (c *AdapterClient) SetPool(p *pool.HTTPClientPool) {
 	c.pool = p
 }
 
+// SetCLIFallback sets the runner used for operations the REST API lacks
+func (c *AdapterClient) SetCLIFallback(r *cli.Runner) {
+	c.cli = r
+}
+
+// SetIdempotentSubmit lets job submissions be retried like other requests
+func (c *AdapterClient) SetIdempotentSubmit(enabled bool) {
+	c.retrySubmit = enabled
+}
+
+// SetUsername sets the user the client authenticates as, for operations
+// made on that user's behalf
+func (c *AdapterClient) SetUsername(username string) {
+	c.username = username
+}
+
 // === Standalone Operations ===
 
 // GetLicenses retrieves license information
@@ -258,6 +353,28 @@ func (c *AdapterClient) GetDBDiagnostics(ctx context.Context) (*types.Diagnostic
 	return convertDiagnosticsToInterface(result), nil
 }
 
+// Versions retrieves the slurmrestd version and the REST plugin version,
+// along with the API version the client speaks. slurmrestd does not expose
+// the daemons' own releases: with the CLI fallback configured, the
+// slurmctld version is read from "scontrol show config".
+func (c *AdapterClient) Versions(ctx context.Context) (*types.VersionInfo, error) {
+	info, err := c.adapter.GetInfoManager().Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info.ClientVersion = c.version
+	// slurmrestd reports only its own version; ask slurmctld through the
+	// CLI when it is available
+	if c.cli != nil {
+		version, err := c.cli.ControllerVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		info.ControllerVersion = version
+	}
+	return info, nil
+}
+
 // GetInstance retrieves a specific database instance
 func (c *AdapterClient) GetInstance(ctx context.Context, opts *types.GetInstanceOptions) (*types.Instance, error) {
 	standaloneManager := c.adapter.GetStandaloneManager()
@@ -330,7 +447,13 @@ func (c *AdapterClient) Reconfigure(ctx context.Context) (*types.ReconfigureResp
 
 // adapterJobManager wraps a common.JobAdapter to implement types.JobManager
 type adapterJobManager struct {
-	adapter common.JobAdapter
+	adapter      common.JobAdapter
+	partitions   common.PartitionAdapter
+	life         *clientLifecycle
+	cli          *cli.Runner
+	watchTimeout time.Duration
+	retrySubmit  bool
+	username     string
 }
 
 func (m *adapterJobManager) List(ctx context.Context, opts *types.ListJobsOptions) (*types.JobList, error) {
@@ -345,8 +468,14 @@ func (m *adapterJobManager) List(ctx context.Context, opts *types.ListJobsOption
 		if opts.Partition != "" {
 			adapterOpts.Partitions = []string{opts.Partition}
 		}
+		adapterOpts.Reasons = opts.Reasons
+		adapterOpts.HeldOnly = opts.HeldOnly
+		adapterOpts.SubmittedAfter = opts.SubmittedAfter
+		adapterOpts.StartedAfter = opts.StartedAfter
+		adapterOpts.EndBefore = opts.EndBefore
 		adapterOpts.Limit = opts.Limit
 		adapterOpts.Offset = opts.Offset
+		adapterOpts.Flags = opts.Flags
 		// Convert states
 		for _, s := range opts.States {
 			adapterOpts.States = append(adapterOpts.States, types.JobState(s))
@@ -373,20 +502,22 @@ func (m *adapterJobManager) List(ctx context.Context, opts *types.ListJobsOption
 		Total: result.Total, // Total from adapter is full count before pagination
 	}
 
+	normalizeSlices(jobList)
 	return jobList, nil
 }
 
 func (m *adapterJobManager) Get(ctx context.Context, jobID string) (*types.Job, error) {
 	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return nil, fmt.Errorf("invalid job JobId: %w", err)
 	}
 
-	job, err := m.adapter.Get(ctx, int32(jobIDInt))
+	job, err := m.adapter.Get(ctx, jobIDInt)
 	if err != nil {
-		return nil, err
+		return nil, errors.ParseNotFound(err, errors.ErrorCodeJobNotFound, jobID)
 	}
+	normalizeSlices(job)
 	return job, nil
 }
 
@@ -414,20 +545,109 @@ func convertMapToEnvList(env map[string]string) []string {
 	return result
 }
 
+// spankOptionEnvPrefix is the environment prefix Slurm uses to carry SPANK
+// plugin options from the submit host to the remote plugin instances
+const spankOptionEnvPrefix = "_SLURM_SPANK_OPTION_"
+
+// convertSpankOptionsToEnvList converts "<plugin>:<option>" keyed SPANK
+// options to the environment entries sbatch would set for them
+func convertSpankOptionsToEnvList(opts map[string]string) ([]string, error) {
+	if len(opts) == 0 {
+		return nil, nil
+	}
+	result := make([]string, 0, len(opts))
+	for key, value := range opts {
+		plugin, option, ok := strings.Cut(key, ":")
+		if !ok || plugin == "" || option == "" {
+			return nil, errors.NewValidationErrorf("SpankOptions", key,
+				"SPANK option %q must be written as <plugin>:<option>", key)
+		}
+		result = append(result, spankOptionEnvPrefix+spankEnvName(plugin)+"_"+spankEnvName(option)+"="+value)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// spankEnvName replaces characters that are not valid in an environment
+// variable name, matching Slurm's canonicalization of SPANK option names
+func spankEnvName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
 //nolint:staticcheck // SA1019: Submit implements the deprecated JobWriter.Submit interface method
 func (m *adapterJobManager) Submit(ctx context.Context, job *types.JobSubmission) (*types.JobSubmitResponse, error) {
-	// Convert submission - map from types.JobSubmission to types.JobCreate
+	submission, err := newJobCreate(job)
+	if err != nil {
+		return nil, err
+	}
+	var components []*types.JobCreate
+	hetJobs, ok := m.adapter.(common.HetJobAdapter)
+	if len(job.Components) > 0 {
+		if !ok {
+			return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+				"heterogeneous jobs are not supported in this API version")
+		}
+		if components, err = newHetJobComponents(submission, job.Components); err != nil {
+			return nil, err
+		}
+	}
+
+	if job.EnsureUniqueName {
+		user := job.User
+		if user == "" {
+			user = m.username
+		}
+		if err := m.checkUniqueJobName(ctx, job.Name, user); err != nil {
+			return nil, err
+		}
+	}
+	if components != nil {
+		return submitHetJob(m.submitContext(ctx), hetJobs, components)
+	}
+
+	// Call adapter
+	resp, err := m.adapter.Submit(m.submitContext(ctx), submission)
+	if err != nil {
+		return nil, submitError(err)
+	}
+
+	return &types.JobSubmitResponse{
+		JobId: resp.JobId,
+	}, nil
+}
+
+// maxJobNice is the largest adjustment Slurm accepts for a job's nice
+// value (NICE_OFFSET - 3)
+const maxJobNice = 2147483645
+
+// newJobCreate maps a JobSubmission to the JobCreate the adapters submit.
+// The submission is first checked by validateJobSubmission, the checks
+// Validate runs, so Submit rejects exactly what Validate reports.
+func newJobCreate(job *types.JobSubmission) (*types.JobCreate, error) {
+	if err := validationResultError(validateJobSubmission(job)); err != nil {
+		return nil, err
+	}
+
 	submission := &types.JobCreate{
 		Name:                    ptrString(job.Name),
 		Account:                 ptrString(job.Account),
 		Script:                  ptrString(job.Script),
 		Partition:               ptrString(job.Partition),
+		QoS:                     ptrString(job.QoS),
 		MinimumCPUs:             ptrInt32(int32(job.CPUs)),
 		TimeLimit:               ptrUint32(uint32(job.TimeLimit)),
 		CurrentWorkingDirectory: ptrString(job.WorkingDir),
 		Environment:             convertMapToEnvList(job.Environment),
 		MinimumNodes:            ptrInt32(int32(job.Nodes)),
 		Priority:                ptrUint32(uint32(job.Priority)),
+		StandardOutput:          ptrString(job.StdOut),
+		StandardError:           ptrString(job.StdErr),
+		StandardInput:           ptrString(job.StdIn),
 	}
 
 	// Set memory if provided
@@ -435,51 +655,200 @@ func (m *adapterJobManager) Submit(ctx context.Context, job *types.JobSubmission
 		submission.MemoryPerNode = func() *uint64 { v := uint64(job.Memory); return &v }()
 	}
 
-	// Call adapter
-	resp, err := m.adapter.Submit(ctx, submission)
+	// Map node sharing; the two flags are opposite --shared settings
+	switch {
+	case job.Exclusive:
+		submission.Shared = []types.SharedValue{types.SharedNone}
+	case job.Oversubscribe:
+		submission.Shared = []types.SharedValue{types.SharedOversubscribe}
+	}
+	if job.OpenMode != "" {
+		submission.OpenMode = []types.OpenModeValue{job.OpenMode}
+	}
+	submission.CPUBinding = ptrString(job.CPUBind)
+	if job.Nice != 0 {
+		submission.Nice = ptrInt32(int32(job.Nice))
+	}
+	if job.Reboot {
+		reboot := true
+		submission.Reboot = &reboot
+	}
+	// The specs were checked above, so these cannot fail
+	submission.Array, _ = jobArraySpec(job.ArraySpec, job.ArrayMaxConcurrent)
+	submission.Dependency, _ = jobDependencySpec(job.Dependencies)
+	gresFlags, _ := jobGRESFlags(job.GRESFlags)
+	submission.Flags = append(submission.Flags, gresFlags...)
+	if job.Constraints != nil {
+		submission.Constraints = ptrString(job.Constraints.String())
+	}
+
+	// SPANK options travel in the job environment, as with sbatch
+	spankEnv, err := convertSpankOptionsToEnvList(job.SpankOptions)
 	if err != nil {
 		return nil, err
 	}
+	submission.Environment = append(submission.Environment, spankEnv...)
 
-	return &types.JobSubmitResponse{
-		JobId: resp.JobId,
-	}, nil
+	if err := submission.SetExtraFields(job.Extra); err != nil {
+		return nil, errors.NewValidationErrorf("Extra", job.Extra, "%v", err)
+	}
+	return submission, nil
+}
+
+// submitContext turns off retries for a submission, since a retried submit
+// whose first attempt reached slurmctld would queue the job twice, unless the
+// client was built with WithIdempotentSubmit
+func (m *adapterJobManager) submitContext(ctx context.Context) context.Context {
+	if m.retrySubmit {
+		return ctx
+	}
+	return retry.WithNoRetry(ctx)
 }
 
 func (m *adapterJobManager) SubmitRaw(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
-	return m.adapter.Submit(ctx, job)
+	resp, err := m.adapter.Submit(m.submitContext(ctx), job)
+	if err != nil {
+		return nil, submitError(err)
+	}
+	return resp, nil
+}
+
+// jobArraySpec builds the array parameter of a submission from ArraySpec
+// and ArrayMaxConcurrent, or returns nil when the job is not an array
+func jobArraySpec(spec string, maxConcurrent int) (*string, *errors.ValidationError) {
+	spec = strings.TrimSpace(spec)
+	switch {
+	case spec == "" && maxConcurrent != 0:
+		return nil, errors.NewValidationErrorf("ArrayMaxConcurrent", maxConcurrent,
+			"ArrayMaxConcurrent requires ArraySpec")
+	case spec == "":
+		return nil, nil
+	case maxConcurrent < 0:
+		return nil, errors.NewValidationErrorf("ArrayMaxConcurrent", maxConcurrent,
+			"ArrayMaxConcurrent cannot be negative")
+	case maxConcurrent > 0 && strings.Contains(spec, "%"):
+		return nil, errors.NewValidationErrorf("ArrayMaxConcurrent", maxConcurrent,
+			"ArraySpec %q already sets a concurrency limit", spec)
+	}
+	// Count the tasks rather than expanding them, as a specification can
+	// describe millions
+	_, tasks, err := parseArrayTaskString(spec)
+	if err != nil {
+		return nil, errors.NewValidationErrorf("ArraySpec", spec, "invalid job array specification: %v", err)
+	}
+	if tasks == 0 {
+		return nil, errors.NewValidationErrorf("ArraySpec", spec, "job array specification has no tasks")
+	}
+	if maxConcurrent > 0 {
+		spec = fmt.Sprintf("%s%%%d", spec, maxConcurrent)
+	}
+	return &spec, nil
+}
+
+// jobDependencyTypes are the dependency types JobSubmission accepts
+var jobDependencyTypes = map[string]bool{
+	"after":      true,
+	"afterany":   true,
+	"afterok":    true,
+	"afternotok": true,
+	"aftercorr":  true,
+	"singleton":  true,
+}
+
+// jobDependencySpec formats dependencies as the dependency parameter of a
+// submission, e.g. "afterok:12345:12346,afternotok:678", keeping their
+// order. It returns nil when there are none.
+func jobDependencySpec(dependencies []types.JobDependency) (*string, *errors.ValidationError) {
+	if len(dependencies) == 0 {
+		return nil, nil
+	}
+	parts := make([]string, 0, len(dependencies))
+	for _, dep := range dependencies {
+		depType := strings.ToLower(strings.TrimSpace(dep.Type))
+		if !jobDependencyTypes[depType] {
+			return nil, errors.NewValidationErrorf("Dependencies", dep.Type, "unknown dependency type %q", dep.Type)
+		}
+		switch {
+		case depType == "singleton" && len(dep.JobIDs) > 0:
+			return nil, errors.NewValidationErrorf("Dependencies", dep.JobIDs, "singleton dependencies take no job IDs")
+		case depType != "singleton" && len(dep.JobIDs) == 0:
+			return nil, errors.NewValidationErrorf("Dependencies", dep.Type, "%s dependency needs at least one job ID", depType)
+		}
+		part := depType
+		for _, jobID := range dep.JobIDs {
+			if jobID <= 0 {
+				return nil, errors.NewValidationErrorf("Dependencies", jobID, "dependency job IDs must be positive")
+			}
+			part += ":" + strconv.Itoa(int(jobID))
+		}
+		parts = append(parts, part)
+	}
+	spec := strings.Join(parts, ",")
+	return &spec, nil
+}
+
+// jobGRESFlags maps GRES flags such as "enforce-binding" to the job flags
+// slurmrestd takes for them
+func jobGRESFlags(flags []string) ([]types.FlagsValue, *errors.ValidationError) {
+	var values []types.FlagsValue
+	seen := make(map[types.FlagsValue]bool)
+	for _, flag := range flags {
+		value, ok := types.GRESJobFlag(strings.ToLower(strings.TrimSpace(flag)))
+		if !ok {
+			return nil, errors.NewValidationErrorf("GRESFlags", flag, "unknown GRES flag %q, expected %s or %s",
+				flag, types.GRESFlagEnforceBinding, types.GRESFlagDisableBinding)
+		}
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+	if seen[types.FlagsGRESBindingEnforced] && seen[types.FlagsGRESBindingDisabled] {
+		return nil, errors.NewValidationErrorf("GRESFlags", flags, "%s and %s cannot both be set",
+			types.GRESFlagEnforceBinding, types.GRESFlagDisableBinding)
+	}
+	return values, nil
+}
+
+// submitError turns a rejection for an association or QoS limit into a
+// LimitExceededError naming the limit; other errors are returned as they are
+func submitError(err error) error {
+	if limitErr := errors.ParseLimitExceeded(err); limitErr != nil {
+		return limitErr
+	}
+	return err
 }
 
 func (m *adapterJobManager) Update(ctx context.Context, jobID string, update *types.JobUpdate) error {
 	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid job JobId: %w", err)
 	}
 	// JobUpdate is an alias for JobCreate - pass it directly
 	// The adapter will use only the fields that are set
-	return m.adapter.Update(ctx, int32(jobIDInt), update)
+	return m.adapter.Update(ctx, jobIDInt, update)
 }
 
 func (m *adapterJobManager) Cancel(ctx context.Context, jobID string) error {
 	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid job JobId: %w", err)
 	}
-	return m.adapter.Cancel(ctx, int32(jobIDInt), nil)
+	return m.adapter.Cancel(ctx, jobIDInt, nil)
 }
 
 // Hold holds a job (prevents it from running)
 func (m *adapterJobManager) Hold(ctx context.Context, jobID string) error {
 	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid job JobId: %w", err)
 	}
 	// Create hold request (hold = true)
 	req := &types.JobHoldRequest{
-		JobId: int32(jobIDInt),
+		JobId: jobIDInt,
 		Hold:  true,
 	}
 	return m.adapter.Hold(ctx, req)
@@ -488,28 +857,48 @@ func (m *adapterJobManager) Hold(ctx context.Context, jobID string) error {
 // Release releases a held job (allows it to run)
 func (m *adapterJobManager) Release(ctx context.Context, jobID string) error {
 	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid job JobId: %w", err)
 	}
 	// Create hold request (hold = false to release)
 	req := &types.JobHoldRequest{
-		JobId: int32(jobIDInt),
+		JobId: jobIDInt,
 		Hold:  false,
 	}
 	return m.adapter.Hold(ctx, req)
 }
 
-// Signal sends a signal to a job
-func (m *adapterJobManager) Signal(ctx context.Context, jobID string, signal string) error {
+// Signal sends a signal, given by name or number, to a job. Only the batch
+// shell is signalled with opts.BatchOnly; a single step is signalled with
+// scancel, as slurmrestd cannot address steps.
+func (m *adapterJobManager) Signal(ctx context.Context, jobID string, signal string, opts *types.SignalOptions) error {
 	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid job JobId: %w", err)
 	}
+	number, err := signalNumber(signal)
+	if err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = &types.SignalOptions{}
+	}
+	if opts.StepID != "" {
+		if opts.BatchOnly {
+			return errors.NewValidationErrorf("StepID", opts.StepID, "StepID and BatchOnly cannot both be set")
+		}
+		if m.cli == nil {
+			return errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+				"signalling a single job step requires the Slurm CLI fallback")
+		}
+		return m.cli.SignalStep(ctx, jobID, opts.StepID, number)
+	}
 	req := &types.JobSignalRequest{
-		JobId:  int32(jobIDInt),
-		Signal: signal,
+		JobId:     jobIDInt,
+		Signal:    strconv.Itoa(number),
+		BatchOnly: opts.BatchOnly,
 	}
 	return m.adapter.Signal(ctx, req)
 }
@@ -517,79 +906,90 @@ func (m *adapterJobManager) Signal(ctx context.Context, jobID string, signal str
 // Notify sends a message to a job
 func (m *adapterJobManager) Notify(ctx context.Context, jobID string, message string) error {
 	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid job JobId: %w", err)
 	}
 	req := &types.JobNotifyRequest{
-		JobId:   int32(jobIDInt),
+		JobId:   jobIDInt,
 		Message: message,
 	}
 	return m.adapter.Notify(ctx, req)
 }
 
-// Requeue requeues a job
-func (m *adapterJobManager) Requeue(ctx context.Context, jobID string) error {
-	// Convert string to int32 for adapter
-	jobIDInt, err := strconv.ParseInt(jobID, 10, 32)
+// Requeue requeues a job. With opts.Hold the job is held once it is back
+// in the queue, through a second request after the requeue, so the two are
+// not atomic. With opts.Increment its restart count is read before and
+// after, and a requeue that left the count unchanged is reported as an
+// error.
+func (m *adapterJobManager) Requeue(ctx context.Context, jobID string, opts *types.RequeueOptions) error {
+	// Convert string to int64 for adapter
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid job JobId: %w", err)
 	}
-	return m.adapter.Requeue(ctx, int32(jobIDInt))
-}
-
-func (m *adapterJobManager) Watch(ctx context.Context, opts *types.WatchJobsOptions) (<-chan types.JobEvent, error) {
-	// Convert WatchJobsOptions to types.JobWatchOptions
-	adapterOpts := &types.JobWatchOptions{}
+	if opts == nil {
+		opts = &types.RequeueOptions{}
+	}
 
-	if opts != nil {
-		// Convert JobIDs from []string to []int32
-		if len(opts.JobIDs) > 0 {
-			// Just watch the first job ID for now (adapter expects single job ID)
-			jobIDInt, err := strconv.ParseInt(opts.JobIDs[0], 10, 32)
-			if err == nil {
-				adapterOpts.JobId = int32(jobIDInt)
-			}
+	restarts := 0
+	if opts.Increment {
+		job, err := m.adapter.Get(ctx, jobIDInt)
+		if err != nil {
+			return err
+		}
+		restarts = job.RequeueCount()
+	}
+	if err := m.adapter.Requeue(ctx, jobIDInt); err != nil {
+		if requeueErr := errors.ParseNotRequeueable(jobID, err); requeueErr != nil {
+			return requeueErr
+		}
+		return err
+	}
+	if opts.Hold {
+		if err := m.adapter.Hold(ctx, &types.JobHoldRequest{JobId: jobIDInt, Hold: true}); err != nil {
+			return fmt.Errorf("job %s was requeued but not held: %w", jobID, err)
 		}
-
-		// Note: WatchJobsOptions.States (job states like RUNNING, PENDING) are not
-		// mapped to JobWatchOptions.EventTypes (event names like start, end, fail)
-		// as they represent fundamentally different concepts.
-		// State filtering must be implemented at a higher level through event filtering.
 	}
+	if opts.Increment {
+		job, err := m.adapter.Get(ctx, jobIDInt)
+		if err != nil {
+			return fmt.Errorf("job %s was requeued but its restart count could not be read: %w", jobID, err)
+		}
+		if job.RequeueCount() <= restarts {
+			return errors.NewSlurmError(errors.ErrorCodeConflict,
+				fmt.Sprintf("job %s was requeued but its restart count is still %d", jobID, job.RequeueCount()))
+		}
+	}
+	return nil
+}
 
-	// Call adapter's Watch method
-	adapterEventChan, err := m.adapter.Watch(ctx, adapterOpts)
+// Watch polls the job listing through watch.JobPoller and reports the
+// jobs that were added, changed state, reason or nodes, or left it.
+func (m *adapterJobManager) Watch(ctx context.Context, opts *types.WatchJobsOptions) (<-chan types.JobEvent, error) {
+	// Poll the job list, stopping when the client shuts down
+	ctx, cancel := m.life.bindWatch(ctx, m.watchTimeout)
+	events, err := watch.NewJobPoller(m.List).WithBufferSize(10).Watch(ctx, opts)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
-	// Create interface event channel
-	interfaceEventChan := make(chan types.JobEvent, 10)
-
-	// Start goroutine to convert events
-	go func() {
-		defer close(interfaceEventChan)
-
-		for adapterEvent := range adapterEventChan {
-			// Convert types.JobWatchEvent to types.JobEvent
-			interfaceEvent := types.JobEvent{
-				EventType:     adapterEvent.EventType,
-				JobId:         adapterEvent.JobId,
-				PreviousState: adapterEvent.PreviousState,
-				NewState:      adapterEvent.NewState,
-				EventTime:     adapterEvent.EventTime,
-			}
+	eventChan := make(chan types.JobEvent, 10)
+	m.life.goroutine(func() {
+		defer cancel()
+		defer close(eventChan)
 
+		for event := range events {
 			select {
-			case interfaceEventChan <- interfaceEvent:
+			case eventChan <- event:
 			case <-ctx.Done():
 				return
 			}
 		}
-	}()
+	})
 
-	return interfaceEventChan, nil
+	return eventChan, nil
 }
 
 // Allocate allocates resources for a job
@@ -618,7 +1018,10 @@ func (m *adapterJobManager) Allocate(ctx context.Context, req *types.JobAllocate
 
 // adapterNodeManager wraps a common.NodeAdapter to implement types.NodeManager
 type adapterNodeManager struct {
-	adapter common.NodeAdapter
+	adapter      common.NodeAdapter
+	life         *clientLifecycle
+	cli          *cli.Runner
+	watchTimeout time.Duration
 }
 
 func (m *adapterNodeManager) List(ctx context.Context, opts *types.ListNodesOptions) (*types.NodeList, error) {
@@ -633,8 +1036,11 @@ func (m *adapterNodeManager) List(ctx context.Context, opts *types.ListNodesOpti
 		if opts.Partition != "" {
 			adapterOpts.Partitions = []string{opts.Partition}
 		}
-		adapterOpts.Limit = opts.Limit
-		adapterOpts.Offset = opts.Offset
+		if !opts.MPSAvailable {
+			adapterOpts.Limit = opts.Limit
+			adapterOpts.Offset = opts.Offset
+		}
+		adapterOpts.Flags = opts.Flags
 	}
 
 	// Call adapter
@@ -642,6 +1048,9 @@ func (m *adapterNodeManager) List(ctx context.Context, opts *types.ListNodesOpti
 	if err != nil {
 		return nil, err
 	}
+	if result != nil && opts != nil && opts.MPSAvailable {
+		result = filterMPSAvailable(result, opts.Offset, opts.Limit)
+	}
 
 	// Check if result is nil before accessing it
 	if result == nil {
@@ -657,14 +1066,16 @@ func (m *adapterNodeManager) List(ctx context.Context, opts *types.ListNodesOpti
 		Total: result.Total,
 	}
 
+	normalizeSlices(nodeList)
 	return nodeList, nil
 }
 
 func (m *adapterNodeManager) Get(ctx context.Context, nodeName string) (*types.Node, error) {
 	node, err := m.adapter.Get(ctx, nodeName)
 	if err != nil {
-		return nil, err
+		return nil, errors.ParseNotFound(err, errors.ErrorCodeNodeNotFound, nodeName)
 	}
+	normalizeSlices(node)
 	return node, nil
 }
 
@@ -673,55 +1084,33 @@ func (m *adapterNodeManager) Update(ctx context.Context, nodeName string, update
 	return m.adapter.Update(ctx, nodeName, update)
 }
 
+// Watch polls the node listing through watch.NodePoller and reports the
+// existing nodes, a synced bookmark, and then the nodes that were added,
+// changed state or reason, or left it.
 func (m *adapterNodeManager) Watch(ctx context.Context, opts *types.WatchNodesOptions) (<-chan types.NodeEvent, error) {
-	// Convert WatchNodesOptions to types.NodeWatchOptions
-	adapterOpts := &types.NodeWatchOptions{}
-
-	if opts != nil {
-		// Convert node names
-		if len(opts.NodeNames) > 0 {
-			adapterOpts.NodeNames = opts.NodeNames
-		}
-
-		// Convert states
-		if len(opts.States) > 0 {
-			for _, state := range opts.States {
-				adapterOpts.States = append(adapterOpts.States, types.NodeState(state))
-			}
-		}
-
-		// Convert partition
-		if opts.Partition != "" {
-			adapterOpts.Partitions = []string{opts.Partition}
-		}
-	}
-
-	// Call adapter's Watch method
-	adapterEventChan, err := m.adapter.Watch(ctx, adapterOpts)
+	// Poll the node list, stopping when the client shuts down
+	ctx, cancel := m.life.bindWatch(ctx, m.watchTimeout)
+	events, err := watch.NewNodePoller(m.List).WithBufferSize(10).Watch(ctx, opts)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
-	// Create interface event channel
-	interfaceEventChan := make(chan types.NodeEvent, 10)
-
-	// Start goroutine to convert events
-	go func() {
-		defer close(interfaceEventChan)
-
-		for adapterEvent := range adapterEventChan {
-			// Since types.NodeEvent = types.NodeEvent, just pass it through
-			interfaceEvent := adapterEvent
+	eventChan := make(chan types.NodeEvent, 10)
+	m.life.goroutine(func() {
+		defer cancel()
+		defer close(eventChan)
 
+		for event := range events {
 			select {
-			case interfaceEventChan <- interfaceEvent:
+			case eventChan <- event:
 			case <-ctx.Done():
 				return
 			}
 		}
-	}()
+	})
 
-	return interfaceEventChan, nil
+	return eventChan, nil
 }
 
 // Delete deletes a node
@@ -729,20 +1118,13 @@ func (m *adapterNodeManager) Delete(ctx context.Context, nodeName string) error
 	return m.adapter.Delete(ctx, nodeName)
 }
 
-// Drain drains a node, preventing new jobs from being scheduled on it
-func (m *adapterNodeManager) Drain(ctx context.Context, nodeName string, reason string) error {
-	return m.adapter.Drain(ctx, nodeName, reason)
-}
-
-// Resume resumes a drained node, allowing new jobs to be scheduled on it
-func (m *adapterNodeManager) Resume(ctx context.Context, nodeName string) error {
-	return m.adapter.Resume(ctx, nodeName)
-}
-
 // Helper function to convert types.Node to types.Node
 // adapterPartitionManager wraps a common.PartitionAdapter
 type adapterPartitionManager struct {
-	adapter common.PartitionAdapter
+	adapter      common.PartitionAdapter
+	life         *clientLifecycle
+	cli          *cli.Runner
+	watchTimeout time.Duration
 }
 
 func (m *adapterPartitionManager) List(ctx context.Context, opts *types.ListPartitionsOptions) (*types.PartitionList, error) {
@@ -752,6 +1134,7 @@ func (m *adapterPartitionManager) List(ctx context.Context, opts *types.ListPart
 		adapterOpts.Names = opts.States // Using States as Names for now
 		adapterOpts.Limit = opts.Limit
 		adapterOpts.Offset = opts.Offset
+		adapterOpts.Flags = opts.Flags
 	}
 
 	// Call adapter
@@ -774,182 +1157,166 @@ func (m *adapterPartitionManager) List(ctx context.Context, opts *types.ListPart
 		Total:      result.Total,
 	}
 
+	normalizeSlices(partitionList)
 	return partitionList, nil
 }
 
 func (m *adapterPartitionManager) Get(ctx context.Context, partitionName string) (*types.Partition, error) {
 	partition, err := m.adapter.Get(ctx, partitionName)
 	if err != nil {
-		return nil, err
+		return nil, errors.ParseNotFound(err, errors.ErrorCodePartitionNotFound, partitionName)
 	}
+	normalizeSlices(partition)
 	return partition, nil
 }
 
 func (m *adapterPartitionManager) Update(ctx context.Context, partitionName string, update *types.PartitionUpdate) error {
-	// Convert update request
+	// Pass every set field through; nil fields are left unchanged
 	adapterUpdate := &types.PartitionUpdate{}
 	if update != nil {
-		if update.MaxTime != nil {
-			maxTime := int32(*update.MaxTime)
-			adapterUpdate.MaxTime = &maxTime
+		if update.DefaultTime != nil && update.MaxTime != nil && *update.DefaultTime > *update.MaxTime {
+			return errors.NewValidationErrorf("DefaultTime", *update.DefaultTime,
+				"default time %d exceeds max time %d", *update.DefaultTime, *update.MaxTime)
 		}
-		if update.DefaultTime != nil {
-			defaultTime := int32(*update.DefaultTime)
-			adapterUpdate.DefaultTime = &defaultTime
+		if update.MinNodes != nil && update.MaxNodes != nil && *update.MinNodes > *update.MaxNodes {
+			return errors.NewValidationErrorf("MinNodes", *update.MinNodes,
+				"min nodes %d exceeds max nodes %d", *update.MinNodes, *update.MaxNodes)
 		}
-		if update.State != nil {
-			// Convert string to PartitionState
-			state := types.PartitionState(*update.State)
-			adapterUpdate.State = &state
+		if update.GraceTime != nil && *update.GraceTime < 0 {
+			return errors.NewValidationErrorf("GraceTime", *update.GraceTime,
+				"grace time must not be negative")
 		}
-		// Add other fields as needed
+		if update.OverTimeLimit != nil &&
+			(*update.OverTimeLimit < 0 || *update.OverTimeLimit > int32(types.OverTimeLimitUnlimited)) {
+			return errors.NewValidationErrorf("OverTimeLimit", *update.OverTimeLimit,
+				"over time limit must be between 0 and %d minutes", types.OverTimeLimitUnlimited)
+		}
+		*adapterUpdate = *update
 	}
 
-	return m.adapter.Update(ctx, partitionName, adapterUpdate)
+	err := m.adapter.Update(ctx, partitionName, adapterUpdate)
+	if m.cli != nil && errors.GetErrorCode(err) == errors.ErrorCodeUnsupportedOperation {
+		return m.cli.UpdatePartition(ctx, partitionName, adapterUpdate)
+	}
+	return err
 }
 
 func (m *adapterPartitionManager) Watch(ctx context.Context, opts *types.WatchPartitionsOptions) (<-chan types.PartitionEvent, error) {
-	// Implement polling-based watch since the adapter layer doesn't have Watch
-	eventChan := make(chan types.PartitionEvent, 10)
+	// The adapter layer doesn't have Watch, so poll the partition list
+	ctx, cancel := m.life.bindWatch(ctx, m.watchTimeout)
+	lister := func() ([]types.Partition, error) {
+		result, err := m.adapter.List(ctx, &types.PartitionListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if opts == nil || len(opts.PartitionNames) == 0 {
+			return result.Partitions, nil
+		}
+		partitions := make([]types.Partition, 0, len(opts.PartitionNames))
+		for _, p := range result.Partitions {
+			for _, pn := range opts.PartitionNames {
+				if p.Name != nil && *p.Name == pn {
+					partitions = append(partitions, p)
+					break
+				}
+			}
+		}
+		return partitions, nil
+	}
+	events, err := watch.Watch(ctx, lister, func(p types.Partition) string { return derefString(p.Name) }, &watch.Options[types.Partition]{
+		BufferSize: 10,
+		Equal: func(previous, current types.Partition) bool {
+			return partitionState(&previous) == partitionState(&current)
+		},
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
 
-	go func() {
+	eventChan := make(chan types.PartitionEvent, 10)
+	m.life.goroutine(func() {
+		defer cancel()
 		defer close(eventChan)
 
-		// Track previous partition states
-		prevPartitions := make(map[string]*types.Partition)
-		pollInterval := 5 * time.Second
-
-		ticker := time.NewTicker(pollInterval)
-		defer ticker.Stop()
-
-		// Helper to get state from partition
-		getState := func(p *types.Partition) string {
-			if p != nil && p.Partition != nil && len(p.Partition.State) > 0 {
-				return string(p.Partition.State[0])
+		for e := range events {
+			event := types.PartitionEvent{
+				EventTime:     e.Time,
+				PartitionName: e.Key,
+			}
+			switch e.Type {
+			case watch.EventAdded:
+				current := e.Object
+				event.EventType = "created"
+				event.Partition = &current
+				event.NewState = partitionState(&current)
+			case watch.EventModified:
+				current := e.Object
+				event.EventType = "state_change"
+				event.Partition = &current
+				event.PreviousState = partitionState(&e.Previous)
+				event.NewState = partitionState(&current)
+			case watch.EventDeleted:
+				event.EventType = "deleted"
 			}
-			return ""
-		}
-
-		for {
 			select {
+			case eventChan <- event:
 			case <-ctx.Done():
 				return
-			case <-ticker.C:
-				// Fetch current partitions
-				result, err := m.adapter.List(ctx, &types.PartitionListOptions{})
-				if err != nil {
-					continue // Skip this poll on error
-				}
-
-				currentPartitions := make(map[string]*types.Partition)
-				for i := range result.Partitions {
-					p := &result.Partitions[i]
-					name := ""
-					if p.Name != nil {
-						name = *p.Name
-					}
-					if name == "" {
-						continue
-					}
-					// Filter by partition names if specified
-					if opts != nil && len(opts.PartitionNames) > 0 {
-						found := false
-						for _, pn := range opts.PartitionNames {
-							if pn == name {
-								found = true
-								break
-							}
-						}
-						if !found {
-							continue
-						}
-					}
-					currentPartitions[name] = p
-				}
-
-				// Detect changes
-				now := time.Now()
-
-				// Check for new or changed partitions
-				for name, current := range currentPartitions {
-					prev, existed := prevPartitions[name]
-					if !existed {
-						// New partition
-						if len(prevPartitions) > 0 { // Skip first poll
-							event := types.PartitionEvent{
-								EventTime:     now,
-								EventType:     "created",
-								PartitionName: name,
-								Partition:     current,
-								NewState:      types.PartitionState(getState(current)),
-							}
-							select {
-							case eventChan <- event:
-							case <-ctx.Done():
-								return
-							}
-						}
-					} else {
-						// Check for state change
-						prevState := getState(prev)
-						currState := getState(current)
-						if prevState != currState {
-							event := types.PartitionEvent{
-								EventTime:     now,
-								EventType:     "state_change",
-								PartitionName: name,
-								PreviousState: types.PartitionState(prevState),
-								NewState:      types.PartitionState(currState),
-								Partition:     current,
-							}
-							select {
-							case eventChan <- event:
-							case <-ctx.Done():
-								return
-							}
-						}
-					}
-				}
-
-				// Check for deleted partitions
-				for name := range prevPartitions {
-					if _, exists := currentPartitions[name]; !exists {
-						event := types.PartitionEvent{
-							EventTime:     now,
-							EventType:     "deleted",
-							PartitionName: name,
-						}
-						select {
-						case eventChan <- event:
-						case <-ctx.Done():
-							return
-						}
-					}
-				}
-
-				prevPartitions = currentPartitions
 			}
 		}
-	}()
+	})
 
 	return eventChan, nil
 }
 
-// Create creates a new partition
+// partitionState returns the first state of a partition
+func partitionState(p *types.Partition) types.PartitionState {
+	if p.Partition != nil && len(p.Partition.State) > 0 {
+		return types.PartitionState(p.Partition.State[0])
+	}
+	return ""
+}
+
+// Create creates a new partition. slurmrestd cannot create partitions, so
+// when the adapter reports the operation as unsupported the partition is
+// created with scontrol if the CLI fallback is configured.
 func (m *adapterPartitionManager) Create(ctx context.Context, partition *types.PartitionCreate) (*types.PartitionCreateResponse, error) {
-	// Since types.PartitionCreate = types.PartitionCreate, no conversion needed
+	if partition == nil {
+		return nil, errors.NewValidationErrorf("partition", partition, "partition is required")
+	}
+	if partition.Name == "" {
+		return nil, errors.NewValidationErrorf("Name", partition.Name, "partition name is required")
+	}
+	if partition.DefaultTime > 0 && partition.MaxTime > 0 && partition.DefaultTime > partition.MaxTime {
+		return nil, errors.NewValidationErrorf("DefaultTime", partition.DefaultTime,
+			"default time %d exceeds max time %d", partition.DefaultTime, partition.MaxTime)
+	}
+
 	resp, err := m.adapter.Create(ctx, partition)
+	if m.cli != nil && errors.GetErrorCode(err) == errors.ErrorCodeUnsupportedOperation {
+		if err := m.cli.CreatePartition(ctx, partition); err != nil {
+			return nil, err
+		}
+		return &types.PartitionCreateResponse{PartitionName: partition.Name}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-
-	// Since types.PartitionCreateResponse = types.PartitionCreateResponse, just return it
 	return resp, nil
 }
 
-// Delete deletes a partition
+// Delete deletes a partition, through scontrol when slurmrestd cannot and
+// the CLI fallback is configured
 func (m *adapterPartitionManager) Delete(ctx context.Context, partitionName string) error {
-	return m.adapter.Delete(ctx, partitionName)
+	if partitionName == "" {
+		return errors.NewValidationErrorf("partitionName", partitionName, "partition name is required")
+	}
+	err := m.adapter.Delete(ctx, partitionName)
+	if m.cli != nil && errors.GetErrorCode(err) == errors.ErrorCodeUnsupportedOperation {
+		return m.cli.DeletePartition(ctx, partitionName)
+	}
+	return err
 }
 
 // Helper function to convert types.Account to types.Account
@@ -960,14 +1327,25 @@ func (m *adapterPartitionManager) Delete(ctx context.Context, partitionName stri
 
 // adapterInfoManager provides info operations via the adapter
 type adapterInfoManager struct {
-	adapter common.InfoAdapter
-	version string
+	adapter    common.InfoAdapter
+	standalone common.StandaloneAdapter
+	version    string
+	tres       *tresCache
+
+	// now and after stand in for time.Now and time.After when set, so
+	// tests can run QueueDepthHistory on a fake clock
+	now   func() time.Time
+	after func(time.Duration) <-chan time.Time
 }
 
 func (m *adapterInfoManager) Ping(ctx context.Context) error {
 	return m.adapter.Ping(ctx)
 }
 
+func (m *adapterInfoManager) PingControllers(ctx context.Context) ([]types.PingResult, error) {
+	return m.adapter.PingControllers(ctx)
+}
+
 func (m *adapterInfoManager) Get(ctx context.Context) (*types.ClusterInfo, error) {
 	result, err := m.adapter.Get(ctx)
 	if err != nil {
@@ -1077,6 +1455,7 @@ func (m *adapterQoSManager) List(ctx context.Context, opts *types.ListQoSOptions
 		Total: result.Total,
 	}
 
+	normalizeSlices(qosList)
 	return qosList, nil
 }
 
@@ -1085,6 +1464,7 @@ func (m *adapterQoSManager) Get(ctx context.Context, qosName string) (*types.QoS
 	if err != nil {
 		return nil, err
 	}
+	normalizeSlices(qos)
 	return qos, nil
 }
 
@@ -1109,17 +1489,28 @@ func (m *adapterQoSManager) Create(ctx context.Context, qos *types.QoSCreate) (*
 }
 
 func (m *adapterQoSManager) Update(ctx context.Context, qosName string, update *types.QoSUpdate) error {
-	// Convert update request
-	adapterUpdate := &types.QoSUpdate{}
-	if update.Description != nil {
-		adapterUpdate.Description = update.Description
+	if update == nil {
+		return errors.NewValidationErrorf("update", nil, "update is required")
 	}
-	if update.Priority != nil {
-		adapterUpdate.Priority = update.Priority
+	if update.ParentQoS != nil { //nolint:staticcheck // SA1019: rejects the deprecated field
+		return errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation, "slurmdbd does not support a parent QoS")
+	}
+	tresLimits := map[string]*string{
+		"MaxTRESPerUser":    update.MaxTRESPerUser,
+		"MaxTRESPerAccount": update.MaxTRESPerAccount,
+		"MaxTRESPerJob":     update.MaxTRESPerJob,
+	}
+	for field, value := range tresLimits {
+		if value == nil {
+			continue
+		}
+		if _, err := types.ParseTRESList(*value); err != nil {
+			return errors.NewValidationErrorf(field, *value, "%v", err)
+		}
 	}
-	// Add other fields as needed
 
-	return m.adapter.Update(ctx, qosName, adapterUpdate)
+	// Nil fields are left out of the request, so they keep their value
+	return m.adapter.Update(ctx, qosName, update)
 }
 
 func (m *adapterQoSManager) Delete(ctx context.Context, qosName string) error {
@@ -1129,6 +1520,7 @@ func (m *adapterQoSManager) Delete(ctx context.Context, qosName string) error {
 type adapterAccountManager struct {
 	adapter            common.AccountAdapter
 	associationAdapter common.AssociationAdapter
+	jobAdapter         common.JobAdapter
 }
 
 func (m *adapterAccountManager) List(ctx context.Context, opts *types.ListAccountsOptions) (*types.AccountList, error) {
@@ -1160,18 +1552,25 @@ func (m *adapterAccountManager) List(ctx context.Context, opts *types.ListAccoun
 		Total:    result.Total,
 	}
 
+	normalizeSlices(accountList)
 	return accountList, nil
 }
 
 func (m *adapterAccountManager) Get(ctx context.Context, accountName string) (*types.Account, error) {
 	account, err := m.adapter.Get(ctx, accountName)
 	if err != nil {
-		return nil, err
+		return nil, errors.ParseNotFound(err, errors.ErrorCodeAccountNotFound, accountName)
 	}
+	normalizeSlices(account)
 	return account, nil
 }
 
 func (m *adapterAccountManager) Create(ctx context.Context, account *types.AccountCreate) (*types.AccountCreateResponse, error) {
+	if hasTRESMinuteLimits(account.GrpTRESMins, account.GrpTRESRunMins, account.MaxTRESMinsPerJob) {
+		return nil, errors.NewValidationErrorf("account", account.Name,
+			"TRES-minute limits are kept on associations; set them with Update once account %s is associated with a cluster", account.Name)
+	}
+
 	// Convert create request
 	adapterCreate := &types.AccountCreate{
 		Name:         account.Name,
@@ -1189,7 +1588,20 @@ func (m *adapterAccountManager) Create(ctx context.Context, account *types.Accou
 	return resp, nil
 }
 
+// Update updates the account record, then applies any TRES-minute limits
+// to the account's associations. The associations are listed and checked
+// before the first write, but the writes are separate requests: when an
+// association update fails, the account record and the associations
+// updated before it keep their changes.
 func (m *adapterAccountManager) Update(ctx context.Context, accountName string, update *types.AccountUpdate) error {
+	var assocUpdates []*types.AssociationUpdate
+	if hasTRESMinuteLimits(update.GrpTRESMins, update.GrpTRESRunMins, update.MaxTRESMinsPerJob) {
+		var err error
+		if assocUpdates, err = m.accountTRESMinuteUpdates(ctx, accountName, update); err != nil {
+			return err
+		}
+	}
+
 	// Convert update request
 	adapterUpdate := &types.AccountUpdate{
 		Description:  update.Description,
@@ -1197,7 +1609,15 @@ func (m *adapterAccountManager) Update(ctx context.Context, accountName string,
 		// Add other fields as needed
 	}
 
-	return m.adapter.Update(ctx, accountName, adapterUpdate)
+	if err := m.adapter.Update(ctx, accountName, adapterUpdate); err != nil {
+		return err
+	}
+	for _, assocUpdate := range assocUpdates {
+		if err := m.associationAdapter.Update(ctx, strconv.Itoa(int(*assocUpdate.ID)), assocUpdate); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (m *adapterAccountManager) Delete(ctx context.Context, accountName string) error {
@@ -1258,6 +1678,8 @@ type adapterUserManager struct {
 	adapter            common.UserAdapter
 	accountAdapter     common.AccountAdapter
 	associationAdapter common.AssociationAdapter
+	qosAdapter         common.QoSAdapter
+	partitionAdapter   common.PartitionAdapter
 }
 
 func (m *adapterUserManager) List(ctx context.Context, opts *types.ListUsersOptions) (*types.UserList, error) {
@@ -1289,6 +1711,7 @@ func (m *adapterUserManager) List(ctx context.Context, opts *types.ListUsersOpti
 		Total: result.Total,
 	}
 
+	normalizeSlices(userList)
 	return userList, nil
 }
 
@@ -1297,6 +1720,7 @@ func (m *adapterUserManager) Get(ctx context.Context, userName string) (*types.U
 	if err != nil {
 		return nil, err
 	}
+	normalizeSlices(user)
 	return user, nil
 }
 
@@ -1346,6 +1770,11 @@ func (m *adapterUserManager) GetBulkAccountUsers(ctx context.Context, accountNam
 	return ext.GetBulkAccountUsers(ctx, accountNames)
 }
 
+func (m *adapterUserManager) AllowedQoS(ctx context.Context, userName, accountName string) ([]string, error) {
+	ext := &extendedUserManager{adapter: m.adapter, accountAdapter: m.accountAdapter, associationAdapter: m.associationAdapter}
+	return ext.AllowedQoS(ctx, userName, accountName)
+}
+
 // Create creates a new user
 func (m *adapterUserManager) Create(ctx context.Context, user *types.UserCreate) (*types.UserCreateResponse, error) {
 	// Since types.UserCreate = types.UserCreate, no conversion needed
@@ -1385,7 +1814,10 @@ func (m *adapterUserManager) Delete(ctx context.Context, userName string) error
 }
 
 type adapterReservationManager struct {
-	adapter common.ReservationAdapter
+	adapter  common.ReservationAdapter
+	jobs     common.JobAdapter
+	info     common.InfoAdapter
+	username string
 }
 
 func (m *adapterReservationManager) List(ctx context.Context, opts *types.ListReservationsOptions) (*types.ReservationList, error) {
@@ -1416,6 +1848,7 @@ func (m *adapterReservationManager) List(ctx context.Context, opts *types.ListRe
 		Total:        result.Total,
 	}
 
+	normalizeSlices(reservationList)
 	return reservationList, nil
 }
 
@@ -1431,6 +1864,7 @@ func (m *adapterReservationManager) Get(ctx context.Context, reservationName str
 		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("reservation %s not found", reservationName))
 	}
 
+	normalizeSlices(result)
 	return result, nil
 }
 
@@ -1457,7 +1891,10 @@ func (m *adapterReservationManager) Delete(ctx context.Context, reservationName
 }
 
 type adapterAssociationManager struct {
-	adapter common.AssociationAdapter
+	adapter          common.AssociationAdapter
+	accountAdapter   common.AccountAdapter
+	userAdapter      common.UserAdapter
+	partitionAdapter common.PartitionAdapter
 }
 
 func (m *adapterAssociationManager) List(ctx context.Context, opts *types.ListAssociationsOptions) (*types.AssociationList, error) {
@@ -1484,6 +1921,7 @@ func (m *adapterAssociationManager) List(ctx context.Context, opts *types.ListAs
 	}
 
 	// Since types.AssociationList = types.AssociationList, just return it
+	normalizeSlices(result)
 	return result, nil
 }
 
@@ -1503,6 +1941,7 @@ func (m *adapterAssociationManager) Get(ctx context.Context, associationID strin
 		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("association %s not found", associationID))
 	}
 
+	normalizeSlices(result)
 	return result, nil
 }
 
@@ -1536,6 +1975,10 @@ func (m *adapterAssociationManager) Update(ctx context.Context, associations []*
 	}
 	associationID := fmt.Sprintf("%d", *assoc.ID)
 
+	assoc, err := m.resolveQoSDelta(ctx, associationID, assoc)
+	if err != nil {
+		return err
+	}
 	return m.adapter.Update(ctx, associationID, assoc)
 }
 
@@ -1652,6 +2095,7 @@ func (m *adapterClusterManager) List(ctx context.Context, opts *types.ListCluste
 				filtered = append(filtered, cluster)
 			}
 		}
+		normalizeSlices(filtered)
 		return &types.ClusterList{
 			Clusters: filtered,
 			Total:    len(filtered),
@@ -1659,6 +2103,7 @@ func (m *adapterClusterManager) List(ctx context.Context, opts *types.ListCluste
 	}
 
 	// No filtering needed, return result directly (since types.ClusterList = types.ClusterList)
+	normalizeSlices(result)
 	return result, nil
 }
 
@@ -1667,6 +2112,7 @@ func (m *adapterClusterManager) Get(ctx context.Context, clusterName string) (*t
 	if err != nil {
 		return nil, err
 	}
+	normalizeSlices(cluster)
 	return cluster, nil
 }
 
@@ -1718,6 +2164,7 @@ func (m *adapterWCKeyManager) List(ctx context.Context, opts *types.WCKeyListOpt
 		})
 	}
 
+	normalizeSlices(wckeys)
 	return &types.WCKeyList{
 		WCKeys: wckeys,
 		Total:  len(wckeys),
@@ -1738,11 +2185,13 @@ func (m *adapterWCKeyManager) Get(ctx context.Context, wckeyName, user, cluster
 		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("WCKey %s not found", wcKeyID))
 	}
 
-	return &types.WCKey{
+	wckey := &types.WCKey{
 		Name:    result.Name,
 		User:    result.User,
 		Cluster: result.Cluster,
-	}, nil
+	}
+	normalizeSlices(wckey)
+	return wckey, nil
 }
 
 func (m *adapterWCKeyManager) Create(ctx context.Context, wckey *types.WCKeyCreate) (*types.WCKeyCreateResponse, error) {
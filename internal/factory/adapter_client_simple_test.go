@@ -10,6 +10,7 @@ import (
 
 	types "github.com/jontk/slurm-client/api"
 	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
 	"github.com/jontk/slurm-client/tests/helpers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -356,13 +357,24 @@ func TestAdapterClient_AssociationOperations(t *testing.T) {
 
 // Mock job adapter for testing
 type mockJobAdapter struct {
-	submitFunc func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error)
+	listFunc    func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error)
+	getFunc     func(ctx context.Context, jobID int64) (*types.Job, error)
+	cancelFunc  func(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error
+	submitFunc  func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error)
+	requeueFunc func(ctx context.Context, jobID int64) error
+	holdFunc    func(ctx context.Context, req *types.JobHoldRequest) error
 }
 
 func (m *mockJobAdapter) List(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
 	return &types.JobList{}, nil
 }
-func (m *mockJobAdapter) Get(ctx context.Context, jobID int32) (*types.Job, error) {
+func (m *mockJobAdapter) Get(ctx context.Context, jobID int64) (*types.Job, error) {
+	if m.getFunc != nil {
+		return m.getFunc(ctx, jobID)
+	}
 	return &types.Job{}, nil
 }
 func (m *mockJobAdapter) Submit(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
@@ -371,24 +383,32 @@ func (m *mockJobAdapter) Submit(ctx context.Context, job *types.JobCreate) (*typ
 	}
 	return &types.JobSubmitResponse{}, nil
 }
-func (m *mockJobAdapter) Update(ctx context.Context, jobID int32, update *types.JobUpdate) error {
+func (m *mockJobAdapter) Update(ctx context.Context, jobID int64, update *types.JobUpdate) error {
 	return nil
 }
-func (m *mockJobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCancelRequest) error {
+func (m *mockJobAdapter) Cancel(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
+	if m.cancelFunc != nil {
+		return m.cancelFunc(ctx, jobID, opts)
+	}
 	return nil
 }
 func (m *mockJobAdapter) Signal(ctx context.Context, req *types.JobSignalRequest) error {
 	return nil
 }
-func (m *mockJobAdapter) Hold(ctx context.Context, req *types.JobHoldRequest) error { return nil }
+func (m *mockJobAdapter) Hold(ctx context.Context, req *types.JobHoldRequest) error {
+	if m.holdFunc != nil {
+		return m.holdFunc(ctx, req)
+	}
+	return nil
+}
 func (m *mockJobAdapter) Notify(ctx context.Context, req *types.JobNotifyRequest) error {
 	return nil
 }
-func (m *mockJobAdapter) Requeue(ctx context.Context, jobID int32) error { return nil }
-func (m *mockJobAdapter) Watch(ctx context.Context, opts *types.JobWatchOptions) (<-chan types.JobWatchEvent, error) {
-	ch := make(chan types.JobWatchEvent)
-	close(ch)
-	return ch, nil
+func (m *mockJobAdapter) Requeue(ctx context.Context, jobID int64) error {
+	if m.requeueFunc != nil {
+		return m.requeueFunc(ctx, jobID)
+	}
+	return nil
 }
 func (m *mockJobAdapter) Allocate(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error) {
 	return &types.JobAllocateResponse{}, nil
@@ -401,7 +421,7 @@ func TestAdapterClient_SubmitRaw_PassThrough(t *testing.T) {
 	mockJob := &mockJobAdapter{
 		submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
 			capturedJob = job
-			return &types.JobSubmitResponse{JobId: int32(42)}, nil
+			return &types.JobSubmitResponse{JobId: int64(42)}, nil
 		},
 	}
 
@@ -431,7 +451,7 @@ func TestAdapterClient_SubmitRaw_PassThrough(t *testing.T) {
 	resp, err := client.Jobs().SubmitRaw(ctx, job)
 	require.NoError(t, err)
 	require.NotNil(t, resp)
-	assert.Equal(t, int32(42), resp.JobId)
+	assert.Equal(t, int64(42), resp.JobId)
 
 	// Verify the job was passed through unmodified
 	require.NotNil(t, capturedJob)
@@ -494,6 +514,181 @@ func TestAdapterClient_SubmitRaw_NilJob(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestAdapterClient_Submit_SharingFlags(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	tests := []struct {
+		name           string
+		submission     types.JobSubmission
+		expectedShared []types.SharedValue
+		expectedBind   *string
+		expectErr      bool
+	}{
+		{
+			name:           "exclusive",
+			submission:     types.JobSubmission{Name: "job", Script: "#!/bin/bash\ntrue", Exclusive: true},
+			expectedShared: []types.SharedValue{types.SharedNone},
+		},
+		{
+			name:           "oversubscribe",
+			submission:     types.JobSubmission{Name: "job", Script: "#!/bin/bash\ntrue", Oversubscribe: true},
+			expectedShared: []types.SharedValue{types.SharedOversubscribe},
+		},
+		{
+			name:         "cpu bind",
+			submission:   types.JobSubmission{Name: "job", Script: "#!/bin/bash\ntrue", CPUBind: "map_cpu:0,2"},
+			expectedBind: ptrString("map_cpu:0,2"),
+		},
+		{
+			name:       "exclusive and oversubscribe",
+			submission: types.JobSubmission{Name: "job", Script: "#!/bin/bash\ntrue", Exclusive: true, Oversubscribe: true},
+			expectErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedJob *types.JobCreate
+			client := &AdapterClient{
+				adapter: &testVersionAdapter{
+					version: "v0.0.44",
+					jobAdapter: &mockJobAdapter{
+						submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+							capturedJob = job
+							return &types.JobSubmitResponse{JobId: int64(1)}, nil
+						},
+					},
+				},
+				version: "v0.0.44",
+			}
+
+			_, err := client.Jobs().Submit(ctx, &tt.submission)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Nil(t, capturedJob)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, capturedJob)
+			assert.Equal(t, tt.expectedShared, capturedJob.Shared)
+			assert.Equal(t, tt.expectedBind, capturedJob.CPUBinding)
+		})
+	}
+}
+
+func TestAdapterClient_Submit_SpankOptions(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var capturedJob *types.JobCreate
+	mockJob := &mockJobAdapter{
+		submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+			capturedJob = job
+			return &types.JobSubmitResponse{JobId: int64(7)}, nil
+		},
+	}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:    "v0.0.44",
+			jobAdapter: mockJob,
+		},
+		version: "v0.0.44",
+	}
+
+	_, err := client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:        "spank-job",
+		Script:      "#!/bin/bash\nhostname",
+		Environment: map[string]string{"FOO": "bar"},
+		SpankOptions: map[string]string{
+			"auto_tmpdir:tmpdir":  "/scratch",
+			"private-tmp:enabled": "",
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, capturedJob)
+	assert.ElementsMatch(t, []string{
+		"FOO=bar",
+		"_SLURM_SPANK_OPTION_auto_tmpdir_tmpdir=/scratch",
+		"_SLURM_SPANK_OPTION_private_tmp_enabled=",
+	}, capturedJob.Environment)
+
+	_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:         "bad-spank-job",
+		SpankOptions: map[string]string{"tmpdir": "/scratch"},
+	})
+	require.Error(t, err)
+}
+
+func TestAdapterClient_Submit_Constraints(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var capturedJob *types.JobCreate
+	mockJob := &mockJobAdapter{
+		submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+			capturedJob = job
+			return &types.JobSubmitResponse{JobId: int64(7)}, nil
+		},
+	}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:    "v0.0.44",
+			jobAdapter: mockJob,
+		},
+		version: "v0.0.44",
+	}
+
+	_, err := client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:   "counted-job",
+		Script: "#!/bin/bash\nhostname",
+		Constraints: types.ConstraintBracket{Expr: types.ConstraintAnd{
+			types.ConstraintFeature{Name: "rack1", Count: 2},
+			types.ConstraintFeature{Name: "rack2", Count: 2},
+		}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, capturedJob)
+	assert.Equal(t, "[rack1*2&rack2*2]", *capturedJob.Constraints)
+
+	capturedJob = nil
+	_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:        "bad-constraint-job",
+		Script:      "#!/bin/bash\nhostname",
+		Constraints: types.ConstraintExpr("haswell|"),
+	})
+	require.Error(t, err)
+	assert.True(t, errors.IsValidationError(err))
+	assert.Nil(t, capturedJob)
+}
+
+func TestAdapterClient_List_Reasons(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var capturedOpts *types.JobListOptions
+	mockJob := &mockJobAdapter{
+		listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+			capturedOpts = opts
+			return &types.JobList{}, nil
+		},
+	}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:    "v0.0.44",
+			jobAdapter: mockJob,
+		},
+		version: "v0.0.44",
+	}
+
+	_, err := client.Jobs().List(ctx, &types.ListJobsOptions{
+		States:  []string{"PENDING"},
+		Reasons: []string{"Priority", "QOSMaxJobsPerUserLimit"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, capturedOpts)
+	assert.Equal(t, []string{"Priority", "QOSMaxJobsPerUserLimit"}, capturedOpts.Reasons)
+}
+
 func TestAdapterClient_Version(t *testing.T) {
 	// Test with nil adapter
 	client := &AdapterClient{
@@ -540,3 +735,77 @@ func TestAdapterClient_TypeConversions(t *testing.T) {
 	assert.Equal(t, "testaccount", *association.Account)
 	assert.Equal(t, "testcluster", *association.Cluster)
 }
+
+func TestAdapterClient_Submit_Extra(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var capturedJob *types.JobCreate
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+					capturedJob = job
+					return &types.JobSubmitResponse{JobId: 7}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	_, err := client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:   "tagged",
+		Script: "#!/bin/bash\ntrue",
+		Extra:  map[string]any{"cost_center": "physics"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, capturedJob.Extra)
+	assert.Equal(t, `{"cost_center":"physics"}`, *capturedJob.Extra)
+
+	_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:   "bad",
+		Script: "#!/bin/bash\ntrue",
+		Extra:  map[string]any{"bad": func() {}},
+	})
+	require.Error(t, err)
+}
+
+func TestAdapterClient_Submit_LimitExceeded(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	rejection := errors.NewSlurmAPIError(500, "v0.0.44", []errors.SlurmAPIErrorDetail{{
+		Description: "QOSMaxSubmitJobPerUserLimit (100/100)",
+		Source:      "slurm_submit_batch_job()",
+	}}).SlurmError
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+					return nil, rejection
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	_, err := client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:   "one-too-many",
+		Script: "#!/bin/bash\nhostname",
+	})
+	require.Error(t, err)
+	var limitErr *errors.LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "MaxSubmitJobsPerUser", limitErr.Limit)
+	assert.Equal(t, "qos", limitErr.Scope)
+	assert.Equal(t, int64(100), limitErr.Current)
+	assert.Equal(t, int64(100), limitErr.Max)
+	assert.ErrorIs(t, err, rejection)
+
+	// Rejections for other reasons are passed through
+	rejection = errors.NewSlurmError(errors.ErrorCodePartitionUnavailable, "Invalid partition name specified")
+	_, err = client.Jobs().SubmitRaw(ctx, &types.JobCreate{Script: ptrString("#!/bin/bash\nhostname")})
+	require.Error(t, err)
+	assert.False(t, errors.IsLimitExceededError(err))
+	assert.Same(t, rejection, err)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// resolveQoSDelta turns the QoSDelta of update into a full QOS list. The
+// REST API only accepts a whole list, so the association is read first and
+// the delta applied to the QOS it allows now. It returns a copy of update,
+// leaving the caller's untouched, and refuses a delta that would take away
+// the association's default QOS, which slurmdbd would reject.
+func (m *adapterAssociationManager) resolveQoSDelta(ctx context.Context, associationID string, update *types.AssociationUpdate) (*types.AssociationUpdate, error) {
+	if update.QoSDelta == nil {
+		return update, nil
+	}
+	if update.QoSList != nil {
+		return nil, errors.NewValidationErrorf("QoSDelta", update.QoSDelta.String(), "cannot be combined with QoSList")
+	}
+
+	current, err := m.adapter.Get(ctx, associationID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("association %s not found", associationID))
+	}
+
+	resolved := *update
+	resolved.QoSDelta = nil
+	resolved.QoSList = update.QoSDelta.Apply(current.AllowedQoS())
+
+	defaultQoS := current.DefaultQoS()
+	if update.DefaultQoS != nil {
+		defaultQoS = *update.DefaultQoS
+	}
+	if defaultQoS != "" && !hasQoS(resolved.QoSList, defaultQoS) {
+		return nil, errors.NewValidationErrorf("QoSDelta", update.QoSDelta.String(),
+			"would remove the default QOS %s from the association", defaultQoS)
+	}
+	return &resolved, nil
+}
+
+func hasQoS(list []string, qos string) bool {
+	for _, name := range list {
+		if name == qos {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterAssociationManager_UpdateQoSDelta(t *testing.T) {
+	for _, version := range []string{"v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			var posted map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/slurmdb/"+version+"/associations/":
+					body, _ := io.ReadAll(r.Body)
+					var request struct {
+						Associations []map[string]interface{} `json:"associations"`
+					}
+					_ = json.Unmarshal(body, &request)
+					if len(request.Associations) > 0 {
+						posted = request.Associations[0]
+					}
+					_, _ = w.Write([]byte(`{}`))
+				// v0.0.41 finds the association in the full list
+				case r.URL.Path == "/slurmdb/"+version+"/association/", r.URL.Path == "/slurmdb/"+version+"/associations/":
+					_, _ = w.Write([]byte(`{"associations": [{"id": 7, "account": "physics", "user": "alice",
+						"cluster": "main", "qos": ["normal", "scavenger"], "default": {"qos": "normal"}}]}`))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			association, err := client.Associations().Get(ctx, "7")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"normal", "scavenger"}, association.AllowedQoS())
+			assert.Equal(t, "normal", association.DefaultQoS())
+
+			// The delta is applied to the current list, which is sent whole
+			update := (&types.AssociationUpdate{ID: ptrInt32(7)}).AddQoS("urgent").RemoveQoS("scavenger")
+			require.NoError(t, client.Associations().Update(ctx, []*types.AssociationUpdate{update}))
+			require.NotNil(t, posted)
+			assert.Equal(t, []interface{}{"normal", "urgent"}, posted["qos"])
+			assert.Nil(t, update.QoSList, "the caller's update is left as it was")
+
+			// Removing the default QOS is refused before anything is sent
+			posted = nil
+			update = (&types.AssociationUpdate{ID: ptrInt32(7)}).RemoveQoS("normal")
+			err = client.Associations().Update(ctx, []*types.AssociationUpdate{update})
+			var validationErr *errors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "QoSDelta", validationErr.Field)
+			assert.Nil(t, posted)
+
+			// Unless the default changes with it
+			update.DefaultQoS = ptrString("urgent")
+			update.AddQoS("urgent")
+			require.NoError(t, client.Associations().Update(ctx, []*types.AssociationUpdate{update}))
+			assert.Equal(t, []interface{}{"scavenger", "urgent"}, posted["qos"])
+			assert.Equal(t, map[string]interface{}{"qos": "urgent"}, posted["default"])
+		})
+	}
+}
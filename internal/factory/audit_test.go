@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/audit"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/middleware"
+	"github.com/jontk/slurm-client/pkg/retry"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFactory_WithAuditLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"accounts": [], "errors": [], "warnings": []}`))
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var records []audit.Record
+	sink := audit.SinkFunc(func(record *audit.Record) error {
+		mu.Lock()
+		defer mu.Unlock()
+		records = append(records, *record)
+		return nil
+	})
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithAuditLog(sink))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	_, err = client.Accounts().Create(ctx, &types.AccountCreate{Name: "physics", Description: "Physics department"})
+	require.NoError(t, err)
+	require.NoError(t, client.Accounts().Delete(ctx, "physics"))
+
+	// Reads are not audited
+	_, err = client.Accounts().List(ctx, nil)
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, records, 2)
+
+	assert.Equal(t, http.MethodPost, records[0].Method)
+	assert.Contains(t, records[0].Path, "/slurmdb/v0.0.44/account")
+	assert.Contains(t, string(records[0].Request), `"physics"`)
+	assert.Equal(t, http.StatusOK, records[0].StatusCode)
+	assert.False(t, records[0].DryRun)
+
+	assert.Equal(t, http.MethodDelete, records[1].Method)
+	assert.Equal(t, "/slurmdb/v0.0.44/account/physics", records[1].Path)
+	assert.Equal(t, http.StatusOK, records[1].StatusCode)
+	assert.Empty(t, records[1].Error)
+	assert.False(t, records[1].Time.IsZero())
+	assert.Equal(t, 1, records[1].Attempts)
+}
+
+// TestClientFactory_WithAuditLogCLIFallback checks that commands the CLI
+// fallback runs to change the cluster are audited like REST requests
+func TestClientFactory_WithAuditLogCLIFallback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scancel is a shell script")
+	}
+	scancel := filepath.Join(t.TempDir(), "scancel")
+	require.NoError(t, os.WriteFile(scancel, []byte("#!/bin/sh\nexit 0\n"), 0o755)) // #nosec G306 -- test executable
+
+	var records []audit.Record
+	sink := audit.SinkFunc(func(record *audit.Record) error {
+		records = append(records, *record)
+		return nil
+	})
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL("http://localhost:6820"))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithAuditLog(sink))
+	require.NoError(t, factory.WithCLIFallback(cli.Config{ScancelPath: scancel}))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	require.NoError(t, client.Jobs().CancelStep(ctx, "77", "0"))
+
+	require.Len(t, records, 1)
+	assert.Equal(t, audit.MethodExec, records[0].Method)
+	assert.Equal(t, scancel, records[0].Path)
+	assert.Equal(t, []string{"77.0"}, records[0].Args)
+	assert.Empty(t, records[0].Error)
+}
+
+func TestClientFactory_WithAuditLogNilSink(t *testing.T) {
+	factory, err := NewClientFactory()
+	require.NoError(t, err)
+	assert.Error(t, factory.WithAuditLog(nil))
+}
+
+// TestClientFactory_WithAuditLogRetries checks that a retried request is
+// audited once, with the number of attempts it took
+func TestClientFactory_WithAuditLogRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && calls.Add(1) < 3 {
+			http.Error(w, "slurmdbd unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"removed_accounts": ["physics"], "errors": [], "warnings": []}`))
+	}))
+	defer server.Close()
+
+	var records []audit.Record
+	sink := audit.SinkFunc(func(record *audit.Record) error {
+		records = append(records, *record)
+		return nil
+	})
+	passThrough := func(next http.RoundTripper) http.RoundTripper { return next }
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(
+		WithBaseURL(server.URL),
+		WithRetryPolicy(retry.NewFixedDelay(3, time.Millisecond)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, factory.WithMiddleware(middleware.Middleware(passThrough)))
+	require.NoError(t, factory.WithAuditLog(sink))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	require.NoError(t, client.Accounts().Delete(ctx, "physics"))
+	assert.Equal(t, int32(3), calls.Load())
+
+	require.Len(t, records, 1)
+	assert.Equal(t, http.MethodDelete, records[0].Method)
+	assert.Equal(t, 3, records[0].Attempts)
+	assert.Equal(t, http.StatusOK, records[0].StatusCode)
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/audit"
+	"github.com/jontk/slurm-client/pkg/logging"
+)
+
+// auditTransport writes an audit record for every request that would change
+// the cluster. Reads are passed through without a record. It sits above the
+// retry middleware, and an auditAttemptTransport below it counts how many
+// attempts the request took.
+type auditTransport struct {
+	next   http.RoundTripper
+	sink   audit.Sink
+	dryRun bool
+	logger logging.Logger
+}
+
+func newAuditTransport(next http.RoundTripper, sink audit.Sink, dryRun bool, logger logging.Logger) *auditTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &auditTransport{next: next, sink: sink, dryRun: dryRun, logger: logger}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(req)
+	}
+
+	attempts := new(atomic.Int32)
+	req = req.WithContext(context.WithValue(req.Context(), auditAttemptsKey{}, attempts))
+	record := &audit.Record{
+		Time:   time.Now(),
+		User:   req.Header.Get("X-SLURM-USER-NAME"),
+		Method: req.Method,
+		Path:   req.URL.Path,
+		DryRun: t.dryRun,
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		record.Request = jsonBody(body)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		var body []byte
+		body, err = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		record.StatusCode = resp.StatusCode
+		record.Response = jsonBody(body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	record.Duration = time.Since(record.Time)
+	record.Attempts = int(attempts.Load())
+	if err != nil {
+		record.Error = err.Error()
+		resp = nil
+	}
+
+	if writeErr := t.sink.Write(record); writeErr != nil {
+		t.logger.Warn("failed to write audit record",
+			"method", record.Method,
+			"path", record.Path,
+			"error", writeErr,
+		)
+	}
+	return resp, err
+}
+
+// auditAttemptsKey carries the attempt counter of an audited request
+type auditAttemptsKey struct{}
+
+// auditAttemptTransport counts each attempt at an audited request
+type auditAttemptTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *auditAttemptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if attempts, ok := req.Context().Value(auditAttemptsKey{}).(*atomic.Int32); ok {
+		attempts.Add(1)
+	}
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(req)
+}
+
+// jsonBody returns body as raw JSON, or nil when it is empty or not JSON
+func jsonBody(body []byte) json.RawMessage {
+	if len(body) == 0 || !json.Valid(body) {
+		return nil
+	}
+	return json.RawMessage(body)
+}
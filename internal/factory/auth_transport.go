@@ -4,9 +4,15 @@
 package factory
 
 import (
+	"bytes"
+	"io"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/jontk/slurm-client/pkg/auth"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/pkg/events"
 )
 
 // authTransport wraps an http.RoundTripper to add authentication
@@ -31,18 +37,132 @@ func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Clone the request to avoid modifying the original
 	reqCopy := req.Clone(req.Context())
 
-	// Apply authentication if available
+	// Apply authentication if available. A provider that cannot produce
+	// credentials fails the request rather than sending it unauthenticated,
+	// so the caller sees why instead of a bare 401.
 	if t.auth != nil {
-		// Use the request's context for authentication
 		if err := t.auth.Authenticate(req.Context(), reqCopy); err != nil {
-			// Log error but continue - some endpoints may not need auth
-			// In production, you might want to handle this differently
-			_ = err // Explicitly ignore the error as documented above
+			authErr := errors.NewSlurmErrorWithCause(errors.ErrorCodeUnauthorized,
+				"failed to authenticate request with "+t.auth.Type()+" auth", err)
+			authErr.Details = err.Error()
+			return nil, authErr
 		}
 	}
 
 	// Execute the request
-	return t.base.RoundTrip(reqCopy)
+	resp, err := t.base.RoundTrip(reqCopy)
+	if err != nil || t.auth == nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// Surface a clear error if the server names a different auth plugin
+	expected := expectedAuthPlugin(resp.Header)
+	if expected == "" {
+		expected = bodyAuthPlugin(resp)
+	}
+	if expected != "" && !authPluginMatches(expected, t.auth.Type()) {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, errors.NewAuthPluginMismatchError(expected, t.auth.Type())
+	}
+	return t.refreshAndRetry(req, reqCopy, resp)
+}
+
+// refreshAndRetry renews the credentials of a provider that supports it
+// after sent was rejected, and sends req once more with the new ones. The
+// rejection is returned as is if the provider cannot refresh, the refresh
+// fails, or the request body cannot be replayed.
+func (t *authTransport) refreshAndRetry(req, sent *http.Request, resp *http.Response) (*http.Response, error) {
+	refresher, ok := t.auth.(auth.Refresher)
+	if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+	refreshed := events.Event{Type: events.TypeAuthRefresh, Method: req.Method, Path: req.URL.Path, StatusCode: resp.StatusCode}
+	if err := refresher.Refresh(req.Context(), sent); err != nil {
+		refreshed.Error = err.Error()
+		events.Emit(req.Context(), refreshed)
+		return resp, nil
+	}
+	events.Emit(req.Context(), refreshed)
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	if err := t.auth.Authenticate(req.Context(), retry); err != nil {
+		return resp, nil
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return t.base.RoundTrip(retry)
+}
+
+// expectedAuthPlugin extracts the auth plugin a server advertises in the
+// WWW-Authenticate header of a 401 response
+func expectedAuthPlugin(header http.Header) string {
+	for _, challenge := range header.Values("WWW-Authenticate") {
+		scheme := strings.ToLower(strings.TrimSpace(challenge))
+		if idx := strings.IndexAny(scheme, " ,"); idx >= 0 {
+			scheme = scheme[:idx]
+		}
+		switch scheme {
+		case "bearer", "jwt":
+			return "jwt"
+		case "basic":
+			return "basic"
+		case "":
+			continue
+		default:
+			return scheme
+		}
+	}
+	return ""
+}
+
+// maxAuthErrorBody caps how much of a 401 body is searched for a plugin name
+const maxAuthErrorBody = 64 << 10
+
+// authPluginPattern matches a Slurm auth plugin name such as rest_auth/jwt
+// or auth/munge, as slurmrestd writes them in its error messages
+var authPluginPattern = regexp.MustCompile(`(?:\brest_auth|\bauth)/([a-z][a-z0-9_]*)`)
+
+// bodyAuthPlugin returns the auth plugin named in the body of a 401
+// response, for servers that send no WWW-Authenticate header, as slurmrestd
+// does not. The body is left for the caller to read. When neither the header
+// nor the body names a plugin, a mismatch cannot be told from bad
+// credentials and the 401 is returned as an unauthorized error.
+func bodyAuthPlugin(resp *http.Response) string {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return ""
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxAuthErrorBody))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if err != nil {
+		return ""
+	}
+	match := authPluginPattern.FindSubmatch(bytes.ToLower(body))
+	if match == nil {
+		return ""
+	}
+	return string(match[1])
+}
+
+// authPluginMatches reports whether a provider type satisfies the plugin the
+// server expects
+func authPluginMatches(expected, providerType string) bool {
+	switch expected {
+	case "jwt":
+		return providerType == "token" || providerType == "user-token" || providerType == "jwt"
+	default:
+		return expected == providerType
+	}
 }
 
 // createAuthenticatedHTTPClient creates an HTTP client with authentication
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unsupportedPartitionAdapter rejects updates as slurmrestd does
+type unsupportedPartitionAdapter struct {
+	recordingPartitionAdapter
+}
+
+func (m *unsupportedPartitionAdapter) Update(ctx context.Context, partitionName string, update *types.PartitionUpdate) error {
+	return errors.NewClientError(errors.ErrorCodeUnsupportedOperation, "partition update not supported in this version")
+}
+
+func TestAdapterPartitionManager_Update_CLIFallback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scontrol is a shell script")
+	}
+	ctx := helpers.TestContext(t)
+
+	// A fake scontrol on PATH records the arguments it was run with
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "scontrol"), []byte(script), 0o755)) // #nosec G306 -- test executable
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	update := &types.PartitionUpdate{MaxTime: ptrInt32(60)}
+
+	// Without the fallback the unsupported error is returned
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:          "v0.0.44",
+			partitionAdapter: &unsupportedPartitionAdapter{},
+		},
+		version: "v0.0.44",
+	}
+	err := client.Partitions().Update(ctx, "debug", update)
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, errors.GetErrorCode(err))
+	assert.NoFileExists(t, argsFile)
+
+	client.SetCLIFallback(cli.NewRunner(cli.Config{}))
+	require.NoError(t, client.Partitions().Update(ctx, "debug", update))
+	args, err := os.ReadFile(argsFile) // #nosec G304 -- test file
+	require.NoError(t, err)
+	assert.Equal(t, "update PartitionName=debug MaxTime=60", strings.TrimSpace(string(args)))
+}
+
+func TestAdapterJobManager_CancelStep_CLIFallback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scancel is a shell script")
+	}
+	ctx := helpers.TestContext(t)
+
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "scancel"), []byte(script), 0o755)) // #nosec G306 -- test executable
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	// The job's own cancel endpoint must not be used, as it ends the job
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				cancelFunc: func(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
+					t.Errorf("job %d cancelled instead of its step", jobID)
+					return nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+	err := client.Jobs().CancelStep(ctx, "1234", "0")
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, errors.GetErrorCode(err))
+	assert.NoFileExists(t, argsFile)
+
+	client.SetCLIFallback(cli.NewRunner(cli.Config{}))
+	assert.True(t, errors.IsValidationError(client.Jobs().CancelStep(ctx, "abc", "0")))
+	assert.True(t, errors.IsValidationError(client.Jobs().CancelStep(ctx, "1234", "")))
+
+	require.NoError(t, client.Jobs().CancelStep(ctx, "1234", "0"))
+	args, err := os.ReadFile(argsFile) // #nosec G304 -- test file
+	require.NoError(t, err)
+	assert.Equal(t, "1234.0", strings.TrimSpace(string(args)))
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"net/http"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/events"
+)
+
+// Events returns the channel the client reports retries, rate-limit waits,
+// circuit-breaker transitions and credential refreshes on. Events are
+// dropped rather than delaying requests when the channel is not read, and
+// until Events is first called. The channel is closed by Close.
+func (c *AdapterClient) Events() <-chan types.ClientEvent {
+	return c.lifecycle().bus.Events()
+}
+
+// eventDoer attaches the client's event bus to each request, so the
+// transports and middleware below it can report to it
+type eventDoer struct {
+	next types.HTTPDoer
+	bus  *events.Bus
+}
+
+// newEventDoer wraps doer so that its requests report events to bus
+func newEventDoer(doer types.HTTPDoer, bus *events.Bus) types.HTTPDoer {
+	if doer == nil {
+		doer = &http.Client{}
+	}
+	return &eventDoer{next: doer, bus: bus}
+}
+
+func (d *eventDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.next.Do(req.WithContext(events.WithBus(req.Context(), d.bus)))
+}
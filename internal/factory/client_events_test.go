@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/events"
+	"github.com/jontk/slurm-client/pkg/middleware"
+	"github.com/jontk/slurm-client/pkg/retry"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterClient_EventsReportRetries(t *testing.T) {
+	var pings atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/ping/") && pings.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"pings": [{"hostname": "ctl", "pinged": "UP", "responding": true}]}`))
+	}))
+	defer server.Close()
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(
+		WithBaseURL(server.URL),
+		WithRetryPolicy(retry.NewFixedDelay(2, time.Millisecond)),
+	)
+	require.NoError(t, err)
+	passThrough := func(next http.RoundTripper) http.RoundTripper { return next }
+	require.NoError(t, factory.WithMiddleware(middleware.Middleware(passThrough)))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	clientEvents := client.Events()
+	require.NoError(t, client.Info().Ping(ctx))
+	assert.Equal(t, int32(2), pings.Load())
+
+	select {
+	case event := <-clientEvents:
+		assert.Equal(t, events.TypeRetry, event.Type)
+		assert.Equal(t, 1, event.Attempt)
+		assert.Equal(t, http.StatusServiceUnavailable, event.StatusCode)
+		assert.Equal(t, time.Millisecond, event.Wait)
+		assert.Equal(t, http.MethodGet, event.Method)
+		assert.True(t, strings.HasSuffix(event.Path, "/ping/"), event.Path)
+	default:
+		t.Fatal("the retry was not reported")
+	}
+
+	require.NoError(t, client.Close())
+	_, ok := <-clientEvents
+	assert.False(t, ok, "Close closes the events channel")
+}
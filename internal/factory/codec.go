@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"net/http"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/codec"
+)
+
+// codecDoer carries the client's codec: the generated clients encode
+// request bodies with the codec of their doer, and decode responses with
+// the codec attached to each request's context
+type codecDoer struct {
+	next  types.HTTPDoer
+	codec codec.Codec
+}
+
+// newCodecDoer wraps doer so that the generated clients use c, or returns
+// doer as is when c is nil
+func newCodecDoer(doer types.HTTPDoer, c codec.Codec) types.HTTPDoer {
+	if c == nil {
+		return doer
+	}
+	return &codecDoer{next: doer, codec: c}
+}
+
+// Codec implements codec.Carrier
+func (d *codecDoer) Codec() codec.Codec {
+	return d.codec
+}
+
+func (d *codecDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.next.Do(req.WithContext(codec.NewContext(req.Context(), d.codec)))
+}
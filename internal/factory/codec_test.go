@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingCodec encodes with encoding/json and records the type of each
+// value it is called with
+type recordingCodec struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (c *recordingCodec) record(method string, v any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, fmt.Sprintf("%s %T", method, v))
+}
+
+func (c *recordingCodec) Marshal(v any) ([]byte, error) {
+	c.record("Marshal", v)
+	return json.Marshal(v)
+}
+
+func (c *recordingCodec) Unmarshal(data []byte, v any) error {
+	c.record("Unmarshal", v)
+	return json.Unmarshal(data, v)
+}
+
+func TestClientFactory_WithCodec(t *testing.T) {
+	tests := []struct {
+		version string
+		calls   []string
+	}{
+		{"v0.0.40", []string{"Marshal v0_0_40.V0040JobSubmitReq", "Unmarshal *v0_0_40.V0040OpenapiJobSubmitResponse"}},
+		{"v0.0.44", []string{"Marshal v0_0_44.V0044JobSubmitReq", "Unmarshal *v0_0_44.V0044OpenapiJobSubmitResponse"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			var submitted map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.NoError(t, json.Unmarshal(body, &submitted))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"job_id": 42}`))
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			codec := &recordingCodec{}
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			require.NoError(t, factory.WithCodec(codec))
+			require.Error(t, factory.WithCodec(nil))
+
+			client, err := factory.NewClientWithVersion(ctx, tt.version)
+			require.NoError(t, err)
+			defer client.Close()
+
+			resp, err := client.Jobs().SubmitRaw(ctx, &types.JobCreate{
+				Name:   ptrString("encoded"),
+				Script: ptrString("#!/bin/bash\ntrue"),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), resp.JobId)
+
+			job, ok := submitted["job"].(map[string]any)
+			require.True(t, ok, "submitted body: %v", submitted)
+			assert.Equal(t, "encoded", job["name"])
+			// Each body passes through the codec exactly once
+			assert.Equal(t, tt.calls, codec.calls)
+		})
+	}
+}
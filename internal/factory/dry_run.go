@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/jontk/slurm-client/pkg/logging"
+)
+
+// dryRunTransport logs every request that would change the cluster instead
+// of sending it, and answers it with a synthetic success. Reads are sent as
+// usual, so operations that look something up before changing it still
+// see the cluster as it is.
+type dryRunTransport struct {
+	next   http.RoundTripper
+	logger logging.Logger
+}
+
+func newDryRunTransport(next http.RoundTripper, logger logging.Logger) *dryRunTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &dryRunTransport{next: next, logger: logger}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	t.logger.Info("dry run: request not sent",
+		"method", req.Method,
+		"url", req.URL.String(),
+		"body", string(body),
+	)
+
+	// slurmrestd answers a submission with the job ID; a dry run has none
+	response := `{"errors": [], "warnings": []}`
+	if strings.HasSuffix(req.URL.Path, "/job/submit") {
+		response = `{"job_id": 0, "step_id": "batch", "errors": [], "warnings": []}`
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader([]byte(response))),
+		ContentLength: int64(len(response)),
+		Request:       req,
+	}, nil
+}
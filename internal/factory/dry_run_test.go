@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFactory_DryRun(t *testing.T) {
+	var mu sync.Mutex
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jobs": [], "errors": [], "warnings": []}`))
+	}))
+	defer server.Close()
+
+	ctx := helpers.TestContext(t)
+	logger := &recordingLogger{}
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithLogger(logger))
+	require.NoError(t, factory.WithDryRun(true))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	resp, err := client.Jobs().Submit(ctx, &types.JobSubmission{Name: "dry", Script: "#!/bin/bash\ntrue"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.JobId)
+	require.NoError(t, client.Jobs().Cancel(ctx, "42"))
+	require.NoError(t, client.Jobs().Update(ctx, "42", &types.JobUpdate{Comment: ptrString("moved")}))
+	require.NoError(t, client.Reservations().Delete(ctx, "maint"))
+	require.NoError(t, client.Nodes().Update(ctx, "node01", &types.NodeUpdate{Reason: ptrString("testing")}))
+
+	// Reads still reach the cluster
+	_, err = client.Jobs().List(ctx, nil)
+	require.NoError(t, err)
+
+	mu.Lock()
+	assert.Equal(t, []string{http.MethodGet}, methods, "no mutating request may be sent")
+	mu.Unlock()
+
+	infos := logger.Infos()
+	require.Len(t, infos, 5)
+	assert.Contains(t, infos[0], "dry run")
+	assert.Contains(t, infos[0], "/slurm/v0.0.44/job/submit")
+	assert.Contains(t, infos[0], `"name":"dry"`)
+	assert.True(t, strings.Contains(infos[1], "DELETE") && strings.Contains(infos[1], "/job/42"), infos[1])
+	assert.Contains(t, infos[2], `"comment":"moved"`)
+	assert.Contains(t, infos[3], "/reservation/maint")
+	assert.Contains(t, infos[4], "/node/node01")
+}
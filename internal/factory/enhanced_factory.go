@@ -6,9 +6,15 @@ package factory
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/jontk/slurm-client/pkg/audit"
+	"github.com/jontk/slurm-client/pkg/auth"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/codec"
+	"github.com/jontk/slurm-client/pkg/config"
 	slurmctx "github.com/jontk/slurm-client/pkg/context"
 	"github.com/jontk/slurm-client/pkg/logging"
 	"github.com/jontk/slurm-client/pkg/metrics"
@@ -32,6 +38,8 @@ type EnhancedOptions struct {
 	// Timeouts
 	DefaultTimeout time.Duration
 	TimeoutConfig  *slurmctx.TimeoutConfig
+	// OperationTimeouts override the config's Timeouts where set
+	OperationTimeouts config.Timeouts
 
 	// Connection pooling
 	ConnectionPool *pool.HTTPClientPool
@@ -50,6 +58,35 @@ type EnhancedOptions struct {
 
 	// Debug mode
 	Debug bool
+
+	// ValidateOnCreate pings the cluster during client construction
+	ValidateOnCreate bool
+
+	// StrictDecoding warns about response fields the API schema does not define
+	StrictDecoding bool
+
+	// MaxConcurrentRequests caps the number of requests in flight (0 = unlimited)
+	MaxConcurrentRequests int
+
+	// CLIFallback runs scontrol/sacct for operations the REST API lacks
+	CLIFallback *cli.Config
+
+	// ScriptSizeWarning is the script size above which submissions are
+	// logged (nil = DefaultScriptSizeWarning, 0 = never)
+	ScriptSizeWarning *int
+
+	// DryRun logs requests that would change the cluster instead of
+	// sending them
+	DryRun bool
+
+	// IdempotentSubmit retries job submissions under the retry policy
+	IdempotentSubmit bool
+
+	// AuditSink receives a record of every request that changes the cluster
+	AuditSink audit.Sink
+
+	// Codec encodes request and decodes response bodies (default JSON)
+	Codec codec.Codec
 }
 
 type circuitBreakerConfig struct {
@@ -102,6 +139,15 @@ func (f *ClientFactory) WithTimeout(timeout time.Duration) error {
 	return nil
 }
 
+// WithOperationTimeout sets the default deadline of one class of
+// operation, overriding the config's Timeouts
+func (f *ClientFactory) WithOperationTimeout(class config.OperationClass, timeout time.Duration) error {
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	return f.enhanced.OperationTimeouts.Set(class, timeout)
+}
+
 // WithTimeoutConfig sets custom timeout configuration
 func (f *ClientFactory) WithTimeoutConfig(config *slurmctx.TimeoutConfig) error {
 	if f.enhanced == nil {
@@ -195,6 +241,174 @@ func (f *ClientFactory) WithDebug() error {
 	return nil
 }
 
+// WithValidateOnCreate enables or disables connectivity and credential
+// validation when the client is created
+func (f *ClientFactory) WithValidateOnCreate(enabled bool) error {
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.ValidateOnCreate = enabled
+	return nil
+}
+
+// WithStrictDecoding enables or disables warnings for response fields that
+// are not part of the client's API schema
+func (f *ClientFactory) WithStrictDecoding(enabled bool) error {
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.StrictDecoding = enabled
+	return nil
+}
+
+// WithMaxConcurrentRequests limits the number of requests the client has in
+// flight at once
+func (f *ClientFactory) WithMaxConcurrentRequests(n int) error {
+	if n < 0 {
+		return fmt.Errorf("max concurrent requests cannot be negative: %d", n)
+	}
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.MaxConcurrentRequests = n
+	return nil
+}
+
+// WithCLIFallback lets the client run the Slurm command-line tools for the
+// operations listed in package cli when the REST API does not support them
+func (f *ClientFactory) WithCLIFallback(config cli.Config) error {
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.CLIFallback = &config
+	return nil
+}
+
+// WithScriptSizeWarning sets the batch script size, in bytes, above which
+// job submissions are logged as a warning; zero turns the warning off. The
+// warning goes to the logger set with WithLogger and is dropped without one.
+func (f *ClientFactory) WithScriptSizeWarning(bytes int) error {
+	if bytes < 0 {
+		return fmt.Errorf("script size warning cannot be negative: %d", bytes)
+	}
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.ScriptSizeWarning = &bytes
+	return nil
+}
+
+// WithDryRun enables or disables dry-run mode, in which every request that
+// would change the cluster is logged to the WithLogger logger and answered
+// with a synthetic success instead of being sent
+func (f *ClientFactory) WithDryRun(enabled bool) error {
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.DryRun = enabled
+	return nil
+}
+
+// WithAuditLog sends a record of every request that would change the
+// cluster, with its result, to sink
+func (f *ClientFactory) WithAuditLog(sink audit.Sink) error {
+	if sink == nil {
+		return fmt.Errorf("audit sink cannot be nil")
+	}
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.AuditSink = sink
+	return nil
+}
+
+// WithCodec sets the codec the generated clients encode request and decode
+// response bodies with
+func (f *ClientFactory) WithCodec(c codec.Codec) error {
+	if c == nil {
+		return fmt.Errorf("codec cannot be nil")
+	}
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.Codec = c
+	return nil
+}
+
+// codec returns the configured codec, or nil for the default
+func (f *ClientFactory) codec() codec.Codec {
+	if f.enhanced == nil {
+		return nil
+	}
+	return f.enhanced.Codec
+}
+
+// WithIdempotentSubmit lets job submissions be retried under the retry
+// policy, for callers whose duplicate submissions are harmless
+func (f *ClientFactory) WithIdempotentSubmit(enabled bool) error {
+	if f.enhanced == nil {
+		f.enhanced = &EnhancedOptions{}
+	}
+	f.enhanced.IdempotentSubmit = enabled
+	return nil
+}
+
+// attachCLIFallback gives an adapter client a CLI runner when the fallback
+// is configured
+func (f *ClientFactory) attachCLIFallback(client SlurmClient) {
+	// The CLI would make changes the dry run only pretends to
+	if f.enhanced != nil && f.enhanced.DryRun {
+		return
+	}
+	if ac, ok := client.(*AdapterClient); ok && f.enhanced != nil && f.enhanced.CLIFallback != nil {
+		runner := cli.NewRunner(*f.enhanced.CLIFallback)
+		if f.enhanced.AuditSink != nil {
+			runner.SetAuditSink(f.cliAuditSink())
+		}
+		ac.SetCLIFallback(runner)
+	}
+}
+
+// cliAuditSink returns the audit sink for CLI fallback commands, which logs
+// the records it fails to write as the audit transport does
+func (f *ClientFactory) cliAuditSink() audit.Sink {
+	var logger logging.Logger = logging.NoOpLogger{}
+	if f.enhanced.Logger != nil {
+		logger = f.enhanced.Logger
+	}
+	sink := f.enhanced.AuditSink
+	return audit.SinkFunc(func(record *audit.Record) error {
+		if err := sink.Write(record); err != nil {
+			logger.Warn("failed to write audit record",
+				"method", record.Method,
+				"path", record.Path,
+				"error", err,
+			)
+		}
+		return nil
+	})
+}
+
+// attachIdentity tells an adapter client which user the auth provider
+// authenticates as, when the provider names one
+func (f *ClientFactory) attachIdentity(client SlurmClient) {
+	identity, ok := f.auth.(auth.Identity)
+	if !ok || identity.Username() == "" {
+		return
+	}
+	if ac, ok := client.(*AdapterClient); ok {
+		ac.SetUsername(identity.Username())
+	}
+}
+
+// attachSubmitRetries lets an adapter client retry job submissions when
+// idempotent submit is configured
+func (f *ClientFactory) attachSubmitRetries(client SlurmClient) {
+	if ac, ok := client.(*AdapterClient); ok && f.enhanced != nil && f.enhanced.IdempotentSubmit {
+		ac.SetIdempotentSubmit(true)
+	}
+}
+
 // buildEnhancedHTTPClient builds an HTTP client with all enhancements
 func (f *ClientFactory) buildEnhancedHTTPClient(ctx context.Context) *http.Client {
 	// Start with base client or pooled client
@@ -236,6 +450,46 @@ func (f *ClientFactory) buildEnhancedHTTPClient(ctx context.Context) *http.Clien
 		}
 	}
 
+	// Wrap a copy, so the caller's or pooled client is never changed and
+	// each build starts from the same transport
+	client := *baseClient
+	baseClient = &client
+
+	threshold := DefaultScriptSizeWarning
+	var logger logging.Logger = logging.NoOpLogger{}
+	if f.enhanced != nil {
+		if f.enhanced.ScriptSizeWarning != nil {
+			threshold = *f.enhanced.ScriptSizeWarning
+		}
+		if f.enhanced.Logger != nil {
+			logger = f.enhanced.Logger
+		}
+	}
+
+	// Hold back changes instead of sending them
+	if f.enhanced != nil && f.enhanced.DryRun {
+		baseClient.Transport = newDryRunTransport(baseClient.Transport, logger)
+	}
+
+	// Count the attempts of audited requests below the retry middleware,
+	// which is where each attempt is sent
+	if f.enhanced != nil && f.enhanced.AuditSink != nil {
+		baseClient.Transport = &auditAttemptTransport{next: baseClient.Transport}
+	}
+
+	// Warn about oversized batch scripts in submissions
+	baseClient.Transport = newScriptSizeTransport(baseClient.Transport, logger, threshold)
+
+	// Limit concurrent requests below the rest of the chain, so each retry
+	// attempt takes its own slot instead of holding one while backing off
+	if f.enhanced != nil && f.enhanced.MaxConcurrentRequests > 0 {
+		transport := baseClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		baseClient.Transport = middleware.WithMaxConcurrentRequests(f.enhanced.MaxConcurrentRequests)(transport)
+	}
+
 	// Apply middleware if configured
 	if f.enhanced != nil && len(f.enhanced.Middlewares) > 0 {
 		transport := baseClient.Transport
@@ -254,6 +508,22 @@ func (f *ClientFactory) buildEnhancedHTTPClient(ctx context.Context) *http.Clien
 		baseClient.Transport = transport
 	}
 
+	// Audit above the retries, so a request is recorded once however many
+	// attempts it took, and above the dry run, so held-back changes are
+	// recorded as well
+	if f.enhanced != nil && f.enhanced.AuditSink != nil {
+		baseClient.Transport = newAuditTransport(baseClient.Transport, f.enhanced.AuditSink, f.enhanced.DryRun, logger)
+	}
+
+	// Check responses for unknown fields if strict decoding is enabled
+	if f.enhanced != nil && f.enhanced.StrictDecoding {
+		logger := f.enhanced.Logger
+		if logger == nil {
+			logger = logging.NewLogger(logging.DefaultConfig())
+		}
+		baseClient.Transport = newUnknownFieldTransport(baseClient.Transport, logger)
+	}
+
 	return baseClient
 }
 
@@ -31,7 +31,7 @@ func (m *extendedAccountManager) GetAccountHierarchy(ctx context.Context, rootAc
 		return nil, err
 	}
 	if account == nil {
-		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("account %s not found", rootAccount))
+		return nil, errors.NewSlurmError(errors.ErrorCodeAccountNotFound, fmt.Sprintf("account %s not found", rootAccount))
 	}
 
 	// Get all associations to build the hierarchy
@@ -446,7 +446,7 @@ func (m *extendedAccountManager) GetAccountUsersWithPermissions(ctx context.Cont
 		return nil, err
 	}
 	if account == nil {
-		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("account %s not found", accountName))
+		return nil, errors.NewSlurmError(errors.ErrorCodeAccountNotFound, fmt.Sprintf("account %s not found", accountName))
 	}
 
 	// Get all users for this account
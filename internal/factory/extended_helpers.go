@@ -5,6 +5,7 @@ package factory
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"time"
 
@@ -222,6 +223,7 @@ func convertAssociationToUserAccountAssociation(assoc *types.Association) *types
 	}
 
 	// Check for coordinator flags
+	result.Flags = assoc.Flags
 	for _, flag := range assoc.Flags {
 		if flag == types.AssociationDefaultFlagsUsersarecoords {
 			result.IsCoordinator = true
@@ -278,6 +280,8 @@ func convertAssociationToUserAccount(assoc *types.Association) *types.UserAccoun
 		result.QoS = assoc.QoS[0]
 	}
 
+	result.Flags = assoc.Flags
+
 	if assoc.Default != nil && assoc.Default.QoS != nil {
 		result.DefaultQoS = *assoc.Default.QoS
 	}
@@ -306,8 +310,8 @@ func derefString(s *string) string {
 	return *s
 }
 
-// derefInt32 safely dereferences an int32 pointer
-func derefInt32(i *int32) int32 {
+// derefInt64 safely dereferences an int64 pointer
+func derefInt64(i *int64) int64 {
 	if i == nil {
 		return 0
 	}
@@ -496,3 +500,67 @@ func aggregateUserQuotas(associations []types.Association, userName string) *typ
 
 	return quota
 }
+
+// getAccountAllowedQoS returns the QoS list of an account-level association,
+// walking up the parent chain until an account with an explicit list is found
+func getAccountAllowedQoS(ctx context.Context, adapter common.AssociationAdapter, accountName string) ([]string, error) {
+	seen := make(map[string]bool)
+	for accountName != "" && !seen[accountName] {
+		seen[accountName] = true
+
+		result, err := adapter.List(ctx, &types.AssociationListOptions{
+			Accounts: []string{accountName},
+			Limit:    1000,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			return nil, nil
+		}
+
+		parent := ""
+		for _, assoc := range result.Associations {
+			// Account-level associations have no user
+			if assoc.User != "" || derefString(assoc.Account) != accountName {
+				continue
+			}
+			if len(assoc.QoS) > 0 {
+				return assoc.QoS, nil
+			}
+			parent = derefString(assoc.ParentAccount)
+		}
+		accountName = parent
+	}
+	return nil, nil
+}
+
+// intersectQoS combines user-level and account-level QoS lists into the
+// effective allowed set, returned sorted. If inherit is set, at least one of
+// the user's associations has no list of its own and uses the account's.
+func intersectQoS(userQoS map[string]bool, inherit bool, accountQoS []string) []string {
+	allowed := make(map[string]bool)
+	switch {
+	case len(accountQoS) == 0:
+		for qos := range userQoS {
+			allowed[qos] = true
+		}
+	case inherit:
+		for _, qos := range accountQoS {
+			allowed[qos] = true
+		}
+	default:
+		for _, qos := range accountQoS {
+			if userQoS[qos] {
+				allowed[qos] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(allowed))
+	for qos := range allowed {
+		result = append(result, qos)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -477,3 +477,48 @@ func (m *extendedUserManager) GetBulkAccountUsers(ctx context.Context, accountNa
 
 	return result, nil
 }
+
+// AllowedQoS computes the QoS names a user may request when submitting under
+// the given account. User associations without an explicit QoS list inherit
+// the account's list; when both are set only QoS present in both are allowed.
+func (m *extendedUserManager) AllowedQoS(ctx context.Context, userName, accountName string) ([]string, error) {
+	if userName == "" {
+		return nil, fmt.Errorf("user name required")
+	}
+	if accountName == "" {
+		return nil, fmt.Errorf("account name required")
+	}
+
+	associations, err := getAssociationsForUser(ctx, m.associationAdapter, userName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get associations: %w", err)
+	}
+
+	// Union the QoS lists across the user's associations for this account
+	// (there may be one per partition)
+	found := false
+	inherit := false
+	userQoS := make(map[string]bool)
+	for _, assoc := range associations {
+		if assoc.User != userName || derefString(assoc.Account) != accountName {
+			continue
+		}
+		found = true
+		if len(assoc.QoS) == 0 {
+			inherit = true
+		}
+		for _, qos := range assoc.QoS {
+			userQoS[qos] = true
+		}
+	}
+	if !found {
+		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("no association found for user %s with account %s", userName, accountName))
+	}
+
+	accountQoS, err := getAccountAllowedQoS(ctx, m.associationAdapter, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account associations: %w", err)
+	}
+
+	return intersectQoS(userQoS, inherit, accountQoS), nil
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAssociationListFunc returns a list function that filters the given
+// associations by the requested users and accounts
+func newAssociationListFunc(associations []types.Association) func(context.Context, *types.AssociationListOptions) (*types.AssociationList, error) {
+	return func(_ context.Context, opts *types.AssociationListOptions) (*types.AssociationList, error) {
+		var result []types.Association
+		for _, assoc := range associations {
+			if opts != nil && len(opts.Users) > 0 && !contains(opts.Users, assoc.User) {
+				continue
+			}
+			if opts != nil && len(opts.Accounts) > 0 && !contains(opts.Accounts, derefString(assoc.Account)) {
+				continue
+			}
+			result = append(result, assoc)
+		}
+		return &types.AssociationList{Associations: result, Total: len(result)}, nil
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAdapterUserManager_AllowedQoS(t *testing.T) {
+	associations := []types.Association{
+		// Account hierarchy: root -> physics -> astro
+		{Account: ptrString("root"), QoS: []string{"normal", "high", "debug", "long"}},
+		{Account: ptrString("physics"), ParentAccount: ptrString("root"), QoS: []string{"normal", "high", "debug"}},
+		{Account: ptrString("astro"), ParentAccount: ptrString("physics")},
+
+		// alice restricts herself to a subset that partially overlaps physics
+		{User: "alice", Account: ptrString("physics"), QoS: []string{"high", "long", "normal"}},
+		// bob inherits the account list
+		{User: "bob", Account: ptrString("physics")},
+		// carol has a partition-specific association without a list
+		{User: "carol", Account: ptrString("physics"), Partition: ptrString("gpu"), QoS: []string{"debug"}},
+		{User: "carol", Account: ptrString("physics"), Partition: ptrString("cpu")},
+		// dave is in an account that inherits from its parent
+		{User: "dave", Account: ptrString("astro"), QoS: []string{"debug", "long"}},
+	}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:            "v0.0.44",
+			associationAdapter: &mockAssociationAdapter{listFunc: newAssociationListFunc(associations)},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		user     string
+		account  string
+		expected []string
+	}{
+		{
+			name:     "user and account lists intersect",
+			user:     "alice",
+			account:  "physics",
+			expected: []string{"high", "normal"},
+		},
+		{
+			name:     "user inherits account list",
+			user:     "bob",
+			account:  "physics",
+			expected: []string{"debug", "high", "normal"},
+		},
+		{
+			name:     "partition association inherits account list",
+			user:     "carol",
+			account:  "physics",
+			expected: []string{"debug", "high", "normal"},
+		},
+		{
+			name:     "account inherits from parent",
+			user:     "dave",
+			account:  "astro",
+			expected: []string{"debug"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+
+			qos, err := client.Users().AllowedQoS(ctx, tt.user, tt.account)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, qos)
+		})
+	}
+
+	t.Run("no association with account", func(t *testing.T) {
+		ctx := helpers.TestContext(t)
+
+		_, err := client.Users().AllowedQoS(ctx, "alice", "astro")
+		require.Error(t, err)
+		assert.Equal(t, errors.ErrorCodeResourceNotFound, errors.GetErrorCode(err))
+	})
+
+	t.Run("missing arguments", func(t *testing.T) {
+		ctx := helpers.TestContext(t)
+
+		_, err := client.Users().AllowedQoS(ctx, "", "physics")
+		require.Error(t, err)
+		_, err = client.Users().AllowedQoS(ctx, "alice", "")
+		require.Error(t, err)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/jontk/slurm-client/internal/versioning"
 	"github.com/jontk/slurm-client/pkg/auth"
 	"github.com/jontk/slurm-client/pkg/config"
+	"github.com/jontk/slurm-client/pkg/errors"
 	"github.com/jontk/slurm-client/pkg/retry"
 )
 
@@ -346,20 +347,66 @@ func (f *ClientFactory) findCompatibleAPIVersion(slurmVersion string) (*versioni
 
 // createClient creates a version-specific client implementation
 func (f *ClientFactory) createClient(ctx context.Context, version *versioning.APIVersion) (SlurmClient, error) {
+	var client SlurmClient
+	var err error
+
 	switch version.String() {
 	case "v0.0.40":
-		return f.createV0_0_40Client(ctx)
+		client, err = f.createV0_0_40Client(ctx)
 	case "v0.0.41":
-		return f.createV0_0_41Client(ctx)
+		client, err = f.createV0_0_41Client(ctx)
 	case "v0.0.42":
-		return f.createV0_0_42Client(ctx)
+		client, err = f.createV0_0_42Client(ctx)
 	case "v0.0.43":
-		return f.createV0_0_43Client(ctx)
+		client, err = f.createV0_0_43Client(ctx)
 	case "v0.0.44":
-		return f.createV0_0_44Client(ctx)
+		client, err = f.createV0_0_44Client(ctx)
 	default:
 		return nil, fmt.Errorf("unsupported API version: %s", version.String())
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Eagerly verify connectivity and credentials if requested
+	if f.enhanced != nil && f.enhanced.ValidateOnCreate {
+		if err := f.validateClient(ctx, client); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// validateClient pings the cluster with the configured credentials so that
+// rejected authentication surfaces at construction time instead of on the
+// first API call
+func (f *ClientFactory) validateClient(ctx context.Context, client SlurmClient) error {
+	err := client.Info().Ping(ctx)
+	if err == nil {
+		return nil
+	}
+
+	if errors.IsAuthenticationError(err) {
+		code := errors.GetErrorCode(err)
+		if code == errors.ErrorCodeUnknown {
+			code = errors.ErrorCodeUnauthorized
+		}
+		authMethod := ""
+		if f.auth != nil {
+			authMethod = f.auth.Type()
+		}
+		return errors.NewAuthenticationError(
+			code,
+			"credentials rejected during client validation",
+			authMethod,
+			"",
+			err,
+		)
+	}
+
+	return fmt.Errorf("client validation failed: %w", err)
 }
 
 // Version-specific client creation methods (to be implemented with generated code)
@@ -372,12 +419,15 @@ func (f *ClientFactory) createV0_0_40Client(ctx context.Context) (SlurmClient, e
 	if f.auth != nil {
 		httpClient = createAuthenticatedHTTPClient(httpClient, f.auth)
 	}
+	httpClient, timeouts := f.operationTimeouts(httpClient)
 
 	// Create adapter client config
 	config := &types.ClientConfig{
 		BaseURL:    f.baseURL,
 		HTTPClient: httpClient,
 		Debug:      f.config.Debug,
+		Timeouts:   timeouts,
+		Codec:      f.codec(),
 	}
 	client, err := NewAdapterClient("v0.0.40", config)
 	if err != nil {
@@ -387,6 +437,12 @@ func (f *ClientFactory) createV0_0_40Client(ctx context.Context) (SlurmClient, e
 	if ac, ok := client.(*AdapterClient); ok && f.enhanced != nil && f.enhanced.ConnectionPool != nil {
 		ac.SetPool(f.enhanced.ConnectionPool)
 	}
+	// Flush buffered metrics and logs on Shutdown()
+	f.registerFlushers(client)
+	// Fall back to scontrol/sacct where the REST API has no endpoint
+	f.attachCLIFallback(client)
+	f.attachSubmitRetries(client)
+	f.attachIdentity(client)
 	return client, nil
 }
 
@@ -398,12 +454,15 @@ func (f *ClientFactory) createV0_0_41Client(ctx context.Context) (SlurmClient, e
 	if f.auth != nil {
 		httpClient = createAuthenticatedHTTPClient(httpClient, f.auth)
 	}
+	httpClient, timeouts := f.operationTimeouts(httpClient)
 
 	// Create adapter client config
 	config := &types.ClientConfig{
 		BaseURL:    f.baseURL,
 		HTTPClient: httpClient,
 		Debug:      f.config.Debug,
+		Timeouts:   timeouts,
+		Codec:      f.codec(),
 	}
 	client, err := NewAdapterClient("v0.0.41", config)
 	if err != nil {
@@ -413,6 +472,12 @@ func (f *ClientFactory) createV0_0_41Client(ctx context.Context) (SlurmClient, e
 	if ac, ok := client.(*AdapterClient); ok && f.enhanced != nil && f.enhanced.ConnectionPool != nil {
 		ac.SetPool(f.enhanced.ConnectionPool)
 	}
+	// Flush buffered metrics and logs on Shutdown()
+	f.registerFlushers(client)
+	// Fall back to scontrol/sacct where the REST API has no endpoint
+	f.attachCLIFallback(client)
+	f.attachSubmitRetries(client)
+	f.attachIdentity(client)
 	return client, nil
 }
 
@@ -424,12 +489,15 @@ func (f *ClientFactory) createV0_0_42Client(ctx context.Context) (SlurmClient, e
 	if f.auth != nil {
 		httpClient = createAuthenticatedHTTPClient(httpClient, f.auth)
 	}
+	httpClient, timeouts := f.operationTimeouts(httpClient)
 
 	// Create adapter client config
 	config := &types.ClientConfig{
 		BaseURL:    f.baseURL,
 		HTTPClient: httpClient,
 		Debug:      f.config.Debug,
+		Timeouts:   timeouts,
+		Codec:      f.codec(),
 	}
 	client, err := NewAdapterClient("v0.0.42", config)
 	if err != nil {
@@ -439,6 +507,12 @@ func (f *ClientFactory) createV0_0_42Client(ctx context.Context) (SlurmClient, e
 	if ac, ok := client.(*AdapterClient); ok && f.enhanced != nil && f.enhanced.ConnectionPool != nil {
 		ac.SetPool(f.enhanced.ConnectionPool)
 	}
+	// Flush buffered metrics and logs on Shutdown()
+	f.registerFlushers(client)
+	// Fall back to scontrol/sacct where the REST API has no endpoint
+	f.attachCLIFallback(client)
+	f.attachSubmitRetries(client)
+	f.attachIdentity(client)
 	return client, nil
 }
 
@@ -450,12 +524,15 @@ func (f *ClientFactory) createV0_0_43Client(ctx context.Context) (SlurmClient, e
 	if f.auth != nil {
 		httpClient = createAuthenticatedHTTPClient(httpClient, f.auth)
 	}
+	httpClient, timeouts := f.operationTimeouts(httpClient)
 
 	// Create adapter client config
 	config := &types.ClientConfig{
 		BaseURL:    f.baseURL,
 		HTTPClient: httpClient,
 		Debug:      f.config.Debug,
+		Timeouts:   timeouts,
+		Codec:      f.codec(),
 	}
 	client, err := NewAdapterClient("v0.0.43", config)
 	if err != nil {
@@ -465,6 +542,12 @@ func (f *ClientFactory) createV0_0_43Client(ctx context.Context) (SlurmClient, e
 	if ac, ok := client.(*AdapterClient); ok && f.enhanced != nil && f.enhanced.ConnectionPool != nil {
 		ac.SetPool(f.enhanced.ConnectionPool)
 	}
+	// Flush buffered metrics and logs on Shutdown()
+	f.registerFlushers(client)
+	// Fall back to scontrol/sacct where the REST API has no endpoint
+	f.attachCLIFallback(client)
+	f.attachSubmitRetries(client)
+	f.attachIdentity(client)
 	return client, nil
 }
 
@@ -476,12 +559,15 @@ func (f *ClientFactory) createV0_0_44Client(ctx context.Context) (SlurmClient, e
 	if f.auth != nil {
 		httpClient = createAuthenticatedHTTPClient(httpClient, f.auth)
 	}
+	httpClient, timeouts := f.operationTimeouts(httpClient)
 
 	// Use adapters for v0.0.44 as they are now implemented
 	config := &types.ClientConfig{
 		BaseURL:    f.baseURL,
 		HTTPClient: httpClient,
 		Debug:      f.config.Debug,
+		Timeouts:   timeouts,
+		Codec:      f.codec(),
 	}
 	client, err := NewAdapterClient("v0.0.44", config)
 	if err != nil {
@@ -491,6 +577,12 @@ func (f *ClientFactory) createV0_0_44Client(ctx context.Context) (SlurmClient, e
 	if ac, ok := client.(*AdapterClient); ok && f.enhanced != nil && f.enhanced.ConnectionPool != nil {
 		ac.SetPool(f.enhanced.ConnectionPool)
 	}
+	// Flush buffered metrics and logs on Shutdown()
+	f.registerFlushers(client)
+	// Fall back to scontrol/sacct where the REST API has no endpoint
+	f.attachCLIFallback(client)
+	f.attachSubmitRetries(client)
+	f.attachIdentity(client)
 	return client, nil
 }
 
@@ -4,13 +4,21 @@
 package factory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/fs"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/jontk/slurm-client/pkg/auth"
 	"github.com/jontk/slurm-client/pkg/config"
+	"github.com/jontk/slurm-client/pkg/errors"
 	"github.com/jontk/slurm-client/tests/helpers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -835,3 +843,294 @@ func TestClientFactory_detectVersion_WithAuthentication(t *testing.T) {
 	require.NotNil(t, version)
 	assert.Equal(t, "v0.0.44", version.String())
 }
+
+// Test eager credential validation during client creation
+func TestClientFactory_NewClient_ValidateOnCreate(t *testing.T) {
+	const validToken = "valid-token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/openapi/v3" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"info": map[string]interface{}{"version": "v0.0.44"},
+			})
+			return
+		}
+		if r.Header.Get("X-SLURM-USER-TOKEN") != validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]interface{}{{"description": "Authentication failure"}},
+			})
+			return
+		}
+		if r.URL.Path == "/slurm/v0.0.44/ping/" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP"}},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		token     string
+		validate  bool
+		expectErr bool
+	}{
+		{name: "valid credentials", token: validToken, validate: true},
+		{name: "invalid credentials", token: "bad-token", validate: true, expectErr: true},
+		{name: "invalid credentials with lazy validation", token: "bad-token", validate: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+
+			factory, err := NewClientFactory(
+				WithBaseURL(server.URL),
+				WithAuth(auth.NewTokenAuth(tt.token)),
+			)
+			require.NoError(t, err)
+			require.NoError(t, factory.WithValidateOnCreate(tt.validate))
+
+			client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Nil(t, client)
+
+				var authErr *errors.AuthenticationError
+				require.ErrorAs(t, err, &authErr)
+				assert.Equal(t, errors.ErrorCodeUnauthorized, authErr.Code)
+				assert.Equal(t, "token", authErr.AuthMethod)
+				assert.True(t, errors.IsAuthenticationError(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, client)
+			_ = client.Close()
+		})
+	}
+}
+
+// Test that a 401 advertising a different auth scheme yields a typed error
+func TestClientFactory_AuthPluginMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("X-SLURM-USER-TOKEN") == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="slurmrestd"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]interface{}{{"description": "Authentication failure"}},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP"}},
+		})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		provider     auth.Provider
+		expectedCode errors.ErrorCode
+	}{
+		{name: "basic auth against jwt server", provider: auth.NewBasicAuth("user", "pass"), expectedCode: errors.ErrorCodeAuthPluginMismatch},
+		{name: "token auth against jwt server", provider: auth.NewTokenAuth("token")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+
+			factory, err := NewClientFactory(WithBaseURL(server.URL), WithAuth(tt.provider))
+			require.NoError(t, err)
+
+			client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+			require.NoError(t, err)
+			defer func() { _ = client.Close() }()
+
+			err = client.Info().Ping(ctx)
+			if tt.expectedCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedCode, errors.GetErrorCode(err))
+			assert.True(t, errors.IsAuthenticationError(err))
+			assert.Contains(t, err.Error(), "jwt")
+		})
+	}
+}
+
+// Test that a rejected token is refreshed and the request retried once
+func TestClientFactory_RefreshTokenOnUnauthorized(t *testing.T) {
+	var valid atomic.Value
+	valid.Store("token-2")
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("X-SLURM-USER-TOKEN") != valid.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]interface{}{{"description": "Authentication failure"}},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP"}},
+		})
+	}))
+	defer server.Close()
+
+	var refreshes atomic.Int32
+	provider := auth.NewRefreshingTokenAuth(func(ctx context.Context) (string, time.Time, error) {
+		n := refreshes.Add(1)
+		return fmt.Sprintf("token-%d", n+1), time.Now().Add(30 * time.Minute), nil
+	}, auth.WithInitialToken("token-1", time.Now().Add(30*time.Minute)))
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL), WithAuth(provider))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	// token-1 has been revoked early: one refresh, one retry
+	require.NoError(t, client.Info().Ping(ctx))
+	assert.Equal(t, int32(1), refreshes.Load())
+	assert.Equal(t, int32(2), requests.Load())
+
+	// A token that is still rejected after a refresh is not retried again
+	valid.Store("never")
+	requests.Store(0)
+	require.Error(t, client.Info().Ping(ctx))
+	assert.Equal(t, int32(2), refreshes.Load())
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+// Test that a token file missing at request time fails the request with the
+// provider's error instead of sending it without a token
+func TestClientFactory_TokenFileMissingAtRequestTime(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP"}},
+		})
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "token")
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL), WithAuth(auth.NewFileTokenAuth(path)))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	err = client.Info().Ping(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.True(t, errors.IsAuthenticationError(err))
+	assert.Contains(t, err.Error(), "failed to read token file")
+	assert.Equal(t, int32(0), requests.Load())
+
+	require.NoError(t, os.WriteFile(path, []byte("token\n"), 0o600))
+	require.NoError(t, client.Info().Ping(ctx))
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+// Test that munge and refreshing providers that cannot produce a credential
+// fail the request with their own error
+func TestClientFactory_ProviderErrorFailsRequest(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP"}},
+		})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		provider auth.Provider
+		expected string
+	}{
+		{
+			name:     "munge binary missing",
+			provider: auth.NewMungeAuth(auth.WithMungePath(filepath.Join(t.TempDir(), "munge"))),
+			expected: "set its path with WithMungePath",
+		},
+		{
+			name: "refresh callback fails",
+			provider: auth.NewRefreshingTokenAuth(func(ctx context.Context) (string, time.Time, error) {
+				return "", time.Time{}, fmt.Errorf("identity provider unreachable")
+			}),
+			expected: "identity provider unreachable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests.Store(0)
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL), WithAuth(tt.provider))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+			require.NoError(t, err)
+			defer func() { _ = client.Close() }()
+
+			err = client.Info().Ping(ctx)
+			require.Error(t, err)
+			assert.Equal(t, errors.ErrorCodeUnauthorized, errors.GetErrorCode(err))
+			assert.Contains(t, err.Error(), tt.expected)
+			assert.Equal(t, int32(0), requests.Load())
+		})
+	}
+}
+
+// Test that the plugin named in slurmrestd's error body is recognised when
+// the 401 carries no WWW-Authenticate header
+func TestClientFactory_AuthPluginMismatchFromBody(t *testing.T) {
+	tests := []struct {
+		name         string
+		description  string
+		expectedCode errors.ErrorCode
+	}{
+		{name: "plugin named", description: "rest_auth/jwt: unable to authenticate request", expectedCode: errors.ErrorCodeAuthPluginMismatch},
+		{name: "no plugin named", description: "Authentication failure", expectedCode: errors.ErrorCodeUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"errors": []map[string]interface{}{{"description": tt.description}},
+				})
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL), WithAuth(auth.NewBasicAuth("user", "pass")))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+			require.NoError(t, err)
+			defer func() { _ = client.Close() }()
+
+			err = client.Info().Ping(ctx)
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedCode, errors.GetErrorCode(err))
+			assert.True(t, errors.IsAuthenticationError(err))
+		})
+	}
+}
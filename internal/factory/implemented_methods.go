@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"reflect"
+	"sort"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+)
+
+// managerInterfaces maps each manager accessor of SlurmClient to the
+// interface it returns
+var managerInterfaces = map[string]reflect.Type{
+	"Jobs":         reflect.TypeOf((*types.JobManager)(nil)).Elem(),
+	"Nodes":        reflect.TypeOf((*types.NodeManager)(nil)).Elem(),
+	"Partitions":   reflect.TypeOf((*types.PartitionManager)(nil)).Elem(),
+	"Info":         reflect.TypeOf((*types.InfoManager)(nil)).Elem(),
+	"Reservations": reflect.TypeOf((*types.ReservationManager)(nil)).Elem(),
+	"QoS":          reflect.TypeOf((*types.QoSManager)(nil)).Elem(),
+	"Accounts":     reflect.TypeOf((*types.AccountManager)(nil)).Elem(),
+	"Users":        reflect.TypeOf((*types.UserManager)(nil)).Elem(),
+	"Clusters":     reflect.TypeOf((*types.ClusterManager)(nil)).Elem(),
+	"Associations": reflect.TypeOf((*types.AssociationManager)(nil)).Elem(),
+	"WCKeys":       reflect.TypeOf((*types.WCKeyManager)(nil)).Elem(),
+	"Analytics":    reflect.TypeOf((*types.AnalyticsManager)(nil)).Elem(),
+}
+
+// unimplementedMethods lists, per API version, the manager methods whose
+// adapter answers with a not-implemented or unsupported-operation error
+// instead of calling slurmrestd. It has to be kept in step with the
+// adapters.
+var unimplementedMethods = map[string]map[string][]string{
+	"v0.0.40": {
+		"Jobs":         {"Update", "Requeue", "Allocate"},
+		"Nodes":        {"Drain", "DrainNodes", "Resume"},
+		"Partitions":   {"Create", "Update", "Delete"},
+		"Reservations": {"Create", "Update", "Skip", "StartNow", "ReserveLicenses"},
+		"QoS":          {"Create", "Update", "Delete"},
+		"Associations": {"Update", "Delete"},
+	},
+	"v0.0.41": {
+		"Jobs":         {"Hold", "Release", "Notify", "Requeue", "Allocate"},
+		"Partitions":   {"Create", "Update", "Delete"},
+		"Reservations": {"Create", "Update", "Delete", "Skip", "StartNow", "ReserveLicenses", "ReleaseLicenses"},
+		"Clusters":     {"Create"},
+		"WCKeys":       {"Create"},
+	},
+	"v0.0.42": {
+		"Jobs":         {"Notify"},
+		"Partitions":   {"Create", "Update", "Delete"},
+		"Reservations": {"Create", "Update", "Skip", "StartNow", "ReserveLicenses"},
+	},
+	"v0.0.43": {
+		"Jobs":       {"Notify"},
+		"Partitions": {"Create", "Update", "Delete"},
+	},
+	"v0.0.44": {
+		"Jobs":       {"Notify"},
+		"Partitions": {"Create", "Update", "Delete"},
+	},
+}
+
+// ImplementedMethods returns, for each manager the client provides, the
+// sorted names of the methods the negotiated API version implements.
+// Methods that fail with a not-implemented or unsupported-operation error
+// without asking slurmrestd are left out, taking the CLI fallback into
+// account. A method listed here can still be refused by the server, for
+// instance when slurmdbd is not configured.
+func (c *AdapterClient) ImplementedMethods() map[string][]string {
+	missing := make(map[string]map[string]bool)
+	for manager, methods := range unimplementedMethods[c.version] {
+		missing[manager] = make(map[string]bool, len(methods))
+		for _, method := range methods {
+			missing[manager][method] = true
+		}
+	}
+	if c.cli != nil && missing["Partitions"] != nil {
+		// Partition changes fall back to scontrol
+		delete(missing["Partitions"], "Create")
+		delete(missing["Partitions"], "Update")
+		delete(missing["Partitions"], "Delete")
+	}
+	if missing["Jobs"] == nil {
+		missing["Jobs"] = make(map[string]bool)
+	}
+	if _, ok := c.adapter.GetJobManager().(common.JobScriptAdapter); !ok && c.cli == nil {
+		missing["Jobs"]["Script"] = true
+	}
+	if _, ok := c.adapter.GetJobManager().(common.JobStepsAdapter); !ok && c.cli == nil {
+		missing["Jobs"]["ListSteps"] = true
+	}
+	if _, ok := c.adapter.GetJobManager().(common.JobTRESUsageAdapter); !ok {
+		missing["Jobs"]["TRESUsage"] = true
+	}
+	if _, ok := c.adapter.GetJobManager().(common.JobHistoryAdapter); !ok {
+		missing["Jobs"]["History"] = true
+	}
+	if c.cli == nil {
+		// slurmrestd has no reboot endpoint; reboots need scontrol
+		if missing["Nodes"] == nil {
+			missing["Nodes"] = make(map[string]bool)
+		}
+		missing["Nodes"]["Reboot"] = true
+		// nor can it address job steps; cancelling one needs scancel
+		missing["Jobs"]["CancelStep"] = true
+	}
+
+	implemented := make(map[string][]string, len(managerInterfaces))
+	for manager, iface := range managerInterfaces {
+		if manager == "Analytics" && c.Analytics() == nil {
+			continue
+		}
+		methods := make([]string, 0, iface.NumMethod())
+		for i := 0; i < iface.NumMethod(); i++ {
+			if name := iface.Method(i).Name; !missing[manager][name] {
+				methods = append(methods, name)
+			}
+		}
+		sort.Strings(methods)
+		implemented[manager] = methods
+	}
+	return implemented
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func implementedMethodsFor(t *testing.T, version string) map[string][]string {
+	t.Helper()
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, version)
+	require.NoError(t, err)
+	return client.(*AdapterClient).ImplementedMethods()
+}
+
+func TestAdapterClient_ImplementedMethods(t *testing.T) {
+	v40 := implementedMethodsFor(t, "v0.0.40")
+	v43 := implementedMethodsFor(t, "v0.0.43")
+
+	// Analytics is not provided by any version yet
+	assert.NotContains(t, v40, "Analytics")
+	assert.NotContains(t, v43, "Analytics")
+
+	assert.Equal(t, []string{"Delete", "Get", "GetPowerState", "List", "PowerUsage", "SetPowerState", "Update", "WaitForState", "Watch"}, v40["Nodes"])
+	assert.Equal(t, []string{"Delete", "Drain", "DrainNodes", "Get", "GetPowerState", "List", "PowerUsage", "Resume", "SetPowerState", "Update", "WaitForState", "Watch"}, v43["Nodes"])
+
+	// Reboots and step cancellation need the CLI fallback, which is not
+	// configured
+	assert.NotContains(t, v43["Nodes"], "Reboot")
+	assert.NotContains(t, v43["Jobs"], "CancelStep")
+
+	assert.Equal(t, []string{"Get", "List"}, v40["QoS"])
+	assert.Equal(t, []string{"Create", "Delete", "Get", "List", "Update"}, v43["QoS"])
+
+	assert.Equal(t, []string{"Delete", "DrainJobs", "ExportICal", "Get", "Jobs", "List", "ReleaseLicenses"}, v40["Reservations"])
+	assert.Equal(t, []string{"Create", "Delete", "DrainJobs", "ExportICal", "Get", "Jobs", "List", "ReleaseLicenses", "ReserveLicenses", "Skip", "StartNow", "Update"}, v43["Reservations"])
+
+	for _, method := range []string{"Update", "Requeue", "Allocate"} {
+		assert.NotContains(t, v40["Jobs"], method)
+		assert.Contains(t, v43["Jobs"], method)
+	}
+	// Job watches poll the job list, which every version serves
+	assert.Contains(t, v40["Jobs"], "Watch")
+	assert.NotContains(t, v43["Jobs"], "Notify")
+	// Scripts, steps and TRES usage come from the slurmdb job record of
+	// every version
+	assert.Contains(t, v40["Jobs"], "Script")
+	assert.Contains(t, v40["Jobs"], "ListSteps")
+	assert.Contains(t, v40["Jobs"], "TRESUsage")
+	assert.Equal(t, []string{"Get", "List", "Watch"}, v43["Partitions"])
+}
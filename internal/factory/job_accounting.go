@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// GetWithOptions retrieves a job from the live queue. With IncludeAccounting
+// set, finished jobs are completed with their slurmdbd record, which carries
+// the allocated TRES, exit codes and start/end times (and so the elapsed
+// time) that slurmctld no longer reports. A job slurmctld has already purged
+// is answered from the accounting record alone. Accounting records are read
+// from the slurmdb job endpoint, or through sacct with the CLI fallback.
+func (m *adapterJobManager) GetWithOptions(ctx context.Context, jobID string, opts *types.GetJobOptions) (*types.Job, error) {
+	if opts == nil || !opts.IncludeAccounting {
+		return m.Get(ctx, jobID)
+	}
+	getAccounting, err := m.accountingSource()
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := m.Get(ctx, jobID)
+	if errors.IsNotFoundError(err) {
+		return getAccounting(ctx, jobID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isTerminalJob(job) {
+		return job, nil
+	}
+	record, err := getAccounting(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	mergeJobAccounting(job, record)
+	return job, nil
+}
+
+// accountingSource returns the lookup of slurmdbd job records: the adapter
+// when it has one, otherwise sacct through the CLI fallback
+func (m *adapterJobManager) accountingSource() (func(ctx context.Context, jobID string) (*types.Job, error), error) {
+	if accounting, ok := m.adapter.(common.JobAccountingAdapter); ok {
+		return func(ctx context.Context, jobID string) (*types.Job, error) {
+			id, err := strconv.ParseInt(jobID, 10, 64)
+			if err != nil {
+				return nil, errors.NewValidationErrorf("jobID", jobID, "invalid job ID: %v", err)
+			}
+			return accounting.GetAccounting(ctx, id)
+		}, nil
+	}
+	if m.cli != nil {
+		return m.cli.JobAccounting, nil
+	}
+	return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+		"job accounting records are not available in this API version")
+}
+
+// isTerminalJob reports whether the job has left the running states
+func isTerminalJob(job *types.Job) bool {
+	if job.JobID == nil {
+		return false
+	}
+	for _, state := range job.JobState {
+		switch state {
+		case types.JobStateCompleted, types.JobStateCancelled, types.JobStateFailed,
+			types.JobStateTimeout, types.JobStateNodeFail, types.JobStatePreempted,
+			types.JobStateBootFail, types.JobStateDeadline, types.JobStateOutOfMemory:
+			return true
+		}
+	}
+	return false
+}
+
+// mergeJobAccounting copies the accounting fields from record into job. The
+// allocated TRES and exit codes are taken from the record, as slurmdbd holds
+// their final values; the other fields are only filled when the live record
+// lacks them.
+func mergeJobAccounting(job, record *types.Job) {
+	if record.TRESAllocStr != nil {
+		job.TRESAllocStr = record.TRESAllocStr
+	}
+	if job.TRESReqStr == nil {
+		job.TRESReqStr = record.TRESReqStr
+	}
+	if record.ExitCode != nil {
+		job.ExitCode = record.ExitCode
+	}
+	if record.DerivedExitCode != nil {
+		job.DerivedExitCode = record.DerivedExitCode
+	}
+	if job.StartTime.IsZero() {
+		job.StartTime = record.StartTime
+	}
+	if job.EndTime.IsZero() {
+		job.EndTime = record.EndTime
+	}
+	if job.Nodes == nil {
+		job.Nodes = record.Nodes
+	}
+	if job.Name == nil {
+		job.Name = record.Name
+	}
+	if job.UserName == nil {
+		job.UserName = record.UserName
+	}
+	if job.Account == nil {
+		job.Account = record.Account
+	}
+	if job.Partition == nil {
+		job.Partition = record.Partition
+	}
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAccountingJobAdapter adds slurmdbd lookups to mockJobAdapter
+type mockAccountingJobAdapter struct {
+	mockJobAdapter
+	accountingCalls int
+	accountingFunc  func(ctx context.Context, jobID int64) (*types.Job, error)
+}
+
+func (m *mockAccountingJobAdapter) GetAccounting(ctx context.Context, jobID int64) (*types.Job, error) {
+	m.accountingCalls++
+	return m.accountingFunc(ctx, jobID)
+}
+
+func TestAdapterJobManager_GetWithOptions_IncludeAccounting(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	start := time.Unix(1700000000, 0)
+	end := start.Add(90 * time.Minute)
+	rc := uint32(3)
+
+	adapter := &mockAccountingJobAdapter{
+		mockJobAdapter: mockJobAdapter{
+			getFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+				return &types.Job{
+					JobID:      ptrInt64(jobID),
+					JobState:   []types.JobState{types.JobStateCompleted},
+					TRESReqStr: ptrString("cpu=4,mem=8G"),
+					ExitCode:   &types.ExitCode{ReturnCode: ptrUint32(0)},
+				}, nil
+			},
+		},
+		accountingFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+			return &types.Job{
+				JobID:        ptrInt64(jobID),
+				TRESAllocStr: ptrString("cpu=4,mem=8192,node=1"),
+				TRESReqStr:   ptrString("cpu=4,mem=8192"),
+				ExitCode:     &types.ExitCode{ReturnCode: &rc},
+				StartTime:    start,
+				EndTime:      end,
+				Nodes:        ptrString("node01"),
+			}, nil
+		},
+	}
+	manager := &adapterJobManager{adapter: adapter}
+
+	job, err := manager.GetWithOptions(ctx, "42", &types.GetJobOptions{IncludeAccounting: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, adapter.accountingCalls)
+	assert.Equal(t, "cpu=4,mem=8192,node=1", *job.TRESAllocStr)
+	assert.Equal(t, "cpu=4,mem=8G", *job.TRESReqStr, "live values are kept for unset-only fields")
+	require.NotNil(t, job.ExitCode)
+	assert.Equal(t, uint32(3), *job.ExitCode.ReturnCode, "slurmdbd exit code takes precedence")
+	assert.Equal(t, 90*time.Minute, job.EndTime.Sub(job.StartTime))
+	assert.Equal(t, "node01", *job.Nodes)
+
+	// Without the option the accounting record is not fetched
+	job, err = manager.GetWithOptions(ctx, "42", nil)
+	require.NoError(t, err)
+	assert.Nil(t, job.TRESAllocStr)
+	assert.Equal(t, 1, adapter.accountingCalls)
+}
+
+func TestAdapterJobManager_GetWithOptions_RunningJob(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	adapter := &mockAccountingJobAdapter{
+		mockJobAdapter: mockJobAdapter{
+			getFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+				return &types.Job{
+					JobID:    ptrInt64(jobID),
+					JobState: []types.JobState{types.JobStateRunning},
+				}, nil
+			},
+		},
+	}
+	manager := &adapterJobManager{adapter: adapter}
+
+	_, err := manager.GetWithOptions(ctx, "42", &types.GetJobOptions{IncludeAccounting: true})
+	require.NoError(t, err)
+	assert.Equal(t, 0, adapter.accountingCalls)
+}
+
+func TestAdapterJobManager_GetWithOptions_Unsupported(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	manager := &adapterJobManager{adapter: &mockJobAdapter{
+		getFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+			return &types.Job{
+				JobID:    ptrInt64(jobID),
+				JobState: []types.JobState{types.JobStateFailed},
+			}, nil
+		},
+	}}
+
+	_, err := manager.GetWithOptions(ctx, "42", &types.GetJobOptions{IncludeAccounting: true})
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, errors.GetErrorCode(err))
+}
+
+func TestAdapterJobManager_GetWithOptions_PurgedJob(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	adapter := &mockAccountingJobAdapter{
+		mockJobAdapter: mockJobAdapter{
+			getFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+				return nil, errors.NewSlurmError(errors.ErrorCodeJobNotFound, "job not found")
+			},
+		},
+		accountingFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+			return &types.Job{
+				JobID:    ptrInt64(jobID),
+				JobState: []types.JobState{types.JobStateCompleted},
+				Nodes:    ptrString("node01"),
+			}, nil
+		},
+	}
+	manager := &adapterJobManager{adapter: adapter}
+
+	job, err := manager.GetWithOptions(ctx, "42", &types.GetJobOptions{IncludeAccounting: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, adapter.accountingCalls)
+	assert.Equal(t, int64(42), *job.JobID)
+	assert.Equal(t, "node01", *job.Nodes)
+
+	// Without the option the not-found error is returned as is
+	_, err = manager.GetWithOptions(ctx, "42", nil)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+// TestAdapterJobManager_GetWithOptions_AllVersions checks that every version
+// completes a finished job from its slurmdb job record
+func TestAdapterJobManager_GetWithOptions_AllVersions(t *testing.T) {
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/slurm/" + version + "/job/42":
+					_, _ = w.Write([]byte(`{"jobs": [{"job_id": 42, "job_state": ["COMPLETED"]}]}`))
+				case "/slurmdb/" + version + "/job/42":
+					_, _ = w.Write([]byte(`{"jobs": [{"job_id": 42, "nodes": "node01", "name": "train",
+						"user": "alice", "account": "physics", "partition": "gpu",
+						"state": {"current": ["COMPLETED"]},
+						"time": {"start": 1700000000, "end": 1700005400},
+						"exit_code": {"status": ["SUCCESS"], "return_code": {"set": true, "number": 0}},
+						"tres": {"allocated": [{"type": "cpu", "count": 4}, {"type": "mem", "count": 8192}]}}]}`))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			job, err := client.Jobs().GetWithOptions(ctx, "42", &types.GetJobOptions{IncludeAccounting: true})
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), *job.JobID)
+			require.NotNil(t, job.TRESAllocStr)
+			assert.Equal(t, "cpu=4,mem=8192", *job.TRESAllocStr)
+			require.NotNil(t, job.ExitCode)
+			assert.Equal(t, uint32(0), *job.ExitCode.ReturnCode)
+			assert.Equal(t, 90*time.Minute, job.EndTime.Sub(job.StartTime))
+			assert.Equal(t, "node01", *job.Nodes)
+			assert.Equal(t, "train", *job.Name)
+			assert.Equal(t, "alice", *job.UserName)
+			assert.Equal(t, "physics", *job.Account)
+			assert.Equal(t, "gpu", *job.Partition)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// ListArrayTasks returns one job record per task of a job array.
+//
+// Slurm reports started tasks as individual records but keeps pending tasks
+// collapsed into a single record whose ArrayTaskString holds the remaining
+// task IDs (e.g. "4-9%2"). Collapsed records are expanded here so that each
+// task is returned with its own ArrayTaskID, state and node assignment.
+func (m *adapterJobManager) ListArrayTasks(ctx context.Context, arrayJobID string) ([]*types.Job, error) {
+	arrayID, err := strconv.ParseUint(arrayJobID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid array job ID: %w", err)
+	}
+	if arrayID == 0 {
+		return nil, errors.NewValidationErrorf("arrayJobID", arrayJobID, "array job ID must be positive")
+	}
+
+	opts := &types.JobListOptions{ArrayJobIDs: []int64{int64(arrayID)}}
+	result, err := m.adapter.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return []*types.Job{}, nil
+	}
+
+	tasks := make([]*types.Job, 0)
+	for i := range result.Jobs {
+		job := &result.Jobs[i]
+		if !opts.MatchesArrayJobIDs(job) {
+			continue
+		}
+
+		// Individual task record
+		if job.ArrayTaskID != nil && (job.ArrayTaskString == nil || *job.ArrayTaskString == "") {
+			tasks = append(tasks, job)
+			continue
+		}
+
+		// Collapsed record - expand remaining task IDs
+		if job.ArrayTaskString == nil {
+			continue
+		}
+		taskIDs, err := expandArrayTaskString(*job.ArrayTaskString)
+		if err != nil {
+			return nil, fmt.Errorf("job %s: %w", arrayJobID, err)
+		}
+		for _, taskID := range taskIDs {
+			task := *job
+			task.ArrayTaskID = ptrUint32(taskID)
+			task.ArrayTaskString = nil
+			tasks = append(tasks, &task)
+		}
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return *tasks[i].ArrayTaskID < *tasks[j].ArrayTaskID
+	})
+
+	return tasks, nil
+}
+
+// maxArrayTaskID is the highest array task ID Slurm can accept: task IDs
+// must be below MaxArraySize, which is capped at 4000001
+const maxArrayTaskID = 4000000
+
+// arrayTaskRange is one "start-end:step" part of an array task expression
+type arrayTaskRange struct {
+	start, end, step uint64
+}
+
+// count returns the number of task IDs in the range
+func (r arrayTaskRange) count() uint64 {
+	return (r.end-r.start)/r.step + 1
+}
+
+// parseArrayTaskString parses a Slurm array task expression such as
+// "1,3,5-11:2%4" into its ranges and counts their tasks without expanding
+// them. Task IDs above maxArrayTaskID, and expressions with more tasks than
+// an array can hold, are rejected.
+func parseArrayTaskString(expr string) ([]arrayTaskRange, uint64, error) {
+	// Strip the concurrency limit suffix
+	if idx := strings.Index(expr, "%"); idx >= 0 {
+		expr = expr[:idx]
+	}
+
+	var ranges []arrayTaskRange
+	var total uint64
+	for _, part := range strings.Split(expr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		step := uint64(1)
+		if idx := strings.Index(part, ":"); idx >= 0 {
+			s, err := strconv.ParseUint(part[idx+1:], 10, 32)
+			if err != nil || s == 0 {
+				return nil, 0, fmt.Errorf("invalid array task step in %q", part)
+			}
+			step = s
+			part = part[:idx]
+		}
+
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := strconv.ParseUint(bounds[0], 10, 32)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid array task ID in %q", part)
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.ParseUint(bounds[1], 10, 32)
+			if err != nil || end < start {
+				return nil, 0, fmt.Errorf("invalid array task range in %q", part)
+			}
+		}
+		if end > maxArrayTaskID {
+			return nil, 0, fmt.Errorf("array task ID %d in %q exceeds the maximum of %d", end, part, maxArrayTaskID)
+		}
+
+		r := arrayTaskRange{start: start, end: end, step: step}
+		total += r.count()
+		if total > maxArrayTaskID+1 {
+			return nil, 0, fmt.Errorf("array task expression %q has more than %d tasks", expr, maxArrayTaskID+1)
+		}
+		ranges = append(ranges, r)
+	}
+
+	return ranges, total, nil
+}
+
+// expandArrayTaskString expands a Slurm array task expression such as
+// "1,3,5-11:2%4" into the individual task IDs it describes
+func expandArrayTaskString(expr string) ([]uint32, error) {
+	ranges, total, err := parseArrayTaskString(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint32, 0, total)
+	for _, r := range ranges {
+		for id := r.start; id <= r.end; id += r.step {
+			ids = append(ids, uint32(id))
+		}
+	}
+
+	return ids, nil
+}
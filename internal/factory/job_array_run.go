@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// RunArray submits base as a job array over arraySpec, which takes the place
+// of base.ArraySpec, waits for it with WaitForArray and reports the exit
+// code of every task. Tasks of the
+// specification that were never seen in the queue are reported as
+// unfinished, so the success rate is taken over the whole array.
+func (m *adapterJobManager) RunArray(ctx context.Context, base *types.JobSubmission, arraySpec string, opts *types.RunArrayOptions) (*types.ArrayRunReport, error) {
+	if base == nil {
+		return nil, errors.NewValidationErrorf("base", nil, "job submission is required")
+	}
+	taskIDs, err := expandArrayTaskString(arraySpec)
+	if err != nil {
+		return nil, errors.NewValidationErrorf("arraySpec", arraySpec, "%v", err)
+	}
+	if len(taskIDs) == 0 {
+		return nil, errors.NewValidationErrorf("arraySpec", arraySpec, "array specification has no tasks")
+	}
+	if opts == nil {
+		opts = &types.RunArrayOptions{}
+	}
+
+	job := *base
+	job.ArraySpec = arraySpec
+	resp, err := m.Submit(ctx, &job)
+	if err != nil {
+		return nil, err
+	}
+	arrayJobID := strconv.Itoa(int(resp.JobId))
+	result, err := m.WaitForArray(ctx, arrayJobID, opts.Wait)
+	if err != nil {
+		return nil, fmt.Errorf("job array %s: %w", arrayJobID, err)
+	}
+	return arrayRunReport(result, taskIDs), nil
+}
+
+// arrayRunReport reports every task in taskIDs from the result of the wait
+func arrayRunReport(result *types.ArrayResult, taskIDs []uint32) *types.ArrayRunReport {
+	report := &types.ArrayRunReport{
+		ArrayJobID: result.ArrayJobID,
+		Completed:  result.Completed,
+		Failed:     result.Failed,
+		Unfinished: result.Unfinished,
+	}
+	waited := make(map[uint32]types.ArrayTaskResult, len(result.Tasks))
+	for _, task := range result.Tasks {
+		waited[task.TaskID] = task
+	}
+	expected := make(map[uint32]bool, len(taskIDs))
+	for _, taskID := range taskIDs {
+		expected[taskID] = true
+	}
+	for taskID := range waited {
+		expected[taskID] = true
+	}
+
+	ids := make([]uint32, 0, len(expected))
+	for taskID := range expected {
+		ids = append(ids, taskID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, taskID := range ids {
+		task, ok := waited[taskID]
+		if !ok {
+			report.Unfinished++
+			report.Tasks = append(report.Tasks, types.ArrayTaskReport{TaskID: taskID})
+			continue
+		}
+		entry := types.ArrayTaskReport{TaskID: taskID, State: task.State}
+		if task.Job != nil {
+			if task.Job.JobID != nil {
+				entry.JobID = *task.Job.JobID
+			}
+			if exit := task.Job.ExitCode; exit != nil {
+				if exit.ReturnCode != nil {
+					code := int(*exit.ReturnCode)
+					entry.ExitCode = &code
+				}
+				if exit.Signal != nil && exit.Signal.Name != nil {
+					entry.Signal = *exit.Signal.Name
+				}
+			}
+		}
+		report.Tasks = append(report.Tasks, entry)
+	}
+	if len(report.Tasks) > 0 {
+		report.SuccessRate = float64(report.Completed) / float64(len(report.Tasks))
+	}
+	return report
+}
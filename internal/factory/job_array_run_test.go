@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func finishedArrayTask(taskID uint32, state types.JobState, returnCode uint32) types.Job {
+	task := arrayTask(taskID, state)
+	task.ExitCode = &types.ExitCode{ReturnCode: ptrUint32(returnCode)}
+	return task
+}
+
+func TestAdapterJobManager_RunArray(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var submitted *types.JobCreate
+	polls := 0
+	states := [][]types.Job{
+		{
+			arrayTask(0, types.JobStateRunning),
+			{JobID: ptrInt64(300), ArrayJobID: ptrUint32(300), ArrayTaskString: ptrString("1-2"), JobState: []types.JobState{types.JobStatePending}},
+		},
+		{
+			finishedArrayTask(0, types.JobStateCompleted, 0),
+			finishedArrayTask(1, types.JobStateFailed, 3),
+			finishedArrayTask(2, types.JobStateCompleted, 0),
+		},
+	}
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+					submitted = job
+					return &types.JobSubmitResponse{JobId: 300}, nil
+				},
+				listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+					i := polls
+					if i >= len(states) {
+						i = len(states) - 1
+					}
+					polls++
+					return &types.JobList{Jobs: states[i]}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	// Task 3 is purged from the queue before it is ever seen
+	report, err := client.Jobs().RunArray(ctx, &types.JobSubmission{Name: "sweep", Script: "#!/bin/bash\ntrain.py"}, "0-3%2",
+		&types.RunArrayOptions{Wait: &types.WaitForArrayOptions{PollInterval: time.Millisecond}})
+	require.NoError(t, err)
+
+	require.NotNil(t, submitted)
+	assert.Equal(t, "0-3%2", *submitted.Array)
+	assert.Equal(t, "sweep", *submitted.Name)
+
+	assert.Equal(t, uint32(300), report.ArrayJobID)
+	assert.Equal(t, 2, report.Completed)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, 1, report.Unfinished)
+	assert.InDelta(t, 0.5, report.SuccessRate, 1e-9)
+
+	require.Len(t, report.Tasks, 4)
+	assert.Equal(t, 0, *report.Tasks[0].ExitCode)
+	assert.Equal(t, int64(302), report.Tasks[1].JobID)
+	assert.Equal(t, types.JobStateFailed, report.Tasks[1].State)
+	assert.Equal(t, 3, *report.Tasks[1].ExitCode)
+	assert.Equal(t, uint32(3), report.Tasks[3].TaskID)
+	assert.Nil(t, report.Tasks[3].ExitCode)
+}
+
+func TestAdapterJobManager_RunArray_InvalidSpec(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	client := &AdapterClient{adapter: &testVersionAdapter{version: "v0.0.44", jobAdapter: &mockJobAdapter{}}}
+
+	_, err := client.Jobs().RunArray(ctx, &types.JobSubmission{Name: "sweep"}, "5-1", nil)
+	assert.True(t, errors.IsValidationError(err))
+
+	_, err = client.Jobs().RunArray(ctx, nil, "0-3", nil)
+	assert.True(t, errors.IsValidationError(err))
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_SubmitArray(t *testing.T) {
+	var job map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/job/submit"):
+			var body struct {
+				Job map[string]any `json:"job"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			job = body.Job
+			_, _ = w.Write([]byte(`{"job_id": 100}`))
+		case strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/job/101"):
+			_, _ = w.Write([]byte(`{"jobs": [{
+				"job_id": 101,
+				"name": "sweep",
+				"array_job_id": {"set": true, "infinite": false, "number": 100},
+				"array_task_id": {"set": true, "infinite": false, "number": 3},
+				"array_max_tasks": {"set": true, "infinite": false, "number": 10}
+			}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			job = nil
+			resp, err := client.Jobs().Submit(ctx, &types.JobSubmission{
+				Name:               "sweep",
+				Script:             "#!/bin/bash\n./run $SLURM_ARRAY_TASK_ID",
+				ArraySpec:          "1-100:2",
+				ArrayMaxConcurrent: 10,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, int64(100), resp.JobId)
+			require.NotNil(t, job)
+			assert.Equal(t, "1-100:2%10", job["array"])
+
+			task, err := client.Jobs().Get(ctx, "101")
+			require.NoError(t, err)
+			require.NotNil(t, task.ArrayJobID)
+			require.NotNil(t, task.ArrayTaskID)
+			assert.Equal(t, uint32(100), *task.ArrayJobID)
+			assert.Equal(t, uint32(3), *task.ArrayTaskID)
+		})
+	}
+}
+
+func TestAdapterJobManager_SubmitArray_Invalid(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	submitted := false
+	client := &AdapterClient{adapter: &testVersionAdapter{version: "v0.0.44", jobAdapter: &mockJobAdapter{
+		submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+			submitted = true
+			return &types.JobSubmitResponse{}, nil
+		},
+	}}}
+
+	for _, job := range []*types.JobSubmission{
+		{Script: "#!/bin/bash", ArraySpec: "1-x"},
+		{Script: "#!/bin/bash", ArrayMaxConcurrent: 4},
+		{Script: "#!/bin/bash", ArraySpec: "0-9%2", ArrayMaxConcurrent: 4},
+		{Script: "#!/bin/bash", ArraySpec: "0-9", ArrayMaxConcurrent: -1},
+		// Rejected from its bounds, without expanding billions of task IDs
+		{Script: "#!/bin/bash", ArraySpec: "0-4000000000"},
+	} {
+		_, err := client.Jobs().Submit(ctx, job)
+		assert.True(t, errors.IsValidationError(err), "%+v", job)
+
+		result, err := client.Jobs().Validate(ctx, job)
+		require.NoError(t, err)
+		assert.False(t, result.Valid(), "%+v", job)
+	}
+	assert.False(t, submitted)
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_ListArrayTasks(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	// Array 100 with tasks 0-5: tasks 0-1 completed, task 2 running,
+	// tasks 3-5 still pending and collapsed into the array's own record.
+	// Job 200 is an unrelated job.
+	var listed *types.JobListOptions
+	mockJobs := &mockJobAdapter{
+		listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+			listed = opts
+			return &types.JobList{
+				Jobs: []types.Job{
+					{
+						JobID:           ptrInt64(100),
+						ArrayJobID:      ptrUint32(100),
+						ArrayTaskString: ptrString("3-5%2"),
+						JobState:        []types.JobState{types.JobStatePending},
+					},
+					{
+						JobID:       ptrInt64(103),
+						ArrayJobID:  ptrUint32(100),
+						ArrayTaskID: ptrUint32(2),
+						JobState:    []types.JobState{types.JobStateRunning},
+						Nodes:       ptrString("node03"),
+					},
+					{
+						JobID:       ptrInt64(101),
+						ArrayJobID:  ptrUint32(100),
+						ArrayTaskID: ptrUint32(0),
+						JobState:    []types.JobState{types.JobStateCompleted},
+						Nodes:       ptrString("node01"),
+					},
+					{
+						JobID:       ptrInt64(102),
+						ArrayJobID:  ptrUint32(100),
+						ArrayTaskID: ptrUint32(1),
+						JobState:    []types.JobState{types.JobStateCompleted},
+						Nodes:       ptrString("node02"),
+					},
+					{
+						JobID:    ptrInt64(200),
+						JobState: []types.JobState{types.JobStateRunning},
+					},
+				},
+			}, nil
+		},
+	}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{version: "v0.0.44", jobAdapter: mockJobs},
+	}
+
+	tasks, err := client.Jobs().ListArrayTasks(ctx, "100")
+	require.NoError(t, err)
+	require.Len(t, tasks, 6)
+	require.NotNil(t, listed)
+	assert.Equal(t, []int64{100}, listed.ArrayJobIDs, "the array is filtered in the listing")
+
+	expected := []struct {
+		taskID uint32
+		state  types.JobState
+		nodes  string
+	}{
+		{0, types.JobStateCompleted, "node01"},
+		{1, types.JobStateCompleted, "node02"},
+		{2, types.JobStateRunning, "node03"},
+		{3, types.JobStatePending, ""},
+		{4, types.JobStatePending, ""},
+		{5, types.JobStatePending, ""},
+	}
+	for i, want := range expected {
+		task := tasks[i]
+		require.NotNil(t, task.ArrayTaskID)
+		assert.Equal(t, want.taskID, *task.ArrayTaskID)
+		assert.Equal(t, []types.JobState{want.state}, task.JobState)
+		assert.Equal(t, want.nodes, derefString(task.Nodes))
+		assert.Nil(t, task.ArrayTaskString)
+	}
+
+	// Invalid array job IDs
+	_, err = client.Jobs().ListArrayTasks(ctx, "not-a-number")
+	assert.Error(t, err)
+	_, err = client.Jobs().ListArrayTasks(ctx, "0")
+	assert.True(t, errors.IsValidationError(err))
+}
+
+func TestExpandArrayTaskString(t *testing.T) {
+	tests := []struct {
+		expr      string
+		expected  []uint32
+		expectErr bool
+	}{
+		{expr: "7", expected: []uint32{7}},
+		{expr: "1-4", expected: []uint32{1, 2, 3, 4}},
+		{expr: "1,3,5-6", expected: []uint32{1, 3, 5, 6}},
+		{expr: "0-10:5%2", expected: []uint32{0, 5, 10}},
+		{expr: "5-1", expectErr: true},
+		{expr: "a-b", expectErr: true},
+		{expr: "1-4:0", expectErr: true},
+		{expr: "0-4000001", expectErr: true},
+		{expr: "0-4000000000", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			ids, err := expandArrayTaskString(tt.expr)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestParseArrayTaskString(t *testing.T) {
+	// The largest array is counted without being expanded
+	ranges, total, err := parseArrayTaskString("0-4000000")
+	require.NoError(t, err)
+	assert.Equal(t, []arrayTaskRange{{start: 0, end: 4000000, step: 1}}, ranges)
+	assert.Equal(t, uint64(4000001), total)
+
+	_, total, err = parseArrayTaskString("1,3,5-11:2%4")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), total)
+
+	// Repeated ranges cannot add up to more tasks than an array holds
+	_, _, err = parseArrayTaskString("0-4000000,0-4000000")
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// WaitForArray polls a job array with ListArrayTasks until every task has
+// reached a terminal state, or until one has failed when StopOnFirstFailure
+// is set, and returns the state of each task. A task that leaves the queue
+// before it is seen to finish, as when slurmctld purges it, no longer holds
+// up the wait and is counted as unfinished.
+func (m *adapterJobManager) WaitForArray(ctx context.Context, arrayJobID string, opts *types.WaitForArrayOptions) (*types.ArrayResult, error) {
+	arrayID, err := strconv.ParseUint(arrayJobID, 10, 32)
+	if err != nil {
+		return nil, errors.NewValidationErrorf("arrayJobID", arrayJobID, "invalid array job ID: %v", err)
+	}
+	if opts == nil {
+		opts = &types.WaitForArrayOptions{}
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = types.DefaultArrayPollInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	// The last record seen of every task, including those gone from the queue
+	seen := make(map[uint32]*types.Job)
+	for {
+		tasks, err := m.ListArrayTasks(ctx, arrayJobID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, waitTimeoutError(ctx, arrayWaitMessage(arrayJobID, seen))
+			}
+			return nil, err
+		}
+		if len(tasks) == 0 && len(seen) == 0 {
+			return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+				fmt.Sprintf("job array %s not found", arrayJobID))
+		}
+
+		queued := make(map[uint32]bool, len(tasks))
+		for _, task := range tasks {
+			seen[*task.ArrayTaskID] = task
+			queued[*task.ArrayTaskID] = true
+		}
+
+		done, failed := true, false
+		for taskID, task := range seen {
+			switch {
+			case !isTerminalJob(task):
+				if queued[taskID] {
+					done = false
+				}
+			case !jobCompleted(task):
+				failed = true
+			}
+		}
+		if done || (failed && opts.StopOnFirstFailure) {
+			return summarizeArray(uint32(arrayID), seen), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, waitTimeoutError(ctx, arrayWaitMessage(arrayJobID, seen))
+		case <-ticker.C:
+		}
+	}
+}
+
+// summarizeArray builds the result from the last record of every task
+func summarizeArray(arrayID uint32, tasks map[uint32]*types.Job) *types.ArrayResult {
+	result := &types.ArrayResult{ArrayJobID: arrayID, Tasks: make([]types.ArrayTaskResult, 0, len(tasks))}
+	for taskID, task := range tasks {
+		var state types.JobState
+		if len(task.JobState) > 0 {
+			state = task.JobState[0]
+		}
+		result.Tasks = append(result.Tasks, types.ArrayTaskResult{TaskID: taskID, State: state, Job: task})
+
+		switch {
+		case !isTerminalJob(task):
+			result.Unfinished++
+		case jobCompleted(task):
+			result.Completed++
+		default:
+			result.Failed++
+		}
+	}
+	sort.Slice(result.Tasks, func(i, j int) bool { return result.Tasks[i].TaskID < result.Tasks[j].TaskID })
+	return result
+}
+
+// jobCompleted reports whether the job finished successfully
+func jobCompleted(job *types.Job) bool {
+	for _, state := range job.JobState {
+		if state == types.JobStateCompleted {
+			return true
+		}
+	}
+	return false
+}
+
+func arrayWaitMessage(arrayJobID string, seen map[uint32]*types.Job) string {
+	running := 0
+	for _, task := range seen {
+		if !isTerminalJob(task) {
+			running++
+		}
+	}
+	return fmt.Sprintf("job array %s still has %d unfinished tasks", arrayJobID, running)
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func arrayTask(taskID uint32, state types.JobState) types.Job {
+	return types.Job{
+		JobID:       ptrInt64(int64(301 + taskID)),
+		ArrayJobID:  ptrUint32(300),
+		ArrayTaskID: ptrUint32(taskID),
+		JobState:    []types.JobState{state},
+	}
+}
+
+// arrayClient serves the array in states[i] on the i-th poll, repeating the
+// last one once they run out
+func arrayClient(polls *int, states [][]types.Job) *AdapterClient {
+	return &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+					i := *polls
+					if i >= len(states) {
+						i = len(states) - 1
+					}
+					*polls++
+					return &types.JobList{Jobs: states[i]}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+}
+
+func TestAdapterJobManager_WaitForArray(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	// Task 1 fails while task 2 is still pending; task 2 finishes on the
+	// third poll
+	polls := 0
+	client := arrayClient(&polls, [][]types.Job{
+		{
+			arrayTask(0, types.JobStateRunning),
+			arrayTask(1, types.JobStateRunning),
+			{JobID: ptrInt64(300), ArrayJobID: ptrUint32(300), ArrayTaskString: ptrString("2"), JobState: []types.JobState{types.JobStatePending}},
+		},
+		{
+			arrayTask(0, types.JobStateCompleted),
+			arrayTask(1, types.JobStateFailed),
+			arrayTask(2, types.JobStateRunning),
+		},
+		{
+			arrayTask(0, types.JobStateCompleted),
+			arrayTask(1, types.JobStateFailed),
+			arrayTask(2, types.JobStateCompleted),
+		},
+	})
+
+	result, err := client.Jobs().WaitForArray(ctx, "300", &types.WaitForArrayOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, uint32(300), result.ArrayJobID)
+	assert.Equal(t, 2, result.Completed)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, 0, result.Unfinished)
+	assert.False(t, result.Succeeded())
+
+	require.Len(t, result.Tasks, 3)
+	for i, state := range []types.JobState{types.JobStateCompleted, types.JobStateFailed, types.JobStateCompleted} {
+		assert.Equal(t, uint32(i), result.Tasks[i].TaskID)
+		assert.Equal(t, state, result.Tasks[i].State)
+	}
+}
+
+func TestAdapterJobManager_WaitForArray_StopOnFirstFailure(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	polls := 0
+	client := arrayClient(&polls, [][]types.Job{
+		{arrayTask(0, types.JobStateRunning), arrayTask(1, types.JobStateRunning)},
+		{arrayTask(0, types.JobStateRunning), arrayTask(1, types.JobStateOutOfMemory)},
+	})
+
+	result, err := client.Jobs().WaitForArray(ctx, "300", &types.WaitForArrayOptions{
+		PollInterval:       time.Millisecond,
+		StopOnFirstFailure: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, 0, result.Completed)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, 1, result.Unfinished)
+	assert.Equal(t, types.JobStateRunning, result.Tasks[0].State)
+}
+
+func TestAdapterJobManager_WaitForArray_Errors(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	polls := 0
+	client := arrayClient(&polls, [][]types.Job{{arrayTask(0, types.JobStateRunning)}})
+
+	_, err := client.Jobs().WaitForArray(ctx, "not-a-job", nil)
+	assert.True(t, errors.IsValidationError(err))
+
+	_, err = client.Jobs().WaitForArray(ctx, "999", nil)
+	assert.Equal(t, errors.ErrorCodeResourceNotFound, errors.GetErrorCode(err))
+
+	_, err = client.Jobs().WaitForArray(ctx, "300", &types.WaitForArrayOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	})
+	assert.Equal(t, errors.ErrorCodeDeadlineExceeded, errors.GetErrorCode(err))
+	assert.Contains(t, err.Error(), "1 unfinished tasks")
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// CancelByName cancels every pending or running job with the given name, like
+// scancel --name, and returns the number of jobs cancelled
+func (m *adapterJobManager) CancelByName(ctx context.Context, name string) (int, error) {
+	if name == "" {
+		return 0, errors.NewValidationErrorf("name", name, "job name is required")
+	}
+	opts := &types.JobListOptions{JobNames: []string{name}}
+	return m.cancelMatching(ctx, opts, func(job *types.Job) bool {
+		return derefString(job.Name) == name
+	})
+}
+
+// CancelByUser cancels every pending or running job owned by the given user,
+// like scancel --user, and returns the number of jobs cancelled
+func (m *adapterJobManager) CancelByUser(ctx context.Context, user string) (int, error) {
+	if user == "" {
+		return 0, errors.NewValidationErrorf("user", user, "user name is required")
+	}
+	opts := &types.JobListOptions{Users: []string{user}}
+	return m.cancelMatching(ctx, opts, func(job *types.Job) bool {
+		return derefString(job.UserName) == user
+	})
+}
+
+// CancelIfPending cancels a job only while it is still waiting to start, so
+// a job that has just begun running is not killed, and reports whether it
+// cancelled the job. slurmctld applies the pending filter itself as it
+// signals the job, on every supported API version. An adapter without the
+// filtered cancellation falls back to reading the state immediately before
+// cancelling, which leaves the time of one request for the job to start in
+// between.
+func (m *adapterJobManager) CancelIfPending(ctx context.Context, jobID string) (bool, error) {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return false, errors.NewValidationErrorf("jobID", jobID, "invalid job ID: %v", err)
+	}
+	if pending, ok := m.adapter.(common.JobCancelPendingAdapter); ok {
+		return pending.CancelIfPending(ctx, id)
+	}
+
+	job, err := m.adapter.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if !jobPending(job) {
+		return false, nil
+	}
+	if err := m.adapter.Cancel(ctx, id, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CancelStep cancels one step of a running job, such as an srun in an
+// interactive allocation, and leaves the job and its other steps running.
+// slurmrestd cannot address steps, so this runs scancel and needs the CLI
+// fallback.
+func (m *adapterJobManager) CancelStep(ctx context.Context, jobID, stepID string) error {
+	if _, err := strconv.ParseInt(jobID, 10, 64); err != nil {
+		return errors.NewValidationErrorf("jobID", jobID, "invalid job ID: %v", err)
+	}
+	if stepID == "" {
+		return errors.NewValidationErrorf("stepID", stepID, "step ID is required")
+	}
+	if m.cli == nil {
+		return errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			"cancelling a single job step requires the Slurm CLI fallback")
+	}
+	return m.cli.CancelStep(ctx, jobID, stepID)
+}
+
+// jobPending reports whether the job is waiting to start
+func jobPending(job *types.Job) bool {
+	return jobInState(job, types.JobStatePending)
+}
+
+// jobInState reports whether the job has the given state
+func jobInState(job *types.Job, want types.JobState) bool {
+	if job == nil {
+		return false
+	}
+	for _, state := range job.JobState {
+		if state == want {
+			return true
+		}
+	}
+	return false
+}
+
+// cancelMatching lists jobs with opts and cancels the active ones accepted by
+// match. The filter is re-applied here because not every API version honours
+// the list options server side. It stops at the first failed cancellation.
+func (m *adapterJobManager) cancelMatching(ctx context.Context, opts *types.JobListOptions, match func(*types.Job) bool) (int, error) {
+	jobs, err := m.adapter.List(ctx, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	cancelled := 0
+	for i := range jobs.Jobs {
+		job := &jobs.Jobs[i]
+		if job.JobID == nil || isTerminalJob(job) || !match(job) {
+			continue
+		}
+		if err := m.adapter.Cancel(ctx, *job.JobID, nil); err != nil {
+			return cancelled, fmt.Errorf("failed to cancel job %d: %w", *job.JobID, err)
+		}
+		cancelled++
+	}
+	return cancelled, nil
+}
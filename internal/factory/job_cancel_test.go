@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCancelTestAdapter returns a job adapter listing a fixed queue and
+// recording which jobs were cancelled
+func newCancelTestAdapter(cancelled *[]int64) *mockJobAdapter {
+	queue := []types.Job{
+		{JobID: ptrInt64(1), Name: ptrString("train"), UserName: ptrString("alice"), JobState: []types.JobState{types.JobStateRunning}},
+		{JobID: ptrInt64(2), Name: ptrString("train"), UserName: ptrString("bob"), JobState: []types.JobState{types.JobStatePending}},
+		{JobID: ptrInt64(3), Name: ptrString("eval"), UserName: ptrString("alice"), JobState: []types.JobState{types.JobStatePending}},
+		{JobID: ptrInt64(4), Name: ptrString("train"), UserName: ptrString("alice"), JobState: []types.JobState{types.JobStateCompleted}},
+	}
+	return &mockJobAdapter{
+		// Return the whole queue regardless of filters, as older versions do
+		listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+			return &types.JobList{Jobs: queue, Total: len(queue)}, nil
+		},
+		cancelFunc: func(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
+			*cancelled = append(*cancelled, jobID)
+			return nil
+		},
+	}
+}
+
+func TestAdapterJobManager_CancelByName(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var cancelled []int64
+	manager := &adapterJobManager{adapter: newCancelTestAdapter(&cancelled)}
+
+	count, err := manager.CancelByName(ctx, "train")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []int64{1, 2}, cancelled)
+}
+
+func TestAdapterJobManager_CancelByUser(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var cancelled []int64
+	manager := &adapterJobManager{adapter: newCancelTestAdapter(&cancelled)}
+
+	count, err := manager.CancelByUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []int64{1, 3}, cancelled)
+
+	_, err = manager.CancelByUser(ctx, "")
+	assert.Error(t, err)
+}
+
+func TestAdapterJobManager_CancelByUser_StopsOnError(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var cancelled []int64
+	adapter := newCancelTestAdapter(&cancelled)
+	adapter.cancelFunc = func(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
+		if jobID == 3 {
+			return fmt.Errorf("permission denied")
+		}
+		cancelled = append(cancelled, jobID)
+		return nil
+	}
+	manager := &adapterJobManager{adapter: adapter}
+
+	count, err := manager.CancelByUser(ctx, "alice")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "job 3")
+	assert.Equal(t, 1, count)
+}
+
+func TestAdapterJobManager_CancelIfPending(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var cancelled []int64
+	adapter := newCancelTestAdapter(&cancelled)
+	adapter.getFunc = func(ctx context.Context, jobID int64) (*types.Job, error) {
+		jobs, _ := adapter.listFunc(ctx, nil)
+		for i := range jobs.Jobs {
+			if *jobs.Jobs[i].JobID == jobID {
+				return &jobs.Jobs[i], nil
+			}
+		}
+		return nil, fmt.Errorf("job %d not found", jobID)
+	}
+	manager := &adapterJobManager{adapter: adapter}
+
+	// A pending job is cancelled
+	ok, err := manager.CancelIfPending(ctx, "2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{2}, cancelled)
+
+	// Running and finished jobs are left alone
+	for _, jobID := range []string{"1", "4"} {
+		ok, err = manager.CancelIfPending(ctx, jobID)
+		require.NoError(t, err)
+		assert.False(t, ok, "job %s", jobID)
+	}
+	assert.Equal(t, []int64{2}, cancelled)
+
+	_, err = manager.CancelIfPending(ctx, "9")
+	assert.Error(t, err)
+	_, err = manager.CancelIfPending(ctx, "two")
+	assert.Error(t, err)
+}
+
+// TestAdapterJobManager_CancelIfPending_StateFilter checks that every version
+// leaves the pending check to slurmctld in a single DELETE /jobs
+func TestAdapterJobManager_CancelIfPending_StateFilter(t *testing.T) {
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			var requests []map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/slurm/"+version+"/jobs/" {
+					http.NotFound(w, r)
+					return
+				}
+				var body map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				requests = append(requests, body)
+
+				// Only job 2 is pending, so only it is signalled
+				w.Header().Set("Content-Type", "application/json")
+				if body["jobs"].([]interface{})[0] == "2" {
+					_, _ = w.Write([]byte(`{"status": [{"job_id": {"set": true, "number": 2}, "step_id": "2"}]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"status": []}`))
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			ok, err := client.Jobs().CancelIfPending(ctx, "2")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			ok, err = client.Jobs().CancelIfPending(ctx, "1")
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			require.Len(t, requests, 2)
+			assert.Equal(t, []interface{}{"PENDING"}, requests[0]["job_state"])
+			assert.Equal(t, []interface{}{"VERBOSE"}, requests[0]["flags"])
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// CancelAndWait cancels the given jobs and polls each until it has reached a
+// terminal state and is no longer COMPLETING, so its nodes have been
+// released, for use when a workflow manager shuts down. Up to
+// opts.Concurrency jobs are handled at once. A job that has already finished,
+// or has left the queue, counts as done. It carries on past jobs that fail
+// and returns their errors together; if opts.Timeout or ctx runs out first,
+// the error names the jobs still outstanding.
+func (m *adapterJobManager) CancelAndWait(ctx context.Context, jobIDs []string, opts *types.CancelAndWaitOptions) error {
+	if len(jobIDs) == 0 {
+		return errors.NewValidationErrorf("jobIDs", jobIDs, "at least one job ID is required")
+	}
+	ids := make([]int64, len(jobIDs))
+	for i, jobID := range jobIDs {
+		id, err := strconv.ParseInt(jobID, 10, 64)
+		if err != nil {
+			return errors.NewValidationErrorf("jobIDs", jobID, "invalid job ID: %v", err)
+		}
+		ids[i] = id
+	}
+	if opts == nil {
+		opts = &types.CancelAndWaitOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = types.DefaultCancelWaitConcurrency
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = types.DefaultCancelWaitPollInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	var (
+		mu          sync.Mutex
+		failures    []error
+		outstanding []string
+		wg          sync.WaitGroup
+	)
+	slots := make(chan struct{}, concurrency)
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				mu.Lock()
+				outstanding = append(outstanding, strconv.Itoa(int(id)))
+				mu.Unlock()
+				return
+			}
+			err := m.cancelAndWaitJob(ctx, id, interval)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+			case ctx.Err() != nil:
+				outstanding = append(outstanding, strconv.Itoa(int(id)))
+			default:
+				failures = append(failures, fmt.Errorf("job %d: %w", id, err))
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	if len(outstanding) > 0 {
+		sort.Strings(outstanding)
+		failures = append(failures, waitTimeoutError(ctx,
+			fmt.Sprintf("jobs %s have not finished", strings.Join(outstanding, ", "))))
+	}
+	return stderrors.Join(failures...)
+}
+
+// cancelAndWaitJob cancels one job and polls it until it has finished. A
+// failed cancellation is only reported if the job is still active, since
+// Slurm refuses to cancel a job that has already completed.
+func (m *adapterJobManager) cancelAndWaitJob(ctx context.Context, jobID int64, interval time.Duration) error {
+	cancelErr := m.adapter.Cancel(ctx, jobID, nil)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		job, err := m.adapter.Get(ctx, jobID)
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil
+		case err != nil:
+			return err
+		case isTerminalJob(job) && !jobCompleting(job):
+			return nil
+		case cancelErr != nil && !isTerminalJob(job):
+			return cancelErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// jobCompleting reports whether the job is still releasing its nodes
+func jobCompleting(job *types.Job) bool {
+	for _, state := range job.JobState {
+		if state == types.JobStateCompleting {
+			return true
+		}
+	}
+	return false
+}
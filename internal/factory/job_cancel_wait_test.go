@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cancellingJobs simulates jobs that take a few polls to finish once
+// cancelled, passing through COMPLETING on the way
+type cancellingJobs struct {
+	mu        sync.Mutex
+	polls     map[int64]int
+	cancelled map[int64]bool
+	finished  map[int64]bool
+	active    int
+	maxActive int
+}
+
+func (c *cancellingJobs) cancel(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if jobID == 3 {
+		return errors.NewSlurmError(errors.ErrorCodeInvalidRequest, "Job/step already completing or completed")
+	}
+	c.cancelled[jobID] = true
+	c.active++
+	c.maxActive = max(c.maxActive, c.active)
+	return nil
+}
+
+func (c *cancellingJobs) get(ctx context.Context, jobID int64) (*types.Job, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	job := &types.Job{JobID: &jobID}
+	switch {
+	case jobID == 3:
+		job.JobState = []types.JobState{types.JobStateCompleted}
+	case !c.cancelled[jobID]:
+		job.JobState = []types.JobState{types.JobStateRunning}
+	case c.polls[jobID] < 2:
+		c.polls[jobID]++
+		job.JobState = []types.JobState{types.JobStateRunning}
+	case c.polls[jobID] < 3:
+		c.polls[jobID]++
+		job.JobState = []types.JobState{types.JobStateCancelled, types.JobStateCompleting}
+	default:
+		if !c.finished[jobID] {
+			c.finished[jobID] = true
+			c.active--
+		}
+		job.JobState = []types.JobState{types.JobStateCancelled}
+	}
+	return job, nil
+}
+
+func TestAdapterJobManager_CancelAndWait(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	jobs := &cancellingJobs{
+		polls:     make(map[int64]int),
+		cancelled: make(map[int64]bool),
+		finished:  make(map[int64]bool),
+	}
+	manager := &adapterJobManager{adapter: &mockJobAdapter{cancelFunc: jobs.cancel, getFunc: jobs.get}}
+
+	err := manager.CancelAndWait(ctx, []string{"1", "2", "3", "4", "5"}, &types.CancelAndWaitOptions{
+		Concurrency:  2,
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	// Every cancelled job was seen to finish before the call returned
+	jobs.mu.Lock()
+	defer jobs.mu.Unlock()
+	for _, id := range []int64{1, 2, 4, 5} {
+		assert.True(t, jobs.finished[id], "job %d", id)
+	}
+	assert.Equal(t, 0, jobs.active)
+	assert.LessOrEqual(t, jobs.maxActive, 2)
+}
+
+func TestAdapterJobManager_CancelAndWait_Timeout(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	// The job never leaves RUNNING
+	manager := &adapterJobManager{adapter: &mockJobAdapter{
+		getFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+			return &types.Job{JobID: &jobID, JobState: []types.JobState{types.JobStateRunning}}, nil
+		},
+	}}
+
+	err := manager.CancelAndWait(ctx, []string{"7"}, &types.CancelAndWaitOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	})
+	var slurmErr *errors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeDeadlineExceeded, slurmErr.Code)
+	assert.Contains(t, err.Error(), "7")
+
+	err = manager.CancelAndWait(ctx, []string{"7", "x"}, nil)
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "jobIDs", validationErr.Field)
+}
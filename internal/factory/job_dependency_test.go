@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_SubmitDependencies(t *testing.T) {
+	var job map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.HasSuffix(r.URL.Path, "/job/submit") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body struct {
+			Job map[string]any `json:"job"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		job = body.Job
+		_, _ = w.Write([]byte(`{"job_id": 100}`))
+	}))
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			job = nil
+			_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+				Name:   "report",
+				Script: "#!/bin/bash\n./report",
+				Dependencies: []types.JobDependency{
+					{Type: "afterok", JobIDs: []int64{12345, 12346}},
+					{Type: "afternotok", JobIDs: []int64{678}},
+					{Type: "singleton"},
+				},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, job)
+			assert.Equal(t, "afterok:12345:12346,afternotok:678,singleton", job["dependency"])
+		})
+	}
+}
+
+func TestAdapterJobManager_SubmitDependencies_Invalid(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	submitted := false
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+					submitted = true
+					return &types.JobSubmitResponse{JobId: 1}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	for name, deps := range map[string][]types.JobDependency{
+		"unknown type":         {{Type: "afterwards", JobIDs: []int64{1}}},
+		"missing job IDs":      {{Type: "afterany"}},
+		"singleton with IDs":   {{Type: "singleton", JobIDs: []int64{1}}},
+		"non-positive job ID":  {{Type: "afterok", JobIDs: []int64{0}}},
+		"second entry invalid": {{Type: "afterok", JobIDs: []int64{1}}, {Type: "before", JobIDs: []int64{2}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.Jobs().Submit(ctx, &types.JobSubmission{Name: "x", Script: "#!/bin/bash\ntrue", Dependencies: deps})
+			require.Error(t, err)
+			var validationErr *errors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, errors.ErrorCodeValidationFailed, validationErr.Code)
+			assert.Equal(t, "Dependencies", validationErr.Field)
+		})
+	}
+	assert.False(t, submitted, "an invalid dependency must not be sent")
+}
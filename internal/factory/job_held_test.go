@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterClient_ListHeldJobs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jobs": [
+			{"job_id": 1, "job_state": ["PENDING"], "state_reason": "Priority", "hold": false},
+			{"job_id": 2, "job_state": ["PENDING"], "state_reason": "JobHeldUser", "hold": true},
+			{"job_id": 3, "job_state": ["PENDING"], "state_reason": "JobHeldAdmin", "hold": true,
+			 "state_description": "waiting for storage maintenance"},
+			{"job_id": 4, "job_state": ["RUNNING"], "state_reason": "None", "hold": false}
+		]}`))
+	}))
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			jobs, err := client.Jobs().List(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, jobs.Jobs, 4)
+			assert.Empty(t, jobs.Jobs[0].HeldBy())
+			assert.Empty(t, jobs.Jobs[0].HoldReason())
+			assert.Equal(t, types.JobHeldByUser, jobs.Jobs[1].HeldBy())
+			assert.Equal(t, "The job is held by its owner", jobs.Jobs[1].HoldReason())
+			assert.Equal(t, types.JobHeldByAdmin, jobs.Jobs[2].HeldBy())
+			assert.Equal(t, "waiting for storage maintenance", jobs.Jobs[2].HoldReason())
+
+			held, err := client.Jobs().List(ctx, &types.ListJobsOptions{HeldOnly: true})
+			require.NoError(t, err)
+			require.Len(t, held.Jobs, 2)
+			assert.Equal(t, int64(2), *held.Jobs[0].JobID)
+			assert.Equal(t, int64(3), *held.Jobs[1].JobID)
+		})
+	}
+}
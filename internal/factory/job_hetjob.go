@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// newHetJobComponents builds one JobCreate per component of a heterogeneous
+// job from base, the JobCreate of the whole submission. Only the first
+// component carries the script and the dependencies, as with sbatch.
+func newHetJobComponents(base *types.JobCreate, components []types.JobComponent) ([]*types.JobCreate, error) {
+	if len(components) < 2 {
+		return nil, errors.NewValidationErrorf("Components", len(components),
+			"a heterogeneous job needs at least two components")
+	}
+	if base.Array != nil {
+		return nil, errors.NewValidationErrorf("ArraySpec", *base.Array,
+			"heterogeneous jobs cannot be job arrays")
+	}
+
+	result := make([]*types.JobCreate, 0, len(components))
+	for i, component := range components {
+		create := *base
+		if i > 0 {
+			create.Script = nil
+			create.Dependency = nil
+		}
+		if component.Partition != "" {
+			create.Partition = ptrString(component.Partition)
+		}
+		if component.Account != "" {
+			create.Account = ptrString(component.Account)
+		}
+		if component.QoS != "" {
+			create.QoS = ptrString(component.QoS)
+		}
+		if component.CPUs > 0 {
+			create.MinimumCPUs = ptrInt32(int32(component.CPUs))
+		}
+		if component.Memory > 0 {
+			memory := uint64(component.Memory)
+			create.MemoryPerNode = &memory
+		}
+		if component.Nodes > 0 {
+			create.MinimumNodes = ptrInt32(int32(component.Nodes))
+		}
+		if component.TimeLimit > 0 {
+			create.TimeLimit = ptrUint32(uint32(component.TimeLimit))
+		}
+		if component.TRESPerNode != "" {
+			create.TRESPerNode = ptrString(component.TRESPerNode)
+		}
+		if component.Constraints != nil {
+			if err := component.Constraints.Validate(); err != nil {
+				return nil, errors.NewValidationErrorf("Components", component.Constraints.String(),
+					"component %d: %v", i, err)
+			}
+			create.Constraints = ptrString(component.Constraints.String())
+		}
+		if component.Exclusive {
+			create.Shared = []types.SharedValue{types.SharedNone}
+		}
+		result = append(result, &create)
+	}
+	return result, nil
+}
+
+// submitHetJob submits the components of a heterogeneous job and reports
+// the job ID of each. Slurm numbers the components consecutively from the
+// het job ID.
+func submitHetJob(ctx context.Context, hetJobs common.HetJobAdapter, components []*types.JobCreate) (*types.JobSubmitResponse, error) {
+	resp, err := hetJobs.SubmitHetJob(ctx, components)
+	if err != nil {
+		return nil, submitError(err)
+	}
+
+	result := &types.JobSubmitResponse{
+		JobId:            resp.JobId,
+		HetJobID:         resp.JobId,
+		HetJobComponents: make([]types.HetJobComponent, len(components)),
+	}
+	for i := range components {
+		result.HetJobComponents[i] = types.HetJobComponent{
+			Offset: int32(i),
+			JobID:  resp.JobId + int64(i),
+		}
+	}
+	return result, nil
+}
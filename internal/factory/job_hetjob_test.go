@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func coupledSimulation() *types.JobSubmission {
+	return &types.JobSubmission{
+		Name:      "coupled",
+		Script:    "#!/bin/bash\nsrun --het-group=0,1 ./couple",
+		Partition: "cpu",
+		TimeLimit: 120,
+		Dependencies: []types.JobDependency{
+			{Type: "afterok", JobIDs: []int64{7}},
+		},
+		Components: []types.JobComponent{
+			{CPUs: 64, Nodes: 2},
+			{Partition: "gpu", Nodes: 1, TRESPerNode: "gres/gpu:4", Exclusive: true},
+		},
+	}
+}
+
+func TestAdapterJobManager_SubmitHetJob(t *testing.T) {
+	var body struct {
+		Job  map[string]any   `json:"job"`
+		Jobs []map[string]any `json:"jobs"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/job/submit") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"job_id": 500}`))
+	}))
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			body.Job, body.Jobs = nil, nil
+			resp, err := client.Jobs().Submit(ctx, coupledSimulation())
+			require.NoError(t, err)
+			assert.Equal(t, int64(500), resp.JobId)
+			assert.Equal(t, int64(500), resp.HetJobID)
+			assert.Equal(t, []types.HetJobComponent{
+				{Offset: 0, JobID: 500},
+				{Offset: 1, JobID: 501},
+			}, resp.HetJobComponents)
+
+			assert.Nil(t, body.Job)
+			require.Len(t, body.Jobs, 2)
+			first, second := body.Jobs[0], body.Jobs[1]
+			assert.Equal(t, "coupled", first["name"])
+			assert.Equal(t, "cpu", first["partition"])
+			assert.Equal(t, float64(64), first["minimum_cpus"])
+			assert.Equal(t, float64(2), first["minimum_nodes"])
+			assert.Equal(t, "afterok:7", first["dependency"])
+			assert.Contains(t, first["script"], "--het-group")
+
+			assert.Equal(t, "coupled", second["name"])
+			assert.Equal(t, "gpu", second["partition"])
+			assert.Equal(t, float64(1), second["minimum_nodes"])
+			assert.Equal(t, "gres/gpu:4", second["tres_per_node"])
+			assert.NotContains(t, second, "script")
+			assert.NotContains(t, second, "dependency")
+			assert.NotNil(t, second["shared"])
+		})
+	}
+}
+
+func TestNewHetJobComponents_Validation(t *testing.T) {
+	base, err := newJobCreate(&types.JobSubmission{Script: "#!/bin/bash\ntrue"})
+	require.NoError(t, err)
+
+	_, err = newHetJobComponents(base, []types.JobComponent{{CPUs: 4}})
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "Components", validationErr.Field)
+
+	array := "1-4"
+	base.Array = &array
+	_, err = newHetJobComponents(base, []types.JobComponent{{CPUs: 4}, {CPUs: 8}})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "ArraySpec", validationErr.Field)
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// History returns the slurmdbd records of the jobs selected by opts. Unlike
+// List, which asks slurmctld, it includes jobs that have finished and been
+// purged from the queue.
+func (m *adapterJobManager) History(ctx context.Context, opts *types.JobHistoryOptions) ([]types.Job, error) {
+	history, ok := m.adapter.(common.JobHistoryAdapter)
+	if !ok {
+		return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			"job history is not available in this API version")
+	}
+	if opts == nil {
+		opts = &types.JobHistoryOptions{}
+	}
+	if !opts.Until.IsZero() && opts.Until.Before(opts.Since) {
+		return nil, errors.NewValidationErrorf("Until", opts.Until,
+			"Until cannot be before Since")
+	}
+	return history.ListHistory(ctx, opts)
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_History(t *testing.T) {
+	since := time.Unix(1700000000, 0)
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/slurmdb/"+version+"/jobs/" {
+					http.NotFound(w, r)
+					return
+				}
+				query := r.URL.Query()
+				assert.Equal(t, "1700000000", query.Get("start_time"))
+				assert.False(t, query.Has("end_time"))
+				assert.Equal(t, "gpu", query.Get("partition"))
+				assert.Equal(t, "normal", query.Get("qos"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"jobs": [{
+					"job_id": 42, "partition": "gpu", "qos": "normal",
+					"allocation_nodes": 2, "required": {"CPUs": 16},
+					"state": {"current": ["COMPLETED"]},
+					"time": {"submission": 1700000100, "eligible": 1700000160, "start": 1700000400,
+						"end": 1700004000, "limit": {"set": true, "number": 120}}
+				}]}`))
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			jobs, err := client.Jobs().History(ctx, &types.JobHistoryOptions{
+				Since:     since,
+				Partition: "gpu",
+				QoS:       "normal",
+			})
+			require.NoError(t, err)
+			require.Len(t, jobs, 1)
+			job := jobs[0]
+			assert.Equal(t, int64(42), *job.JobID)
+			assert.Equal(t, "normal", *job.QoS)
+			assert.Equal(t, uint32(2), *job.NodeCount)
+			assert.Equal(t, uint32(16), *job.CPUs)
+			assert.Equal(t, uint32(120), *job.TimeLimit)
+			assert.Equal(t, time.Unix(1700000160, 0), job.EligibleTime)
+			assert.Equal(t, time.Unix(1700000400, 0), job.StartTime)
+		})
+	}
+}
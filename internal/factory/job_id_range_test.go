@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdapterJobManager_JobIDAboveInt32 decodes job IDs past the int32 range,
+// which Slurm hands out as its job IDs are unsigned 32-bit
+func TestAdapterJobManager_JobIDAboveInt32(t *testing.T) {
+	const jobID = int64(3000000000) // above math.MaxInt32
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/job/submit"):
+					_, _ = w.Write([]byte(`{"job_id": 3000000001, "step_id": "batch"}`))
+				case strings.HasSuffix(r.URL.Path, "/job/3000000000"), strings.HasSuffix(r.URL.Path, "/jobs/"):
+					_, _ = w.Write([]byte(`{"jobs": [{"job_id": 3000000000, "name": "large"}]}`))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			job, err := client.Jobs().Get(ctx, "3000000000")
+			require.NoError(t, err)
+			require.NotNil(t, job.JobID)
+			assert.Equal(t, jobID, *job.JobID)
+
+			list, err := client.Jobs().List(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, list.Jobs, 1)
+			assert.Equal(t, jobID, *list.Jobs[0].JobID)
+
+			resp, err := client.Jobs().Submit(ctx, &types.JobSubmission{
+				Name:   "large",
+				Script: "#!/bin/bash\ntrue",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, jobID+1, resp.JobId)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// ListAll returns every matching job. slurmrestd has no server-side paging
+// and answers each List with the whole queue, which List then slices, so
+// paging with Limit and Offset would fetch the queue once per page. ListAll
+// makes a single request instead, and fails when more than MaxJobs match,
+// so callers do not go on to process an unexpectedly large listing. The
+// response itself is decoded in full before the cap is checked.
+func (m *adapterJobManager) ListAll(ctx context.Context, opts *types.ListAllJobsOptions) ([]types.Job, error) {
+	maxJobs := types.DefaultListAllMaxJobs
+	var filters types.ListJobsOptions
+	if opts != nil {
+		if opts.MaxJobs < 0 {
+			return nil, errors.NewValidationErrorf("MaxJobs", opts.MaxJobs,
+				"MaxJobs cannot be negative")
+		}
+		if opts.MaxJobs > 0 {
+			maxJobs = opts.MaxJobs
+		}
+		if opts.Filters != nil {
+			filters = *opts.Filters
+		}
+	}
+	filters.Limit = 0
+	filters.Offset = 0
+
+	list, err := m.List(ctx, &filters)
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Jobs) > maxJobs {
+		return nil, errors.NewClientError(errors.ErrorCodeResourceExhausted,
+			fmt.Sprintf("more than %d jobs match", maxJobs),
+			"narrow the filters or raise MaxJobs")
+	}
+	return list.Jobs, nil
+}
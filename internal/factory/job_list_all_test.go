@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_ListAll(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	queue := make([]types.Job, 7)
+	for i := range queue {
+		queue[i] = types.Job{JobID: ptrInt64(int64(i + 1)), Partition: ptrString("batch")}
+	}
+
+	var requests []types.JobListOptions
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+					requests = append(requests, *opts)
+					start := min(opts.Offset, len(queue))
+					end := len(queue)
+					if opts.Limit > 0 {
+						end = min(start+opts.Limit, len(queue))
+					}
+					return &types.JobList{Jobs: queue[start:end], Total: len(queue)}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	// One request fetches everything, whatever the filters' own Limit and
+	// Offset
+	jobs, err := client.Jobs().ListAll(ctx, &types.ListAllJobsOptions{
+		Filters: &types.ListJobsOptions{Partition: "batch", Limit: 1, Offset: 5},
+	})
+	require.NoError(t, err)
+	require.Len(t, jobs, 7)
+	for i, job := range jobs {
+		assert.Equal(t, int64(i+1), *job.JobID)
+	}
+	require.Len(t, requests, 1)
+	assert.Zero(t, requests[0].Limit)
+	assert.Zero(t, requests[0].Offset)
+	assert.Equal(t, []string{"batch"}, requests[0].Partitions)
+
+	requests = nil
+	jobs, err = client.Jobs().ListAll(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, jobs, 7)
+	assert.Len(t, requests, 1)
+
+	// More matches than the cap fail instead of being returned
+	_, err = client.Jobs().ListAll(ctx, &types.ListAllJobsOptions{MaxJobs: 5})
+	require.Error(t, err)
+	var slurmErr *errors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeResourceExhausted, slurmErr.Code)
+
+	_, err = client.Jobs().ListAll(ctx, &types.ListAllJobsOptions{MaxJobs: -1})
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_SubmitNiceReboot(t *testing.T) {
+	var job map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.HasSuffix(r.URL.Path, "/job/submit") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body struct {
+			Job map[string]any `json:"job"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		job = body.Job
+		_, _ = w.Write([]byte(`{"job_id": 100}`))
+	}))
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			job = nil
+			_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+				Name:   "backfill",
+				Script: "#!/bin/bash\ntrue",
+				Nice:   50,
+				Reboot: true,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, job)
+			assert.Equal(t, float64(50), job["nice"])
+			assert.Equal(t, true, job["reboot"])
+
+			job = nil
+			_, err = client.Jobs().Submit(ctx, &types.JobSubmission{Name: "plain", Script: "#!/bin/bash\ntrue"})
+			require.NoError(t, err)
+			require.NotNil(t, job)
+			assert.NotContains(t, job, "nice")
+			assert.NotContains(t, job, "reboot")
+		})
+	}
+}
+
+func TestAdapterJobManager_SubmitNiceOutOfRange(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	submitted := false
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+					submitted = true
+					return &types.JobSubmitResponse{JobId: 1}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	_, err := client.Jobs().Submit(ctx, &types.JobSubmission{Name: "x", Script: "#!/bin/bash\ntrue", Nice: maxJobNice + 1})
+	require.Error(t, err)
+	assert.True(t, errors.IsValidationError(err))
+	assert.False(t, submitted)
+}
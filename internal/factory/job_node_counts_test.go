@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdapterClient_JobNodeCounts checks that each version's raw node
+// fields map to RequestedNodes and AllocatedNodes. Job 1 asked for 2-3 nodes
+// and got 3, which node_count then reports; job 2 is pending on 4.
+func TestAdapterClient_JobNodeCounts(t *testing.T) {
+	// v0.0.40 reports the allocation as allocated_hosts and a node string
+	const v40Jobs = `{"jobs": [
+		{"job_id": 1, "job_state": ["RUNNING"], "node_count": {"set": true, "number": 3},
+		 "tres_req_str": "cpu=8,node=2", "nodes": "c[1-3]",
+		 "job_resources": {"nodes": "c[1-3]", "allocated_cpus": 12, "allocated_hosts": 3}},
+		{"job_id": 2, "job_state": ["PENDING"], "node_count": {"set": true, "number": 4}}
+	]}`
+	// Later versions nest the count and list under job_resources.nodes
+	const v41Jobs = `{"jobs": [
+		{"job_id": 1, "job_state": ["RUNNING"], "node_count": {"set": true, "number": 3},
+		 "tres_req_str": "cpu=8,node=2", "nodes": "c[1-3]",
+		 "job_resources": {"cpus": 12, "nodes": {"count": 3, "list": "c[1-3]"}}},
+		{"job_id": 2, "job_state": ["PENDING"], "node_count": {"set": true, "number": 4}}
+	]}`
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			body := v41Jobs
+			if version == "v0.0.40" {
+				body = v40Jobs
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			jobs, err := client.Jobs().List(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, jobs.Jobs, 2)
+
+			running, pending := &jobs.Jobs[0], &jobs.Jobs[1]
+			assert.Equal(t, 2, running.RequestedNodes())
+			assert.Equal(t, 3, running.AllocatedNodes())
+			require.NotNil(t, running.JobResources)
+			require.NotNil(t, running.JobResources.Nodes)
+			assert.Equal(t, int32(3), *running.JobResources.Nodes.Count)
+			assert.Equal(t, 4, pending.RequestedNodes())
+			assert.Equal(t, 0, pending.AllocatedNodes())
+		})
+	}
+}
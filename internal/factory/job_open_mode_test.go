@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_SubmitStdioAndOpenMode(t *testing.T) {
+	var job map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/job/submit") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body struct {
+			Job map[string]any `json:"job"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		job = body.Job
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"job_id": 42}`))
+	}))
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			job = nil
+			_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+				Name:     "stdio",
+				Script:   "#!/bin/bash\ncat",
+				StdIn:    "/data/input.txt",
+				StdOut:   "/logs/%j.out",
+				StdErr:   "/logs/%j.err",
+				OpenMode: types.OpenModeAppend,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, job)
+			assert.Equal(t, "/data/input.txt", job["standard_input"])
+			assert.Equal(t, "/logs/%j.out", job["standard_output"])
+			assert.Equal(t, "/logs/%j.err", job["standard_error"])
+			assert.Equal(t, []any{"APPEND"}, job["open_mode"])
+
+			_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+				Script:   "#!/bin/bash\ntrue",
+				OpenMode: "overwrite",
+			})
+			require.Error(t, err)
+			assert.True(t, errors.IsValidationError(err))
+		})
+	}
+}
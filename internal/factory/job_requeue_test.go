@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requeueTestClient(jobAdapter *mockJobAdapter) *AdapterClient {
+	return &AdapterClient{
+		adapter: &testVersionAdapter{version: "v0.0.44", jobAdapter: jobAdapter},
+		version: "v0.0.44",
+	}
+}
+
+func TestAdapterJobManager_RequeueHold(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var calls []string
+	client := requeueTestClient(&mockJobAdapter{
+		requeueFunc: func(ctx context.Context, jobID int64) error {
+			calls = append(calls, "requeue")
+			assert.Equal(t, int64(42), jobID)
+			return nil
+		},
+		holdFunc: func(ctx context.Context, req *types.JobHoldRequest) error {
+			calls = append(calls, "hold")
+			assert.Equal(t, int64(42), req.JobId)
+			assert.True(t, req.Hold)
+			return nil
+		},
+	})
+
+	require.NoError(t, client.Jobs().Requeue(ctx, "42", nil))
+	assert.Equal(t, []string{"requeue"}, calls)
+
+	calls = nil
+	require.NoError(t, client.Jobs().Requeue(ctx, "42", &types.RequeueOptions{Hold: true}))
+	assert.Equal(t, []string{"requeue", "hold"}, calls)
+}
+
+func TestAdapterJobManager_RequeueIncrement(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	restarts := int32(2)
+	counted := true
+	client := requeueTestClient(&mockJobAdapter{
+		getFunc: func(ctx context.Context, jobID int64) (*types.Job, error) {
+			count := restarts
+			return &types.Job{JobID: &jobID, RestartCnt: &count}, nil
+		},
+		requeueFunc: func(ctx context.Context, jobID int64) error {
+			if counted {
+				restarts++
+			}
+			return nil
+		},
+	})
+
+	require.NoError(t, client.Jobs().Requeue(ctx, "42", &types.RequeueOptions{Increment: true}))
+	job, err := client.Jobs().Get(ctx, "42")
+	require.NoError(t, err)
+	assert.Equal(t, 3, job.RequeueCount())
+
+	counted = false
+	err = client.Jobs().Requeue(ctx, "42", &types.RequeueOptions{Increment: true})
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeConflict, errors.GetErrorCode(err))
+}
+
+func TestAdapterJobManager_RequeueNotRequeueable(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	held := false
+	client := requeueTestClient(&mockJobAdapter{
+		requeueFunc: func(ctx context.Context, jobID int64) error {
+			return errors.NewSlurmError(errors.ErrorCodeInvalidRequest, "Requested operation is presently disabled")
+		},
+		holdFunc: func(ctx context.Context, req *types.JobHoldRequest) error {
+			held = true
+			return nil
+		},
+	})
+
+	err := client.Jobs().Requeue(ctx, "42", &types.RequeueOptions{Hold: true})
+	require.Error(t, err)
+	assert.True(t, errors.IsNotRequeueableError(err))
+	assert.False(t, held, "a job that was not requeued must not be held")
+
+	var requeueErr *errors.NotRequeueableError
+	require.ErrorAs(t, err, &requeueErr)
+	assert.Equal(t, "42", requeueErr.JobID)
+	assert.Equal(t, "requeue is disabled for the job", requeueErr.Reason)
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// Script returns the batch script of a job. Scripts are only available from
+// the accounting database, and only if slurmdbd is configured to store them.
+// An adapter without that endpoint uses scontrol when the CLI fallback is
+// configured.
+func (m *adapterJobManager) Script(ctx context.Context, jobID string) (string, error) {
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid job JobId: %w", err)
+	}
+
+	scripts, ok := m.adapter.(common.JobScriptAdapter)
+	if !ok && m.cli != nil {
+		return m.cli.JobScript(ctx, jobID)
+	}
+	if !ok {
+		return "", errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			"job scripts are not available in this API version")
+	}
+	return scripts.GetScript(ctx, jobIDInt)
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockScriptJobAdapter adds stored batch scripts to mockJobAdapter
+type mockScriptJobAdapter struct {
+	mockJobAdapter
+	scripts map[int64]string
+}
+
+func (m *mockScriptJobAdapter) GetScript(ctx context.Context, jobID int64) (string, error) {
+	script, ok := m.scripts[jobID]
+	if !ok {
+		return "", errors.NewSlurmError(errors.ErrorCodeResourceNotFound, "no script")
+	}
+	return script, nil
+}
+
+func TestAdapterJobManager_Script(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	stored := "#!/bin/bash\n#SBATCH --nodes=2\nsrun ./simulate\n"
+	manager := &adapterJobManager{adapter: &mockScriptJobAdapter{
+		scripts: map[int64]string{42: stored},
+	}}
+
+	script, err := manager.Script(ctx, "42")
+	require.NoError(t, err)
+	assert.Equal(t, stored, script)
+
+	_, err = manager.Script(ctx, "43")
+	require.Error(t, err)
+
+	_, err = manager.Script(ctx, "not-a-job")
+	require.Error(t, err)
+}
+
+func TestAdapterJobManager_Script_Unsupported(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	manager := &adapterJobManager{adapter: &mockJobAdapter{}}
+
+	_, err := manager.Script(ctx, "42")
+	require.Error(t, err)
+	assert.True(t, errors.IsNotImplementedError(err))
+}
+
+// TestAdapterJobManager_Script_Accounting checks that every version reads
+// the script from the slurmdbd job record
+func TestAdapterJobManager_Script_Accounting(t *testing.T) {
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/slurmdb/"+version+"/jobs/" {
+					http.NotFound(w, r)
+					return
+				}
+				assert.Equal(t, "true", r.URL.Query().Get("show_batch_script"))
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Query().Get("step") {
+				case "42":
+					_, _ = w.Write([]byte(`{"jobs": [{"job_id": 42, "script": "#!/bin/bash\nsrun ./simulate\n"}]}`))
+				case "43":
+					// Stored without AccountingStoreFlags=job_script
+					_, _ = w.Write([]byte(`{"jobs": [{"job_id": 43}]}`))
+				default:
+					_, _ = w.Write([]byte(`{"jobs": []}`))
+				}
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			script, err := client.Jobs().Script(ctx, "42")
+			require.NoError(t, err)
+			assert.Equal(t, "#!/bin/bash\nsrun ./simulate\n", script)
+
+			for _, jobID := range []string{"43", "44"} {
+				_, err = client.Jobs().Script(ctx, jobID)
+				assert.True(t, errors.IsNotFoundError(err), "job %s: %v", jobID, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// signalNumbers maps signal names to their numbers on Linux, where the
+// signal is delivered; the numbers of the host the client runs on can
+// differ (SIGUSR1 is 30 on macOS)
+var signalNumbers = map[string]int{
+	"HUP": 1, "INT": 2, "QUIT": 3, "ILL": 4, "TRAP": 5, "ABRT": 6, "BUS": 7,
+	"FPE": 8, "KILL": 9, "USR1": 10, "SEGV": 11, "USR2": 12, "PIPE": 13,
+	"ALRM": 14, "TERM": 15, "STKFLT": 16, "CHLD": 17, "CONT": 18, "STOP": 19,
+	"TSTP": 20, "TTIN": 21, "TTOU": 22, "URG": 23, "XCPU": 24, "XFSZ": 25,
+	"VTALRM": 26, "PROF": 27, "WINCH": 28, "IO": 29, "PWR": 30, "SYS": 31,
+}
+
+// maxSignal is the highest real-time signal number on Linux
+const maxSignal = 64
+
+// signalNumber reads a signal given as a name, with or without the SIG
+// prefix and in any case ("SIGUSR1", "usr1"), or as a number ("10")
+func signalNumber(signal string) (int, error) {
+	signal = strings.TrimSpace(signal)
+	if n, err := strconv.Atoi(signal); err == nil {
+		if n < 1 || n > maxSignal {
+			return 0, errors.NewValidationErrorf("signal", signal, "signal number must be between 1 and %d", maxSignal)
+		}
+		return n, nil
+	}
+	name := strings.TrimPrefix(strings.ToUpper(signal), "SIG")
+	if n, ok := signalNumbers[name]; ok {
+		return n, nil
+	}
+	return 0, errors.NewValidationErrorf("signal", signal, "unknown signal %q", signal)
+}
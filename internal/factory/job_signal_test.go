@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_Signal(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || !strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/job/42") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"errors": [], "warnings": []}`))
+	}))
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			query = nil
+			require.NoError(t, client.Jobs().Signal(ctx, "42", "SIGUSR1", nil))
+			require.NotNil(t, query)
+			assert.Equal(t, "10", query.Get("signal"))
+			assert.Empty(t, query.Get("flags"))
+
+			query = nil
+			require.NoError(t, client.Jobs().Signal(ctx, "42", "15", &types.SignalOptions{BatchOnly: true}))
+			require.NotNil(t, query)
+			assert.Equal(t, "15", query.Get("signal"))
+			assert.Equal(t, "BATCH_JOB", query.Get("flags"))
+		})
+	}
+}
+
+func TestAdapterJobManager_SignalInvalid(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{version: "v0.0.44", jobAdapter: &mockJobAdapter{}},
+		version: "v0.0.44",
+	}
+
+	err := client.Jobs().Signal(ctx, "42", "SIGFOO", nil)
+	assert.True(t, errors.IsValidationError(err))
+	err = client.Jobs().Signal(ctx, "42", "99", nil)
+	assert.True(t, errors.IsValidationError(err))
+
+	// Steps can only be signalled through scancel
+	err = client.Jobs().Signal(ctx, "42", "usr2", &types.SignalOptions{StepID: "0"})
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, errors.GetErrorCode(err))
+}
+
+func TestSignalNumber(t *testing.T) {
+	for signal, want := range map[string]int{"SIGUSR1": 10, "usr2": 12, "TERM": 15, "SigKill": 9, "34": 34} {
+		got, err := signalNumber(signal)
+		require.NoError(t, err, signal)
+		assert.Equal(t, want, got, signal)
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// ListSteps returns the steps of a job. Step records come from the
+// accounting database, which slurmctld updates while the job runs, so this
+// works for running and completed jobs alike. Step records are read from
+// the slurmdb job endpoint, or with sacct when the adapter lacks it and the
+// CLI fallback is configured.
+func (m *adapterJobManager) ListSteps(ctx context.Context, jobID string) ([]types.JobStep, error) {
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job JobId: %w", err)
+	}
+
+	steps, ok := m.adapter.(common.JobStepsAdapter)
+	if !ok && m.cli != nil {
+		return m.cli.JobSteps(ctx, jobID)
+	}
+	if !ok {
+		return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			"job steps are not available in this API version")
+	}
+	return steps.ListSteps(ctx, jobIDInt)
+}
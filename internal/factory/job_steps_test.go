@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_ListSteps(t *testing.T) {
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != fmt.Sprintf("/slurmdb/%s/job/123", version) {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"jobs": [{"job_id": 123, "steps": [
+					{"step": {"id": "123.batch", "name": "batch"}, "state": ["COMPLETED"],
+					 "time": {"start": {"set": true, "number": 1740823200}, "end": {"set": true, "number": 1740827100}},
+					 "nodes": {"range": "node01"}, "exit_code": {"return_code": {"set": true, "number": 0}},
+					 "tres": {"allocated": [{"type": "cpu", "count": 4}, {"type": "mem", "count": 8192}]}},
+					{"step": {"id": "123.0", "name": "hydra"}, "state": ["RUNNING"],
+					 "time": {"start": {"set": true, "number": 1740823260}, "end": {"set": true, "number": 0}},
+					 "nodes": {"range": "node[01-02]"},
+					 "tres": {"allocated": [{"type": "cpu", "count": 8}, {"type": "gres", "name": "gpu", "count": 2}]}}
+				]}]}`))
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			steps, err := client.Jobs().ListSteps(ctx, "123")
+			require.NoError(t, err)
+			require.Len(t, steps, 2)
+
+			assert.Equal(t, "batch", steps[0].ID)
+			assert.Equal(t, "123", steps[0].JobID)
+			assert.Equal(t, "COMPLETED", steps[0].State)
+			assert.Equal(t, 4, steps[0].CPUs)
+			assert.Equal(t, 8192, steps[0].Memory)
+			assert.Equal(t, "node01", steps[0].Nodes)
+			require.NotNil(t, steps[0].EndTime)
+			assert.Equal(t, int64(3900), steps[0].EndTime.Unix()-steps[0].StartTime.Unix())
+
+			assert.Equal(t, "0", steps[1].ID)
+			assert.Equal(t, "hydra", steps[1].Name)
+			assert.Equal(t, "RUNNING", steps[1].State)
+			assert.Equal(t, "cpu=8,gpu=2", steps[1].TRESAllocStr)
+			assert.NotNil(t, steps[1].StartTime)
+			assert.Nil(t, steps[1].EndTime)
+
+			_, err = client.Jobs().ListSteps(ctx, "not-a-job")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestAdapterJobManager_ListSteps_Unsupported(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	manager := &adapterJobManager{adapter: &mockJobAdapter{}}
+
+	_, err := manager.ListSteps(ctx, "42")
+	var slurmErr *errors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, slurmErr.Code)
+}
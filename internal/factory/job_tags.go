@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// ListByTag returns the jobs tagged key=value in their admin comment (see
+// api.MergeJobTags). Slurm cannot filter on comments, so every job is fetched
+// and matched client-side.
+func (m *adapterJobManager) ListByTag(ctx context.Context, key, value string) ([]*types.Job, error) {
+	if key == "" {
+		return nil, errors.NewValidationErrorf("key", key, "tag key is required")
+	}
+
+	result, err := m.adapter.List(ctx, &types.JobListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*types.Job, 0)
+	if result == nil {
+		return jobs, nil
+	}
+	for i := range result.Jobs {
+		job := &result.Jobs[i]
+		if tag, ok := job.Tags()[key]; ok && tag == value {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_ListByTag(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	queue := []types.Job{
+		{JobID: ptrInt64(1), AdminComment: ptrString(types.FormatJobTags(map[string]string{"project": "atlas", "stage": "train"}))},
+		{JobID: ptrInt64(2), AdminComment: ptrString(types.MergeJobTags("requeued by admin", map[string]string{"project": "atlas"}))},
+		{JobID: ptrInt64(3), AdminComment: ptrString("project=cms")},
+		{JobID: ptrInt64(4)},
+	}
+	manager := &adapterJobManager{adapter: &mockJobAdapter{
+		listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+			return &types.JobList{Jobs: queue, Total: len(queue)}, nil
+		},
+	}}
+
+	jobs, err := manager.ListByTag(ctx, "project", "atlas")
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, int64(1), *jobs[0].JobID)
+	assert.Equal(t, int64(2), *jobs[1].JobID)
+
+	jobs, err = manager.ListByTag(ctx, "stage", "train")
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, int64(1), *jobs[0].JobID)
+
+	jobs, err = manager.ListByTag(ctx, "project", "lhcb")
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	_, err = manager.ListByTag(ctx, "", "atlas")
+	assert.True(t, errors.IsValidationError(err))
+}
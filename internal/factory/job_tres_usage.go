@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// TRESUsage returns the resources a job consumed, per step and per node,
+// from its accounting record.
+func (m *adapterJobManager) TRESUsage(ctx context.Context, jobID string) (*types.TRESUsageReport, error) {
+	jobIDInt, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job JobId: %w", err)
+	}
+
+	usage, ok := m.adapter.(common.JobTRESUsageAdapter)
+	if !ok {
+		return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			"job TRES usage is not available in this API version")
+	}
+	return usage.GetTRESUsage(ctx, jobIDInt)
+}
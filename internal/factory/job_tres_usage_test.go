@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_TRESUsage(t *testing.T) {
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != fmt.Sprintf("/slurmdb/%s/job/123", version) {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"jobs": [{"job_id": 123,
+					"tres": {"allocated": [{"type": "cpu", "count": 8}, {"type": "gres", "name": "gpu", "count": 2}]},
+					"steps": [
+					{"step": {"id": "123.batch", "name": "batch"}, "nodes": {"list": ["node01"]},
+					 "time": {"total": {"seconds": 30, "microseconds": 500000}},
+					 "statistics": {"energy": {"consumed": {"set": true, "number": 1000}}},
+					 "tres": {"consumed": {"max": [{"type": "mem", "count": 104857600}]}}},
+					{"step": {"id": "123.0", "name": "hydra"}, "nodes": {"list": ["node01", "node02"]},
+					 "time": {"total": {"seconds": 3600}},
+					 "statistics": {"energy": {"consumed": {"set": true, "number": 50000}}},
+					 "tres": {"consumed": {
+					   "max": [{"type": "mem", "count": 2147483648}, {"type": "fs", "name": "disk", "count": 4096}],
+					   "total": [{"type": "mem", "count": 4294967296}]}}}
+				]}]}`))
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			usage, err := client.Jobs().TRESUsage(ctx, "123")
+			require.NoError(t, err)
+			assert.Equal(t, "123", usage.JobID)
+			assert.Equal(t, map[string]int64{"cpu": 8, "gres/gpu": 2}, usage.Allocated)
+			assert.Equal(t, int64(2147483648), usage.MaxRSS)
+			assert.Equal(t, time.Hour+30500*time.Millisecond, usage.CPUTime)
+			assert.Equal(t, int64(51000), usage.EnergyConsumed)
+
+			require.Len(t, usage.Steps, 2)
+			assert.Equal(t, "batch", usage.Steps[0].StepID)
+			assert.Equal(t, int64(104857600), usage.Steps[0].MaxRSS)
+			assert.Equal(t, "0", usage.Steps[1].StepID)
+			assert.Equal(t, int64(4096), usage.Steps[1].ConsumedMax["fs/disk"])
+			assert.Equal(t, int64(4294967296), usage.Steps[1].ConsumedTotal["mem"])
+
+			require.Len(t, usage.Nodes, 2)
+			assert.Equal(t, "node01", usage.Nodes[0].Node)
+			assert.Equal(t, []string{"batch", "0"}, usage.Nodes[0].Steps)
+			assert.Equal(t, 30500*time.Millisecond+30*time.Minute, usage.Nodes[0].CPUTime)
+			assert.Equal(t, int64(26000), usage.Nodes[0].EnergyConsumed)
+			assert.Equal(t, "node02", usage.Nodes[1].Node)
+			assert.Equal(t, int64(2147483648), usage.Nodes[1].MaxRSS)
+			assert.Equal(t, 30*time.Minute, usage.Nodes[1].CPUTime)
+		})
+	}
+}
+
+func TestAdapterJobManager_TRESUsage_Unsupported(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	manager := &adapterJobManager{adapter: &mockJobAdapter{}}
+
+	_, err := manager.TRESUsage(ctx, "42")
+	var slurmErr *errors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, slurmErr.Code)
+
+	_, err = manager.TRESUsage(ctx, "not-a-job")
+	require.Error(t, err)
+}
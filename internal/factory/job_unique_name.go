@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// checkUniqueJobName fails with a conflict error when a job named name is
+// still active among user's jobs, or among every user's jobs when user is
+// empty. Two submitters racing each other can both pass the check; it
+// guards against repeated runs, not concurrent ones.
+func (m *adapterJobManager) checkUniqueJobName(ctx context.Context, name, user string) error {
+	if name == "" {
+		return errors.NewValidationErrorf("Name", name, "a job name is required to ensure it is unique")
+	}
+
+	opts := &types.JobListOptions{JobNames: []string{name}}
+	if user != "" {
+		opts.Users = []string{user}
+	}
+	result, err := m.adapter.List(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to check for jobs named %q: %w", name, err)
+	}
+	if result == nil {
+		return nil
+	}
+
+	for i := range result.Jobs {
+		job := &result.Jobs[i]
+		if derefString(job.Name) != name || job.JobID == nil || isTerminalJob(job) {
+			continue
+		}
+		if user != "" && derefString(job.UserName) != user {
+			continue
+		}
+		return errors.NewSlurmError(errors.ErrorCodeConflict,
+			fmt.Sprintf("job %q is already active as job %d", name, *job.JobID))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterClient_Submit_EnsureUniqueName(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	queue := []types.Job{
+		{JobID: ptrInt64(10), Name: ptrString("nightly-etl"), UserName: ptrString("alice"),
+			JobState: []types.JobState{types.JobStateRunning}},
+		{JobID: ptrInt64(11), Name: ptrString("weekly-report"), UserName: ptrString("alice"),
+			JobState: []types.JobState{types.JobStateCompleted}},
+	}
+
+	submitted := 0
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+					return &types.JobList{Jobs: queue, Total: len(queue)}, nil
+				},
+				submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+					submitted++
+					return &types.JobSubmitResponse{JobId: 42}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	submission := &types.JobSubmission{
+		Name:             "nightly-etl",
+		Script:           "#!/bin/bash\n./etl.sh",
+		EnsureUniqueName: true,
+	}
+
+	// A running job with the same name blocks the submission
+	_, err := client.Jobs().Submit(ctx, submission)
+	require.Error(t, err)
+	var slurmErr *errors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeConflict, slurmErr.Code)
+	assert.Contains(t, err.Error(), "job 10")
+	assert.Zero(t, submitted)
+
+	// ...unless it belongs to another user
+	submission.User = "bob"
+	resp, err := client.Jobs().Submit(ctx, submission)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.JobId)
+
+	// A finished job with the same name does not
+	_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:             "weekly-report",
+		Script:           "#!/bin/bash\n./report.sh",
+		EnsureUniqueName: true,
+	})
+	require.NoError(t, err)
+
+	// Without User the check is limited to the authenticated user's jobs
+	submission.User = ""
+	client.username = "bob"
+	_, err = client.Jobs().Submit(ctx, submission)
+	require.NoError(t, err)
+	client.username = "alice"
+	_, err = client.Jobs().Submit(ctx, submission)
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeConflict, slurmErr.Code)
+
+	// Without the option duplicates are allowed
+	submission.EnsureUniqueName = false
+	_, err = client.Jobs().Submit(ctx, submission)
+	require.NoError(t, err)
+	assert.Equal(t, 4, submitted)
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// Validate checks a job submission client-side and collects every problem,
+// so callers can report them all at once as a form would. When the job names
+// both a partition and a QOS, the partition is looked up to check the QOS
+// against its AllowQos and DenyQos lists. The returned error is reserved for
+// failures to perform the validation itself.
+func (m *adapterJobManager) Validate(ctx context.Context, job *types.JobSubmission) (*types.ValidationResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	result := validateJobSubmission(job)
+	if err := m.validatePartitionQoS(ctx, job, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// validatePartitionQoS reports a QOS the job's partition does not accept
+func (m *adapterJobManager) validatePartitionQoS(ctx context.Context, job *types.JobSubmission, result *types.ValidationResult) error {
+	if job == nil || job.Partition == "" || job.QoS == "" || m.partitions == nil {
+		return nil
+	}
+	partition, err := m.partitions.Get(ctx, job.Partition)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			result.Add("Partition", job.Partition, "partition %q does not exist", job.Partition)
+			return nil
+		}
+		return err
+	}
+	if !partition.PermitsQoS(job.QoS) {
+		result.Add("QoS", job.QoS, "QOS %q is not allowed in partition %q", job.QoS, job.Partition)
+	}
+	return nil
+}
+
+// validateJobSubmission runs every JobSubmission check
+func validateJobSubmission(job *types.JobSubmission) *types.ValidationResult {
+	result := &types.ValidationResult{}
+	if job == nil {
+		result.Add("job", nil, "job submission is required")
+		return result
+	}
+
+	if strings.TrimSpace(job.Script) == "" && strings.TrimSpace(job.Command) == "" {
+		result.Add("Script", job.Script, "a script or command is required")
+	}
+
+	nonNegative := []struct {
+		field string
+		value int
+	}{
+		{"CPUs", job.CPUs},
+		{"Memory", job.Memory},
+		{"TimeLimit", job.TimeLimit},
+		{"Nodes", job.Nodes},
+		{"Priority", job.Priority},
+	}
+	for _, f := range nonNegative {
+		if f.value < 0 {
+			result.Add(f.field, f.value, "%s cannot be negative", f.field)
+		}
+	}
+
+	if job.EnsureUniqueName && job.Name == "" {
+		result.Add("Name", job.Name, "a job name is required to ensure it is unique")
+	}
+
+	if job.Exclusive && job.Oversubscribe {
+		result.Add("Oversubscribe", job.Oversubscribe, "Exclusive and Oversubscribe cannot both be set")
+	}
+
+	if _, invalid := jobArraySpec(job.ArraySpec, job.ArrayMaxConcurrent); invalid != nil {
+		result.Add(invalid.Field, invalid.Value, "%s", invalid.Message)
+	}
+	if _, invalid := jobDependencySpec(job.Dependencies); invalid != nil {
+		result.Add(invalid.Field, invalid.Value, "%s", invalid.Message)
+	}
+	if _, invalid := jobGRESFlags(job.GRESFlags); invalid != nil {
+		result.Add(invalid.Field, invalid.Value, "%s", invalid.Message)
+	}
+
+	if job.Nice < -maxJobNice || job.Nice > maxJobNice {
+		result.Add("Nice", job.Nice, "nice must be between %d and %d", -maxJobNice, maxJobNice)
+	}
+
+	switch job.OpenMode {
+	case "", types.OpenModeAppend, types.OpenModeTruncate:
+	default:
+		result.Add("OpenMode", job.OpenMode, "open mode must be %s or %s", types.OpenModeTruncate, types.OpenModeAppend)
+	}
+
+	if job.Constraints != nil {
+		if err := job.Constraints.Validate(); err != nil {
+			result.Add("Constraints", job.Constraints.String(), "%v", err)
+		}
+	}
+
+	for _, key := range sortedKeys(job.Environment) {
+		if key == "" || strings.ContainsAny(key, "= ") {
+			result.Add("Environment", key, "invalid environment variable name %q", key)
+		}
+	}
+
+	for _, key := range sortedKeys(job.SpankOptions) {
+		if plugin, option, ok := strings.Cut(key, ":"); !ok || plugin == "" || option == "" {
+			result.Add("SpankOptions", key, "SPANK option %q must be written as <plugin>:<option>", key)
+		}
+	}
+
+	if _, err := types.FormatExtra(job.Extra); err != nil {
+		result.Add("Extra", job.Extra, "%v", err)
+	}
+
+	return result
+}
+
+// validationResultError returns the issues of result as a ValidationError
+// naming the first field, or nil when there are none
+func validationResultError(result *types.ValidationResult) error {
+	if result.Valid() {
+		return nil
+	}
+	messages := make([]string, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		messages = append(messages, issue.Message)
+	}
+	first := result.Issues[0]
+	return errors.NewValidationErrorf(first.Field, first.Value, "%s", strings.Join(messages, "; "))
+}
+
+// sortedKeys returns the keys of m in sorted order, so issues are reported
+// deterministically
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
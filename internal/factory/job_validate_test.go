@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_Validate_ReportsAllIssues(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	manager := &adapterJobManager{adapter: &mockJobAdapter{}}
+
+	// No script, a negative CPU count and conflicting sharing flags
+	result, err := manager.Validate(ctx, &types.JobSubmission{
+		Name:          "broken",
+		CPUs:          -4,
+		Exclusive:     true,
+		Oversubscribe: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.False(t, result.Valid())
+	assert.Equal(t, []string{"Script", "CPUs", "Oversubscribe"}, result.Fields())
+	for _, issue := range result.Issues {
+		assert.NotEmpty(t, issue.Message)
+	}
+}
+
+func TestAdapterJobManager_Submit_RejectsWhatValidateReports(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	submitted := false
+	manager := &adapterJobManager{adapter: &mockJobAdapter{
+		submitFunc: func(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+			submitted = true
+			return &types.JobSubmitResponse{JobId: 1}, nil
+		},
+	}}
+
+	job := &types.JobSubmission{
+		Script: "#!/bin/bash\nhostname",
+		CPUs:   -4,
+		Nice:   maxJobNice + 1,
+	}
+	result, err := manager.Validate(ctx, job)
+	require.NoError(t, err)
+	require.Equal(t, []string{"CPUs", "Nice"}, result.Fields())
+
+	_, err = manager.Submit(ctx, job)
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "CPUs", validationErr.Field)
+	for _, issue := range result.Issues {
+		assert.Contains(t, err.Error(), issue.Message)
+	}
+	assert.False(t, submitted)
+
+	_, err = manager.Submit(ctx, nil)
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestAdapterJobManager_Validate_Valid(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	manager := &adapterJobManager{adapter: &mockJobAdapter{}}
+
+	result, err := manager.Validate(ctx, &types.JobSubmission{
+		Name:         "ok",
+		Script:       "#!/bin/bash\nhostname",
+		CPUs:         4,
+		Environment:  map[string]string{"OMP_NUM_THREADS": "4"},
+		SpankOptions: map[string]string{"auto_tmpdir:tmpdir": "/scratch"},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	assert.Empty(t, result.Issues)
+
+	result, err = manager.Validate(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"job"}, result.Fields())
+}
+
+// qosPartitionAdapter serves partitions with fixed QOS lists
+type qosPartitionAdapter struct {
+	recordingPartitionAdapter
+	partitions map[string]*types.Partition
+}
+
+func (m *qosPartitionAdapter) Get(ctx context.Context, partitionName string) (*types.Partition, error) {
+	if partition, ok := m.partitions[partitionName]; ok {
+		return partition, nil
+	}
+	return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, "partition not found")
+}
+
+func TestAdapterJobManager_Validate_PartitionQoS(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	deny := "scavenger"
+	manager := &adapterJobManager{
+		adapter: &mockJobAdapter{},
+		partitions: &qosPartitionAdapter{partitions: map[string]*types.Partition{
+			"gpu": {Name: ptrString("gpu"), QoS: &types.PartitionQoS{Deny: &deny}},
+		}},
+	}
+	job := &types.JobSubmission{
+		Script:    "#!/bin/bash\nhostname",
+		Partition: "gpu",
+		QoS:       "scavenger",
+	}
+
+	result, err := manager.Validate(ctx, job)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"QoS"}, result.Fields())
+
+	job.QoS = "normal"
+	result, err = manager.Validate(ctx, job)
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+
+	job.Partition = "missing"
+	result, err = manager.Validate(ctx, job)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Partition"}, result.Fields())
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func watchTestJob(id int64, state types.JobState, priority uint32) types.Job {
+	return types.Job{JobID: &id, JobState: []types.JobState{state}, Priority: &priority}
+}
+
+func TestAdapterJobManager_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(helpers.TestContext(t))
+	defer cancel()
+
+	listings := [][]types.Job{
+		{watchTestJob(1, types.JobStatePending, 10), watchTestJob(2, types.JobStateRunning, 5)},
+		// Only job 1's priority changed: nothing to report
+		{watchTestJob(1, types.JobStatePending, 20), watchTestJob(2, types.JobStateRunning, 5)},
+		{watchTestJob(1, types.JobStatePending, 30), watchTestJob(2, types.JobStateRunning, 5), watchTestJob(3, types.JobStatePending, 1)},
+		{watchTestJob(1, types.JobStateRunning, 30), watchTestJob(3, types.JobStatePending, 1)},
+		// Job 4 is filtered out by JobIDs
+		{watchTestJob(1, types.JobStateRunning, 30), watchTestJob(3, types.JobStatePending, 1), watchTestJob(4, types.JobStatePending, 1)},
+	}
+	var (
+		mu    sync.Mutex
+		polls int
+		sent  *types.JobListOptions
+	)
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				sent = opts
+				listing := listings[min(polls, len(listings)-1)]
+				polls++
+				return &types.JobList{Jobs: listing, Total: len(listing)}, nil
+			}},
+		},
+		version: "v0.0.44",
+	}
+
+	events, err := client.Jobs().Watch(ctx, &types.WatchJobsOptions{
+		UserID:       "alice",
+		Partition:    "gpu",
+		States:       []string{"PENDING", "RUNNING"},
+		JobIDs:       []string{"1", "2", "3"},
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	got := make(map[int64]types.JobEvent)
+	for len(got) < 3 {
+		select {
+		case event := <-events:
+			_, seen := got[event.JobId]
+			require.False(t, seen, "duplicate event for job %d: %+v", event.JobId, event)
+			got[event.JobId] = event
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for events, got %v", got)
+		}
+	}
+	assert.Equal(t, types.JobEventAdded, got[3].EventType)
+	assert.Equal(t, types.JobStatePending, got[3].NewState)
+	assert.Equal(t, types.JobEventModified, got[1].EventType)
+	assert.Equal(t, types.JobStatePending, got[1].PreviousState)
+	assert.Equal(t, types.JobStateRunning, got[1].NewState)
+	require.NotNil(t, got[1].Job)
+	assert.Equal(t, types.JobEventDeleted, got[2].EventType)
+	assert.Equal(t, types.JobStateRunning, got[2].PreviousState)
+
+	// Unchanged listings produce no further events
+	for {
+		mu.Lock()
+		done := polls > len(listings)+2
+		mu.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %+v", event)
+	default:
+	}
+
+	mu.Lock()
+	assert.Equal(t, []string{"alice"}, sent.Users)
+	assert.Equal(t, []string{"gpu"}, sent.Partitions)
+	assert.Equal(t, []types.JobState{types.JobStatePending, types.JobStateRunning}, sent.States)
+	mu.Unlock()
+
+	// Cancelling the context closes the channel
+	cancel()
+	for range events {
+	}
+}
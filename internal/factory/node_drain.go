@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// Drain drains a node, preventing new jobs from being scheduled on it.
+// Slurm refuses to drain a node without a reason, so an empty reason fails
+// validation here instead. Line breaks and other control characters are
+// replaced by spaces, as sinfo and scontrol show the reason on one line.
+func (m *adapterNodeManager) Drain(ctx context.Context, nodeName string, reason string) error {
+	if nodeName == "" {
+		return errors.NewValidationErrorf("nodeName", nodeName, "node name is required")
+	}
+	reason, err := drainReason(reason)
+	if err != nil {
+		return err
+	}
+	return m.adapter.Drain(ctx, nodeName, reason)
+}
+
+// DrainNodes drains each of the named nodes with the same reason. It carries
+// on past nodes that fail, returning the errors for them together.
+func (m *adapterNodeManager) DrainNodes(ctx context.Context, nodeNames []string, reason string) error {
+	if len(nodeNames) == 0 {
+		return errors.NewValidationErrorf("nodeNames", nodeNames, "at least one node is required")
+	}
+	for _, name := range nodeNames {
+		if name == "" {
+			return errors.NewValidationErrorf("nodeNames", nodeNames, "node names must not be empty")
+		}
+	}
+	reason, err := drainReason(reason)
+	if err != nil {
+		return err
+	}
+
+	var failures []error
+	for _, name := range nodeNames {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := m.adapter.Drain(ctx, name, reason); err != nil {
+			failures = append(failures, fmt.Errorf("node %s: %w", name, err))
+		}
+	}
+	return stderrors.Join(failures...)
+}
+
+// Resume returns a drained, down or failed node to service. Slurm rejects
+// RESUME for a node in none of those states, so for a node that is already
+// available Resume does nothing.
+func (m *adapterNodeManager) Resume(ctx context.Context, nodeName string) error {
+	if nodeName == "" {
+		return errors.NewValidationErrorf("nodeName", nodeName, "node name is required")
+	}
+	node, err := m.adapter.Get(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+	if !isResumableNode(node) {
+		return nil
+	}
+	return m.adapter.Resume(ctx, nodeName)
+}
+
+// drainReason returns reason on a single line, failing if nothing is left
+func drainReason(reason string) (string, error) {
+	cleaned := singleLine(reason)
+	if cleaned == "" {
+		return "", errors.NewValidationErrorf("reason", reason, "a reason is required to drain a node")
+	}
+	return cleaned, nil
+}
+
+// singleLine replaces control characters in s, such as line breaks, by
+// spaces and trims it
+func singleLine(s string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, s))
+}
+
+// isResumableNode reports whether node has a state flag that RESUME clears
+func isResumableNode(node *types.Node) bool {
+	if node == nil {
+		return false
+	}
+	for _, state := range node.State {
+		switch types.NodeState(strings.ToUpper(string(state))) {
+		case types.NodeStateDrain, types.NodeStateDown, types.NodeStateFail:
+			return true
+		}
+	}
+	return false
+}
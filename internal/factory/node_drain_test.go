@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nodeUpdateServer serves nodes in the given states and records the body of
+// every node update it receives, keyed by node name
+type nodeUpdateServer struct {
+	mu      sync.Mutex
+	states  map[string]string
+	updates map[string]map[string]interface{}
+}
+
+func (s *nodeUpdateServer) handler(version string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/slurm/%s/node/", version))
+		state, ok := s.states[name]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			var update map[string]interface{}
+			_ = json.Unmarshal(body, &update)
+			s.mu.Lock()
+			s.updates[name] = update
+			s.mu.Unlock()
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"nodes": [{"name": %q, "state": [%s]}]}`, name, state)
+	}
+}
+
+func TestAdapterNodeManager_DrainAndResume(t *testing.T) {
+	for _, version := range []string{"v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			nodes := &nodeUpdateServer{
+				states: map[string]string{
+					"node01": `"IDLE"`,
+					"node02": `"IDLE", "DRAIN"`,
+					"node03": `"DOWN"`,
+				},
+				updates: make(map[string]map[string]interface{}),
+			}
+			server := httptest.NewServer(nodes.handler(version))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			// Quotes survive and the line break is flattened
+			reason := "bad DIMM \"A2\", ticket=42\nsee log"
+			require.NoError(t, client.Nodes().Drain(ctx, "node01", reason))
+			require.Contains(t, nodes.updates, "node01")
+			assert.Equal(t, "bad DIMM \"A2\", ticket=42 see log", nodes.updates["node01"]["reason"])
+			assert.Equal(t, []interface{}{"DRAIN"}, nodes.updates["node01"]["state"])
+
+			// An available node is left alone
+			delete(nodes.updates, "node01")
+			require.NoError(t, client.Nodes().Resume(ctx, "node01"))
+			assert.NotContains(t, nodes.updates, "node01")
+
+			require.NoError(t, client.Nodes().Resume(ctx, "node02"))
+			require.Contains(t, nodes.updates, "node02")
+			assert.Equal(t, []interface{}{"RESUME"}, nodes.updates["node02"]["state"])
+
+			// Batch drains carry on past nodes that fail
+			err = client.Nodes().DrainNodes(ctx, []string{"node02", "missing", "node03"}, "rack power work")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "node missing")
+			assert.Equal(t, "rack power work", nodes.updates["node03"]["reason"])
+		})
+	}
+}
+
+func TestAdapterNodeManager_Drain_RequiresReason(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	manager := &adapterNodeManager{adapter: &mockNodeAdapter{}}
+
+	for _, reason := range []string{"", "  ", "\n\t"} {
+		err := manager.Drain(ctx, "node01", reason)
+		var validationErr *errors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "reason", validationErr.Field)
+	}
+
+	err := manager.DrainNodes(ctx, nil, "maintenance")
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "nodeNames", validationErr.Field)
+
+	err = manager.DrainNodes(ctx, []string{"node01"}, "")
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "reason", validationErr.Field)
+}
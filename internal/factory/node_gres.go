@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	types "github.com/jontk/slurm-client/api"
+)
+
+// filterMPSAvailable keeps the nodes with free MPS shares and then applies
+// offset and limit, which the adapter could not apply before the filter
+func filterMPSAvailable(list *types.NodeList, offset, limit int) *types.NodeList {
+	nodes := make([]types.Node, 0, len(list.Nodes))
+	for i := range list.Nodes {
+		if list.Nodes[i].MPSUsage().Available() > 0 {
+			nodes = append(nodes, list.Nodes[i])
+		}
+	}
+	total := len(nodes)
+	if offset > 0 {
+		if offset >= len(nodes) {
+			nodes = nodes[:0]
+		} else {
+			nodes = nodes[offset:]
+		}
+	}
+	if limit > 0 && limit < len(nodes) {
+		nodes = nodes[:limit]
+	}
+	return &types.NodeList{Nodes: nodes, Total: total}
+}
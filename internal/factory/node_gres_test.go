@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterNodeManager_ListMPSAvailable(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	gres := "gpu:a100:4(S:0-1),mps:400(S:0-1)"
+	nodes := []types.Node{
+		// All GPUs taken, MPS shares free
+		{Name: ptrString("gpu01"), GRES: &gres, GRESUsed: ptrString("gpu:a100:4(IDX:0-3),mps:0(IDX:N/A)")},
+		// MPS fully allocated
+		{Name: ptrString("gpu02"), GRES: &gres, GRESUsed: ptrString("gpu:a100:0(IDX:N/A),mps:400(IDX:0-3)")},
+		// No MPS configured
+		{Name: ptrString("gpu03"), GRES: ptrString("gpu:a100:2(IDX:0,2)")},
+		{Name: ptrString("gpu04"), GRES: &gres, GRESUsed: ptrString("mps:100(IDX:0)")},
+		{Name: ptrString("gpu05"), GRES: &gres},
+	}
+	var sent *types.NodeListOptions
+	manager := &adapterNodeManager{adapter: &mockNodeAdapter{
+		listFunc: func(ctx context.Context, opts *types.NodeListOptions) (*types.NodeList, error) {
+			sent = opts
+			return &types.NodeList{Nodes: nodes, Total: len(nodes)}, nil
+		},
+	}}
+
+	list, err := manager.List(ctx, &types.ListNodesOptions{MPSAvailable: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gpu01", "gpu04", "gpu05"}, nodeNames(list.Nodes))
+	assert.Equal(t, 3, list.Total)
+
+	// Pagination applies to the filtered nodes, not to the adapter's page
+	list, err = manager.List(ctx, &types.ListNodesOptions{MPSAvailable: true, Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gpu04"}, nodeNames(list.Nodes))
+	assert.Equal(t, 3, list.Total)
+	assert.Zero(t, sent.Limit)
+	assert.Zero(t, sent.Offset)
+}
+
+func nodeNames(nodes []types.Node) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, *node.Name)
+	}
+	return names
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// PowerUsage returns the energy readings of every node, keyed by node name.
+// Nodes without energy data are left out, so the map is empty on clusters
+// that run no acct_gather_energy plugin or whose API version does not
+// report one.
+func (m *adapterNodeManager) PowerUsage(ctx context.Context) (map[string]types.PowerStats, error) {
+	result, err := m.adapter.List(ctx, &types.NodeListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[string]types.PowerStats)
+	if result == nil {
+		return usage, nil
+	}
+	for i := range result.Nodes {
+		node := &result.Nodes[i]
+		if node.Name == nil || *node.Name == "" {
+			continue
+		}
+		if stats, ok := node.PowerStats(); ok {
+			usage[*node.Name] = stats
+		}
+	}
+	return usage, nil
+}
+
+// SetPowerState asks slurmctld to power a node up or down, as with
+// "scontrol update NodeName=... State=POWER_UP". The request only starts
+// the transition; GetPowerState shows when it has finished.
+func (m *adapterNodeManager) SetPowerState(ctx context.Context, nodeName string, state types.NodePowerState) error {
+	if nodeName == "" {
+		return errors.NewValidationErrorf("nodeName", nodeName, "node name is required")
+	}
+	var nodeState types.NodeState
+	switch state {
+	case types.NodePowerUp:
+		nodeState = types.NodeStatePowerUp
+	case types.NodePowerDown:
+		nodeState = types.NodeStatePowerDown
+	default:
+		return errors.NewValidationErrorf("state", state, "power state must be %s or %s",
+			types.NodePowerUp, types.NodePowerDown)
+	}
+	return m.adapter.Update(ctx, nodeName, &types.NodeUpdate{State: []types.NodeState{nodeState}})
+}
+
+// GetPowerState returns the current power status of a node, see
+// Node.PowerState. A power-up has finished once it returns NodePowerUp, and
+// a power-down once it returns NodePoweredDown.
+func (m *adapterNodeManager) GetPowerState(ctx context.Context, nodeName string) (types.NodePowerState, error) {
+	node, err := m.adapter.Get(ctx, nodeName)
+	if err != nil {
+		return "", err
+	}
+	return node.PowerState(), nil
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockNodeAdapter serves a fixed node list, or the result of listFunc when
+// set; other methods are not used
+type mockNodeAdapter struct {
+	common.NodeAdapter
+	nodes    []types.Node
+	listFunc func(ctx context.Context, opts *types.NodeListOptions) (*types.NodeList, error)
+}
+
+func (m *mockNodeAdapter) List(ctx context.Context, opts *types.NodeListOptions) (*types.NodeList, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
+	return &types.NodeList{Nodes: m.nodes, Total: len(m.nodes)}, nil
+}
+
+func TestAdapterNodeManager_PowerUsage(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	watts := uint32(350)
+	average := int32(300)
+	consumed := int64(7200000)
+	collected := time.Now().Unix()
+	zero := uint32(0)
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			nodeAdapter: &mockNodeAdapter{nodes: []types.Node{
+				{Name: ptrString("gpu01"), Energy: &types.NodeEnergy{
+					CurrentWatts: &watts, AverageWatts: &average,
+					ConsumedEnergy: &consumed, LastCollected: &collected,
+				}},
+				// No energy plugin: readings are present but all zero
+				{Name: ptrString("cpu01"), Energy: &types.NodeEnergy{CurrentWatts: &zero}},
+				{Name: ptrString("cpu02")},
+			}},
+		},
+		version: "v0.0.44",
+	}
+
+	usage, err := client.Nodes().PowerUsage(ctx)
+	require.NoError(t, err)
+	require.Len(t, usage, 1)
+	assert.Equal(t, types.PowerStats{
+		CurrentWatts:   350,
+		AverageWatts:   300,
+		ConsumedEnergy: 7200000,
+		LastCollected:  time.Unix(collected, 0),
+	}, usage["gpu01"])
+}
+
+func TestAdapterNodeManager_SetPowerState(t *testing.T) {
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			nodes := &nodeUpdateServer{
+				states: map[string]string{
+					"node01": `"IDLE", "POWERED_DOWN"`,
+					"node02": `"IDLE", "POWERING_UP"`,
+					"node03": `"IDLE", "POWER_DOWN"`,
+					"node04": `"IDLE"`,
+				},
+				updates: make(map[string]map[string]interface{}),
+			}
+			server := httptest.NewServer(nodes.handler(version))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			require.NoError(t, client.Nodes().SetPowerState(ctx, "node01", types.NodePowerUp))
+			assert.Equal(t, []interface{}{"POWER_UP"}, nodes.updates["node01"]["state"])
+			require.NoError(t, client.Nodes().SetPowerState(ctx, "node04", types.NodePowerDown))
+			assert.Equal(t, []interface{}{"POWER_DOWN"}, nodes.updates["node04"]["state"])
+
+			err = client.Nodes().SetPowerState(ctx, "node04", types.NodePowerSave)
+			var validationErr *errors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "state", validationErr.Field)
+
+			expected := map[string]types.NodePowerState{
+				"node01": types.NodePoweredDown,
+				"node02": types.NodePoweringUp,
+				"node03": types.NodePoweringDown,
+				"node04": types.NodePowerUp,
+			}
+			for name, want := range expected {
+				state, err := client.Nodes().GetPowerState(ctx, name)
+				require.NoError(t, err)
+				assert.Equal(t, want, state, name)
+			}
+		})
+	}
+}
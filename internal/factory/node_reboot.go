@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"strings"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// Reboot asks slurmctld to reboot a node: once it is idle by default, or as
+// soon as its running jobs finish with opts.ASAP. slurmrestd has no reboot
+// endpoint in any version, so this runs "scontrol reboot" and needs the CLI
+// fallback.
+//
+// Asking again while a reboot is pending is safe: slurmctld keeps a single
+// pending reboot and the options of the latest request apply. A node that
+// is already rebooting (REBOOT_ISSUED) is left alone rather than being
+// queued for a second reboot.
+func (m *adapterNodeManager) Reboot(ctx context.Context, nodeName string, opts *types.RebootOptions) error {
+	if nodeName == "" {
+		return errors.NewValidationErrorf("nodeName", nodeName, "node name is required")
+	}
+	request := types.RebootOptions{}
+	if opts != nil {
+		request = *opts
+	}
+	request.NextState = strings.ToUpper(strings.TrimSpace(request.NextState))
+	switch request.NextState {
+	case "", string(types.NodeStateResume), string(types.NodeStateDown):
+	default:
+		return errors.NewValidationErrorf("NextState", opts.NextState,
+			"next state must be RESUME or DOWN")
+	}
+	request.Reason = singleLine(request.Reason)
+
+	if m.cli == nil {
+		return errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			"node reboot is not available through slurmrestd; configure the CLI fallback")
+	}
+
+	node, err := m.adapter.Get(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+	for _, state := range node.State {
+		if strings.EqualFold(string(state), string(types.NodeStateRebootIssued)) {
+			return nil
+		}
+	}
+	return m.cli.RebootNode(ctx, nodeName, &request)
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterNodeManager_Reboot(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scontrol is a shell script")
+	}
+
+	// A fake scontrol on PATH records the arguments it was run with
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "scontrol"), []byte(script), 0o755)) // #nosec G306 -- test executable
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	for _, version := range []string{"v0.0.40", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			nodes := &nodeUpdateServer{
+				states: map[string]string{
+					"node01": `"IDLE"`,
+					"node02": `"IDLE", "REBOOT_REQUESTED"`,
+					"node03": `"DOWN", "REBOOT_ISSUED"`,
+				},
+				updates: make(map[string]map[string]interface{}),
+			}
+			server := httptest.NewServer(nodes.handler(version))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			// Without the CLI fallback there is no way to reboot
+			err = client.Nodes().Reboot(ctx, "node01", nil)
+			var slurmErr *errors.SlurmError
+			require.ErrorAs(t, err, &slurmErr)
+			assert.Equal(t, errors.ErrorCodeUnsupportedOperation, slurmErr.Code)
+
+			client.(*AdapterClient).SetCLIFallback(cli.NewRunner(cli.Config{}))
+			readArgs := func() string {
+				args, err := os.ReadFile(argsFile) // #nosec G304 -- test file
+				require.NoError(t, err)
+				return strings.TrimSpace(string(args))
+			}
+
+			opts := &types.RebootOptions{ASAP: true, NextState: "resume", Reason: "BIOS\nupdate"}
+			require.NoError(t, client.Nodes().Reboot(ctx, "node01", opts))
+			assert.Equal(t, "reboot ASAP nextstate=RESUME reason=BIOS update node01", readArgs())
+
+			// A pending reboot is requested again with the new options
+			require.NoError(t, client.Nodes().Reboot(ctx, "node02", &types.RebootOptions{NextState: "DOWN"}))
+			assert.Equal(t, "reboot nextstate=DOWN node02", readArgs())
+
+			// A node that is already rebooting is not queued for another reboot
+			require.NoError(t, os.Remove(argsFile))
+			require.NoError(t, client.Nodes().Reboot(ctx, "node03", nil))
+			assert.NoFileExists(t, argsFile)
+
+			err = client.Nodes().Reboot(ctx, "node01", &types.RebootOptions{NextState: "IDLE"})
+			var validationErr *errors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "NextState", validationErr.Field)
+			assert.NoFileExists(t, argsFile)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// WaitForState polls the named nodes until each of them is in state, for
+// example IDLE once a reboot has finished. A node counts as in the state
+// when the state is among its state flags, so IDLE also matches IDLE+DRAIN.
+// A node that is not listed, as while slurmctld has not yet heard back from
+// it, is still waited for. It fails once opts.Timeout or ctx runs out,
+// naming the nodes that were still outstanding.
+func (m *adapterNodeManager) WaitForState(ctx context.Context, nodes []string, state types.NodeState, opts *types.WaitForNodeStateOptions) error {
+	if len(nodes) == 0 {
+		return errors.NewValidationErrorf("nodes", nodes, "at least one node is required")
+	}
+	if state == "" {
+		return errors.NewValidationErrorf("state", state, "node state is required")
+	}
+	if opts == nil {
+		opts = &types.WaitForNodeStateOptions{}
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = types.DefaultNodeStatePollInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	waiting := nodes
+	for {
+		result, err := m.adapter.List(ctx, &types.NodeListOptions{Names: nodes})
+		if err != nil {
+			if ctx.Err() != nil {
+				return waitTimeoutError(ctx, nodeWaitMessage(waiting, state))
+			}
+			return err
+		}
+		waiting = nodesNotInState(result, nodes, state)
+		if opts.OnProgress != nil {
+			opts.OnProgress(waiting)
+		}
+		if len(waiting) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return waitTimeoutError(ctx, nodeWaitMessage(waiting, state))
+		case <-ticker.C:
+		}
+	}
+}
+
+// nodesNotInState returns the names in nodes that the list does not show
+// in state, in the order given
+func nodesNotInState(list *types.NodeList, nodes []string, state types.NodeState) []string {
+	reached := make(map[string]bool, len(nodes))
+	if list != nil {
+		for i := range list.Nodes {
+			node := &list.Nodes[i]
+			if node.Name == nil {
+				continue
+			}
+			for _, s := range node.State {
+				if strings.EqualFold(string(s), string(state)) {
+					reached[*node.Name] = true
+					break
+				}
+			}
+		}
+	}
+	waiting := make([]string, 0, len(nodes))
+	for _, name := range nodes {
+		if !reached[name] {
+			waiting = append(waiting, name)
+		}
+	}
+	return waiting
+}
+
+func nodeWaitMessage(waiting []string, state types.NodeState) string {
+	return fmt.Sprintf("nodes %s did not reach state %s", strings.Join(waiting, ","), state)
+}
+
+// waitTimeoutError reports a wait that ctx ended, as a deadline or a
+// cancellation
+func waitTimeoutError(ctx context.Context, message string) error {
+	code := errors.ErrorCodeDeadlineExceeded
+	if ctx.Err() == context.Canceled {
+		code = errors.ErrorCodeContextCanceled
+	}
+	return errors.NewSlurmErrorWithCause(code, message, ctx.Err())
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func nodeClient(list func(ctx context.Context, opts *types.NodeListOptions) (*types.NodeList, error)) *AdapterClient {
+	return &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:     "v0.0.44",
+			nodeAdapter: &mockNodeAdapter{listFunc: list},
+		},
+		version: "v0.0.44",
+	}
+}
+
+func TestAdapterNodeManager_WaitForState(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	// node02 reboots and comes back IDLE on the third poll
+	polls := 0
+	client := nodeClient(func(ctx context.Context, opts *types.NodeListOptions) (*types.NodeList, error) {
+		polls++
+		rebooting := []types.NodeState{types.NodeStateDown, "REBOOT_ISSUED"}
+		if polls >= 3 {
+			rebooting = []types.NodeState{types.NodeStateIdle}
+		}
+		return &types.NodeList{Nodes: []types.Node{
+			{Name: ptrString("node01"), State: []types.NodeState{types.NodeStateIdle}},
+			{Name: ptrString("node02"), State: rebooting},
+		}}, nil
+	})
+
+	var progress [][]string
+	err := client.Nodes().WaitForState(ctx, []string{"node01", "node02"}, types.NodeStateIdle, &types.WaitForNodeStateOptions{
+		PollInterval: time.Millisecond,
+		OnProgress:   func(waiting []string) { progress = append(progress, waiting) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, [][]string{{"node02"}, {"node02"}, {}}, progress)
+}
+
+func TestAdapterNodeManager_WaitForState_Timeout(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	// node02 never reports back
+	client := nodeClient(func(ctx context.Context, opts *types.NodeListOptions) (*types.NodeList, error) {
+		return &types.NodeList{Nodes: []types.Node{
+			{Name: ptrString("node01"), State: []types.NodeState{types.NodeStateIdle}},
+		}}, nil
+	})
+
+	err := client.Nodes().WaitForState(ctx, []string{"node01", "node02"}, types.NodeStateIdle, &types.WaitForNodeStateOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "node02")
+	assert.NotContains(t, err.Error(), "node01")
+
+	err = client.Nodes().WaitForState(ctx, nil, types.NodeStateIdle, nil)
+	assert.True(t, errors.IsValidationError(err))
+}
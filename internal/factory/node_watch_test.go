@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func watchTestNode(name string, state types.NodeState, features ...string) types.Node {
+	return types.Node{Name: &name, State: []types.NodeState{state}, Features: features}
+}
+
+// nodeListings serves one listing per poll, repeating the last
+type nodeListings struct {
+	mu       sync.Mutex
+	listings [][]types.Node
+	polls    int
+	sent     *types.NodeListOptions
+}
+
+func (l *nodeListings) list(_ context.Context, opts *types.NodeListOptions) (*types.NodeList, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sent = opts
+	listing := l.listings[min(l.polls, len(l.listings)-1)]
+	l.polls++
+	return &types.NodeList{Nodes: listing, Total: len(listing)}, nil
+}
+
+func nodeWatchClient(listings *nodeListings) *AdapterClient {
+	return &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:     "v0.0.44",
+			nodeAdapter: &mockNodeAdapter{listFunc: listings.list},
+		},
+		version: "v0.0.44",
+	}
+}
+
+func nextNodeEvent(t *testing.T, events <-chan types.NodeEvent) types.NodeEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a node event")
+		return types.NodeEvent{}
+	}
+}
+
+func TestAdapterNodeManager_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(helpers.TestContext(t))
+	defer cancel()
+
+	listings := &nodeListings{listings: [][]types.Node{
+		{watchTestNode("n1", types.NodeStateIdle, "a100"), watchTestNode("n2", types.NodeStateAllocated, "a100"), watchTestNode("n3", types.NodeStateIdle)},
+		{watchTestNode("n1", types.NodeStateDrain, "a100"), watchTestNode("n3", types.NodeStateIdle)},
+	}}
+	events, err := nodeWatchClient(listings).Nodes().Watch(ctx, &types.WatchNodesOptions{
+		Partition:    "gpu",
+		Features:     []string{"a100"},
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	// The snapshot comes first, without the node lacking the feature
+	snapshot := make(map[string]types.NodeEvent)
+	for range 2 {
+		event := nextNodeEvent(t, events)
+		assert.Equal(t, types.NodeEventAdded, event.EventType)
+		require.NotNil(t, event.Node)
+		snapshot[event.NodeName] = event
+	}
+	assert.Equal(t, types.NodeStateIdle, snapshot["n1"].NewState)
+	assert.Equal(t, types.NodeStateAllocated, snapshot["n2"].NewState)
+
+	event := nextNodeEvent(t, events)
+	assert.Equal(t, types.NodeEventSynced, event.EventType)
+	assert.Empty(t, event.NodeName)
+
+	changes := make(map[string]types.NodeEvent)
+	for range 2 {
+		event := nextNodeEvent(t, events)
+		changes[event.NodeName] = event
+	}
+	assert.Equal(t, types.NodeEventModified, changes["n1"].EventType)
+	assert.Equal(t, types.NodeStateIdle, changes["n1"].PreviousState)
+	assert.Equal(t, types.NodeStateDrain, changes["n1"].NewState)
+	assert.Equal(t, types.NodeEventDeleted, changes["n2"].EventType)
+	assert.Equal(t, types.NodeStateAllocated, changes["n2"].PreviousState)
+
+	listings.mu.Lock()
+	assert.Equal(t, []string{"gpu"}, listings.sent.Partitions)
+	listings.mu.Unlock()
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestAdapterNodeManager_WatchSkipInitial(t *testing.T) {
+	ctx, cancel := context.WithCancel(helpers.TestContext(t))
+	defer cancel()
+
+	listings := &nodeListings{listings: [][]types.Node{
+		{watchTestNode("n1", types.NodeStateIdle)},
+		{watchTestNode("n1", types.NodeStateIdle), watchTestNode("n2", types.NodeStateIdle)},
+	}}
+	events, err := nodeWatchClient(listings).Nodes().Watch(ctx, &types.WatchNodesOptions{
+		SkipInitial:  true,
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, types.NodeEventSynced, nextNodeEvent(t, events).EventType)
+	event := nextNodeEvent(t, events)
+	assert.Equal(t, types.NodeEventAdded, event.EventType)
+	assert.Equal(t, "n2", event.NodeName)
+
+	cancel()
+	for range events {
+	}
+}
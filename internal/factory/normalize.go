@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import "reflect"
+
+// normalizeSlices replaces every nil slice reachable from v with an empty
+// one. slurmrestd reports an empty list as null in some versions and as []
+// in others, and the converters do not agree on which of the two gives nil,
+// so managers run their results through this before returning them and
+// callers can rely on list fields never being nil. Nil pointers and maps are
+// left alone, as they mean a value was not reported.
+func normalizeSlices(v interface{}) {
+	normalizeValue(reflect.ValueOf(v))
+}
+
+func normalizeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			normalizeValue(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			// Unexported fields, such as those of time.Time, cannot be set
+			if field := v.Field(i); field.CanSet() {
+				normalizeValue(field)
+			}
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			if v.CanSet() {
+				v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			normalizeValue(v.Index(i))
+		}
+	case reflect.Map:
+		// Map values cannot be set in place; those held by pointer still are
+		// normalized through it
+		iter := v.MapRange()
+		for iter.Next() {
+			normalizeValue(iter.Value())
+		}
+	}
+}
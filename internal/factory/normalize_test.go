@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSlices(t *testing.T) {
+	list := &types.NodeList{Nodes: []types.Node{{Name: ptrString("node01")}}}
+	normalizeSlices(list)
+
+	node := list.Nodes[0]
+	assert.NotNil(t, node.State)
+	assert.NotNil(t, node.Partitions)
+	assert.NotNil(t, node.Features)
+	assert.Empty(t, node.Partitions)
+	// Optional values stay unreported
+	assert.Nil(t, node.Energy)
+
+	// Slices that hold values are left as they are
+	job := &types.Job{JobState: []types.JobState{types.JobStateRunning}}
+	normalizeSlices(job)
+	assert.Equal(t, []types.JobState{types.JobStateRunning}, job.JobState)
+	assert.NotNil(t, job.Flags)
+
+	assert.NotPanics(t, func() {
+		normalizeSlices(nil)
+		normalizeSlices((*types.Job)(nil))
+		normalizeSlices(types.Job{})
+	})
+}
+
+// TestAdapterClient_EmptyLists checks that lists reported as null and as []
+// come back the same, as empty non-nil slices, in every API version
+func TestAdapterClient_EmptyLists(t *testing.T) {
+	for _, empty := range []string{"null", "[]"} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{
+				"nodes": [{"name": "node01", "partitions": %[1]s, "features": %[1]s, "state": %[1]s}],
+				"jobs": [{"job_id": 1, "job_state": %[1]s, "flags": %[1]s}],
+				"accounts": [{"name": "research", "coordinators": %[1]s}],
+				"partitions": %[1]s,
+				"reservations": %[1]s
+			}`, empty)
+		}))
+
+		factory, err := NewClientFactory(WithBaseURL(server.URL))
+		require.NoError(t, err)
+
+		for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+			t.Run(empty+"/"+version, func(t *testing.T) {
+				ctx := helpers.TestContext(t)
+				client, err := factory.NewClientWithVersion(ctx, version)
+				require.NoError(t, err)
+
+				nodes, err := client.Nodes().List(ctx, nil)
+				require.NoError(t, err)
+				require.Len(t, nodes.Nodes, 1)
+				assert.Equal(t, []string{}, nodes.Nodes[0].Partitions)
+				assert.Equal(t, []string{}, nodes.Nodes[0].Features)
+				assert.Equal(t, []types.NodeState{}, nodes.Nodes[0].State)
+
+				jobs, err := client.Jobs().List(ctx, nil)
+				require.NoError(t, err)
+				require.Len(t, jobs.Jobs, 1)
+				assert.NotNil(t, jobs.Jobs[0].JobState)
+				assert.Empty(t, jobs.Jobs[0].JobState)
+				assert.NotNil(t, jobs.Jobs[0].Flags)
+
+				accounts, err := client.Accounts().List(ctx, nil)
+				require.NoError(t, err)
+				require.Len(t, accounts.Accounts, 1)
+				assert.NotNil(t, accounts.Accounts[0].Coordinators)
+				assert.Empty(t, accounts.Accounts[0].Coordinators)
+
+				partitions, err := client.Partitions().List(ctx, nil)
+				require.NoError(t, err)
+				assert.Equal(t, []types.Partition{}, partitions.Partitions)
+
+				reservations, err := client.Reservations().List(ctx, nil)
+				require.NoError(t, err)
+				assert.Equal(t, []types.Reservation{}, reservations.Reservations)
+			})
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdapterClient_NotFound checks that Slurm's answer for a job or node
+// that does not exist is reported with its own code, while a 404 from an
+// endpoint the server lacks stays a generic not-found error
+func TestAdapterClient_NotFound(t *testing.T) {
+	const invalidJob = `{"jobs": [], "errors": [{"description": "Unable to query JobId=999",
+		"error_number": 2017, "error": "Invalid job id specified", "source": "_handle_job_get"}]}`
+	const invalidNode = `{"nodes": [], "errors": [{"description": "Unable to query node",
+		"error_number": 2018, "error": "Invalid node name specified", "source": "_handle_node_get"}]}`
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/job/999"):
+					w.WriteHeader(http.StatusInternalServerError)
+					_, _ = w.Write([]byte(invalidJob))
+				case strings.HasSuffix(r.URL.Path, "/node/ghost"):
+					w.WriteHeader(http.StatusInternalServerError)
+					_, _ = w.Write([]byte(invalidNode))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			_, err = client.Jobs().Get(ctx, "999")
+			require.Error(t, err)
+			assert.Equal(t, errors.ErrorCodeJobNotFound, errors.GetErrorCode(err), "%v", err)
+			assert.True(t, errors.IsNotFoundError(err))
+
+			_, err = client.Nodes().Get(ctx, "ghost")
+			require.Error(t, err)
+			assert.Equal(t, errors.ErrorCodeNodeNotFound, errors.GetErrorCode(err), "%v", err)
+
+			// A plain 404 is not about the job
+			_, err = client.Jobs().Get(ctx, "1")
+			require.Error(t, err)
+			assert.NotEqual(t, errors.ErrorCodeJobNotFound, errors.GetErrorCode(err), "%v", err)
+		})
+	}
+}
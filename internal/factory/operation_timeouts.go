@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/config"
+)
+
+// listResources are the path segments, after the API version, of the
+// endpoints that return collections. qos also names a single QoS when
+// followed by its name.
+var listResources = map[string]bool{
+	"jobs": true, "nodes": true, "partitions": true, "reservations": true,
+	"licenses": true, "shares": true, "accounts": true, "associations": true,
+	"clusters": true, "instances": true, "qos": true, "tres": true,
+	"users": true, "wckeys": true,
+}
+
+// requestClass returns the operation class of an API request
+func requestClass(req *http.Request) config.OperationClass {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return config.OperationWrite
+	}
+	// e.g. /slurm/v0.0.44/jobs/ or /slurmdb/v0.0.44/qos/normal
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, "v0.") {
+			continue
+		}
+		if i+1 < len(segments) && listResources[segments[i+1]] &&
+			(segments[i+1] != "qos" || i+2 == len(segments)) {
+			return config.OperationList
+		}
+		break
+	}
+	return config.OperationGet
+}
+
+// timeoutDoer gives each request the deadline of its operation class,
+// unless its context already has one
+type timeoutDoer struct {
+	next     types.HTTPDoer
+	timeouts config.Timeouts
+}
+
+// newTimeoutDoer wraps doer so that its requests get the deadlines of
+// timeouts
+func newTimeoutDoer(doer types.HTTPDoer, timeouts config.Timeouts) types.HTTPDoer {
+	if doer == nil {
+		doer = &http.Client{}
+	}
+	if timeouts.List <= 0 && timeouts.Get <= 0 && timeouts.Write <= 0 {
+		return doer
+	}
+	return &timeoutDoer{next: doer, timeouts: timeouts}
+}
+
+func (d *timeoutDoer) Do(req *http.Request) (*http.Response, error) {
+	if _, ok := req.Context().Deadline(); ok {
+		return d.next.Do(req)
+	}
+	timeout := d.timeouts.For(requestClass(req))
+	if timeout <= 0 {
+		return d.next.Do(req)
+	}
+
+	// The deadline covers reading the body, so it ends when the body is closed
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := d.next.Do(req.WithContext(ctx))
+	if err != nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = &trackedBody{ReadCloser: resp.Body, done: cancel}
+	return resp, nil
+}
+
+// bindWatch is bind for a watch, which also ends after timeout unless ctx
+// has a deadline of its own
+func (l *clientLifecycle) bindWatch(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return l.bind(ctx)
+	}
+	ctx, stop := context.WithTimeout(ctx, timeout)
+	ctx, cancel := l.bind(ctx)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// operationTimeouts returns the deadlines the factory's clients give each
+// class of operation and the HTTP client to send their requests with. When
+// request deadlines are set, the client's own timeout would cut them short
+// and override the caller's deadlines, so it is cleared and becomes the
+// deadline of the classes left unset.
+func (f *ClientFactory) operationTimeouts(httpClient *http.Client) (*http.Client, config.Timeouts) {
+	var timeouts config.Timeouts
+	if f.config != nil {
+		timeouts = f.config.Timeouts
+	}
+	if f.enhanced != nil {
+		for _, class := range []config.OperationClass{
+			config.OperationList, config.OperationGet, config.OperationWrite, config.OperationWatch,
+		} {
+			if timeout := f.enhanced.OperationTimeouts.For(class); timeout > 0 {
+				_ = timeouts.Set(class, timeout)
+			}
+		}
+	}
+	if timeouts.List <= 0 && timeouts.Get <= 0 && timeouts.Write <= 0 {
+		return httpClient, timeouts
+	}
+
+	for _, timeout := range []*time.Duration{&timeouts.List, &timeouts.Get, &timeouts.Write} {
+		if *timeout <= 0 {
+			*timeout = httpClient.Timeout
+		}
+	}
+	client := *httpClient
+	client.Timeout = 0
+	return &client, timeouts
+}
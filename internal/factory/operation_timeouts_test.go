@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/config"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestClass(t *testing.T) {
+	for _, tt := range []struct {
+		method string
+		path   string
+		want   config.OperationClass
+	}{
+		{http.MethodGet, "/slurm/v0.0.44/jobs/", config.OperationList},
+		{http.MethodGet, "/slurm/v0.0.44/jobs/state/", config.OperationList},
+		{http.MethodGet, "/slurmdb/v0.0.44/qos/", config.OperationList},
+		{http.MethodGet, "/slurm/v0.0.44/job/42", config.OperationGet},
+		{http.MethodGet, "/slurmdb/v0.0.44/qos/normal", config.OperationGet},
+		{http.MethodGet, "/slurm/v0.0.44/ping/", config.OperationGet},
+		{http.MethodGet, "/prefix/slurm/v0.0.40/nodes/", config.OperationList},
+		{http.MethodPost, "/slurm/v0.0.44/job/submit", config.OperationWrite},
+		{http.MethodDelete, "/slurm/v0.0.44/job/42", config.OperationWrite},
+	} {
+		req := httptest.NewRequest(tt.method, "http://slurm"+tt.path, nil)
+		assert.Equal(t, tt.want, requestClass(req), "%s %s", tt.method, tt.path)
+	}
+}
+
+func TestAdapterClient_OperationTimeouts(t *testing.T) {
+	const delay = 200 * time.Millisecond
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/ping/"):
+			_, _ = w.Write([]byte(`{"pings": [{"hostname": "ctld", "pinged": "UP"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	// No deadline of the caller's own
+	ctx := context.Background()
+	cfg := config.NewDefault()
+	cfg.Timeouts.Get = delay / 4
+	factory, err := NewClientFactory(
+		WithBaseURL(server.URL),
+		WithConfig(cfg),
+		// Shorter than the write deadline, which replaces it
+		WithHTTPClient(&http.Client{Timeout: delay / 2}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, factory.WithOperationTimeout(config.OperationWrite, 5*time.Second))
+	assert.Error(t, factory.WithOperationTimeout("ping", time.Second))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	// Reads get their short deadline
+	err = client.Info().Ping(ctx)
+	assert.Equal(t, errors.ErrorCodeDeadlineExceeded, errors.GetErrorCode(err), "%v", err)
+
+	// A deadline of the caller's own is honored instead
+	require.NoError(t, client.Info().Ping(helpers.TestContext(t)))
+
+	// Writes outlast the HTTP client's timeout
+	require.NoError(t, client.Jobs().Cancel(ctx, "42"))
+	// and lists, left unset, keep it
+	_, err = client.Jobs().List(ctx, nil)
+	assert.Equal(t, errors.ErrorCodeDeadlineExceeded, errors.GetErrorCode(err), "%v", err)
+}
+
+func TestClientLifecycle_BindWatch(t *testing.T) {
+	life := newClientLifecycle()
+
+	ctx, cancel := life.bindWatch(context.Background(), time.Minute)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+
+	// The caller's deadline wins
+	callerCtx, callerCancel := context.WithTimeout(context.Background(), time.Hour)
+	defer callerCancel()
+	ctx, cancel = life.bindWatch(callerCtx, time.Minute)
+	defer cancel()
+	deadline, _ = ctx.Deadline()
+	assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, 5*time.Second)
+
+	// No timeout leaves the watch to its context
+	ctx, cancel = life.bindWatch(context.Background(), 0)
+	defer cancel()
+	_, ok = ctx.Deadline()
+	assert.False(t, ok)
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// FindOrphans returns the associations whose user, account, parent account
+// or partition does not exist. Partitions are only known for the cluster
+// slurmrestd serves, so the partition of an association on another cluster
+// of the same database is not checked.
+func (m *adapterAssociationManager) FindOrphans(ctx context.Context) ([]*types.Association, error) {
+	associations, err := getAllAssociations(ctx, m.adapter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get associations: %w", err)
+	}
+	users, err := m.userAdapter.List(ctx, &types.UserListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	accounts, err := m.accountAdapter.List(ctx, &types.AccountListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	partitions, err := m.partitionAdapter.List(ctx, &types.PartitionListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions: %w", err)
+	}
+
+	userNames := make(map[string]bool)
+	for _, user := range users.Users {
+		userNames[user.Name] = true
+	}
+	accountNames := make(map[string]bool)
+	for _, account := range accounts.Accounts {
+		accountNames[account.Name] = true
+	}
+	partitionNames := make(map[string]bool)
+	partitionClusters := make(map[string]bool)
+	for _, partition := range partitions.Partitions {
+		partitionNames[derefString(partition.Name)] = true
+		if cluster := derefString(partition.Cluster); cluster != "" {
+			partitionClusters[cluster] = true
+		}
+	}
+
+	orphans := make([]*types.Association, 0)
+	for i := range associations {
+		assoc := &associations[i]
+		account := derefString(assoc.Account)
+		parent := derefString(assoc.ParentAccount)
+		partition := derefString(assoc.Partition)
+		localPartition := len(partitionClusters) == 0 || partitionClusters[derefString(assoc.Cluster)]
+		switch {
+		case assoc.User != "" && !userNames[assoc.User],
+			account != "" && !accountNames[account],
+			parent != "" && !accountNames[parent],
+			partition != "" && localPartition && !partitionNames[partition]:
+			orphans = append(orphans, assoc)
+		}
+	}
+	return orphans, nil
+}
+
+// FindEmpty returns the accounts, sorted by name, that no user is associated
+// with, that have no sub-accounts and that no job in the queue is charged to.
+// The root account is never reported.
+func (m *adapterAccountManager) FindEmpty(ctx context.Context) ([]*types.Account, error) {
+	accounts, err := m.adapter.List(ctx, &types.AccountListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	associations, err := getAllAssociations(ctx, m.associationAdapter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get associations: %w", err)
+	}
+	jobs, err := m.jobAdapter.List(ctx, &types.JobListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	used := map[string]bool{"root": true}
+	for _, assoc := range associations {
+		if assoc.User != "" {
+			used[derefString(assoc.Account)] = true
+		}
+		if parent := derefString(assoc.ParentAccount); parent != "" {
+			used[parent] = true
+		}
+	}
+	for _, job := range jobs.Jobs {
+		used[derefString(job.Account)] = true
+	}
+
+	empty := make([]*types.Account, 0)
+	for i := range accounts.Accounts {
+		if account := &accounts.Accounts[i]; !used[account.Name] {
+			empty = append(empty, account)
+		}
+	}
+	sort.Slice(empty, func(i, j int) bool { return empty[i].Name < empty[j].Name })
+	return empty, nil
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockUserAdapter serves a fixed list of users
+type mockUserAdapter struct {
+	users []types.User
+}
+
+func (m *mockUserAdapter) List(ctx context.Context, opts *types.UserListOptions) (*types.UserList, error) {
+	return &types.UserList{Users: m.users, Total: len(m.users)}, nil
+}
+
+func (m *mockUserAdapter) Get(ctx context.Context, userName string) (*types.User, error) {
+	return nil, nil
+}
+
+func (m *mockUserAdapter) Create(ctx context.Context, user *types.UserCreate) (*types.UserCreateResponse, error) {
+	return nil, nil
+}
+
+func (m *mockUserAdapter) Update(ctx context.Context, userName string, update *types.UserUpdate) error {
+	return nil
+}
+
+func (m *mockUserAdapter) Delete(ctx context.Context, userName string) error {
+	return nil
+}
+
+func (m *mockUserAdapter) CreateAssociation(ctx context.Context, req *types.UserAssociationRequest) (*types.AssociationCreateResponse, error) {
+	return nil, nil
+}
+
+// listPartitionAdapter serves a fixed list of partitions
+type listPartitionAdapter struct {
+	recordingPartitionAdapter
+	partitions []types.Partition
+}
+
+func (m *listPartitionAdapter) List(ctx context.Context, opts *types.PartitionListOptions) (*types.PartitionList, error) {
+	return &types.PartitionList{Partitions: m.partitions, Total: len(m.partitions)}, nil
+}
+
+func orphanTestClient(associations []types.Association, jobs []types.Job) *AdapterClient {
+	return &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:            "v0.0.44",
+			associationAdapter: &mockAssociationAdapter{listFunc: newAssociationListFunc(associations)},
+			accountAdapter: &mockAccountAdapter{
+				listFunc: func(ctx context.Context, opts *types.AccountListOptions) (*types.AccountList, error) {
+					return &types.AccountList{Accounts: []types.Account{
+						{Name: "root"}, {Name: "science"}, {Name: "physics"}, {Name: "chemistry"}, {Name: "retired"}, {Name: "sandbox"},
+					}}, nil
+				},
+			},
+			userAdapter: &mockUserAdapter{users: []types.User{{Name: "alice"}, {Name: "bob"}}},
+			partitionAdapter: &listPartitionAdapter{partitions: []types.Partition{
+				{Name: ptrString("cpu"), Cluster: ptrString("local")},
+				{Name: ptrString("gpu"), Cluster: ptrString("local")},
+			}},
+			jobAdapter: &mockJobAdapter{
+				listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+					return &types.JobList{Jobs: jobs}, nil
+				},
+			},
+		},
+	}
+}
+
+func TestAdapterAssociationManager_FindOrphans(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	associations := []types.Association{
+		{Account: ptrString("root"), Cluster: ptrString("local")},
+		{Account: ptrString("science"), ParentAccount: ptrString("root"), Cluster: ptrString("local")},
+		{User: "alice", Account: ptrString("physics"), Cluster: ptrString("local"), Partition: ptrString("gpu")},
+		// user removed without cleaning up the association
+		{User: "mallory", Account: ptrString("physics"), Cluster: ptrString("local")},
+		// account no longer exists
+		{User: "bob", Account: ptrString("astronomy"), Cluster: ptrString("local")},
+		// parent account no longer exists
+		{Account: ptrString("chemistry"), ParentAccount: ptrString("labs"), Cluster: ptrString("local")},
+		// partition no longer exists on this cluster
+		{User: "bob", Account: ptrString("physics"), Cluster: ptrString("local"), Partition: ptrString("bigmem")},
+		// partition of another cluster cannot be checked
+		{User: "bob", Account: ptrString("physics"), Cluster: ptrString("remote"), Partition: ptrString("fpga")},
+	}
+	client := orphanTestClient(associations, nil)
+
+	orphans, err := client.Associations().FindOrphans(ctx)
+	require.NoError(t, err)
+
+	var got []string
+	for _, assoc := range orphans {
+		got = append(got, assoc.User+"@"+derefString(assoc.Account)+"/"+derefString(assoc.Partition))
+	}
+	assert.Equal(t, []string{
+		"mallory@physics/",
+		"bob@astronomy/",
+		"@chemistry/",
+		"bob@physics/bigmem",
+	}, got)
+}
+
+func TestAdapterAccountManager_FindEmpty(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	associations := []types.Association{
+		{Account: ptrString("root")},
+		{Account: ptrString("science"), ParentAccount: ptrString("root")},
+		{Account: ptrString("physics"), ParentAccount: ptrString("science")},
+		{Account: ptrString("chemistry"), ParentAccount: ptrString("science")},
+		{Account: ptrString("retired"), ParentAccount: ptrString("root")},
+		{Account: ptrString("sandbox"), ParentAccount: ptrString("root")},
+		{User: "alice", Account: ptrString("physics")},
+	}
+	// chemistry has no users, but a job is still charged to it
+	jobs := []types.Job{{JobID: ptrInt64(1), Account: ptrString("chemistry")}}
+	client := orphanTestClient(associations, jobs)
+
+	empty, err := client.Accounts().FindEmpty(ctx)
+	require.NoError(t, err)
+
+	var got []string
+	for _, account := range empty {
+		got = append(got, account.Name)
+	}
+	assert.Equal(t, []string{"retired", "sandbox"}, got)
+}
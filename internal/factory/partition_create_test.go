@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterPartitionManager_CreateAndDelete(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scontrol is a shell script")
+	}
+
+	// The fake scontrol keeps one file per partition it has created, holding
+	// the arguments it was created with, and the server reports those
+	// partitions as configured
+	dir := t.TempDir()
+	partitionDir := filepath.Join(dir, "partitions")
+	require.NoError(t, os.Mkdir(partitionDir, 0o750))
+	script := `#!/bin/sh
+name=${2#PartitionName=}
+case "$1" in
+create) echo "$@" > "` + partitionDir + `/$name" ;;
+delete) rm "` + partitionDir + `/$name" ;;
+esac
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "scontrol"), []byte(script), 0o755)) // #nosec G306 -- test executable
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				name := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/slurm/%s/partition/", version))
+				if _, err := os.Stat(filepath.Join(partitionDir, name)); err != nil {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"partitions": [{"name": %q, "nodes": {"configured": "gpu[01-04]"}}]}`, name)
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			create := &types.PartitionCreate{
+				Name:          "gpu",
+				Nodes:         "gpu[01-04]",
+				DefaultTime:   60,
+				MaxTime:       1440,
+				State:         types.PartitionStateUp,
+				AllowAccounts: []string{"physics", "chemistry"},
+				AllowQoS:      []string{"normal"},
+				PriorityTier:  10,
+			}
+
+			// slurmrestd has no endpoint for it, so without the fallback
+			// the operation is unsupported
+			_, err = client.Partitions().Create(ctx, create)
+			assert.Equal(t, errors.ErrorCodeUnsupportedOperation, errors.GetErrorCode(err))
+			assert.Equal(t, errors.ErrorCodeUnsupportedOperation, errors.GetErrorCode(client.Partitions().Delete(ctx, "gpu")))
+
+			client.(*AdapterClient).SetCLIFallback(cli.NewRunner(cli.Config{}))
+			resp, err := client.Partitions().Create(ctx, create)
+			require.NoError(t, err)
+			assert.Equal(t, "gpu", resp.PartitionName)
+			args, err := os.ReadFile(filepath.Join(partitionDir, "gpu")) // #nosec G304 -- test file
+			require.NoError(t, err)
+			assert.Equal(t, "create PartitionName=gpu AllowAccounts=physics,chemistry AllowQos=normal "+
+				"DefaultTime=60 MaxTime=1440 Nodes=gpu[01-04] PriorityTier=10 State=UP", strings.TrimSpace(string(args)))
+
+			partition, err := client.Partitions().Get(ctx, "gpu")
+			require.NoError(t, err)
+			assert.Equal(t, "gpu", *partition.Name)
+
+			require.NoError(t, client.Partitions().Delete(ctx, "gpu"))
+			_, err = client.Partitions().Get(ctx, "gpu")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAdapterPartitionManager_Create_Validation(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	manager := &adapterPartitionManager{adapter: &recordingPartitionAdapter{}}
+
+	_, err := manager.Create(ctx, &types.PartitionCreate{DefaultTime: 60})
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "Name", validationErr.Field)
+
+	_, err = manager.Create(ctx, &types.PartitionCreate{Name: "short", DefaultTime: 120, MaxTime: 60})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "DefaultTime", validationErr.Field)
+}
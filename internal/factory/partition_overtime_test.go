@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterPartitionManager_OverTimeAndGraceTime(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scontrol is a shell script")
+	}
+
+	// A fake scontrol on PATH records the arguments it was run with
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "scontrol"), []byte(script), 0o755)) // #nosec G306 -- test executable
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	partitions := map[string]string{
+		"gpu":  `{"set": true, "infinite": false, "number": 15}`,
+		"long": `{"set": true, "infinite": true}`,
+	}
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				name := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/slurm/%s/partition/", version))
+				overTime, ok := partitions[name]
+				if !ok {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"partitions": [{"name": %q, "grace_time": 120,
+					"maximums": {"over_time_limit": %s}}]}`, name, overTime)
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			gpu, err := client.Partitions().Get(ctx, "gpu")
+			require.NoError(t, err)
+			require.NotNil(t, gpu.OverTimeLimit())
+			assert.Equal(t, uint16(15), *gpu.OverTimeLimit())
+			assert.Equal(t, 2*time.Minute, gpu.GracePeriod())
+
+			long, err := client.Partitions().Get(ctx, "long")
+			require.NoError(t, err)
+			require.NotNil(t, long.OverTimeLimit())
+			assert.Equal(t, types.OverTimeLimitUnlimited, *long.OverTimeLimit())
+
+			// Writing the values read back goes through scontrol
+			client.(*AdapterClient).SetCLIFallback(cli.NewRunner(cli.Config{}))
+			update := &types.PartitionUpdate{
+				GraceTime:     gpu.GraceTime,
+				OverTimeLimit: ptrInt32(int32(*long.OverTimeLimit())),
+			}
+			require.NoError(t, client.Partitions().Update(ctx, "gpu", update))
+			args, err := os.ReadFile(argsFile) // #nosec G304 -- test file
+			require.NoError(t, err)
+			assert.Equal(t, "update PartitionName=gpu GraceTime=120 OverTimeLimit=UNLIMITED", strings.TrimSpace(string(args)))
+
+			update.OverTimeLimit = ptrInt32(int32(*gpu.OverTimeLimit()))
+			require.NoError(t, client.Partitions().Update(ctx, "gpu", update))
+			args, err = os.ReadFile(argsFile) // #nosec G304 -- test file
+			require.NoError(t, err)
+			assert.Equal(t, "update PartitionName=gpu GraceTime=120 OverTimeLimit=15", strings.TrimSpace(string(args)))
+		})
+	}
+}
+
+func TestAdapterPartitionManager_Update_OverTimeValidation(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	adapter := &recordingPartitionAdapter{}
+	manager := &adapterPartitionManager{adapter: adapter}
+
+	err := manager.Update(ctx, "gpu", &types.PartitionUpdate{OverTimeLimit: ptrInt32(70000)})
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "OverTimeLimit", validationErr.Field)
+
+	err = manager.Update(ctx, "gpu", &types.PartitionUpdate{GraceTime: ptrInt32(-1)})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "GraceTime", validationErr.Field)
+	assert.Nil(t, adapter.update)
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingPartitionAdapter records the last update it received
+type recordingPartitionAdapter struct {
+	name   string
+	update *types.PartitionUpdate
+}
+
+func (m *recordingPartitionAdapter) List(ctx context.Context, opts *types.PartitionListOptions) (*types.PartitionList, error) {
+	return &types.PartitionList{}, nil
+}
+
+func (m *recordingPartitionAdapter) Get(ctx context.Context, partitionName string) (*types.Partition, error) {
+	return &types.Partition{Name: &partitionName}, nil
+}
+
+func (m *recordingPartitionAdapter) Create(ctx context.Context, partition *types.PartitionCreate) (*types.PartitionCreateResponse, error) {
+	return &types.PartitionCreateResponse{}, nil
+}
+
+func (m *recordingPartitionAdapter) Update(ctx context.Context, partitionName string, update *types.PartitionUpdate) error {
+	m.name = partitionName
+	m.update = update
+	return nil
+}
+
+func (m *recordingPartitionAdapter) Delete(ctx context.Context, partitionName string) error {
+	return nil
+}
+
+func TestAdapterPartitionManager_Update_AllFields(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	adapter := &recordingPartitionAdapter{}
+	manager := &adapterPartitionManager{adapter: adapter}
+
+	state := types.PartitionStateDrain
+	update := &types.PartitionUpdate{
+		State:         &state,
+		Nodes:         ptrString("gpu[01-04]"),
+		AllowGroups:   []string{"ml"},
+		AllowAccounts: []string{"physics", "chemistry"},
+		DefaultTime:   ptrInt32(60),
+		MaxTime:       ptrInt32(1440),
+		MaxNodes:      ptrInt32(4),
+		PriorityTier:  ptrInt32(0),
+		OverSubscribe: &types.PartitionMaximumsOversubscribe{
+			Jobs:  ptrInt32(2),
+			Flags: []types.PartitionMaximumsOversubscribeFlagsValue{types.PartitionMaximumsOversubscribeFlagsForce},
+		},
+	}
+
+	require.NoError(t, manager.Update(ctx, "gpu", update))
+	assert.Equal(t, "gpu", adapter.name)
+	require.NotNil(t, adapter.update)
+	assert.Equal(t, update, adapter.update)
+	// An explicit zero must survive the conversion
+	require.NotNil(t, adapter.update.PriorityTier)
+	assert.Equal(t, int32(0), *adapter.update.PriorityTier)
+	assert.Nil(t, adapter.update.MinNodes)
+}
+
+func TestAdapterPartitionManager_Update_Validation(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	adapter := &recordingPartitionAdapter{}
+	manager := &adapterPartitionManager{adapter: adapter}
+
+	err := manager.Update(ctx, "gpu", &types.PartitionUpdate{DefaultTime: ptrInt32(120), MaxTime: ptrInt32(60)})
+	require.Error(t, err)
+	assert.True(t, errors.IsValidationError(err))
+
+	err = manager.Update(ctx, "gpu", &types.PartitionUpdate{MinNodes: ptrInt32(8), MaxNodes: ptrInt32(4)})
+	require.Error(t, err)
+	assert.True(t, errors.IsValidationError(err))
+	assert.Nil(t, adapter.update)
+}
+
+// TestAdapterClient_PartitionUpdate_OmitsUnsetFields checks that only the
+// fields set in an update reach the request, through the scontrol fallback
+// that carries partition updates
+func TestAdapterClient_PartitionUpdate_OmitsUnsetFields(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scontrol is a shell script")
+	}
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "scontrol.args")
+	scontrol := filepath.Join(dir, "scontrol")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\n"
+	require.NoError(t, os.WriteFile(scontrol, []byte(script), 0o755)) // #nosec G306 -- test executable
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL("http://localhost:6820"))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithCLIFallback(cli.Config{ScontrolPath: scontrol}))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	state := types.PartitionStateDrain
+	require.NoError(t, client.Partitions().Update(ctx, "gpu", &types.PartitionUpdate{
+		State:        &state,
+		PriorityTier: ptrInt32(0),
+	}))
+
+	data, err := os.ReadFile(argsFile) // #nosec G304 -- test file
+	require.NoError(t, err)
+	// Nothing but the two set fields, the zero tier included, is sent
+	assert.ElementsMatch(t, []string{"update", "PartitionName=gpu", "State=DRAIN", "PriorityTier=0"},
+		strings.Fields(string(data)))
+}
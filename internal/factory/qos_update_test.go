@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// qosUpdateServer records the body of every QoS update it receives
+func qosUpdateServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"errors": [], "warnings": []}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &bodies
+}
+
+func TestAdapterQoSManager_Update_OnlySetFields(t *testing.T) {
+	for _, version := range []string{"v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server, bodies := qosUpdateServer(t)
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			maxWallTime := uint32(120)
+			err = client.QoS().Update(ctx, "normal", &types.QoSUpdate{
+				Limits: &types.QoSLimits{Max: &types.QoSLimitsMax{
+					WallClock: &types.QoSLimitsMaxWallClock{Per: &types.QoSLimitsMaxWallClockPer{Job: &maxWallTime}},
+				}},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, *bodies, 1)
+			assert.JSONEq(t, `{"qos": [{
+				"name": "normal",
+				"limits": {"max": {"wall_clock": {"per": {"job": {"set": true, "infinite": false, "number": 120}}}}}
+			}]}`, (*bodies)[0])
+		})
+	}
+}
+
+func TestAdapterQoSManager_Update_ClearFields(t *testing.T) {
+	server, bodies := qosUpdateServer(t)
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	unlimited := uint32(math.MaxUint32)
+	description := ""
+	graceTime := 30
+	err = client.QoS().Update(ctx, "normal", &types.QoSUpdate{
+		Description:   &description,
+		PreemptList:   &[]string{},
+		GraceTime:     &graceTime,
+		MaxTRESPerJob: ptrString("cpu=16,mem=64G,gres/gpu=2"),
+		Limits: &types.QoSLimits{Max: &types.QoSLimitsMax{
+			Jobs: &types.QoSLimitsMaxJobs{Per: &types.QoSLimitsMaxJobsPer{User: &unlimited}},
+		}},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *bodies, 1)
+	assert.JSONEq(t, `{"qos": [{
+		"name": "normal",
+		"description": "",
+		"preempt": {"list": []},
+		"limits": {
+			"grace_time": 30,
+			"max": {
+				"jobs": {"per": {"user": {"set": true, "infinite": true}}},
+				"tres": {"per": {"job": [
+					{"type": "cpu", "count": 16},
+					{"type": "mem", "count": 65536},
+					{"type": "gres", "name": "gpu", "count": 2}
+				]}}
+			}
+		}
+	}]}`, (*bodies)[0])
+}
+
+func TestAdapterQoSManager_Update_Rejected(t *testing.T) {
+	server, bodies := qosUpdateServer(t)
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	err = client.QoS().Update(ctx, "normal", &types.QoSUpdate{MaxTRESPerUser: ptrString("cpu")})
+	assert.True(t, errors.IsValidationError(err))
+
+	err = client.QoS().Update(ctx, "normal", &types.QoSUpdate{ParentQoS: ptrString("high")}) //nolint:staticcheck // SA1019: the deprecated field is still rejected
+	require.Error(t, err)
+	var slurmErr *errors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, slurmErr.Code)
+
+	assert.Empty(t, *bodies)
+}
+
+func TestAdapterQoSManager_Update_TRESMinuteLimits(t *testing.T) {
+	for _, version := range []string{"v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server, bodies := qosUpdateServer(t)
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			err = client.QoS().Update(ctx, "normal", &types.QoSUpdate{
+				GrpTRESMins:              map[string]int64{"cpu": 30000000},
+				GrpTRESRunMins:           map[string]int64{"gres/gpu": 6000},
+				MaxTRESMinsPerJob:        map[string]int64{"cpu": 10000, "mem": -1},
+				MaxTRESRunMinsPerUser:    map[string]int64{"cpu": 50000},
+				MaxTRESRunMinsPerAccount: map[string]int64{"cpu": 200000},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, *bodies, 1)
+			assert.JSONEq(t, `{"qos": [{
+				"name": "normal",
+				"limits": {"max": {"tres": {"minutes": {
+					"total": [{"type": "cpu", "count": 30000000}],
+					"per": {
+						"qos": [{"type": "gres", "name": "gpu", "count": 6000}],
+						"job": [{"type": "cpu", "count": 10000}, {"type": "mem", "count": -1}],
+						"user": [{"type": "cpu", "count": 50000}],
+						"account": [{"type": "cpu", "count": 200000}]
+					}
+				}}}}
+			}]}`, (*bodies)[0])
+		})
+	}
+}
+
+func TestAdapterQoSManager_Update_TRESMinuteLimitsV41(t *testing.T) {
+	server, bodies := qosUpdateServer(t)
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.41")
+	require.NoError(t, err)
+
+	err = client.QoS().Update(ctx, "normal", &types.QoSUpdate{GrpTRESMins: map[string]int64{"cpu": 30000000}})
+	var slurmErr *errors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, errors.ErrorCodeUnsupportedOperation, slurmErr.Code)
+	assert.Empty(t, *bodies)
+
+	err = client.QoS().Update(ctx, "normal", &types.QoSUpdate{
+		GrpTRESRunMins:    map[string]int64{"gres/gpu": 6000},
+		MaxTRESMinsPerJob: map[string]int64{"cpu": 10000},
+	})
+	require.NoError(t, err)
+	require.Len(t, *bodies, 1)
+	assert.JSONEq(t, `{"qos": [{
+		"name": "normal",
+		"limits": {"max": {"tres": {"minutes": {"per": {
+			"qos": [{"type": "gres", "name": "gpu", "count": 6000}],
+			"job": [{"type": "cpu", "count": 10000}]
+		}}}}}
+	}]}`, (*bodies)[0])
+}
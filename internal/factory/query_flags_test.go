@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterClient_ListQueryFlags(t *testing.T) {
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			var mu sync.Mutex
+			flags := make(map[string]string)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				path := strings.TrimSuffix(r.URL.Path, "/")
+				flags[path[strings.LastIndex(path, "/")+1:]] = r.URL.Query().Get("flags")
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"jobs": [], "nodes": [], "partitions": [], "errors": [], "warnings": []}`))
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			all := []types.QueryFlag{types.QueryFlagAll}
+			_, err = client.Jobs().List(ctx, &types.ListJobsOptions{Flags: all})
+			require.NoError(t, err)
+			_, err = client.Nodes().List(ctx, &types.ListNodesOptions{Flags: all})
+			require.NoError(t, err)
+			_, err = client.Partitions().List(ctx, &types.ListPartitionsOptions{Flags: all})
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if version == "v0.0.41" {
+				// v0.0.41 always asks for the detailed job records
+				assert.Equal(t, "DETAIL,ALL", flags["jobs"])
+			} else {
+				assert.Equal(t, "ALL", flags["jobs"])
+			}
+			assert.Equal(t, "ALL", flags["nodes"])
+			assert.Equal(t, "ALL", flags["partitions"])
+		})
+	}
+}
+
+func TestAdapterClient_ListWithoutQueryFlags(t *testing.T) {
+	var mu sync.Mutex
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if _, ok := r.URL.Query()["flags"]; ok {
+			sent = append(sent, r.URL.Path)
+		}
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jobs": [], "nodes": [], "partitions": [], "errors": [], "warnings": []}`))
+	}))
+	defer server.Close()
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	_, err = client.Jobs().List(ctx, nil)
+	require.NoError(t, err)
+	_, err = client.Nodes().List(ctx, &types.ListNodesOptions{})
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Empty(t, sent)
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// QueueDepthHistory reads the controller diagnostics types.QueueDepthSamples
+// times over window and returns the pending job count and backfill depth of
+// each reading. If ctx ends early, the samples taken so far are returned
+// along with its error.
+func (m *adapterInfoManager) QueueDepthHistory(ctx context.Context, window time.Duration) ([]types.QueueDepthSample, error) {
+	if window <= 0 {
+		return nil, errors.NewValidationErrorf("window", window, "window must be positive")
+	}
+	if m.standalone == nil {
+		return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			fmt.Sprintf("diagnostics are not supported by API version %s", m.version))
+	}
+	now, after := time.Now, time.After
+	if m.now != nil {
+		now = m.now
+	}
+	if m.after != nil {
+		after = m.after
+	}
+
+	interval := window / (types.QueueDepthSamples - 1)
+	samples := make([]types.QueueDepthSample, 0, types.QueueDepthSamples)
+	for i := 0; i < types.QueueDepthSamples; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return samples, ctx.Err()
+			case <-after(interval):
+			}
+		}
+		diag, err := m.standalone.GetDiagnostics(ctx)
+		if err != nil {
+			return samples, err
+		}
+		samples = append(samples, types.QueueDepthSample{
+			Time:             now(),
+			PendingJobs:      diag.JobsPending,
+			RunningJobs:      diag.JobsRunning,
+			BackfillDepth:    diag.BFDepth,
+			BackfillQueueLen: diag.BFQueueLen,
+		})
+	}
+	return samples, nil
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock moves forward only when something waits on it
+type fakeClock struct {
+	current time.Time
+	waits   []time.Duration
+}
+
+func (c *fakeClock) now() time.Time { return c.current }
+
+func (c *fakeClock) after(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	c.current = c.current.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.current
+	return ch
+}
+
+func TestAdapterInfoManager_QueueDepthHistory(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	standalone := &MockStandaloneManager{}
+	for i := 0; i < types.QueueDepthSamples; i++ {
+		standalone.On("GetDiagnostics", mock.Anything).Return(&types.Diagnostics{
+			JobsPending: 100 + 10*i,
+			JobsRunning: 50,
+			BFDepth:     20 + i,
+			BFQueueLen:  90 + 10*i,
+		}, nil).Once()
+	}
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := &fakeClock{current: start}
+	manager := &adapterInfoManager{standalone: standalone, now: clock.now, after: clock.after}
+
+	samples, err := manager.QueueDepthHistory(ctx, 45*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, samples, types.QueueDepthSamples)
+	standalone.AssertExpectations(t)
+
+	// The samples span the window at even intervals
+	assert.Equal(t, start, samples[0].Time)
+	assert.Equal(t, start.Add(45*time.Minute), samples[len(samples)-1].Time)
+	for _, wait := range clock.waits {
+		assert.Equal(t, 5*time.Minute, wait)
+	}
+	assert.Equal(t, 100, samples[0].PendingJobs)
+	assert.Equal(t, 190, samples[9].PendingJobs)
+	assert.Equal(t, 29, samples[9].BackfillDepth)
+	assert.Equal(t, 180, samples[9].BackfillQueueLen)
+	assert.Equal(t, 50, samples[5].RunningJobs)
+}
+
+func TestAdapterInfoManager_QueueDepthHistory_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(helpers.TestContext(t))
+	standalone := &MockStandaloneManager{}
+	standalone.On("GetDiagnostics", mock.Anything).Return(&types.Diagnostics{JobsPending: 7}, nil).Once()
+	// The first wait never ends; the cancellation does
+	manager := &adapterInfoManager{standalone: standalone, after: func(time.Duration) <-chan time.Time {
+		cancel()
+		return nil
+	}}
+
+	samples, err := manager.QueueDepthHistory(ctx, time.Hour)
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, samples, 1)
+	assert.Equal(t, 7, samples[0].PendingJobs)
+
+	_, err = manager.QueueDepthHistory(ctx, 0)
+	var validationErr *errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "window", validationErr.Field)
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/base"
+)
+
+// defaultCalendarName names the feed when ExportICalOptions does not
+const defaultCalendarName = "Slurm reservations"
+
+// defaultICalDomain stands in for the cluster name in event UIDs when
+// slurmrestd does not report one
+const defaultICalDomain = "slurm"
+
+// ExportICal lists the reservations and writes those selected by opts as an
+// iCalendar (RFC 5545) feed with one event per reservation. Maintenance
+// reservations are marked as such in the summary and categories. Events are
+// keyed by reservation name and cluster name, so a calendar app refreshing
+// the feed updates a reservation in place when it changes, even when it is
+// moved to another time.
+func (m *adapterReservationManager) ExportICal(ctx context.Context, opts *types.ExportICalOptions) (string, error) {
+	if opts == nil {
+		opts = &types.ExportICalOptions{}
+	}
+	list, err := m.List(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+
+	filter := &types.ReservationListOptions{Names: opts.Names}
+	if !opts.Start.IsZero() {
+		filter.StartTime = &opts.Start
+	}
+	if !opts.End.IsZero() {
+		filter.EndTime = &opts.End
+	}
+	reservations := base.NewReservationBaseManager("").FilterReservationList(list.Reservations, filter)
+
+	cluster := defaultICalDomain
+	info, err := m.info.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if info != nil && info.ClusterName != "" {
+		cluster = info.ClusterName
+	}
+
+	name := opts.CalendarName
+	if name == "" {
+		name = defaultCalendarName
+	}
+	return formatICal(reservations, name, cluster, time.Now()), nil
+}
+
+// formatICal writes the calendar, stamping its events with stamp and
+// naming cluster in their UIDs. Reservations without a start time are left
+// out.
+func formatICal(reservations []types.Reservation, calendarName, cluster string, stamp time.Time) string {
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//jontk//slurm-client//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	writeICalLine(&b, "X-WR-CALNAME:"+escapeICalText(calendarName))
+
+	for i := range reservations {
+		r := &reservations[i]
+		if r.StartTime.IsZero() {
+			continue
+		}
+		name := derefString(r.Name)
+		maintenance := false
+		flags := make([]string, 0, len(r.Flags))
+		for _, flag := range r.Flags {
+			flags = append(flags, string(flag))
+			if flag == types.ReservationFlagMaintenance {
+				maintenance = true
+			}
+		}
+
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:"+escapeICalText(name)+"@"+escapeICalText(cluster))
+		writeICalLine(&b, "DTSTAMP:"+formatICalTime(stamp))
+		writeICalLine(&b, "DTSTART:"+formatICalTime(r.StartTime))
+		if r.EndTime.After(r.StartTime) {
+			writeICalLine(&b, "DTEND:"+formatICalTime(r.EndTime))
+		}
+		summary := "Reservation " + name
+		if maintenance {
+			summary = "Maintenance: " + name
+			writeICalLine(&b, "CATEGORIES:MAINTENANCE")
+		}
+		writeICalLine(&b, "SUMMARY:"+escapeICalText(summary))
+		if r.NodeList != nil && *r.NodeList != "" {
+			writeICalLine(&b, "LOCATION:"+escapeICalText(*r.NodeList))
+		}
+
+		var details []string
+		for _, field := range []struct{ label, value string }{
+			{"Partition", derefString(r.Partition)},
+			{"Nodes", derefString(r.NodeList)},
+			{"Users", derefString(r.Users)},
+			{"Accounts", derefString(r.Accounts)},
+			{"Flags", strings.Join(flags, ",")},
+		} {
+			if field.value != "" {
+				details = append(details, field.label+": "+field.value)
+			}
+		}
+		if len(details) > 0 {
+			writeICalLine(&b, "DESCRIPTION:"+escapeICalText(strings.Join(details, "\n")))
+		}
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// formatICalTime formats t as a UTC date-time
+func formatICalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeICalText escapes a TEXT value
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// writeICalLine writes a content line ended by CRLF, folded so that no line
+// is longer than 75 octets. A continuation line starts with a space, which
+// counts towards its length.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// Folding must not split a UTF-8 sequence
+		for line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
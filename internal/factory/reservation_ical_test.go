@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+// icalInfoAdapter reports the cluster name ExportICal puts in event UIDs
+type icalInfoAdapter struct {
+	common.InfoAdapter
+	cluster string
+}
+
+func (a *icalInfoAdapter) Get(ctx context.Context) (*types.ClusterInfo, error) {
+	return &types.ClusterInfo{ClusterName: a.cluster}, nil
+}
+
+func icalTestClient(reservations []types.Reservation) *AdapterClient {
+	return &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:     "v0.0.44",
+			infoAdapter: &icalInfoAdapter{cluster: "hpc1"},
+			reservationAdapter: &mockReservationAdapter{
+				listFunc: func(ctx context.Context, opts *types.ReservationListOptions) (*types.ReservationList, error) {
+					return &types.ReservationList{Reservations: reservations}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+}
+
+func TestAdapterReservationManager_ExportICal(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	client := icalTestClient([]types.Reservation{
+		{
+			Name:      ptrString("maint-2025-03"),
+			StartTime: time.Date(2025, 3, 4, 8, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2025, 3, 4, 16, 0, 0, 0, time.UTC),
+			NodeList:  ptrString("node[001-128]"),
+			Users:     ptrString("root"),
+			Flags:     []types.ReservationFlag{types.ReservationFlagMaintenance, types.ReservationFlagIgnoreJobs},
+		},
+		{
+			Name:      ptrString("training"),
+			StartTime: time.Date(2025, 3, 10, 9, 30, 0, 0, time.UTC),
+			EndTime:   time.Date(2025, 3, 10, 12, 30, 0, 0, time.UTC),
+			NodeList:  ptrString("gpu[01-04]"),
+			Partition: ptrString("gpu"),
+			Accounts:  ptrString("physics,chemistry,biology,materials-science,astronomy"),
+		},
+		{
+			Name:      ptrString("next-year"),
+			StartTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{Name: ptrString("unscheduled")},
+	})
+
+	feed, err := client.Reservations().ExportICal(ctx, &types.ExportICalOptions{
+		End: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	for _, line := range strings.Split(strings.TrimSuffix(feed, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+	feed = regexp.MustCompile(`DTSTAMP:\d{8}T\d{6}Z`).ReplaceAllString(feed, "DTSTAMP:20250101T000000Z")
+
+	golden := filepath.Join("testdata", "reservations.ics")
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(golden, []byte(feed), 0o644))
+	}
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), feed)
+}
+
+func TestAdapterReservationManager_ExportICalNames(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	client := icalTestClient([]types.Reservation{
+		{Name: ptrString("a"), StartTime: time.Date(2025, 3, 4, 8, 0, 0, 0, time.UTC)},
+		{Name: ptrString("b"), StartTime: time.Date(2025, 3, 5, 8, 0, 0, 0, time.UTC)},
+	})
+
+	feed, err := client.Reservations().ExportICal(ctx, &types.ExportICalOptions{Names: []string{"b"}, CalendarName: "Ops; downtime"})
+	require.NoError(t, err)
+	assert.Contains(t, feed, "X-WR-CALNAME:Ops\\; downtime\r\n")
+	assert.Contains(t, feed, "UID:b@hpc1\r\n")
+	assert.NotContains(t, feed, "UID:a@")
+	assert.Equal(t, 1, strings.Count(feed, "BEGIN:VEVENT"))
+	assert.NotContains(t, feed, "DTEND")
+}
+
+func TestWriteICalLine(t *testing.T) {
+	var b strings.Builder
+	writeICalLine(&b, "DESCRIPTION:"+strings.Repeat("é", 80))
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n ")
+	require.Len(t, lines, 3)
+	for _, line := range lines {
+		assert.True(t, utf8.ValidString(line), line)
+	}
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("é", 80), strings.Join(lines, ""))
+}
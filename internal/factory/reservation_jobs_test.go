@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterReservationManager_Jobs(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	queue := []types.Job{
+		{JobID: ptrInt64(1), ResvName: ptrString("maint"), JobState: []types.JobState{types.JobStateRunning}},
+		{JobID: ptrInt64(2), ResvName: ptrString("maint"), JobState: []types.JobState{types.JobStatePending}},
+		{JobID: ptrInt64(3), ResvName: ptrString("maint"), JobState: []types.JobState{types.JobStateCompleted}},
+		{JobID: ptrInt64(4), ResvName: ptrString("training"), JobState: []types.JobState{types.JobStateRunning}},
+		{JobID: ptrInt64(5), JobState: []types.JobState{types.JobStateRunning}},
+	}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			jobAdapter: &mockJobAdapter{
+				listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+					return &types.JobList{Jobs: queue, Total: len(queue)}, nil
+				},
+			},
+			reservationAdapter: &mockReservationAdapter{
+				getFunc: func(ctx context.Context, name string) (*types.Reservation, error) {
+					if name == "missing" {
+						return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, "reservation not found")
+					}
+					return &types.Reservation{Name: ptrString(name)}, nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	jobs, err := client.Reservations().Jobs(ctx, "maint")
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, int64(1), *jobs[0].JobID)
+	assert.Equal(t, int64(2), *jobs[1].JobID)
+
+	jobs, err = client.Reservations().Jobs(ctx, "empty")
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	_, err = client.Reservations().Jobs(ctx, "missing")
+	require.Error(t, err)
+
+	_, err = client.Reservations().Jobs(ctx, "")
+	require.Error(t, err)
+	assert.True(t, errors.IsValidationError(err))
+}
+
+func TestAdapterReservationManager_DrainJobs(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	queue := []types.Job{
+		{JobID: ptrInt64(1), ResvName: ptrString("maint"), JobState: []types.JobState{types.JobStateRunning}},
+		{JobID: ptrInt64(2), ResvName: ptrString("maint"), JobState: []types.JobState{types.JobStatePending}},
+		{JobID: ptrInt64(3), ResvName: ptrString("maint"), JobState: []types.JobState{types.JobStateRunning}},
+		{JobID: ptrInt64(4), ResvName: ptrString("maint"), JobState: []types.JobState{types.JobStateCompleted}},
+		{JobID: ptrInt64(5), ResvName: ptrString("training"), JobState: []types.JobState{types.JobStateRunning}},
+	}
+	var cancelled, requeued []int64
+	var signals []string
+	jobs := &mockJobAdapter{
+		listFunc: func(ctx context.Context, opts *types.JobListOptions) (*types.JobList, error) {
+			return &types.JobList{Jobs: queue, Total: len(queue)}, nil
+		},
+		cancelFunc: func(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
+			cancelled = append(cancelled, jobID)
+			if opts != nil {
+				signals = append(signals, opts.Signal)
+			}
+			return nil
+		},
+		requeueFunc: func(ctx context.Context, jobID int64) error {
+			requeued = append(requeued, jobID)
+			if jobID == 3 {
+				return errors.NewSlurmError(errors.ErrorCodeServerInternal, "requeue failed")
+			}
+			return nil
+		},
+	}
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:            "v0.0.44",
+			jobAdapter:         jobs,
+			reservationAdapter: &mockReservationAdapter{},
+		},
+		version: "v0.0.44",
+	}
+
+	n, err := client.Reservations().DrainJobs(ctx, "maint", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []int64{1, 2, 3}, cancelled)
+	assert.Empty(t, signals)
+
+	cancelled = nil
+	n, err = client.Reservations().DrainJobs(ctx, "maint", &types.DrainReservationJobsOptions{Signal: "SIGTERM"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"SIGTERM", "SIGTERM", "SIGTERM"}, signals)
+
+	// Requeue skips the pending job and reports the failed one
+	cancelled = nil
+	n, err = client.Reservations().DrainJobs(ctx, "maint", &types.DrainReservationJobsOptions{Requeue: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "job 3")
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []int64{1, 3}, requeued)
+	assert.Empty(t, cancelled)
+}
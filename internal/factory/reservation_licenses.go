@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// licenseReservationPrefix starts the name of every reservation made by
+// ReserveLicenses
+const licenseReservationPrefix = "license-"
+
+// ReserveLicenses holds count of a floating license for ttl. Slurm has no
+// call that hands out licenses on their own, so this creates a reservation
+// of the licenses and no nodes, starting now, for opts.User, else the user
+// the auth provider authenticates as, else the user running the client.
+// The reservation is flagged ANY_NODES so jobs naming it may run on any
+// node; without the flag they could only use its nodes, and it has none.
+// Slurm only lets operators and administrators create reservations, so the
+// client must authenticate as one. Creating it is a single request: it
+// either holds all the licenses or fails. The reservation ends by itself
+// after ttl, rounded up to whole minutes, and jobs use the licenses by
+// naming the token as their reservation.
+func (m *adapterReservationManager) ReserveLicenses(ctx context.Context, license string, count int, ttl time.Duration, opts *types.ReserveLicensesOptions) (*types.LicenseReservation, error) {
+	if license == "" || strings.ContainsAny(license, ",: ") {
+		return nil, errors.NewValidationErrorf("license", license, "a single license name is required")
+	}
+	if count <= 0 {
+		return nil, errors.NewValidationErrorf("count", count, "count must be positive")
+	}
+	if ttl <= 0 {
+		return nil, errors.NewValidationErrorf("ttl", ttl, "ttl must be positive")
+	}
+	owner, err := m.licenseOwner(opts)
+	if err != nil {
+		return nil, err
+	}
+	token, err := licenseReservationName(license)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	minutes := uint32((ttl + time.Minute - 1) / time.Minute)
+	nodes := uint32(0)
+	_, err = m.adapter.Create(ctx, &types.ReservationCreate{
+		Name:      &token,
+		Licenses:  []string{fmt.Sprintf("%s:%d", license, count)},
+		NodeCount: &nodes,
+		StartTime: start,
+		Duration:  &minutes,
+		Users:     []string{owner},
+		Flags:     []types.ReservationFlag{types.ReservationFlagAnyNodes},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve %d %s licenses: %w", count, license, err)
+	}
+	return &types.LicenseReservation{
+		Token:   token,
+		License: license,
+		Count:   count,
+		Expires: start.Add(time.Duration(minutes) * time.Minute),
+	}, nil
+}
+
+// ReleaseLicenses deletes a reservation made by ReserveLicenses, returning
+// its licenses before the reservation would have ended
+func (m *adapterReservationManager) ReleaseLicenses(ctx context.Context, token string) error {
+	if !strings.HasPrefix(token, licenseReservationPrefix) {
+		return errors.NewValidationErrorf("token", token, "not a license reservation token")
+	}
+	return m.adapter.Delete(ctx, token)
+}
+
+// licenseReservationName returns a reservation name for license that will
+// not collide with another reservation of it
+func licenseReservationName(license string) (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate a reservation name: %w", err)
+	}
+	return licenseReservationPrefix + license + "-" + hex.EncodeToString(suffix), nil
+}
+
+// licenseOwner returns the user allowed to use a license reservation: the
+// one asked for, else the one the client authenticates as, else the user
+// running the client
+func (m *adapterReservationManager) licenseOwner(opts *types.ReserveLicensesOptions) (string, error) {
+	if opts != nil && opts.User != "" {
+		return opts.User, nil
+	}
+	if m.username != "" {
+		return m.username, nil
+	}
+	return currentUsername()
+}
+
+// currentUsername returns the user running the client
+func currentUsername() (string, error) {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username, nil
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name, nil
+	}
+	return "", fmt.Errorf("cannot determine the current user to own the reservation")
+}
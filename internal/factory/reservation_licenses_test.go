@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/auth"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// licenseServer is a slurmrestd that keeps license-only reservations and
+// counts their licenses as reserved. Like slurmctld, it only runs a job in
+// a reservation without nodes if the reservation is flagged ANY_NODES.
+type licenseServer struct {
+	mu       sync.Mutex
+	total    int
+	reserved map[string]int
+	anyNodes map[string]bool
+	created  map[string]interface{}
+}
+
+func (s *licenseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/slurm/v0.0.44/reservations/":
+		var body struct {
+			Reservations []map[string]interface{} `json:"reservations"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Reservations) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resv := body.Reservations[0]
+		name, _ := resv["name"].(string)
+		licenses, _ := resv["licenses"].([]interface{})
+		for _, l := range licenses {
+			_, count, _ := strings.Cut(l.(string), ":")
+			n, _ := strconv.Atoi(count)
+			s.reserved[name] += n
+		}
+		flags, _ := resv["flags"].([]interface{})
+		for _, f := range flags {
+			if f == "ANY_NODES" {
+				s.anyNodes[name] = true
+			}
+		}
+		s.created = resv
+	case r.Method == http.MethodPost && r.URL.Path == "/slurm/v0.0.44/job/submit":
+		var body struct {
+			Job map[string]interface{} `json:"job"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		name, _ := body.Job["reservation"].(string)
+		if _, ok := s.reserved[name]; !ok || !s.anyNodes[name] {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]interface{}{{"description": "Requested node configuration is not available"}},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"job_id": 100})
+		return
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/slurm/v0.0.44/reservation/"):
+		delete(s.reserved, strings.TrimPrefix(r.URL.Path, "/slurm/v0.0.44/reservation/"))
+	case r.Method == http.MethodGet && r.URL.Path == "/slurm/v0.0.44/licenses/":
+		reserved := 0
+		for _, n := range s.reserved {
+			reserved += n
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"licenses": []map[string]interface{}{{
+				"LicenseName": "matlab", "Total": s.total, "Used": 0,
+				"Reserved": reserved, "Free": s.total - reserved,
+			}},
+		})
+		return
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{})
+}
+
+func TestAdapterReservationManager_ReserveLicenses(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	backend := &licenseServer{total: 10, reserved: make(map[string]int), anyNodes: make(map[string]bool)}
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	free := func() int {
+		list, err := client.GetLicenses(ctx)
+		require.NoError(t, err)
+		require.Len(t, list.Licenses, 1)
+		return list.Licenses[0].Free
+	}
+	require.Equal(t, 10, free())
+
+	before := time.Now()
+	hold, err := client.Reservations().ReserveLicenses(ctx, "matlab", 4, 90*time.Second, nil)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hold.Token, "license-matlab-"))
+	assert.Equal(t, "matlab", hold.License)
+	assert.Equal(t, 4, hold.Count)
+	assert.WithinRange(t, hold.Expires, before.Add(2*time.Minute), time.Now().Add(2*time.Minute))
+	assert.Equal(t, 6, free())
+
+	backend.mu.Lock()
+	assert.Equal(t, hold.Token, backend.created["name"])
+	assert.Equal(t, []interface{}{"matlab:4"}, backend.created["licenses"])
+	assert.NotEmpty(t, backend.created["users"])
+	assert.Equal(t, map[string]interface{}{"number": float64(2), "set": true}, backend.created["duration"])
+	assert.Equal(t, map[string]interface{}{"number": float64(0), "set": true}, backend.created["node_count"])
+	assert.Equal(t, []interface{}{"ANY_NODES"}, backend.created["flags"])
+	backend.mu.Unlock()
+
+	// A job naming the token can run although the reservation has no nodes
+	script := "#!/bin/bash\nmatlab -batch run"
+	licenses := "matlab:4"
+	submitted, err := client.Jobs().SubmitRaw(ctx, &types.JobCreate{
+		Script:      &script,
+		Licenses:    &licenses,
+		Reservation: &hold.Token,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), submitted.JobId)
+
+	require.NoError(t, client.Reservations().ReleaseLicenses(ctx, hold.Token))
+	assert.Equal(t, 10, free())
+
+	_, err = client.Reservations().ReserveLicenses(ctx, "matlab", 0, time.Minute, nil)
+	assert.True(t, errors.IsValidationError(err))
+	err = client.Reservations().ReleaseLicenses(ctx, "weekly-maint")
+	assert.True(t, errors.IsValidationError(err))
+}
+
+func TestAdapterReservationManager_ReserveLicensesOwner(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	backend := &licenseServer{total: 10, reserved: make(map[string]int), anyNodes: make(map[string]bool)}
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	// The auth provider's user owns the reservation unless one is asked for
+	provider := auth.NewRefreshingTokenAuth(func(ctx context.Context) (string, time.Time, error) {
+		return "token", time.Now().Add(time.Hour), nil
+	}, auth.WithRefreshUser("alice"))
+	factory, err := NewClientFactory(WithBaseURL(server.URL), WithAuth(provider))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	owner := func() interface{} {
+		backend.mu.Lock()
+		defer backend.mu.Unlock()
+		return backend.created["users"]
+	}
+
+	_, err = client.Reservations().ReserveLicenses(ctx, "matlab", 1, time.Minute, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"alice"}, owner())
+
+	_, err = client.Reservations().ReserveLicenses(ctx, "matlab", 1, time.Minute, &types.ReserveLicensesOptions{User: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"bob"}, owner())
+}
+
+func TestAdapterReservationManager_ReserveLicensesUnsupported(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.40",
+			reservationAdapter: &mockReservationAdapter{
+				createFunc: func(ctx context.Context, res *types.ReservationCreate) (*types.ReservationCreateResponse, error) {
+					return nil, errors.NewNotImplementedError("reservation creation", "v0.0.40")
+				},
+			},
+		},
+		version: "v0.0.40",
+	}
+
+	_, err := client.Reservations().ReserveLicenses(ctx, "matlab", 1, time.Minute, nil)
+	assert.True(t, errors.IsNotImplementedError(err))
+}
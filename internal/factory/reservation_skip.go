@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// Skip cancels the next occurrence of a recurring reservation by setting
+// its SKIP flag; slurmctld clears the flag once the occurrence has been
+// skipped. Versions without reservation updates return their
+// not-implemented error.
+func (m *adapterReservationManager) Skip(ctx context.Context, reservationName string) error {
+	if reservationName == "" {
+		return errors.NewValidationErrorf("reservationName", reservationName, "reservation name is required")
+	}
+	return m.adapter.Update(ctx, reservationName, &types.ReservationUpdate{
+		Flags: []types.ReservationFlag{types.ReservationFlagsSkip},
+	})
+}
+
+// StartNow moves the start time of a reservation to now, the equivalent of
+// "scontrol update ReservationName=<name> StartTime=now".
+func (m *adapterReservationManager) StartNow(ctx context.Context, reservationName string) error {
+	if reservationName == "" {
+		return errors.NewValidationErrorf("reservationName", reservationName, "reservation name is required")
+	}
+	now := time.Now()
+	return m.adapter.Update(ctx, reservationName, &types.ReservationUpdate{StartTime: &now})
+}
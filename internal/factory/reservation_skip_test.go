@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterReservationManager_SkipAndStartNow(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	var name string
+	var sent *types.ReservationUpdate
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.44",
+			reservationAdapter: &mockReservationAdapter{
+				updateFunc: func(ctx context.Context, n string, update *types.ReservationUpdate) error {
+					name, sent = n, update
+					return nil
+				},
+			},
+		},
+		version: "v0.0.44",
+	}
+
+	require.NoError(t, client.Reservations().Skip(ctx, "weekly-maint"))
+	assert.Equal(t, "weekly-maint", name)
+	assert.Equal(t, []types.ReservationFlag{types.ReservationFlagsSkip}, sent.Flags)
+	assert.Nil(t, sent.StartTime)
+
+	before := time.Now()
+	require.NoError(t, client.Reservations().StartNow(ctx, "training"))
+	assert.Equal(t, "training", name)
+	assert.Empty(t, sent.Flags)
+	require.NotNil(t, sent.StartTime)
+	assert.WithinRange(t, *sent.StartTime, before, time.Now())
+
+	err := client.Reservations().Skip(ctx, "")
+	require.Error(t, err)
+	assert.True(t, errors.IsValidationError(err))
+}
+
+func TestAdapterReservationManager_SkipUnsupported(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version: "v0.0.40",
+			reservationAdapter: &mockReservationAdapter{
+				updateFunc: func(ctx context.Context, n string, update *types.ReservationUpdate) error {
+					return errors.NewNotImplementedError("reservation updates", "v0.0.40")
+				},
+			},
+		},
+		version: "v0.0.40",
+	}
+
+	err := client.Reservations().Skip(ctx, "weekly-maint")
+	require.Error(t, err)
+	assert.True(t, errors.IsNotImplementedError(err))
+	err = client.Reservations().StartNow(ctx, "weekly-maint")
+	assert.True(t, errors.IsNotImplementedError(err))
+}
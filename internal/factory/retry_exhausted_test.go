@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/pkg/middleware"
+	"github.com/jontk/slurm-client/pkg/retry"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdapterClient_RetryExhausted checks that a request the retry
+// middleware gave up on reports how often it was tried
+func TestAdapterClient_RetryExhausted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "slurmctld unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	passThrough := func(next http.RoundTripper) http.RoundTripper { return next }
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(
+				WithBaseURL(server.URL),
+				WithRetryPolicy(retry.NewFixedDelay(2, time.Millisecond)),
+			)
+			require.NoError(t, err)
+			require.NoError(t, factory.WithMiddleware(middleware.Middleware(passThrough)))
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+			defer client.Close()
+
+			_, err = client.Jobs().Get(ctx, "42")
+			require.Error(t, err)
+
+			var exhausted *retry.RetryExhaustedError
+			require.True(t, stderrors.As(err, &exhausted), "%T: %v", err, err)
+			assert.Equal(t, 3, exhausted.Attempts)
+			assert.Equal(t, http.StatusServiceUnavailable, exhausted.LastStatusCode)
+			assert.Positive(t, exhausted.Elapsed)
+
+			var slurmErr *errors.SlurmError
+			require.True(t, stderrors.As(err, &slurmErr))
+			assert.Equal(t, http.StatusServiceUnavailable, slurmErr.StatusCode)
+			assert.Equal(t, errors.ErrorCodeSlurmDaemonDown, errors.GetErrorCode(err))
+		})
+	}
+}
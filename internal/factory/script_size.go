@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/jontk/slurm-client/pkg/logging"
+)
+
+// DefaultScriptSizeWarning is the batch script size, in bytes, above which a
+// submission is logged as a warning. It is slurmctld's default
+// max_script_size; larger scripts are rejected unless SchedulerParameters
+// raises it.
+const DefaultScriptSizeWarning = 4 << 20
+
+// maxRequestBodyBytes is the largest request body slurmrestd reads
+// (MAX_BODY_BYTES); it closes the connection on anything larger
+const maxRequestBodyBytes = 50 << 20
+
+// scriptSizeTransport warns about job submissions with a batch script larger
+// than threshold, and about any request slurmrestd will refuse to read. The
+// body is passed on byte for byte: the script is encoded once, by the
+// generated client, and slurmrestd does not accept compressed bodies. It is
+// only read when the logger records warnings.
+type scriptSizeTransport struct {
+	next      http.RoundTripper
+	logger    logging.Logger
+	threshold int
+}
+
+func newScriptSizeTransport(next http.RoundTripper, logger logging.Logger, threshold int) *scriptSizeTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &scriptSizeTransport{next: next, logger: logger, threshold: threshold}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *scriptSizeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.Method != http.MethodPost {
+		return t.next.RoundTrip(req)
+	}
+	if req.ContentLength > maxRequestBodyBytes {
+		t.logger.Warn("request body is larger than slurmrestd accepts and will be rejected",
+			"path", req.URL.Path,
+			"bytes", req.ContentLength,
+			"max_bytes", maxRequestBodyBytes,
+		)
+	}
+	if t.threshold <= 0 || !strings.HasSuffix(req.URL.Path, "/job/submit") || !logging.WarnEnabled(t.logger) {
+		return t.next.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	if size := submittedScriptSize(body); size > t.threshold {
+		t.logger.Warn("batch script is larger than slurmctld accepts by default (max_script_size)",
+			"path", req.URL.Path,
+			"script_bytes", size,
+			"threshold_bytes", t.threshold,
+		)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// submittedScriptSize returns the decoded size of the script in a job submit
+// body, which carries it either at the top level or inside the job
+func submittedScriptSize(body []byte) int {
+	var submit struct {
+		Script *string `json:"script"`
+		Job    *struct {
+			Script *string `json:"script"`
+		} `json:"job"`
+	}
+	if json.Unmarshal(body, &submit) != nil {
+		return 0
+	}
+	switch {
+	case submit.Script != nil:
+		return len(*submit.Script)
+	case submit.Job != nil && submit.Job.Script != nil:
+		return len(*submit.Job.Script)
+	}
+	return 0
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/logging"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFactory_ScriptSizeWarning(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Script string `json:"script"`
+			Job    struct {
+				Script string `json:"script"`
+			} `json:"job"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		received = []string{body.Script, body.Job.Script}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"job_id": 42}`))
+	}))
+	defer server.Close()
+
+	ctx := helpers.TestContext(t)
+	logger := &recordingLogger{}
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithLogger(logger))
+	require.NoError(t, factory.WithScriptSizeWarning(1024))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	// Quotes and newlines are escaped in the body but must arrive as written
+	small := "#!/bin/bash\necho \"hello\"\n"
+	_, err = client.Jobs().Submit(ctx, &types.JobSubmission{Name: "small", Script: small})
+	require.NoError(t, err)
+	assert.Equal(t, []string{small, small}, received)
+	assert.Empty(t, logger.Warnings())
+
+	large := "#!/bin/bash\n" + strings.Repeat("echo \"padding the script\"\n", 100)
+	resp, err := client.Jobs().Submit(ctx, &types.JobSubmission{Name: "large", Script: large})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.JobId)
+	assert.Equal(t, []string{large, large}, received)
+
+	warnings := logger.Warnings()
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "max_script_size")
+	assert.Contains(t, warnings[0], "script_bytes")
+}
+
+func TestClientFactory_ScriptSizeWarning_Options(t *testing.T) {
+	factory, err := NewClientFactory(WithBaseURL("http://localhost:6820"))
+	require.NoError(t, err)
+	assert.Error(t, factory.WithScriptSizeWarning(-1))
+	require.NoError(t, factory.WithScriptSizeWarning(0))
+	assert.Equal(t, 0, *factory.enhanced.ScriptSizeWarning)
+
+	assert.Equal(t, 0, submittedScriptSize([]byte(`{"job": {"name": "no-script"}}`)))
+	assert.Equal(t, 5, submittedScriptSize([]byte(`{"job": {"script": "a\nbcd"}}`)))
+	assert.Equal(t, 3, submittedScriptSize([]byte(`{"script": "abc", "job": {}}`)))
+	assert.Equal(t, 0, submittedScriptSize([]byte(`not json`)))
+}
+
+// bodyCheckTransport records whether the submit body reached it untouched
+type bodyCheckTransport struct {
+	body io.ReadCloser
+}
+
+func (t *bodyCheckTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.body = req.Body
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+func TestScriptSizeTransport_SkipsBodyWithoutLogger(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		logger logging.Logger
+		read   bool
+	}{
+		{"no-op logger", logging.NoOpLogger{}, false},
+		{"recording logger", &recordingLogger{}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			next := &bodyCheckTransport{}
+			transport := newScriptSizeTransport(next, tc.logger, 1)
+			body := io.NopCloser(strings.NewReader(`{"script": "#!/bin/bash\nsleep 60\n"}`))
+			req := httptest.NewRequest(http.MethodPost, "http://localhost:6820/slurm/v0.0.44/job/submit", body)
+
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			// The body is only buffered, and so replaced, when it is read
+			assert.Equal(t, tc.read, next.body != body)
+		})
+	}
+}
+
+func TestClientFactory_ScriptSizeWarning_WrapsOnce(t *testing.T) {
+	base := http.DefaultTransport
+	httpClient := &http.Client{Transport: base}
+	factory, err := NewClientFactory(WithBaseURL("http://localhost:6820"), WithHTTPClient(httpClient))
+	require.NoError(t, err)
+
+	ctx := helpers.TestContext(t)
+	for range 2 {
+		_, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+		require.NoError(t, err)
+	}
+	// The caller's client is left as it was given
+	assert.Equal(t, base, httpClient.Transport)
+
+	// Every build wraps the original transport once
+	for range 2 {
+		transport, ok := factory.buildEnhancedHTTPClient(ctx).Transport.(*scriptSizeTransport)
+		require.True(t, ok)
+		assert.Equal(t, base, transport.next)
+		// Without WithLogger the warning is dropped rather than printed
+		assert.Equal(t, logging.NoOpLogger{}, transport.logger)
+	}
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/events"
+)
+
+// flusher is implemented by metrics collectors, loggers and audit sinks
+// that buffer output. Shutdown flushes every one registered with the client.
+// The event bus delivers events as they are emitted and has nothing to
+// flush.
+type flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// activityTracker counts running operations and lets callers wait for the
+// count to drop to zero. Unlike sync.WaitGroup it may be incremented while
+// another goroutine is waiting.
+type activityTracker struct {
+	mu     sync.Mutex
+	active int
+	idle   chan struct{} // closed when active drops to zero; nil while idle
+}
+
+func (t *activityTracker) add() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.active == 0 {
+		t.idle = make(chan struct{})
+	}
+	t.active++
+}
+
+func (t *activityTracker) done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.active--
+	if t.active == 0 {
+		close(t.idle)
+		t.idle = nil
+	}
+}
+
+// wait blocks until no operations are running or ctx is done
+func (t *activityTracker) wait(ctx context.Context) error {
+	t.mu.Lock()
+	idle := t.idle
+	t.mu.Unlock()
+	if idle == nil {
+		return nil
+	}
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// clientLifecycle tracks the background goroutines and in-flight requests of
+// an AdapterClient so that Shutdown can stop and wait for them. A nil
+// lifecycle is valid and tracks nothing.
+type clientLifecycle struct {
+	ctx    context.Context // cancelled when Shutdown starts
+	cancel context.CancelFunc
+
+	goroutines activityTracker
+	requests   activityTracker
+
+	bus *events.Bus // retries, rate-limit waits and the like, for Events
+
+	mu       sync.Mutex
+	flushers []flusher
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func newClientLifecycle() *clientLifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &clientLifecycle{ctx: ctx, cancel: cancel, bus: events.NewBus(events.DefaultBufferSize)}
+}
+
+// bind returns a context that is cancelled when either ctx is done or the
+// client shuts down
+func (l *clientLifecycle) bind(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if l == nil {
+		return ctx, cancel
+	}
+	stop := context.AfterFunc(l.ctx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// goroutine runs fn in a new goroutine that Shutdown waits for
+func (l *clientLifecycle) goroutine(fn func()) {
+	if l == nil {
+		go fn()
+		return
+	}
+	l.goroutines.add()
+	go func() {
+		defer l.goroutines.done()
+		fn()
+	}()
+}
+
+// addFlusher registers a metrics collector, logger or audit sink to flush
+// on Shutdown
+func (l *clientLifecycle) addFlusher(f flusher) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.flushers = append(l.flushers, f)
+}
+
+// trackRequests wraps doer so that Shutdown waits for its requests
+func (l *clientLifecycle) trackRequests(doer types.HTTPDoer) types.HTTPDoer {
+	if doer == nil {
+		doer = &http.Client{}
+	}
+	return &inFlightDoer{next: doer, requests: &l.requests}
+}
+
+// shutdown stops background goroutines, waits for them and for in-flight
+// requests, then flushes the registered flushers and calls closeFn. The
+// flush and close steps run once; a call that times out while waiting can
+// be repeated with a longer deadline.
+func (l *clientLifecycle) shutdown(ctx context.Context, closeFn func() error) error {
+	l.cancel()
+
+	if err := l.goroutines.wait(ctx); err != nil {
+		return fmt.Errorf("waiting for background goroutines: %w", err)
+	}
+	if err := l.requests.wait(ctx); err != nil {
+		return fmt.Errorf("waiting for in-flight requests: %w", err)
+	}
+
+	l.closeOnce.Do(func() {
+		l.mu.Lock()
+		flushers := l.flushers
+		l.mu.Unlock()
+		for _, f := range flushers {
+			if err := f.Flush(ctx); err != nil && l.closeErr == nil {
+				l.closeErr = fmt.Errorf("failed to flush: %w", err)
+			}
+		}
+		if err := closeFn(); err != nil && l.closeErr == nil {
+			l.closeErr = err
+		}
+	})
+	return l.closeErr
+}
+
+// inFlightDoer counts requests until their response body is closed
+type inFlightDoer struct {
+	next     types.HTTPDoer
+	requests *activityTracker
+}
+
+func (d *inFlightDoer) Do(req *http.Request) (*http.Response, error) {
+	d.requests.add()
+	resp, err := d.next.Do(req)
+	if err != nil || resp.Body == nil {
+		d.requests.done()
+		return resp, err
+	}
+	resp.Body = &trackedBody{ReadCloser: resp.Body, done: d.requests.done}
+	return resp, nil
+}
+
+// trackedBody marks its request finished the first time it is closed
+type trackedBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *trackedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
+
+// Shutdown stops the client's watchers, waits for in-flight requests to
+// finish, flushes any buffered metrics and logs, and then closes the client.
+// It returns ctx's error if the wait is cut short. Shutdown is idempotent:
+// once it has succeeded, further calls return the same result.
+func (c *AdapterClient) Shutdown(ctx context.Context) error {
+	return c.lifecycle().shutdown(ctx, c.Close)
+}
+
+// lifecycle returns the client's lifecycle, creating one for clients that
+// were not built by NewAdapterClient
+func (c *AdapterClient) lifecycle() *clientLifecycle {
+	c.lifeOnce.Do(func() {
+		if c.life == nil {
+			c.life = newClientLifecycle()
+		}
+	})
+	return c.life
+}
+
+// registerFlushers arranges for the factory's metrics collector, logger and
+// audit sink to be flushed on Shutdown when they buffer output
+func (f *ClientFactory) registerFlushers(client SlurmClient) {
+	ac, ok := client.(*AdapterClient)
+	if !ok || f.enhanced == nil {
+		return
+	}
+	if fl, ok := f.enhanced.MetricsCollector.(flusher); ok {
+		ac.lifecycle().addFlusher(fl)
+	}
+	if fl, ok := f.enhanced.Logger.(flusher); ok {
+		ac.lifecycle().addFlusher(fl)
+	}
+	if fl, ok := f.enhanced.AuditSink.(flusher); ok {
+		ac.lifecycle().addFlusher(fl)
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/audit"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingFlusher counts Flush calls
+type countingFlusher struct {
+	calls int
+}
+
+func (f *countingFlusher) Flush(ctx context.Context) error {
+	f.calls++
+	return nil
+}
+
+// doerFunc adapts a function to types.HTTPDoer
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestAdapterClient_Shutdown_StopsWatchers(t *testing.T) {
+	ctx := helpers.TestContext(t)
+	baseline := runtime.NumGoroutine()
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:          "v0.0.44",
+			jobAdapter:       &mockJobAdapter{},
+			partitionAdapter: &recordingPartitionAdapter{},
+		},
+		version: "v0.0.44",
+	}
+	flusher := &countingFlusher{}
+	client.lifecycle().addFlusher(flusher)
+
+	// The watches outlive the caller's context; only Shutdown stops them
+	jobEvents, err := client.Jobs().Watch(context.Background(), nil)
+	require.NoError(t, err)
+	partitionEvents, err := client.Partitions().Watch(context.Background(), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Shutdown(ctx))
+
+	for _, closed := range []func() bool{
+		func() bool { _, ok := <-jobEvents; return !ok },
+		func() bool { _, ok := <-partitionEvents; return !ok },
+	} {
+		assert.True(t, closed())
+	}
+	// assert.Eventually runs its condition on another goroutine, so poll here
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > baseline && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), baseline)
+	assert.Equal(t, 1, flusher.calls)
+
+	// A second call is a no-op
+	require.NoError(t, client.Shutdown(ctx))
+	assert.Equal(t, 1, flusher.calls)
+
+	// Watches started after Shutdown stop straight away
+	jobEvents, err = client.Jobs().Watch(context.Background(), nil)
+	require.NoError(t, err)
+	_, ok := <-jobEvents
+	assert.False(t, ok)
+}
+
+func TestAdapterClient_Shutdown_WaitsForInFlightRequests(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	life := newClientLifecycle()
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{version: "v0.0.44"},
+		version: "v0.0.44",
+		life:    life,
+	}
+	doer := life.trackRequests(doerFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://slurm.example/slurm/v0.0.44/ping", nil)
+	require.NoError(t, err)
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+
+	// The response body is still open, so the request is in flight
+	shortCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	err = client.Shutdown(shortCtx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, client.Shutdown(ctx))
+}
+
+// flushingAuditSink is an audit sink that buffers records
+type flushingAuditSink struct {
+	countingFlusher
+}
+
+func (s *flushingAuditSink) Write(record *audit.Record) error { return nil }
+
+func TestClientFactory_Shutdown_FlushesAuditSink(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	sink := &flushingAuditSink{}
+	factory, err := NewClientFactory(WithBaseURL("http://localhost:6820"))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithAuditLog(sink))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	require.NoError(t, client.Shutdown(ctx))
+	assert.Equal(t, 1, sink.calls)
+}
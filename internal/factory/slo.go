@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// CheckSLO runs a small probe workload against the cluster and reports
+// whether its p95 latency and error rate meet slo. Probes run one at a time
+// so the check adds little load; a failed probe counts against the error
+// budget rather than failing the check. The returned error is reserved for
+// an invalid config or a cancelled context.
+func (c *AdapterClient) CheckSLO(ctx context.Context, slo types.SLOConfig) (*types.SLOResult, error) {
+	if slo.MaxP95 <= 0 {
+		return nil, errors.NewValidationErrorf("MaxP95", slo.MaxP95, "MaxP95 must be positive")
+	}
+	if slo.MaxErrorRate < 0 || slo.MaxErrorRate > 1 {
+		return nil, errors.NewValidationErrorf("MaxErrorRate", slo.MaxErrorRate,
+			"MaxErrorRate must be between 0 and 1")
+	}
+	probes := slo.Probes
+	if probes < 0 {
+		return nil, errors.NewValidationErrorf("Probes", slo.Probes, "Probes cannot be negative")
+	}
+	if probes == 0 {
+		probes = types.DefaultSLOProbes
+	}
+
+	workload := []func(ctx context.Context) error{
+		func(ctx context.Context) error { return c.Info().Ping(ctx) },
+		func(ctx context.Context) error {
+			_, err := c.Jobs().List(ctx, &types.ListJobsOptions{Limit: 1})
+			return err
+		},
+	}
+
+	result := &types.SLOResult{}
+	latencies := make([]time.Duration, 0, probes*len(workload))
+	for i := 0; i < probes; i++ {
+		for _, probe := range workload {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			start := time.Now()
+			err := probe(ctx)
+			latencies = append(latencies, time.Since(start))
+			result.Requests++
+			if err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return nil, ctxErr
+				}
+				result.Errors++
+			}
+		}
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	result.P50 = percentile(latencies, 0.50)
+	result.P95 = percentile(latencies, 0.95)
+	result.Max = latencies[len(latencies)-1]
+	result.ErrorRate = float64(result.Errors) / float64(result.Requests)
+
+	if result.P95 > slo.MaxP95 {
+		result.Violations = append(result.Violations,
+			fmt.Sprintf("p95 latency %s exceeds %s", result.P95, slo.MaxP95))
+	}
+	if result.ErrorRate > slo.MaxErrorRate {
+		result.Violations = append(result.Violations,
+			fmt.Sprintf("error rate %.2f%% exceeds %.2f%%", result.ErrorRate*100, slo.MaxErrorRate*100))
+	}
+	result.Met = len(result.Violations) == 0
+	return result, nil
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
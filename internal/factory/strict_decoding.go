@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	v040api "github.com/jontk/slurm-client/internal/openapi/v0_0_40"
+	v041api "github.com/jontk/slurm-client/internal/openapi/v0_0_41"
+	v042api "github.com/jontk/slurm-client/internal/openapi/v0_0_42"
+	v043api "github.com/jontk/slurm-client/internal/openapi/v0_0_43"
+	v044api "github.com/jontk/slurm-client/internal/openapi/v0_0_44"
+	"github.com/jontk/slurm-client/pkg/logging"
+)
+
+// specLoaders returns the embedded OpenAPI document of each API version
+var specLoaders = map[string]func() (*openapi3.T, error){
+	"v0.0.40": v040api.GetSwagger,
+	"v0.0.41": v041api.GetSwagger,
+	"v0.0.42": v042api.GetSwagger,
+	"v0.0.43": v043api.GetSwagger,
+	"v0.0.44": v044api.GetSwagger,
+}
+
+// unknownFieldTransport checks successful JSON responses against the OpenAPI
+// schema the client was generated from and logs a warning listing fields the
+// schema does not define. This is the equivalent of decoding with
+// DisallowUnknownFields, without failing the request: the generated clients
+// ignore such fields, so they usually indicate a newer slurmrestd.
+type unknownFieldTransport struct {
+	next   http.RoundTripper
+	logger logging.Logger
+
+	mu    sync.Mutex
+	specs map[string]*openapi3.T
+}
+
+func newUnknownFieldTransport(next http.RoundTripper, logger logging.Logger) *unknownFieldTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &unknownFieldTransport{
+		next:   next,
+		logger: logger,
+		specs:  make(map[string]*openapi3.T),
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *unknownFieldTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 ||
+		!strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return resp, err
+	}
+
+	schema := t.responseSchema(req.Method, req.URL.Path, resp.StatusCode)
+	if schema == nil {
+		return resp, nil
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return resp, readErr
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if decoder.Decode(&value) != nil {
+		// Leave malformed bodies to the generated parser to report
+		return resp, nil
+	}
+
+	unknown := make(map[string]bool)
+	collectUnknownFields(schema, value, "", unknown)
+	if len(unknown) > 0 {
+		fields := make([]string, 0, len(unknown))
+		for field := range unknown {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		t.logger.Warn("response contains fields not defined by the client's API schema",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"fields", fields,
+		)
+	}
+	return resp, nil
+}
+
+// responseSchema finds the schema of the response for the given request.
+// When several templates match, as /job/{job_id} and /job/submit both match
+// /job/submit, the one with the most literal segments wins.
+func (t *unknownFieldTransport) responseSchema(method, path string, status int) *openapi3.Schema {
+	spec := t.spec(extractVersionFromURL(path))
+	if spec == nil || spec.Paths == nil {
+		return nil
+	}
+	var op *openapi3.Operation
+	best := -1
+	for template, item := range spec.Paths.Map() {
+		if !matchPathTemplate(template, path) {
+			continue
+		}
+		candidate := item.GetOperation(method)
+		if candidate == nil {
+			continue
+		}
+		if literals := literalSegments(template); literals > best {
+			op, best = candidate, literals
+		}
+	}
+	if op == nil || op.Responses == nil {
+		return nil
+	}
+	ref := op.Responses.Status(status)
+	if ref == nil {
+		ref = op.Responses.Default()
+	}
+	if ref == nil || ref.Value == nil {
+		return nil
+	}
+	media := ref.Value.Content.Get("application/json")
+	if media == nil || media.Schema == nil {
+		return nil
+	}
+	return media.Schema.Value
+}
+
+// spec loads and caches the OpenAPI document of version
+func (t *unknownFieldTransport) spec(version string) *openapi3.T {
+	load, ok := specLoaders[version]
+	if !ok {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if spec, ok := t.specs[version]; ok {
+		return spec
+	}
+	spec, err := load()
+	if err != nil {
+		t.logger.Debug("failed to load OpenAPI schema for strict decoding", "version", version, "error", err)
+		spec = nil
+	}
+	t.specs[version] = spec
+	return spec
+}
+
+// matchPathTemplate reports whether path matches an OpenAPI path template
+// such as "/slurm/v0.0.44/job/{job_id}"
+func matchPathTemplate(template, path string) bool {
+	tParts := strings.Split(strings.Trim(template, "/"), "/")
+	pParts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(tParts) != len(pParts) {
+		return false
+	}
+	for i, part := range tParts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if pParts[i] == "" {
+				return false
+			}
+			continue
+		}
+		if part != pParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// literalSegments counts the segments of a path template that are not
+// parameters
+func literalSegments(template string) int {
+	count := 0
+	for _, part := range strings.Split(strings.Trim(template, "/"), "/") {
+		if !strings.HasPrefix(part, "{") {
+			count++
+		}
+	}
+	return count
+}
+
+// collectUnknownFields adds to unknown the JSON paths of object keys in
+// value that schema does not declare. Every element of a list shares the
+// path "list[]", so a field is reported once however many elements have it.
+// Objects without declared properties are treated as free-form.
+func collectUnknownFields(schema *openapi3.Schema, value interface{}, path string, unknown map[string]bool) {
+	if schema == nil {
+		return
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			if prop, ok := schema.Properties[key]; ok {
+				if prop != nil {
+					collectUnknownFields(prop.Value, child, childPath, unknown)
+				}
+				continue
+			}
+			if extra := schema.AdditionalProperties.Schema; extra != nil {
+				collectUnknownFields(extra.Value, child, childPath, unknown)
+				continue
+			}
+			if len(schema.Properties) > 0 {
+				unknown[childPath] = true
+			}
+		}
+	case []interface{}:
+		if schema.Items == nil {
+			return
+		}
+		for _, item := range v {
+			collectUnknownFields(schema.Items.Value, item, path+"[]", unknown)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/logging"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger records warnings and info messages for assertions
+type recordingLogger struct {
+	mu       sync.Mutex
+	warnings []string
+	infos    []string
+}
+
+func (l *recordingLogger) Debug(msg string, args ...any) {}
+func (l *recordingLogger) Error(msg string, args ...any) {}
+
+func (l *recordingLogger) Info(msg string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.infos = append(l.infos, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func (l *recordingLogger) Warn(msg string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warnings = append(l.warnings, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func (l *recordingLogger) With(args ...any) logging.Logger                { return l }
+func (l *recordingLogger) WithContext(ctx context.Context) logging.Logger { return l }
+
+func (l *recordingLogger) Warnings() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.warnings...)
+}
+
+func (l *recordingLogger) Infos() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.infos...)
+}
+
+func TestClientFactory_StrictDecoding(t *testing.T) {
+	tests := []struct {
+		name         string
+		strict       bool
+		extraField   bool
+		expectWarned bool
+	}{
+		{name: "unknown field is reported", strict: true, extraField: true, expectWarned: true},
+		{name: "known fields only", strict: true, extraField: false},
+		{name: "strict decoding disabled", strict: false, extraField: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				pings := []map[string]interface{}{
+					{"hostname": "ctl1", "pinged": "UP"},
+					{"hostname": "ctl2", "pinged": "UP"},
+				}
+				if tt.extraField {
+					for _, ping := range pings {
+						ping["quantum_state"] = "entangled"
+					}
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"pings": pings,
+				})
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			logger := &recordingLogger{}
+
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			require.NoError(t, factory.WithLogger(logger))
+			require.NoError(t, factory.WithStrictDecoding(tt.strict))
+
+			client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+			require.NoError(t, err)
+			defer client.Close()
+
+			// Unknown fields never fail the request
+			require.NoError(t, client.Info().Ping(ctx))
+
+			warnings := logger.Warnings()
+			if !tt.expectWarned {
+				assert.Empty(t, warnings)
+				return
+			}
+			require.Len(t, warnings, 1)
+			// Reported once, however many list elements have the field
+			assert.Equal(t, 1, strings.Count(warnings[0], "pings[].quantum_state"))
+			assert.Contains(t, warnings[0], "/slurm/v0.0.44/ping/")
+		})
+	}
+}
+
+func TestMatchPathTemplate(t *testing.T) {
+	assert.Equal(t, 4, literalSegments("/slurm/v0.0.44/job/submit"))
+	assert.Equal(t, 3, literalSegments("/slurm/v0.0.44/job/{job_id}"))
+	assert.True(t, matchPathTemplate("/slurm/v0.0.44/job/{job_id}", "/slurm/v0.0.44/job/42"))
+	assert.True(t, matchPathTemplate("/slurm/v0.0.44/ping/", "/slurm/v0.0.44/ping/"))
+	assert.False(t, matchPathTemplate("/slurm/v0.0.44/job/{job_id}", "/slurm/v0.0.44/jobs/"))
+	assert.False(t, matchPathTemplate("/slurm/v0.0.44/job/{job_id}", "/slurm/v0.0.44/job/42/extra"))
+}
+
+func TestClientFactory_StrictDecoding_Submit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"job_id": 42, "step_id": "batch", "job_submit_user_msg": ""}`))
+	}))
+	defer server.Close()
+
+	ctx := helpers.TestContext(t)
+	logger := &recordingLogger{}
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithLogger(logger))
+	require.NoError(t, factory.WithStrictDecoding(true))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer client.Close()
+
+	// /job/{job_id} also matches /job/submit, and the map of paths is
+	// walked in random order, so submit often enough to hit both orders
+	for range 20 {
+		_, err := client.Jobs().Submit(ctx, &types.JobSubmission{Name: "train", Script: "#!/bin/bash\ntrue"})
+		require.NoError(t, err)
+	}
+	assert.Empty(t, logger.Warnings())
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/middleware"
+	"github.com/jontk/slurm-client/pkg/retry"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterJobManager_SubmitNotRetried(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "slurmctld unavailable", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	// User middleware sits below the retry middleware and sees every attempt
+	var submits, pings atomic.Int32
+	countAttempts := func(next http.RoundTripper) http.RoundTripper {
+		return middleware.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			switch {
+			case strings.HasSuffix(req.URL.Path, "/job/submit"):
+				submits.Add(1)
+			case strings.HasSuffix(req.URL.Path, "/ping/"):
+				pings.Add(1)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(
+		WithBaseURL(server.URL),
+		WithRetryPolicy(retry.NewFixedDelay(2, time.Millisecond)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, factory.WithMiddleware(countAttempts))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:   "once",
+		Script: "#!/bin/bash\ntrue",
+	})
+	require.Error(t, err)
+	assert.Equal(t, int32(1), submits.Load(), "a failed submit must not be retried")
+
+	_, err = client.Jobs().SubmitRaw(ctx, &types.JobCreate{Script: ptrString("#!/bin/bash\ntrue")})
+	require.Error(t, err)
+	assert.Equal(t, int32(2), submits.Load())
+
+	// Other requests keep the client's policy unless the context opts out
+	require.Error(t, client.Info().Ping(ctx))
+	assert.Equal(t, int32(3), pings.Load())
+
+	require.Error(t, client.Info().Ping(retry.WithNoRetry(ctx)))
+	assert.Equal(t, int32(4), pings.Load())
+}
+
+func TestAdapterJobManager_SubmitRetriedWhenIdempotent(t *testing.T) {
+	// Every other submission fails, so each succeeds on its retry
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if received.Add(1)%2 == 1 {
+			http.Error(w, "slurmctld unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"job_id": 42}`))
+	}))
+	defer server.Close()
+
+	var submits atomic.Int32
+	countAttempts := func(next http.RoundTripper) http.RoundTripper {
+		return middleware.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/job/submit") {
+				submits.Add(1)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(
+		WithBaseURL(server.URL),
+		WithRetryPolicy(retry.NewFixedDelay(2, time.Millisecond)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, factory.WithMiddleware(countAttempts))
+	require.NoError(t, factory.WithIdempotentSubmit(true))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer client.Close()
+
+	resp, err := client.Jobs().Submit(ctx, &types.JobSubmission{
+		Name:   "twice",
+		Script: "#!/bin/bash\ntrue",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.JobId)
+	assert.Equal(t, int32(2), submits.Load(), "the failed submit is retried")
+
+	resp, err = client.Jobs().SubmitRaw(ctx, &types.JobCreate{Script: ptrString("#!/bin/bash\ntrue")})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.JobId)
+	assert.Equal(t, int32(4), submits.Load())
+
+	// WithNoRetry on the context still sends a single attempt
+	_, err = client.Jobs().SubmitRaw(retry.WithNoRetry(ctx), &types.JobCreate{Script: ptrString("#!/bin/bash\ntrue")})
+	require.Error(t, err)
+	assert.Equal(t, int32(5), submits.Load())
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// tresCache holds the TRES table of the cluster once it has been read.
+// TRES are only ever added by an administrator, so the table is kept for
+// the life of the client.
+type tresCache struct {
+	mu   sync.Mutex
+	list []types.TRES
+}
+
+// TRESList returns the TRES defined on the cluster. The first call reads
+// them from slurmdbd; later calls answer from the cache. A failed read is
+// not cached.
+func (m *adapterInfoManager) TRESList(ctx context.Context) ([]types.TRES, error) {
+	if m.standalone == nil {
+		return nil, errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation,
+			fmt.Sprintf("TRES list not supported for version %s", m.version))
+	}
+
+	// Holding the lock while reading makes concurrent first calls share
+	// one request
+	m.tres.mu.Lock()
+	defer m.tres.mu.Unlock()
+	if m.tres.list == nil {
+		result, err := m.standalone.GetTRES(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list := make([]types.TRES, 0)
+		if result != nil {
+			list = append(list, result.TRES...)
+		}
+		m.tres.list = list
+	}
+	return append([]types.TRES(nil), m.tres.list...), nil
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterInfoManager_TRESList(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/slurmdb/v0.0.44/tres") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"TRES": [
+			{"id": 1, "type": "cpu", "name": ""},
+			{"id": 2, "type": "mem", "name": ""},
+			{"id": 1001, "type": "gres", "name": "gpu"}
+		], "errors": [], "warnings": []}`))
+	}))
+	defer server.Close()
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+
+	tres, err := client.Info().TRESList(ctx)
+	require.NoError(t, err)
+	require.Len(t, tres, 3)
+	assert.Equal(t, int32(1), *tres[0].ID)
+	assert.Equal(t, "cpu", tres[0].Type)
+	assert.Equal(t, int32(1001), *tres[2].ID)
+	assert.Equal(t, "gres", tres[2].Type)
+	assert.Equal(t, "gpu", *tres[2].Name)
+
+	// A later call, through another Info manager of the same client, is
+	// answered from the cache
+	again, err := client.Info().TRESList(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, tres, again)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
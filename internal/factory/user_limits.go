@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// EffectiveLimits works out the limits that apply to a user along each path
+// a job can take: one of their associations and a QoS it allows, or the QoS
+// its account allows when the association names none. Along a path the
+// association, the account-level associations of its account and every
+// parent account, the QoS and the association's partition all apply, and the
+// tightest limit per resource counts; both the Max and Grp forms of a limit
+// count, as a job has to fit within each. The paths are alternatives, so the
+// overall limit on a resource is the loosest across them.
+func (m *adapterUserManager) EffectiveLimits(ctx context.Context, userName string) (*types.UserEffectiveLimits, error) {
+	if userName == "" {
+		return nil, fmt.Errorf("user name required")
+	}
+
+	associations, err := getAssociationsForUser(ctx, m.associationAdapter, userName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get associations: %w", err)
+	}
+
+	limits := &types.UserEffectiveLimits{UserName: userName}
+	accountChains := make(map[string][]types.Association)
+	qosByName := make(map[string]*types.QoS)
+	partitions := make(map[string]*types.Partition)
+	for i := range associations {
+		assoc := &associations[i]
+		if assoc.User != userName {
+			continue
+		}
+		account := derefString(assoc.Account)
+		chain, ok := accountChains[account]
+		if !ok {
+			if chain, err = m.accountChain(ctx, account); err != nil {
+				return nil, fmt.Errorf("failed to get account associations: %w", err)
+			}
+			accountChains[account] = chain
+		}
+
+		partitionName := derefString(assoc.Partition)
+		var partition *types.Partition
+		if partitionName != "" {
+			if partition, ok = partitions[partitionName]; !ok {
+				if partition, err = m.partitionAdapter.Get(ctx, partitionName); err != nil {
+					return nil, fmt.Errorf("failed to get partition %s: %w", partitionName, err)
+				}
+				partitions[partitionName] = partition
+			}
+		}
+
+		qosList := assoc.QoS
+		if len(qosList) == 0 {
+			if qosList, err = getAccountAllowedQoS(ctx, m.associationAdapter, account); err != nil {
+				return nil, fmt.Errorf("failed to get account associations: %w", err)
+			}
+		}
+		if len(qosList) == 0 {
+			// A path without a QoS
+			qosList = []string{""}
+		}
+
+		for _, qosName := range qosList {
+			path := types.UserLimitPath{Account: account, Partition: partitionName, QoS: qosName}
+			applyAssociationLimits(&path.EffectiveLimitSet, assoc, types.LimitSourceAssociation, account)
+			for j := range chain {
+				applyAssociationLimits(&path.EffectiveLimitSet, &chain[j], types.LimitSourceAccount, derefString(chain[j].Account))
+			}
+			if qosName != "" {
+				qos, ok := qosByName[qosName]
+				if !ok {
+					if qos, err = m.qosAdapter.Get(ctx, qosName); err != nil {
+						return nil, fmt.Errorf("failed to get QoS %s: %w", qosName, err)
+					}
+					qosByName[qosName] = qos
+				}
+				applyQoSLimits(&path.EffectiveLimitSet, qos, qosName)
+			}
+			if partition != nil {
+				applyPartitionLimits(&path.EffectiveLimitSet, partition, partitionName)
+			}
+			limits.Paths = append(limits.Paths, path)
+		}
+	}
+	if len(limits.Paths) == 0 {
+		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, fmt.Sprintf("no associations found for user %s", userName))
+	}
+
+	limits.EffectiveLimitSet = loosestLimits(limits.Paths)
+	return limits, nil
+}
+
+// accountChain returns the account-level associations of account and every
+// account above it, nearest first
+func (m *adapterUserManager) accountChain(ctx context.Context, account string) ([]types.Association, error) {
+	var chain []types.Association
+	seen := make(map[string]bool)
+	for account != "" && !seen[account] {
+		seen[account] = true
+		accountAssociations, err := getAssociationsForAccount(ctx, m.associationAdapter, account)
+		if err != nil {
+			return nil, err
+		}
+		parent := ""
+		for i := range accountAssociations {
+			assoc := accountAssociations[i]
+			if assoc.User != "" || derefString(assoc.Account) != account {
+				continue
+			}
+			chain = append(chain, assoc)
+			parent = derefString(assoc.ParentAccount)
+		}
+		account = parent
+	}
+	return chain, nil
+}
+
+// loosestLimits returns the highest limit on each resource across paths. A
+// resource without a limit on some path has none overall.
+func loosestLimits(paths []types.UserLimitPath) types.EffectiveLimitSet {
+	loosest := paths[0].EffectiveLimitSet
+	loosest.MaxTRESPerJob = maps.Clone(loosest.MaxTRESPerJob)
+	loosest.MaxTRESPerNode = maps.Clone(loosest.MaxTRESPerNode)
+	for _, path := range paths[1:] {
+		loosenLimit(&loosest.MaxJobs, path.MaxJobs)
+		loosenLimit(&loosest.MaxSubmitJobs, path.MaxSubmitJobs)
+		loosenLimit(&loosest.MaxWallMinutes, path.MaxWallMinutes)
+		loosenLimit(&loosest.MaxNodesPerJob, path.MaxNodesPerJob)
+		loosenTRESLimits(&loosest.MaxTRESPerJob, path.MaxTRESPerJob)
+		loosenTRESLimits(&loosest.MaxTRESPerNode, path.MaxTRESPerNode)
+	}
+	return loosest
+}
+
+// loosenLimit replaces *limit with other when other is higher, and clears
+// it when other is not set
+func loosenLimit(limit **types.EffectiveLimit, other *types.EffectiveLimit) {
+	if *limit == nil {
+		return
+	}
+	if other == nil {
+		*limit = nil
+	} else if other.Value > (*limit).Value {
+		*limit = other
+	}
+}
+
+// loosenTRESLimits loosens the limit of each TRES in limits with other
+func loosenTRESLimits(limits *map[string]*types.EffectiveLimit, other map[string]*types.EffectiveLimit) {
+	for key, limit := range *limits {
+		loosenLimit(&limit, other[key])
+		if limit == nil {
+			delete(*limits, key)
+		} else {
+			(*limits)[key] = limit
+		}
+	}
+	if len(*limits) == 0 {
+		*limits = nil
+	}
+}
+
+// applyAssociationLimits tightens limits with those of a user or account
+// association
+func applyAssociationLimits(limits *types.EffectiveLimitSet, assoc *types.Association, source, name string) {
+	if assoc.Max == nil {
+		return
+	}
+	if jobs := assoc.Max.Jobs; jobs != nil {
+		tightenLimit(&limits.MaxJobs, jobs.Active, source, name)
+		tightenLimit(&limits.MaxSubmitJobs, jobs.Total, source, name)
+		if jobs.Per != nil {
+			tightenLimit(&limits.MaxJobs, jobs.Per.Count, source, name)
+			tightenLimit(&limits.MaxSubmitJobs, jobs.Per.Submitted, source, name)
+			tightenLimit(&limits.MaxWallMinutes, jobs.Per.WallClock, source, name)
+		}
+	}
+	if tres := assoc.Max.TRES; tres != nil {
+		tightenTRESLimits(&limits.MaxTRESPerJob, tres.Total, source, name)
+		if tres.Per != nil {
+			tightenTRESLimits(&limits.MaxTRESPerJob, tres.Per.Job, source, name)
+			tightenTRESLimits(&limits.MaxTRESPerNode, tres.Per.Node, source, name)
+		}
+	}
+}
+
+// applyQoSLimits tightens limits with the per-user, per-job and group limits
+// of a QoS
+func applyQoSLimits(limits *types.EffectiveLimitSet, qos *types.QoS, name string) {
+	if qos == nil || qos.Limits == nil || qos.Limits.Max == nil {
+		return
+	}
+	qosMax := qos.Limits.Max
+	source := types.LimitSourceQoS
+	if qosMax.ActiveJobs != nil {
+		tightenLimit(&limits.MaxJobs, qosMax.ActiveJobs.Count, source, name)
+	}
+	if jobs := qosMax.Jobs; jobs != nil {
+		tightenLimit(&limits.MaxSubmitJobs, jobs.Count, source, name)
+		if jobs.ActiveJobs != nil && jobs.ActiveJobs.Per != nil {
+			tightenLimit(&limits.MaxJobs, jobs.ActiveJobs.Per.User, source, name)
+		}
+		if jobs.Per != nil {
+			tightenLimit(&limits.MaxSubmitJobs, jobs.Per.User, source, name)
+		}
+	}
+	if qosMax.WallClock != nil && qosMax.WallClock.Per != nil {
+		tightenLimit(&limits.MaxWallMinutes, qosMax.WallClock.Per.Job, source, name)
+	}
+	if tres := qosMax.TRES; tres != nil {
+		tightenTRESLimits(&limits.MaxTRESPerJob, tres.Total, source, name)
+		if tres.Per != nil {
+			tightenTRESLimits(&limits.MaxTRESPerJob, tres.Per.Job, source, name)
+			tightenTRESLimits(&limits.MaxTRESPerJob, tres.Per.User, source, name)
+			tightenTRESLimits(&limits.MaxTRESPerNode, tres.Per.Node, source, name)
+		}
+	}
+}
+
+// applyPartitionLimits tightens limits with a partition's maximums
+func applyPartitionLimits(limits *types.EffectiveLimitSet, partition *types.Partition, name string) {
+	if partition == nil || partition.Maximums == nil {
+		return
+	}
+	maximums := partition.Maximums
+	source := types.LimitSourcePartition
+	if !types.IsUnlimitedTimeLimit(maximums.Time) {
+		tightenLimit(&limits.MaxWallMinutes, maximums.Time, source, name)
+	}
+	tightenLimit(&limits.MaxNodesPerJob, maximums.Nodes, source, name)
+	if maximums.CPUsPerNode != nil {
+		tightenTRESLimit(&limits.MaxTRESPerNode, "cpu", int64(*maximums.CPUsPerNode), source, name)
+	}
+	if maximums.PartitionMemoryPerNode != nil {
+		tightenTRESLimit(&limits.MaxTRESPerNode, "mem", int64(*maximums.PartitionMemoryPerNode), source, name)
+	}
+}
+
+// tightenLimit replaces *limit with value when value is set and lower
+func tightenLimit(limit **types.EffectiveLimit, value *uint32, source, name string) {
+	if value == nil {
+		return
+	}
+	if *limit == nil || int64(*value) < (*limit).Value {
+		*limit = &types.EffectiveLimit{Value: int64(*value), Source: source, Name: name}
+	}
+}
+
+// tightenTRESLimits tightens the limit of each TRES in the list
+func tightenTRESLimits(limits *map[string]*types.EffectiveLimit, tres []types.TRES, source, name string) {
+	for _, t := range tres {
+		if t.Count == nil {
+			continue
+		}
+		key := t.Type
+		if t.Name != nil && *t.Name != "" {
+			key += "/" + *t.Name
+		}
+		tightenTRESLimit(limits, key, *t.Count, source, name)
+	}
+}
+
+func tightenTRESLimit(limits *map[string]*types.EffectiveLimit, key string, value int64, source, name string) {
+	if *limits == nil {
+		*limits = make(map[string]*types.EffectiveLimit)
+	}
+	if current, ok := (*limits)[key]; !ok || value < current.Value {
+		(*limits)[key] = &types.EffectiveLimit{Value: value, Source: source, Name: name}
+	}
+}
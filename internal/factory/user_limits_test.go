@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/internal/adapters/common"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedQoSAdapter serves a fixed set of QoS
+type fixedQoSAdapter struct {
+	common.QoSAdapter
+	qos map[string]*types.QoS
+}
+
+func (m *fixedQoSAdapter) Get(ctx context.Context, qosName string) (*types.QoS, error) {
+	if qos, ok := m.qos[qosName]; ok {
+		return qos, nil
+	}
+	return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound, "qos not found")
+}
+
+func tresCount(tresType, name string, count int64) types.TRES {
+	return types.TRES{Type: tresType, Name: ptrString(name), Count: &count}
+}
+
+func TestAdapterUserManager_EffectiveLimits(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	associations := []types.Association{
+		// root caps the jobs of everything below it and the wall time
+		{Account: ptrString("root"), Max: &types.AssociationMax{
+			Jobs: &types.AssociationMaxJobs{Per: &types.AssociationMaxJobsPer{
+				Count:     ptrUint32(500),
+				WallClock: ptrUint32(10080),
+			}},
+		}},
+		// physics allows more running jobs than the user association, and
+		// more CPUs in total than any one job may use
+		{Account: ptrString("physics"), ParentAccount: ptrString("root"), QoS: []string{"normal"}, Max: &types.AssociationMax{
+			Jobs: &types.AssociationMaxJobs{Active: ptrUint32(100)},
+			TRES: &types.AssociationMaxTRES{Total: []types.TRES{tresCount("cpu", "", 1000)}},
+		}},
+		{User: "alice", Account: ptrString("physics"), Partition: ptrString("gpu"), Max: &types.AssociationMax{
+			Jobs: &types.AssociationMaxJobs{
+				Active: ptrUint32(50),
+				Total:  ptrUint32(200),
+				Per:    &types.AssociationMaxJobsPer{WallClock: ptrUint32(2880)},
+			},
+			TRES: &types.AssociationMaxTRES{Per: &types.AssociationMaxTRESPer{
+				Job: []types.TRES{tresCount("cpu", "", 256), tresCount("gres", "gpu", 8)},
+			}},
+		}},
+		// A second association, on all partitions, with a lower submit limit
+		{User: "alice", Account: ptrString("physics"), Max: &types.AssociationMax{
+			Jobs: &types.AssociationMaxJobs{Total: ptrUint32(150)},
+		}},
+	}
+
+	normal := &types.QoS{Name: ptrString("normal"), Limits: &types.QoSLimits{Max: &types.QoSLimitsMax{
+		Jobs: &types.QoSLimitsMaxJobs{ActiveJobs: &types.QoSLimitsMaxJobsActiveJobs{
+			Per: &types.QoSLimitsMaxJobsActiveJobsPer{User: ptrUint32(20)},
+		}},
+		WallClock: &types.QoSLimitsMaxWallClock{Per: &types.QoSLimitsMaxWallClockPer{Job: ptrUint32(1440)}},
+		TRES:      &types.QoSLimitsMaxTRES{Per: &types.QoSLimitsMaxTRESPer{Job: []types.TRES{tresCount("gres", "gpu", 4)}}},
+	}}}
+	gpu := &types.Partition{Name: ptrString("gpu"), Maximums: &types.PartitionMaximums{
+		Time:        ptrUint32(720),
+		Nodes:       ptrUint32(4),
+		CPUsPerNode: ptrUint32(64),
+	}}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:            "v0.0.44",
+			associationAdapter: &mockAssociationAdapter{listFunc: newAssociationListFunc(associations)},
+			qosAdapter:         &fixedQoSAdapter{qos: map[string]*types.QoS{"normal": normal}},
+			partitionAdapter:   &qosPartitionAdapter{partitions: map[string]*types.Partition{"gpu": gpu}},
+		},
+	}
+
+	limits, err := client.Users().EffectiveLimits(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", limits.UserName)
+
+	// Along the gpu association every level applies
+	require.Len(t, limits.Paths, 2)
+	gpuPath := limits.Paths[0]
+	assert.Equal(t, "physics", gpuPath.Account)
+	assert.Equal(t, "gpu", gpuPath.Partition)
+	assert.Equal(t, "normal", gpuPath.QoS)
+	assert.Equal(t, &types.EffectiveLimit{Value: 20, Source: types.LimitSourceQoS, Name: "normal"}, gpuPath.MaxJobs)
+	assert.Equal(t, &types.EffectiveLimit{Value: 200, Source: types.LimitSourceAssociation, Name: "physics"}, gpuPath.MaxSubmitJobs)
+	assert.Equal(t, &types.EffectiveLimit{Value: 720, Source: types.LimitSourcePartition, Name: "gpu"}, gpuPath.MaxWallMinutes)
+	assert.Equal(t, &types.EffectiveLimit{Value: 4, Source: types.LimitSourcePartition, Name: "gpu"}, gpuPath.MaxNodesPerJob)
+	assert.Equal(t, map[string]*types.EffectiveLimit{
+		"cpu":      {Value: 256, Source: types.LimitSourceAssociation, Name: "physics"},
+		"gres/gpu": {Value: 4, Source: types.LimitSourceQoS, Name: "normal"},
+	}, gpuPath.MaxTRESPerJob)
+	assert.Equal(t, map[string]*types.EffectiveLimit{
+		"cpu": {Value: 64, Source: types.LimitSourcePartition, Name: "gpu"},
+	}, gpuPath.MaxTRESPerNode)
+
+	otherPath := limits.Paths[1]
+	assert.Empty(t, otherPath.Partition)
+	assert.Equal(t, &types.EffectiveLimit{Value: 150, Source: types.LimitSourceAssociation, Name: "physics"}, otherPath.MaxSubmitJobs)
+	assert.Equal(t, &types.EffectiveLimit{Value: 1440, Source: types.LimitSourceQoS, Name: "normal"}, otherPath.MaxWallMinutes)
+
+	// The associations are alternatives, so overall the looser limit counts,
+	// and a limit missing on one of them is no limit
+	assert.Equal(t, &types.EffectiveLimit{Value: 20, Source: types.LimitSourceQoS, Name: "normal"}, limits.MaxJobs)
+	assert.Equal(t, &types.EffectiveLimit{Value: 200, Source: types.LimitSourceAssociation, Name: "physics"}, limits.MaxSubmitJobs)
+	assert.Equal(t, &types.EffectiveLimit{Value: 1440, Source: types.LimitSourceQoS, Name: "normal"}, limits.MaxWallMinutes)
+	assert.Nil(t, limits.MaxNodesPerJob)
+	assert.Equal(t, map[string]*types.EffectiveLimit{
+		"cpu":      {Value: 1000, Source: types.LimitSourceAccount, Name: "physics"},
+		"gres/gpu": {Value: 4, Source: types.LimitSourceQoS, Name: "normal"},
+	}, limits.MaxTRESPerJob)
+	assert.Nil(t, limits.MaxTRESPerNode)
+}
+
+func TestAdapterUserManager_EffectiveLimits_QoSAlternatives(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	// carol may submit under either QoS, so the longer wall time is the
+	// limit she can reach
+	associations := []types.Association{
+		{Account: ptrString("bio")},
+		{User: "carol", Account: ptrString("bio"), QoS: []string{"short", "long"}},
+	}
+	short := &types.QoS{Name: ptrString("short"), Limits: &types.QoSLimits{Max: &types.QoSLimitsMax{
+		WallClock: &types.QoSLimitsMaxWallClock{Per: &types.QoSLimitsMaxWallClockPer{Job: ptrUint32(60)}},
+	}}}
+	long := &types.QoS{Name: ptrString("long"), Limits: &types.QoSLimits{Max: &types.QoSLimitsMax{
+		WallClock: &types.QoSLimitsMaxWallClock{Per: &types.QoSLimitsMaxWallClockPer{Job: ptrUint32(4320)}},
+	}}}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:            "v0.0.44",
+			associationAdapter: &mockAssociationAdapter{listFunc: newAssociationListFunc(associations)},
+			qosAdapter:         &fixedQoSAdapter{qos: map[string]*types.QoS{"short": short, "long": long}},
+		},
+	}
+
+	limits, err := client.Users().EffectiveLimits(ctx, "carol")
+	require.NoError(t, err)
+	require.Len(t, limits.Paths, 2)
+	assert.Equal(t, &types.EffectiveLimit{Value: 60, Source: types.LimitSourceQoS, Name: "short"}, limits.Paths[0].MaxWallMinutes)
+	assert.Equal(t, &types.EffectiveLimit{Value: 4320, Source: types.LimitSourceQoS, Name: "long"}, limits.MaxWallMinutes)
+}
+
+func TestAdapterUserManager_EffectiveLimits_AccountLevel(t *testing.T) {
+	ctx := helpers.TestContext(t)
+
+	// Without limits of its own or a QoS, bob is bound by his accounts
+	associations := []types.Association{
+		{Account: ptrString("root"), Max: &types.AssociationMax{
+			Jobs: &types.AssociationMaxJobs{Per: &types.AssociationMaxJobsPer{WallClock: ptrUint32(10080)}},
+		}},
+		{Account: ptrString("chem"), ParentAccount: ptrString("root"), Max: &types.AssociationMax{
+			Jobs: &types.AssociationMaxJobs{Per: &types.AssociationMaxJobsPer{WallClock: ptrUint32(4320)}},
+		}},
+		{User: "bob", Account: ptrString("chem")},
+	}
+
+	client := &AdapterClient{
+		adapter: &testVersionAdapter{
+			version:            "v0.0.44",
+			associationAdapter: &mockAssociationAdapter{listFunc: newAssociationListFunc(associations)},
+		},
+	}
+
+	limits, err := client.Users().EffectiveLimits(ctx, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, &types.EffectiveLimit{Value: 4320, Source: types.LimitSourceAccount, Name: "chem"}, limits.MaxWallMinutes)
+	assert.Nil(t, limits.MaxJobs)
+	assert.Nil(t, limits.MaxTRESPerJob)
+
+	_, err = client.Users().EffectiveLimits(ctx, "mallory")
+	assert.Equal(t, errors.ErrorCodeResourceNotFound, errors.GetErrorCode(err))
+
+	_, err = client.Users().EffectiveLimits(ctx, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jontk/slurm-client/pkg/cli"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdapterClient_Versions checks that the slurmrestd version is read from
+// the meta block of the ping answer
+func TestAdapterClient_Versions(t *testing.T) {
+	const meta = `"meta": {"plugin": {"type": "openapi/slurmctld", "name": "Slurm OpenAPI slurmctld",
+		"data_parser": "data_parser/%s", "accounting_storage": "accounting_storage/slurmdbd"},
+		"slurm": {"version": {"major": "%s", "minor": "%s", "micro": "%s"}, "release": "%s", "cluster": "hpc"}}`
+
+	for _, version := range []string{"v0.0.40", "v0.0.41", "v0.0.42", "v0.0.43", "v0.0.44"} {
+		t.Run(version, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/slurm/"+version+"/ping/"):
+					// No release, so it is built from the version parts
+					fmt.Fprintf(w, `{"pings": [{"hostname": "ctld", "pinged": "UP"}], `+meta+`}`,
+						version, "24", "05", "4", "")
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			ctx := helpers.TestContext(t)
+			factory, err := NewClientFactory(WithBaseURL(server.URL))
+			require.NoError(t, err)
+			client, err := factory.NewClientWithVersion(ctx, version)
+			require.NoError(t, err)
+
+			info, err := client.Versions(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, "24.05.4", info.RestdVersion)
+			// Without the CLI fallback the controller's release is unknown
+			assert.Empty(t, info.ControllerVersion)
+			assert.Equal(t, "data_parser/"+version, info.PluginVersion)
+			assert.Equal(t, version, info.ClientVersion)
+		})
+	}
+}
+
+func TestAdapterClient_Versions_CLIFallback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake scontrol is a shell script")
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"pings": [], "meta": {"slurm": {"release": "24.11.1"}}}`))
+	}))
+	defer server.Close()
+
+	// A fake scontrol on PATH prints the controller's configuration
+	dir := t.TempDir()
+	script := "#!/bin/sh\nprintf 'Configuration data as of 2025-01-01T00:00:00\\nSLURM_CONF              = /etc/slurm/slurm.conf\\nSLURM_VERSION           = 24.05.4\\n'\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "scontrol"), []byte(script), 0o755)) // #nosec G306 -- test executable
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	ctx := helpers.TestContext(t)
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	client.(*AdapterClient).SetCLIFallback(cli.NewRunner(cli.Config{}))
+
+	// slurmrestd and slurmctld may run different releases
+	info, err := client.Versions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "24.11.1", info.RestdVersion)
+	assert.Equal(t, "24.05.4", info.ControllerVersion)
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+package factory
+
+import (
+	"context"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/pkg/pool"
+)
+
+// Warmup opens n connections to the cluster through the client's own HTTP
+// client, so they sit idle in its transport until the first requests reuse
+// them. With a connection pool, n is capped at the idle connections the
+// pool keeps per host and the result shows in the pool's Stats; otherwise
+// the transport's own idle limit applies, which for Go's default transport
+// is two per host.
+func (c *AdapterClient) Warmup(ctx context.Context, n int) error {
+	if n < 1 {
+		return errors.NewValidationErrorf("n", n, "warm-up connection count must be at least 1")
+	}
+	if c.pool != nil {
+		return c.pool.Warmup(ctx, c.baseURL, n)
+	}
+	if c.httpClient == nil || c.baseURL == "" {
+		return errors.NewSlurmError(errors.ErrorCodeUnsupportedOperation, "client was not created with an HTTP client to warm up")
+	}
+	return pool.Warmup(ctx, c.httpClient, c.baseURL, n)
+}
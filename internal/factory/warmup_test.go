@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package factory
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/pkg/pool"
+	"github.com/jontk/slurm-client/tests/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapterClient_Warmup(t *testing.T) {
+	var accepted atomic.Int32
+	server := httptest.NewUnstartedServer(http.NotFoundHandler())
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			accepted.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+	ctx := helpers.TestContext(t)
+
+	factory, err := NewClientFactory(WithBaseURL(server.URL))
+	require.NoError(t, err)
+	require.NoError(t, factory.WithConnectionPool(pool.DefaultPoolConfig()))
+	client, err := factory.NewClientWithVersion(ctx, "v0.0.44")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	require.NoError(t, client.Warmup(ctx, 3))
+	assert.Equal(t, int32(3), accepted.Load())
+	stats := client.(*AdapterClient).pool.Stats()
+	assert.Equal(t, int32(3), stats.ClientStats[server.URL].OpenConns)
+
+	// The first requests go out on the warm connections
+	for i := 0; i < 3; i++ {
+		_, _ = client.Jobs().List(ctx, nil)
+	}
+	assert.Equal(t, int32(3), accepted.Load())
+
+	err = client.Warmup(ctx, 0)
+	require.Error(t, err)
+	assert.True(t, errors.IsValidationError(err))
+}
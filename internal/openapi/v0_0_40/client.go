@@ -679,7 +679,7 @@ type V0040Job struct {
 	AllocationNodes *int32 `json:"allocation_nodes,omitempty"`
 	Array           *struct {
 		// JobId Job ID of job array, or 0 if N/A
-		JobId  *int32 `json:"job_id,omitempty"`
+		JobId  *int64 `json:"job_id,omitempty"`
 		Limits *struct {
 			Max *struct {
 				Running *struct {
@@ -732,7 +732,7 @@ type V0040Job struct {
 	Group *string `json:"group,omitempty"`
 	Het   *struct {
 		// JobId Heterogeneous job ID, if applicable
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// JobOffset Integer number with flags
 		JobOffset *V0040Uint32NoVal `json:"job_offset,omitempty"`
@@ -742,7 +742,7 @@ type V0040Job struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillRequestUser User ID that requested termination of the job
 	KillRequestUser *string `json:"kill_request_user,omitempty"`
@@ -869,7 +869,7 @@ type V0040JobArrayResponseMsgEntry struct {
 	ErrorCode *int32 `json:"error_code,omitempty"`
 
 	// JobId Job ID for updated Job
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// StepId Step ID for updated Job
 	StepId *string `json:"step_id,omitempty"`
@@ -990,7 +990,7 @@ type V0040JobDescMsg struct {
 	Immediate *bool `json:"immediate,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillOnNodeFail If true, kill job on node failure
 	KillOnNodeFail *bool `json:"kill_on_node_fail,omitempty"`
@@ -1391,7 +1391,7 @@ type V0040JobInfo struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId        *int32          `json:"job_id,omitempty"`
+	JobId        *int64          `json:"job_id,omitempty"`
 	JobResources *V0040JobRes    `json:"job_resources,omitempty"`
 	JobSizeStr   *V0040CsvString `json:"job_size_str,omitempty"`
 	JobState     *V0040JobState  `json:"job_state,omitempty"`
@@ -1662,7 +1662,7 @@ type V0040JobSubmitResponseMsg struct {
 	ErrorCode *int32 `json:"error_code,omitempty"`
 
 	// JobId New job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg Message to user from job_submit plugin
 	JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -2075,7 +2075,7 @@ type V0040OpenapiJobSubmitResponse struct {
 	Errors *V0040OpenapiErrors `json:"errors,omitempty"`
 
 	// JobId Submitted Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg job submission user message
 	JobSubmitUserMsg *string                    `json:"job_submit_user_msg,omitempty"`
@@ -4458,7 +4458,9 @@ func (c *Client) SlurmV0040PostJobSubmitWithBody(ctx context.Context, contentTyp
 }
 
 func (c *Client) SlurmV0040PostJobSubmit(ctx context.Context, body SlurmV0040PostJobSubmitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0040PostJobSubmitRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0040PostJobSubmitRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4506,7 +4508,9 @@ func (c *Client) SlurmV0040PostJobWithBody(ctx context.Context, jobId string, co
 }
 
 func (c *Client) SlurmV0040PostJob(ctx context.Context, jobId string, body SlurmV0040PostJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0040PostJobRequest(c.Server, jobId, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0040PostJobRequestWithBody(c.Server, jobId, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4530,7 +4534,9 @@ func (c *Client) SlurmV0040DeleteJobsWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmV0040DeleteJobs(ctx context.Context, body SlurmV0040DeleteJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0040DeleteJobsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0040DeleteJobsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4614,7 +4620,9 @@ func (c *Client) SlurmV0040PostNodeWithBody(ctx context.Context, nodeName string
 }
 
 func (c *Client) SlurmV0040PostNode(ctx context.Context, nodeName string, body SlurmV0040PostNodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0040PostNodeRequest(c.Server, nodeName, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0040PostNodeRequestWithBody(c.Server, nodeName, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4650,7 +4658,9 @@ func (c *Client) SlurmV0040PostNodesWithBody(ctx context.Context, contentType st
 }
 
 func (c *Client) SlurmV0040PostNodes(ctx context.Context, body SlurmV0040PostNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0040PostNodesRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0040PostNodesRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4806,7 +4816,9 @@ func (c *Client) SlurmdbV0040PostAccountsWithBody(ctx context.Context, contentTy
 }
 
 func (c *Client) SlurmdbV0040PostAccounts(ctx context.Context, body SlurmdbV0040PostAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostAccountsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostAccountsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4830,7 +4842,9 @@ func (c *Client) SlurmdbV0040PostAccountsAssociationWithBody(ctx context.Context
 }
 
 func (c *Client) SlurmdbV0040PostAccountsAssociation(ctx context.Context, body SlurmdbV0040PostAccountsAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostAccountsAssociationRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostAccountsAssociationRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4902,7 +4916,9 @@ func (c *Client) SlurmdbV0040PostAssociationsWithBody(ctx context.Context, conte
 }
 
 func (c *Client) SlurmdbV0040PostAssociations(ctx context.Context, body SlurmdbV0040PostAssociationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostAssociationsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostAssociationsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4962,7 +4978,9 @@ func (c *Client) SlurmdbV0040PostClustersWithBody(ctx context.Context, params *S
 }
 
 func (c *Client) SlurmdbV0040PostClusters(ctx context.Context, params *SlurmdbV0040PostClustersParams, body SlurmdbV0040PostClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostClustersRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostClustersRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4998,7 +5016,9 @@ func (c *Client) SlurmdbV0040PostConfigWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0040PostConfig(ctx context.Context, body SlurmdbV0040PostConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostConfigRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostConfigRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5094,7 +5114,9 @@ func (c *Client) SlurmdbV0040PostQosWithBody(ctx context.Context, params *Slurmd
 }
 
 func (c *Client) SlurmdbV0040PostQos(ctx context.Context, params *SlurmdbV0040PostQosParams, body SlurmdbV0040PostQosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostQosRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostQosRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5154,7 +5176,9 @@ func (c *Client) SlurmdbV0040PostTresWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmdbV0040PostTres(ctx context.Context, body SlurmdbV0040PostTresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostTresRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostTresRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5214,7 +5238,9 @@ func (c *Client) SlurmdbV0040PostUsersWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0040PostUsers(ctx context.Context, body SlurmdbV0040PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostUsersRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostUsersRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5238,7 +5264,9 @@ func (c *Client) SlurmdbV0040PostUsersAssociationWithBody(ctx context.Context, p
 }
 
 func (c *Client) SlurmdbV0040PostUsersAssociation(ctx context.Context, params *SlurmdbV0040PostUsersAssociationParams, body SlurmdbV0040PostUsersAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostUsersAssociationRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostUsersAssociationRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5298,7 +5326,9 @@ func (c *Client) SlurmdbV0040PostWckeysWithBody(ctx context.Context, params *Slu
 }
 
 func (c *Client) SlurmdbV0040PostWckeys(ctx context.Context, params *SlurmdbV0040PostWckeysParams, body SlurmdbV0040PostWckeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0040PostWckeysRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0040PostWckeysRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -13194,14 +13224,14 @@ func ParseSlurmV0040GetDiagResponse(rsp *http.Response) (*SlurmV0040GetDiagRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13227,14 +13257,14 @@ func ParseSlurmV0040PostJobSubmitResponse(rsp *http.Response) (*SlurmV0040PostJo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13260,14 +13290,14 @@ func ParseSlurmV0040DeleteJobResponse(rsp *http.Response) (*SlurmV0040DeleteJobR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13293,14 +13323,14 @@ func ParseSlurmV0040GetJobResponse(rsp *http.Response) (*SlurmV0040GetJobRespons
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13326,14 +13356,14 @@ func ParseSlurmV0040PostJobResponse(rsp *http.Response) (*SlurmV0040PostJobRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13359,14 +13389,14 @@ func ParseSlurmV0040DeleteJobsResponse(rsp *http.Response) (*SlurmV0040DeleteJob
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13392,14 +13422,14 @@ func ParseSlurmV0040GetJobsResponse(rsp *http.Response) (*SlurmV0040GetJobsRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13425,14 +13455,14 @@ func ParseSlurmV0040GetJobsStateResponse(rsp *http.Response) (*SlurmV0040GetJobs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13458,14 +13488,14 @@ func ParseSlurmV0040GetLicensesResponse(rsp *http.Response) (*SlurmV0040GetLicen
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13491,14 +13521,14 @@ func ParseSlurmV0040DeleteNodeResponse(rsp *http.Response) (*SlurmV0040DeleteNod
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13524,14 +13554,14 @@ func ParseSlurmV0040GetNodeResponse(rsp *http.Response) (*SlurmV0040GetNodeRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13557,14 +13587,14 @@ func ParseSlurmV0040PostNodeResponse(rsp *http.Response) (*SlurmV0040PostNodeRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13590,14 +13620,14 @@ func ParseSlurmV0040GetNodesResponse(rsp *http.Response) (*SlurmV0040GetNodesRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13623,14 +13653,14 @@ func ParseSlurmV0040PostNodesResponse(rsp *http.Response) (*SlurmV0040PostNodesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13656,14 +13686,14 @@ func ParseSlurmV0040GetPartitionResponse(rsp *http.Response) (*SlurmV0040GetPart
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13689,14 +13719,14 @@ func ParseSlurmV0040GetPartitionsResponse(rsp *http.Response) (*SlurmV0040GetPar
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13722,14 +13752,14 @@ func ParseSlurmV0040GetPingResponse(rsp *http.Response) (*SlurmV0040GetPingRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13755,14 +13785,14 @@ func ParseSlurmV0040GetReconfigureResponse(rsp *http.Response) (*SlurmV0040GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13788,14 +13818,14 @@ func ParseSlurmV0040DeleteReservationResponse(rsp *http.Response) (*SlurmV0040De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13821,14 +13851,14 @@ func ParseSlurmV0040GetReservationResponse(rsp *http.Response) (*SlurmV0040GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13854,14 +13884,14 @@ func ParseSlurmV0040GetReservationsResponse(rsp *http.Response) (*SlurmV0040GetR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13887,14 +13917,14 @@ func ParseSlurmV0040GetSharesResponse(rsp *http.Response) (*SlurmV0040GetSharesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13920,14 +13950,14 @@ func ParseSlurmdbV0040DeleteAccountResponse(rsp *http.Response) (*SlurmdbV0040De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13953,14 +13983,14 @@ func ParseSlurmdbV0040GetAccountResponse(rsp *http.Response) (*SlurmdbV0040GetAc
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13986,14 +14016,14 @@ func ParseSlurmdbV0040GetAccountsResponse(rsp *http.Response) (*SlurmdbV0040GetA
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14019,14 +14049,14 @@ func ParseSlurmdbV0040PostAccountsResponse(rsp *http.Response) (*SlurmdbV0040Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14052,14 +14082,14 @@ func ParseSlurmdbV0040PostAccountsAssociationResponse(rsp *http.Response) (*Slur
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14085,14 +14115,14 @@ func ParseSlurmdbV0040DeleteAssociationResponse(rsp *http.Response) (*SlurmdbV00
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14118,14 +14148,14 @@ func ParseSlurmdbV0040GetAssociationResponse(rsp *http.Response) (*SlurmdbV0040G
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14151,14 +14181,14 @@ func ParseSlurmdbV0040DeleteAssociationsResponse(rsp *http.Response) (*SlurmdbV0
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14184,14 +14214,14 @@ func ParseSlurmdbV0040GetAssociationsResponse(rsp *http.Response) (*SlurmdbV0040
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14217,14 +14247,14 @@ func ParseSlurmdbV0040PostAssociationsResponse(rsp *http.Response) (*SlurmdbV004
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14250,14 +14280,14 @@ func ParseSlurmdbV0040DeleteClusterResponse(rsp *http.Response) (*SlurmdbV0040De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14283,14 +14313,14 @@ func ParseSlurmdbV0040GetClusterResponse(rsp *http.Response) (*SlurmdbV0040GetCl
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14316,14 +14346,14 @@ func ParseSlurmdbV0040GetClustersResponse(rsp *http.Response) (*SlurmdbV0040GetC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14349,14 +14379,14 @@ func ParseSlurmdbV0040PostClustersResponse(rsp *http.Response) (*SlurmdbV0040Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14382,14 +14412,14 @@ func ParseSlurmdbV0040GetConfigResponse(rsp *http.Response) (*SlurmdbV0040GetCon
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14415,14 +14445,14 @@ func ParseSlurmdbV0040PostConfigResponse(rsp *http.Response) (*SlurmdbV0040PostC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14448,14 +14478,14 @@ func ParseSlurmdbV0040GetDiagResponse(rsp *http.Response) (*SlurmdbV0040GetDiagR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14481,14 +14511,14 @@ func ParseSlurmdbV0040GetInstanceResponse(rsp *http.Response) (*SlurmdbV0040GetI
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14514,14 +14544,14 @@ func ParseSlurmdbV0040GetInstancesResponse(rsp *http.Response) (*SlurmdbV0040Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14547,14 +14577,14 @@ func ParseSlurmdbV0040GetJobResponse(rsp *http.Response) (*SlurmdbV0040GetJobRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14580,14 +14610,14 @@ func ParseSlurmdbV0040GetJobsResponse(rsp *http.Response) (*SlurmdbV0040GetJobsR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14613,14 +14643,14 @@ func ParseSlurmdbV0040GetQosResponse(rsp *http.Response) (*SlurmdbV0040GetQosRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14646,14 +14676,14 @@ func ParseSlurmdbV0040PostQosResponse(rsp *http.Response) (*SlurmdbV0040PostQosR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14679,14 +14709,14 @@ func ParseSlurmdbV0040DeleteSingleQosResponse(rsp *http.Response) (*SlurmdbV0040
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14712,14 +14742,14 @@ func ParseSlurmdbV0040GetSingleQosResponse(rsp *http.Response) (*SlurmdbV0040Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14745,14 +14775,14 @@ func ParseSlurmdbV0040GetTresResponse(rsp *http.Response) (*SlurmdbV0040GetTresR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14778,14 +14808,14 @@ func ParseSlurmdbV0040PostTresResponse(rsp *http.Response) (*SlurmdbV0040PostTre
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14811,14 +14841,14 @@ func ParseSlurmdbV0040DeleteUserResponse(rsp *http.Response) (*SlurmdbV0040Delet
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14844,14 +14874,14 @@ func ParseSlurmdbV0040GetUserResponse(rsp *http.Response) (*SlurmdbV0040GetUserR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14877,14 +14907,14 @@ func ParseSlurmdbV0040GetUsersResponse(rsp *http.Response) (*SlurmdbV0040GetUser
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14910,14 +14940,14 @@ func ParseSlurmdbV0040PostUsersResponse(rsp *http.Response) (*SlurmdbV0040PostUs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14943,14 +14973,14 @@ func ParseSlurmdbV0040PostUsersAssociationResponse(rsp *http.Response) (*Slurmdb
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14976,14 +15006,14 @@ func ParseSlurmdbV0040DeleteWckeyResponse(rsp *http.Response) (*SlurmdbV0040Dele
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15009,14 +15039,14 @@ func ParseSlurmdbV0040GetWckeyResponse(rsp *http.Response) (*SlurmdbV0040GetWcke
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15042,14 +15072,14 @@ func ParseSlurmdbV0040GetWckeysResponse(rsp *http.Response) (*SlurmdbV0040GetWck
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15075,14 +15105,14 @@ func ParseSlurmdbV0040PostWckeysResponse(rsp *http.Response) (*SlurmdbV0040PostW
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0040OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -2803,7 +2803,7 @@ type V0041OpenapiJobInfoResp struct {
 		Hold *bool `json:"hold,omitempty"`
 
 		// JobId Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// JobResources Resources used by the job
 		JobResources *struct {
@@ -5727,7 +5727,7 @@ type V0041OpenapiSlurmdbdJobsResp struct {
 		AllocationNodes *int32 `json:"allocation_nodes,omitempty"`
 		Array           *struct {
 			// JobId Job ID of job array, or 0 if N/A
-			JobId  *int32 `json:"job_id,omitempty"`
+			JobId  *int64 `json:"job_id,omitempty"`
 			Limits *struct {
 				Max *struct {
 					Running *struct {
@@ -5874,7 +5874,7 @@ type V0041OpenapiSlurmdbdJobsResp struct {
 		Group *string `json:"group,omitempty"`
 		Het   *struct {
 			// JobId Heterogeneous job ID, if applicable
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// JobOffset Unique sequence number applied to this component of the heterogeneous job
 			JobOffset *struct {
@@ -5893,7 +5893,7 @@ type V0041OpenapiSlurmdbdJobsResp struct {
 		Hold *bool `json:"hold,omitempty"`
 
 		// JobId Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// KillRequestUser User ID that requested termination of the job
 		KillRequestUser *string `json:"kill_request_user,omitempty"`
@@ -7599,7 +7599,7 @@ type SlurmV0041PostJobAllocateJSONBody struct {
 		Immediate *bool `json:"immediate,omitempty"`
 
 		// JobId Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// KillOnNodeFail If true, kill job on node failure
 		KillOnNodeFail *bool `json:"kill_on_node_fail,omitempty"`
@@ -8189,7 +8189,7 @@ type SlurmV0041PostJobAllocateJSONBody struct {
 		Immediate *bool `json:"immediate,omitempty"`
 
 		// JobId Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// KillOnNodeFail If true, kill job on node failure
 		KillOnNodeFail *bool `json:"kill_on_node_fail,omitempty"`
@@ -8848,7 +8848,7 @@ type SlurmV0041PostJobSubmitJSONBody struct {
 		Immediate *bool `json:"immediate,omitempty"`
 
 		// JobId Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// KillOnNodeFail If true, kill job on node failure
 		KillOnNodeFail *bool `json:"kill_on_node_fail,omitempty"`
@@ -9438,7 +9438,7 @@ type SlurmV0041PostJobSubmitJSONBody struct {
 		Immediate *bool `json:"immediate,omitempty"`
 
 		// JobId Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// KillOnNodeFail If true, kill job on node failure
 		KillOnNodeFail *bool `json:"kill_on_node_fail,omitempty"`
@@ -10123,7 +10123,7 @@ type SlurmV0041PostJobJSONBody struct {
 	Immediate *bool `json:"immediate,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillOnNodeFail If true, kill job on node failure
 	KillOnNodeFail *bool `json:"kill_on_node_fail,omitempty"`
@@ -12435,7 +12435,9 @@ func (c *Client) SlurmV0041PostJobAllocateWithBody(ctx context.Context, contentT
 }
 
 func (c *Client) SlurmV0041PostJobAllocate(ctx context.Context, body SlurmV0041PostJobAllocateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0041PostJobAllocateRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0041PostJobAllocateRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12459,7 +12461,9 @@ func (c *Client) SlurmV0041PostJobSubmitWithBody(ctx context.Context, contentTyp
 }
 
 func (c *Client) SlurmV0041PostJobSubmit(ctx context.Context, body SlurmV0041PostJobSubmitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0041PostJobSubmitRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0041PostJobSubmitRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12507,7 +12511,9 @@ func (c *Client) SlurmV0041PostJobWithBody(ctx context.Context, jobId string, co
 }
 
 func (c *Client) SlurmV0041PostJob(ctx context.Context, jobId string, body SlurmV0041PostJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0041PostJobRequest(c.Server, jobId, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0041PostJobRequestWithBody(c.Server, jobId, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12531,7 +12537,9 @@ func (c *Client) SlurmV0041DeleteJobsWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmV0041DeleteJobs(ctx context.Context, body SlurmV0041DeleteJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0041DeleteJobsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0041DeleteJobsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12615,7 +12623,9 @@ func (c *Client) SlurmV0041PostNodeWithBody(ctx context.Context, nodeName string
 }
 
 func (c *Client) SlurmV0041PostNode(ctx context.Context, nodeName string, body SlurmV0041PostNodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0041PostNodeRequest(c.Server, nodeName, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0041PostNodeRequestWithBody(c.Server, nodeName, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12651,7 +12661,9 @@ func (c *Client) SlurmV0041PostNodesWithBody(ctx context.Context, contentType st
 }
 
 func (c *Client) SlurmV0041PostNodes(ctx context.Context, body SlurmV0041PostNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0041PostNodesRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0041PostNodesRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12795,7 +12807,9 @@ func (c *Client) SlurmdbV0041PostAccountsWithBody(ctx context.Context, contentTy
 }
 
 func (c *Client) SlurmdbV0041PostAccounts(ctx context.Context, body SlurmdbV0041PostAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostAccountsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostAccountsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12819,7 +12833,9 @@ func (c *Client) SlurmdbV0041PostAccountsAssociationWithBody(ctx context.Context
 }
 
 func (c *Client) SlurmdbV0041PostAccountsAssociation(ctx context.Context, body SlurmdbV0041PostAccountsAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostAccountsAssociationRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostAccountsAssociationRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12891,7 +12907,9 @@ func (c *Client) SlurmdbV0041PostAssociationsWithBody(ctx context.Context, conte
 }
 
 func (c *Client) SlurmdbV0041PostAssociations(ctx context.Context, body SlurmdbV0041PostAssociationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostAssociationsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostAssociationsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12951,7 +12969,9 @@ func (c *Client) SlurmdbV0041PostClustersWithBody(ctx context.Context, params *S
 }
 
 func (c *Client) SlurmdbV0041PostClusters(ctx context.Context, params *SlurmdbV0041PostClustersParams, body SlurmdbV0041PostClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostClustersRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostClustersRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -12987,7 +13007,9 @@ func (c *Client) SlurmdbV0041PostConfigWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0041PostConfig(ctx context.Context, body SlurmdbV0041PostConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostConfigRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostConfigRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -13083,7 +13105,9 @@ func (c *Client) SlurmdbV0041PostQosWithBody(ctx context.Context, params *Slurmd
 }
 
 func (c *Client) SlurmdbV0041PostQos(ctx context.Context, params *SlurmdbV0041PostQosParams, body SlurmdbV0041PostQosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostQosRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostQosRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -13143,7 +13167,9 @@ func (c *Client) SlurmdbV0041PostTresWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmdbV0041PostTres(ctx context.Context, body SlurmdbV0041PostTresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostTresRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostTresRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -13203,7 +13229,9 @@ func (c *Client) SlurmdbV0041PostUsersWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0041PostUsers(ctx context.Context, body SlurmdbV0041PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostUsersRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostUsersRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -13227,7 +13255,9 @@ func (c *Client) SlurmdbV0041PostUsersAssociationWithBody(ctx context.Context, p
 }
 
 func (c *Client) SlurmdbV0041PostUsersAssociation(ctx context.Context, params *SlurmdbV0041PostUsersAssociationParams, body SlurmdbV0041PostUsersAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostUsersAssociationRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostUsersAssociationRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -13287,7 +13317,9 @@ func (c *Client) SlurmdbV0041PostWckeysWithBody(ctx context.Context, params *Slu
 }
 
 func (c *Client) SlurmdbV0041PostWckeys(ctx context.Context, params *SlurmdbV0041PostWckeysParams, body SlurmdbV0041PostWckeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0041PostWckeysRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0041PostWckeysRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -19966,7 +19998,7 @@ type SlurmV0041PostJobAllocateResponse struct {
 		} `json:"errors,omitempty"`
 
 		// JobId Submitted Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// JobSubmitUserMsg Job submission user message
 		JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -20044,7 +20076,7 @@ type SlurmV0041PostJobAllocateResponse struct {
 		} `json:"errors,omitempty"`
 
 		// JobId Submitted Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// JobSubmitUserMsg Job submission user message
 		JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -20143,7 +20175,7 @@ type SlurmV0041PostJobSubmitResponse struct {
 		} `json:"errors,omitempty"`
 
 		// JobId Submitted Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// JobSubmitUserMsg Job submission user message
 		JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -20205,7 +20237,7 @@ type SlurmV0041PostJobSubmitResponse struct {
 			ErrorCode *int32 `json:"error_code,omitempty"`
 
 			// JobId New job ID
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// JobSubmitUserMsg Message to user from job_submit plugin
 			JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -20243,7 +20275,7 @@ type SlurmV0041PostJobSubmitResponse struct {
 		} `json:"errors,omitempty"`
 
 		// JobId Submitted Job ID
-		JobId *int32 `json:"job_id,omitempty"`
+		JobId *int64 `json:"job_id,omitempty"`
 
 		// JobSubmitUserMsg Job submission user message
 		JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -20305,7 +20337,7 @@ type SlurmV0041PostJobSubmitResponse struct {
 			ErrorCode *int32 `json:"error_code,omitempty"`
 
 			// JobId New job ID
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// JobSubmitUserMsg Message to user from job_submit plugin
 			JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -20473,7 +20505,7 @@ type SlurmV0041PostJobResponse struct {
 			ErrorCode *int32 `json:"error_code,omitempty"`
 
 			// JobId Job ID for updated job
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// StepId Step ID for updated job
 			StepId *string `json:"step_id,omitempty"`
@@ -20575,7 +20607,7 @@ type SlurmV0041PostJobResponse struct {
 			ErrorCode *int32 `json:"error_code,omitempty"`
 
 			// JobId Job ID for updated job
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// StepId Step ID for updated job
 			StepId *string `json:"step_id,omitempty"`
@@ -24685,7 +24717,7 @@ func ParseSlurmV0041GetDiagResponse(rsp *http.Response) (*SlurmV0041GetDiagRespo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -25078,7 +25110,7 @@ func ParseSlurmV0041GetDiagResponse(rsp *http.Response) (*SlurmV0041GetDiagRespo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -25120,7 +25152,7 @@ func ParseSlurmV0041PostJobAllocateResponse(rsp *http.Response) (*SlurmV0041Post
 			} `json:"errors,omitempty"`
 
 			// JobId Submitted Job ID
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// JobSubmitUserMsg Job submission user message
 			JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -25181,7 +25213,7 @@ func ParseSlurmV0041PostJobAllocateResponse(rsp *http.Response) (*SlurmV0041Post
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -25204,7 +25236,7 @@ func ParseSlurmV0041PostJobAllocateResponse(rsp *http.Response) (*SlurmV0041Post
 			} `json:"errors,omitempty"`
 
 			// JobId Submitted Job ID
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// JobSubmitUserMsg Job submission user message
 			JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -25265,7 +25297,7 @@ func ParseSlurmV0041PostJobAllocateResponse(rsp *http.Response) (*SlurmV0041Post
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -25307,7 +25339,7 @@ func ParseSlurmV0041PostJobSubmitResponse(rsp *http.Response) (*SlurmV0041PostJo
 			} `json:"errors,omitempty"`
 
 			// JobId Submitted Job ID
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// JobSubmitUserMsg Job submission user message
 			JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -25369,7 +25401,7 @@ func ParseSlurmV0041PostJobSubmitResponse(rsp *http.Response) (*SlurmV0041PostJo
 				ErrorCode *int32 `json:"error_code,omitempty"`
 
 				// JobId New job ID
-				JobId *int32 `json:"job_id,omitempty"`
+				JobId *int64 `json:"job_id,omitempty"`
 
 				// JobSubmitUserMsg Message to user from job_submit plugin
 				JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -25390,7 +25422,7 @@ func ParseSlurmV0041PostJobSubmitResponse(rsp *http.Response) (*SlurmV0041PostJo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -25413,7 +25445,7 @@ func ParseSlurmV0041PostJobSubmitResponse(rsp *http.Response) (*SlurmV0041PostJo
 			} `json:"errors,omitempty"`
 
 			// JobId Submitted Job ID
-			JobId *int32 `json:"job_id,omitempty"`
+			JobId *int64 `json:"job_id,omitempty"`
 
 			// JobSubmitUserMsg Job submission user message
 			JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -25475,7 +25507,7 @@ func ParseSlurmV0041PostJobSubmitResponse(rsp *http.Response) (*SlurmV0041PostJo
 				ErrorCode *int32 `json:"error_code,omitempty"`
 
 				// JobId New job ID
-				JobId *int32 `json:"job_id,omitempty"`
+				JobId *int64 `json:"job_id,omitempty"`
 
 				// JobSubmitUserMsg Message to user from job_submit plugin
 				JobSubmitUserMsg *string `json:"job_submit_user_msg,omitempty"`
@@ -25496,7 +25528,7 @@ func ParseSlurmV0041PostJobSubmitResponse(rsp *http.Response) (*SlurmV0041PostJo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -25522,14 +25554,14 @@ func ParseSlurmV0041DeleteJobResponse(rsp *http.Response) (*SlurmV0041DeleteJobR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -25555,14 +25587,14 @@ func ParseSlurmV0041GetJobResponse(rsp *http.Response) (*SlurmV0041GetJobRespons
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -25667,7 +25699,7 @@ func ParseSlurmV0041PostJobResponse(rsp *http.Response) (*SlurmV0041PostJobRespo
 				ErrorCode *int32 `json:"error_code,omitempty"`
 
 				// JobId Job ID for updated job
-				JobId *int32 `json:"job_id,omitempty"`
+				JobId *int64 `json:"job_id,omitempty"`
 
 				// StepId Step ID for updated job
 				StepId *string `json:"step_id,omitempty"`
@@ -25689,7 +25721,7 @@ func ParseSlurmV0041PostJobResponse(rsp *http.Response) (*SlurmV0041PostJobRespo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -25775,7 +25807,7 @@ func ParseSlurmV0041PostJobResponse(rsp *http.Response) (*SlurmV0041PostJobRespo
 				ErrorCode *int32 `json:"error_code,omitempty"`
 
 				// JobId Job ID for updated job
-				JobId *int32 `json:"job_id,omitempty"`
+				JobId *int64 `json:"job_id,omitempty"`
 
 				// StepId Step ID for updated job
 				StepId *string `json:"step_id,omitempty"`
@@ -25797,7 +25829,7 @@ func ParseSlurmV0041PostJobResponse(rsp *http.Response) (*SlurmV0041PostJobRespo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -25927,7 +25959,7 @@ func ParseSlurmV0041DeleteJobsResponse(rsp *http.Response) (*SlurmV0041DeleteJob
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -26038,7 +26070,7 @@ func ParseSlurmV0041DeleteJobsResponse(rsp *http.Response) (*SlurmV0041DeleteJob
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26064,14 +26096,14 @@ func ParseSlurmV0041GetJobsResponse(rsp *http.Response) (*SlurmV0041GetJobsRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26097,14 +26129,14 @@ func ParseSlurmV0041GetJobsStateResponse(rsp *http.Response) (*SlurmV0041GetJobs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26243,7 +26275,7 @@ func ParseSlurmV0041GetLicensesResponse(rsp *http.Response) (*SlurmV0041GetLicen
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -26363,7 +26395,7 @@ func ParseSlurmV0041GetLicensesResponse(rsp *http.Response) (*SlurmV0041GetLicen
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26389,14 +26421,14 @@ func ParseSlurmV0041DeleteNodeResponse(rsp *http.Response) (*SlurmV0041DeleteNod
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26422,14 +26454,14 @@ func ParseSlurmV0041GetNodeResponse(rsp *http.Response) (*SlurmV0041GetNodeRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26455,14 +26487,14 @@ func ParseSlurmV0041PostNodeResponse(rsp *http.Response) (*SlurmV0041PostNodeRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26488,14 +26520,14 @@ func ParseSlurmV0041GetNodesResponse(rsp *http.Response) (*SlurmV0041GetNodesRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26521,14 +26553,14 @@ func ParseSlurmV0041PostNodesResponse(rsp *http.Response) (*SlurmV0041PostNodesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26554,14 +26586,14 @@ func ParseSlurmV0041GetPartitionResponse(rsp *http.Response) (*SlurmV0041GetPart
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26587,14 +26619,14 @@ func ParseSlurmV0041GetPartitionsResponse(rsp *http.Response) (*SlurmV0041GetPar
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26706,7 +26738,7 @@ func ParseSlurmV0041GetPingResponse(rsp *http.Response) (*SlurmV0041GetPingRespo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -26799,7 +26831,7 @@ func ParseSlurmV0041GetPingResponse(rsp *http.Response) (*SlurmV0041GetPingRespo
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26825,14 +26857,14 @@ func ParseSlurmV0041GetReconfigureResponse(rsp *http.Response) (*SlurmV0041GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26858,14 +26890,14 @@ func ParseSlurmV0041GetReservationResponse(rsp *http.Response) (*SlurmV0041GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26891,14 +26923,14 @@ func ParseSlurmV0041GetReservationsResponse(rsp *http.Response) (*SlurmV0041GetR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -26924,14 +26956,14 @@ func ParseSlurmV0041GetSharesResponse(rsp *http.Response) (*SlurmV0041GetSharesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27031,7 +27063,7 @@ func ParseSlurmdbV0041DeleteAccountResponse(rsp *http.Response) (*SlurmdbV0041De
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -27112,7 +27144,7 @@ func ParseSlurmdbV0041DeleteAccountResponse(rsp *http.Response) (*SlurmdbV0041De
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27138,14 +27170,14 @@ func ParseSlurmdbV0041GetAccountResponse(rsp *http.Response) (*SlurmdbV0041GetAc
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27171,14 +27203,14 @@ func ParseSlurmdbV0041GetAccountsResponse(rsp *http.Response) (*SlurmdbV0041GetA
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27204,14 +27236,14 @@ func ParseSlurmdbV0041PostAccountsResponse(rsp *http.Response) (*SlurmdbV0041Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27311,7 +27343,7 @@ func ParseSlurmdbV0041PostAccountsAssociationResponse(rsp *http.Response) (*Slur
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -27392,7 +27424,7 @@ func ParseSlurmdbV0041PostAccountsAssociationResponse(rsp *http.Response) (*Slur
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27418,14 +27450,14 @@ func ParseSlurmdbV0041DeleteAssociationResponse(rsp *http.Response) (*SlurmdbV00
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27451,14 +27483,14 @@ func ParseSlurmdbV0041GetAssociationResponse(rsp *http.Response) (*SlurmdbV0041G
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27484,14 +27516,14 @@ func ParseSlurmdbV0041DeleteAssociationsResponse(rsp *http.Response) (*SlurmdbV0
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27517,14 +27549,14 @@ func ParseSlurmdbV0041GetAssociationsResponse(rsp *http.Response) (*SlurmdbV0041
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27550,14 +27582,14 @@ func ParseSlurmdbV0041PostAssociationsResponse(rsp *http.Response) (*SlurmdbV004
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27657,7 +27689,7 @@ func ParseSlurmdbV0041DeleteClusterResponse(rsp *http.Response) (*SlurmdbV0041De
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -27738,7 +27770,7 @@ func ParseSlurmdbV0041DeleteClusterResponse(rsp *http.Response) (*SlurmdbV0041De
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27764,14 +27796,14 @@ func ParseSlurmdbV0041GetClusterResponse(rsp *http.Response) (*SlurmdbV0041GetCl
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27797,14 +27829,14 @@ func ParseSlurmdbV0041GetClustersResponse(rsp *http.Response) (*SlurmdbV0041GetC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27830,14 +27862,14 @@ func ParseSlurmdbV0041PostClustersResponse(rsp *http.Response) (*SlurmdbV0041Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27863,14 +27895,14 @@ func ParseSlurmdbV0041GetConfigResponse(rsp *http.Response) (*SlurmdbV0041GetCon
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -27896,14 +27928,14 @@ func ParseSlurmdbV0041PostConfigResponse(rsp *http.Response) (*SlurmdbV0041PostC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28093,7 +28125,7 @@ func ParseSlurmdbV0041GetDiagResponse(rsp *http.Response) (*SlurmdbV0041GetDiagR
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -28264,7 +28296,7 @@ func ParseSlurmdbV0041GetDiagResponse(rsp *http.Response) (*SlurmdbV0041GetDiagR
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28290,14 +28322,14 @@ func ParseSlurmdbV0041GetInstanceResponse(rsp *http.Response) (*SlurmdbV0041GetI
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28323,14 +28355,14 @@ func ParseSlurmdbV0041GetInstancesResponse(rsp *http.Response) (*SlurmdbV0041Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28356,14 +28388,14 @@ func ParseSlurmdbV0041GetJobResponse(rsp *http.Response) (*SlurmdbV0041GetJobRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28389,14 +28421,14 @@ func ParseSlurmdbV0041GetJobsResponse(rsp *http.Response) (*SlurmdbV0041GetJobsR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28422,14 +28454,14 @@ func ParseSlurmdbV0041GetQosResponse(rsp *http.Response) (*SlurmdbV0041GetQosRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28455,14 +28487,14 @@ func ParseSlurmdbV0041PostQosResponse(rsp *http.Response) (*SlurmdbV0041PostQosR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28562,7 +28594,7 @@ func ParseSlurmdbV0041DeleteSingleQosResponse(rsp *http.Response) (*SlurmdbV0041
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -28643,7 +28675,7 @@ func ParseSlurmdbV0041DeleteSingleQosResponse(rsp *http.Response) (*SlurmdbV0041
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28669,14 +28701,14 @@ func ParseSlurmdbV0041GetSingleQosResponse(rsp *http.Response) (*SlurmdbV0041Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28702,14 +28734,14 @@ func ParseSlurmdbV0041GetTresResponse(rsp *http.Response) (*SlurmdbV0041GetTresR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28735,14 +28767,14 @@ func ParseSlurmdbV0041PostTresResponse(rsp *http.Response) (*SlurmdbV0041PostTre
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28768,14 +28800,14 @@ func ParseSlurmdbV0041DeleteUserResponse(rsp *http.Response) (*SlurmdbV0041Delet
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28801,14 +28833,14 @@ func ParseSlurmdbV0041GetUserResponse(rsp *http.Response) (*SlurmdbV0041GetUserR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28834,14 +28866,14 @@ func ParseSlurmdbV0041GetUsersResponse(rsp *http.Response) (*SlurmdbV0041GetUser
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28867,14 +28899,14 @@ func ParseSlurmdbV0041PostUsersResponse(rsp *http.Response) (*SlurmdbV0041PostUs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -28974,7 +29006,7 @@ func ParseSlurmdbV0041PostUsersAssociationResponse(rsp *http.Response) (*Slurmdb
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -29055,7 +29087,7 @@ func ParseSlurmdbV0041PostUsersAssociationResponse(rsp *http.Response) (*Slurmdb
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -29155,7 +29187,7 @@ func ParseSlurmdbV0041DeleteWckeyResponse(rsp *http.Response) (*SlurmdbV0041Dele
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
@@ -29236,7 +29268,7 @@ func ParseSlurmdbV0041DeleteWckeyResponse(rsp *http.Response) (*SlurmdbV0041Dele
 				Source *string `json:"source,omitempty"`
 			} `json:"warnings,omitempty"`
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -29262,14 +29294,14 @@ func ParseSlurmdbV0041GetWckeyResponse(rsp *http.Response) (*SlurmdbV0041GetWcke
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -29295,14 +29327,14 @@ func ParseSlurmdbV0041GetWckeysResponse(rsp *http.Response) (*SlurmdbV0041GetWck
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -29328,14 +29360,14 @@ func ParseSlurmdbV0041PostWckeysResponse(rsp *http.Response) (*SlurmdbV0041PostW
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0041OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
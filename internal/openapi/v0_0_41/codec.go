@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package v0_0_41
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/jontk/slurm-client/pkg/codec"
+)
+
+// newRequestWithCodec encodes body with the codec of doer and builds the
+// request from the encoded bytes. The generated client methods call it in
+// place of encoding/json; see routeThroughCodec in tools/codegen.
+func newRequestWithCodec(doer HttpRequestDoer, body interface{}, build func(io.Reader) (*http.Request, error)) (*http.Request, error) {
+	buf, err := codec.FromDoer(doer).Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return build(bytes.NewReader(buf))
+}
+
+// unmarshalResponse decodes a response body with the codec carried by the
+// context of its request
+func unmarshalResponse(rsp *http.Response, data []byte, v interface{}) error {
+	if rsp.Request == nil {
+		return codec.JSON{}.Unmarshal(data, v)
+	}
+	return codec.FromContext(rsp.Request.Context()).Unmarshal(data, v)
+}
@@ -636,7 +636,7 @@ type V0042Job struct {
 	AllocationNodes *int32 `json:"allocation_nodes,omitempty"`
 	Array           *struct {
 		// JobId Job ID of job array, or 0 if N/A
-		JobId  *int32 `json:"job_id,omitempty"`
+		JobId  *int64 `json:"job_id,omitempty"`
 		Limits *struct {
 			Max *struct {
 				Running *struct {
@@ -687,7 +687,7 @@ type V0042Job struct {
 	Group *string `json:"group,omitempty"`
 	Het   *struct {
 		// JobId Heterogeneous job ID, if applicable
-		JobId     *int32                  `json:"job_id,omitempty"`
+		JobId     *int64                  `json:"job_id,omitempty"`
 		JobOffset *V0042Uint32NoValStruct `json:"job_offset,omitempty"`
 	} `json:"het,omitempty"`
 
@@ -695,7 +695,7 @@ type V0042Job struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillRequestUser User ID that requested termination of the job
 	KillRequestUser *string `json:"kill_request_user,omitempty"`
@@ -845,7 +845,7 @@ type V0042JobArrayResponseMsgEntry struct {
 	ErrorCode *int32 `json:"error_code,omitempty"`
 
 	// JobId Job ID for updated job
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// StepId Step ID for updated job
 	StepId *string `json:"step_id,omitempty"`
@@ -958,7 +958,7 @@ type V0042JobDescMsg struct {
 	Immediate *bool `json:"immediate,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillOnNodeFail If true, kill job on node failure
 	KillOnNodeFail   *bool                   `json:"kill_on_node_fail,omitempty"`
@@ -1289,7 +1289,7 @@ type V0042JobInfo struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId               *int32                  `json:"job_id,omitempty"`
+	JobId               *int64                  `json:"job_id,omitempty"`
 	JobResources        *V0042JobRes            `json:"job_resources,omitempty"`
 	JobSizeStr          *V0042CsvString         `json:"job_size_str,omitempty"`
 	JobState            *V0042JobState          `json:"job_state,omitempty"`
@@ -1913,7 +1913,7 @@ type V0042OpenapiJobAllocResp struct {
 	Errors *V0042OpenapiErrors `json:"errors,omitempty"`
 
 	// JobId Submitted Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg Job submission user message
 	JobSubmitUserMsg *string               `json:"job_submit_user_msg,omitempty"`
@@ -1944,7 +1944,7 @@ type V0042OpenapiJobSubmitResponse struct {
 	Errors *V0042OpenapiErrors `json:"errors,omitempty"`
 
 	// JobId submitted Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg Job submission user message
 	JobSubmitUserMsg *string           `json:"job_submit_user_msg,omitempty"`
@@ -4313,7 +4313,9 @@ func (c *Client) SlurmV0042PostJobAllocateWithBody(ctx context.Context, contentT
 }
 
 func (c *Client) SlurmV0042PostJobAllocate(ctx context.Context, body SlurmV0042PostJobAllocateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0042PostJobAllocateRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0042PostJobAllocateRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4337,7 +4339,9 @@ func (c *Client) SlurmV0042PostJobSubmitWithBody(ctx context.Context, contentTyp
 }
 
 func (c *Client) SlurmV0042PostJobSubmit(ctx context.Context, body SlurmV0042PostJobSubmitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0042PostJobSubmitRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0042PostJobSubmitRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4385,7 +4389,9 @@ func (c *Client) SlurmV0042PostJobWithBody(ctx context.Context, jobId string, co
 }
 
 func (c *Client) SlurmV0042PostJob(ctx context.Context, jobId string, body SlurmV0042PostJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0042PostJobRequest(c.Server, jobId, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0042PostJobRequestWithBody(c.Server, jobId, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4409,7 +4415,9 @@ func (c *Client) SlurmV0042DeleteJobsWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmV0042DeleteJobs(ctx context.Context, body SlurmV0042DeleteJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0042DeleteJobsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0042DeleteJobsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4493,7 +4501,9 @@ func (c *Client) SlurmV0042PostNodeWithBody(ctx context.Context, nodeName string
 }
 
 func (c *Client) SlurmV0042PostNode(ctx context.Context, nodeName string, body SlurmV0042PostNodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0042PostNodeRequest(c.Server, nodeName, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0042PostNodeRequestWithBody(c.Server, nodeName, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4529,7 +4539,9 @@ func (c *Client) SlurmV0042PostNodesWithBody(ctx context.Context, contentType st
 }
 
 func (c *Client) SlurmV0042PostNodes(ctx context.Context, body SlurmV0042PostNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0042PostNodesRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0042PostNodesRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4685,7 +4697,9 @@ func (c *Client) SlurmdbV0042PostAccountsWithBody(ctx context.Context, contentTy
 }
 
 func (c *Client) SlurmdbV0042PostAccounts(ctx context.Context, body SlurmdbV0042PostAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostAccountsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostAccountsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4709,7 +4723,9 @@ func (c *Client) SlurmdbV0042PostAccountsAssociationWithBody(ctx context.Context
 }
 
 func (c *Client) SlurmdbV0042PostAccountsAssociation(ctx context.Context, body SlurmdbV0042PostAccountsAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostAccountsAssociationRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostAccountsAssociationRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4781,7 +4797,9 @@ func (c *Client) SlurmdbV0042PostAssociationsWithBody(ctx context.Context, conte
 }
 
 func (c *Client) SlurmdbV0042PostAssociations(ctx context.Context, body SlurmdbV0042PostAssociationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostAssociationsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostAssociationsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4841,7 +4859,9 @@ func (c *Client) SlurmdbV0042PostClustersWithBody(ctx context.Context, params *S
 }
 
 func (c *Client) SlurmdbV0042PostClusters(ctx context.Context, params *SlurmdbV0042PostClustersParams, body SlurmdbV0042PostClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostClustersRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostClustersRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4877,7 +4897,9 @@ func (c *Client) SlurmdbV0042PostConfigWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0042PostConfig(ctx context.Context, body SlurmdbV0042PostConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostConfigRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostConfigRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -4985,7 +5007,9 @@ func (c *Client) SlurmdbV0042PostQosWithBody(ctx context.Context, params *Slurmd
 }
 
 func (c *Client) SlurmdbV0042PostQos(ctx context.Context, params *SlurmdbV0042PostQosParams, body SlurmdbV0042PostQosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostQosRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostQosRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5045,7 +5069,9 @@ func (c *Client) SlurmdbV0042PostTresWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmdbV0042PostTres(ctx context.Context, body SlurmdbV0042PostTresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostTresRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostTresRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5105,7 +5131,9 @@ func (c *Client) SlurmdbV0042PostUsersWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0042PostUsers(ctx context.Context, body SlurmdbV0042PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostUsersRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostUsersRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5129,7 +5157,9 @@ func (c *Client) SlurmdbV0042PostUsersAssociationWithBody(ctx context.Context, p
 }
 
 func (c *Client) SlurmdbV0042PostUsersAssociation(ctx context.Context, params *SlurmdbV0042PostUsersAssociationParams, body SlurmdbV0042PostUsersAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostUsersAssociationRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostUsersAssociationRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5189,7 +5219,9 @@ func (c *Client) SlurmdbV0042PostWckeysWithBody(ctx context.Context, params *Slu
 }
 
 func (c *Client) SlurmdbV0042PostWckeys(ctx context.Context, params *SlurmdbV0042PostWckeysParams, body SlurmdbV0042PostWckeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0042PostWckeysRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0042PostWckeysRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -13184,14 +13216,14 @@ func ParseSlurmV0042GetDiagResponse(rsp *http.Response) (*SlurmV0042GetDiagRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13217,14 +13249,14 @@ func ParseSlurmV0042PostJobAllocateResponse(rsp *http.Response) (*SlurmV0042Post
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiJobAllocResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiJobAllocResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13250,14 +13282,14 @@ func ParseSlurmV0042PostJobSubmitResponse(rsp *http.Response) (*SlurmV0042PostJo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13283,14 +13315,14 @@ func ParseSlurmV0042DeleteJobResponse(rsp *http.Response) (*SlurmV0042DeleteJobR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiKillJobResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiKillJobResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13316,14 +13348,14 @@ func ParseSlurmV0042GetJobResponse(rsp *http.Response) (*SlurmV0042GetJobRespons
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13349,14 +13381,14 @@ func ParseSlurmV0042PostJobResponse(rsp *http.Response) (*SlurmV0042PostJobRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13382,14 +13414,14 @@ func ParseSlurmV0042DeleteJobsResponse(rsp *http.Response) (*SlurmV0042DeleteJob
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13415,14 +13447,14 @@ func ParseSlurmV0042GetJobsResponse(rsp *http.Response) (*SlurmV0042GetJobsRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13448,14 +13480,14 @@ func ParseSlurmV0042GetJobsStateResponse(rsp *http.Response) (*SlurmV0042GetJobs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13481,14 +13513,14 @@ func ParseSlurmV0042GetLicensesResponse(rsp *http.Response) (*SlurmV0042GetLicen
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13514,14 +13546,14 @@ func ParseSlurmV0042DeleteNodeResponse(rsp *http.Response) (*SlurmV0042DeleteNod
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13547,14 +13579,14 @@ func ParseSlurmV0042GetNodeResponse(rsp *http.Response) (*SlurmV0042GetNodeRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13580,14 +13612,14 @@ func ParseSlurmV0042PostNodeResponse(rsp *http.Response) (*SlurmV0042PostNodeRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13613,14 +13645,14 @@ func ParseSlurmV0042GetNodesResponse(rsp *http.Response) (*SlurmV0042GetNodesRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13646,14 +13678,14 @@ func ParseSlurmV0042PostNodesResponse(rsp *http.Response) (*SlurmV0042PostNodesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13679,14 +13711,14 @@ func ParseSlurmV0042GetPartitionResponse(rsp *http.Response) (*SlurmV0042GetPart
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13712,14 +13744,14 @@ func ParseSlurmV0042GetPartitionsResponse(rsp *http.Response) (*SlurmV0042GetPar
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13745,14 +13777,14 @@ func ParseSlurmV0042GetPingResponse(rsp *http.Response) (*SlurmV0042GetPingRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13778,14 +13810,14 @@ func ParseSlurmV0042GetReconfigureResponse(rsp *http.Response) (*SlurmV0042GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13811,14 +13843,14 @@ func ParseSlurmV0042DeleteReservationResponse(rsp *http.Response) (*SlurmV0042De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13844,14 +13876,14 @@ func ParseSlurmV0042GetReservationResponse(rsp *http.Response) (*SlurmV0042GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13877,14 +13909,14 @@ func ParseSlurmV0042GetReservationsResponse(rsp *http.Response) (*SlurmV0042GetR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13910,14 +13942,14 @@ func ParseSlurmV0042GetSharesResponse(rsp *http.Response) (*SlurmV0042GetSharesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13943,14 +13975,14 @@ func ParseSlurmdbV0042DeleteAccountResponse(rsp *http.Response) (*SlurmdbV0042De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -13976,14 +14008,14 @@ func ParseSlurmdbV0042GetAccountResponse(rsp *http.Response) (*SlurmdbV0042GetAc
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14009,14 +14041,14 @@ func ParseSlurmdbV0042GetAccountsResponse(rsp *http.Response) (*SlurmdbV0042GetA
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14042,14 +14074,14 @@ func ParseSlurmdbV0042PostAccountsResponse(rsp *http.Response) (*SlurmdbV0042Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14075,14 +14107,14 @@ func ParseSlurmdbV0042PostAccountsAssociationResponse(rsp *http.Response) (*Slur
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14108,14 +14140,14 @@ func ParseSlurmdbV0042DeleteAssociationResponse(rsp *http.Response) (*SlurmdbV00
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14141,14 +14173,14 @@ func ParseSlurmdbV0042GetAssociationResponse(rsp *http.Response) (*SlurmdbV0042G
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14174,14 +14206,14 @@ func ParseSlurmdbV0042DeleteAssociationsResponse(rsp *http.Response) (*SlurmdbV0
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14207,14 +14239,14 @@ func ParseSlurmdbV0042GetAssociationsResponse(rsp *http.Response) (*SlurmdbV0042
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14240,14 +14272,14 @@ func ParseSlurmdbV0042PostAssociationsResponse(rsp *http.Response) (*SlurmdbV004
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14273,14 +14305,14 @@ func ParseSlurmdbV0042DeleteClusterResponse(rsp *http.Response) (*SlurmdbV0042De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14306,14 +14338,14 @@ func ParseSlurmdbV0042GetClusterResponse(rsp *http.Response) (*SlurmdbV0042GetCl
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14339,14 +14371,14 @@ func ParseSlurmdbV0042GetClustersResponse(rsp *http.Response) (*SlurmdbV0042GetC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14372,14 +14404,14 @@ func ParseSlurmdbV0042PostClustersResponse(rsp *http.Response) (*SlurmdbV0042Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14405,14 +14437,14 @@ func ParseSlurmdbV0042GetConfigResponse(rsp *http.Response) (*SlurmdbV0042GetCon
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14438,14 +14470,14 @@ func ParseSlurmdbV0042PostConfigResponse(rsp *http.Response) (*SlurmdbV0042PostC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14471,14 +14503,14 @@ func ParseSlurmdbV0042GetDiagResponse(rsp *http.Response) (*SlurmdbV0042GetDiagR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14504,14 +14536,14 @@ func ParseSlurmdbV0042GetInstanceResponse(rsp *http.Response) (*SlurmdbV0042GetI
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14537,14 +14569,14 @@ func ParseSlurmdbV0042GetInstancesResponse(rsp *http.Response) (*SlurmdbV0042Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14570,14 +14602,14 @@ func ParseSlurmdbV0042GetJobResponse(rsp *http.Response) (*SlurmdbV0042GetJobRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14603,14 +14635,14 @@ func ParseSlurmdbV0042GetJobsResponse(rsp *http.Response) (*SlurmdbV0042GetJobsR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14636,14 +14668,14 @@ func ParseSlurmdbV0042GetPingResponse(rsp *http.Response) (*SlurmdbV0042GetPingR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdPingResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdPingResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14669,14 +14701,14 @@ func ParseSlurmdbV0042GetQosResponse(rsp *http.Response) (*SlurmdbV0042GetQosRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14702,14 +14734,14 @@ func ParseSlurmdbV0042PostQosResponse(rsp *http.Response) (*SlurmdbV0042PostQosR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14735,14 +14767,14 @@ func ParseSlurmdbV0042DeleteSingleQosResponse(rsp *http.Response) (*SlurmdbV0042
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14768,14 +14800,14 @@ func ParseSlurmdbV0042GetSingleQosResponse(rsp *http.Response) (*SlurmdbV0042Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14801,14 +14833,14 @@ func ParseSlurmdbV0042GetTresResponse(rsp *http.Response) (*SlurmdbV0042GetTresR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14834,14 +14866,14 @@ func ParseSlurmdbV0042PostTresResponse(rsp *http.Response) (*SlurmdbV0042PostTre
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14867,14 +14899,14 @@ func ParseSlurmdbV0042DeleteUserResponse(rsp *http.Response) (*SlurmdbV0042Delet
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14900,14 +14932,14 @@ func ParseSlurmdbV0042GetUserResponse(rsp *http.Response) (*SlurmdbV0042GetUserR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14933,14 +14965,14 @@ func ParseSlurmdbV0042GetUsersResponse(rsp *http.Response) (*SlurmdbV0042GetUser
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14966,14 +14998,14 @@ func ParseSlurmdbV0042PostUsersResponse(rsp *http.Response) (*SlurmdbV0042PostUs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14999,14 +15031,14 @@ func ParseSlurmdbV0042PostUsersAssociationResponse(rsp *http.Response) (*Slurmdb
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15032,14 +15064,14 @@ func ParseSlurmdbV0042DeleteWckeyResponse(rsp *http.Response) (*SlurmdbV0042Dele
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15065,14 +15097,14 @@ func ParseSlurmdbV0042GetWckeyResponse(rsp *http.Response) (*SlurmdbV0042GetWcke
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15098,14 +15130,14 @@ func ParseSlurmdbV0042GetWckeysResponse(rsp *http.Response) (*SlurmdbV0042GetWck
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15131,14 +15163,14 @@ func ParseSlurmdbV0042PostWckeysResponse(rsp *http.Response) (*SlurmdbV0042PostW
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0042OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -1347,7 +1347,7 @@ type V0043Job struct {
 	AllocationNodes *int32 `json:"allocation_nodes,omitempty"`
 	Array           *struct {
 		// JobId Job ID of job array, or 0 if N/A
-		JobId  *int32 `json:"job_id,omitempty"`
+		JobId  *int64 `json:"job_id,omitempty"`
 		Limits *struct {
 			Max *struct {
 				Running *struct {
@@ -1400,7 +1400,7 @@ type V0043Job struct {
 	Group *string `json:"group,omitempty"`
 	Het   *struct {
 		// JobId Heterogeneous job ID, if applicable
-		JobId     *int32                  `json:"job_id,omitempty"`
+		JobId     *int64                  `json:"job_id,omitempty"`
 		JobOffset *V0043Uint32NoValStruct `json:"job_offset,omitempty"`
 	} `json:"het,omitempty"`
 
@@ -1408,7 +1408,7 @@ type V0043Job struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillRequestUser User ID that requested termination of the job
 	KillRequestUser *string `json:"kill_request_user,omitempty"`
@@ -1571,7 +1571,7 @@ type V0043JobArrayResponseMsgEntry struct {
 	ErrorCode *int32 `json:"error_code,omitempty"`
 
 	// JobId Job ID for updated job
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// StepId Step ID for updated job
 	StepId *string `json:"step_id,omitempty"`
@@ -1688,7 +1688,7 @@ type V0043JobDescMsg struct {
 	Immediate *bool `json:"immediate,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillOnNodeFail If true, kill job on node failure
 	KillOnNodeFail   *bool                   `json:"kill_on_node_fail,omitempty"`
@@ -2059,7 +2059,7 @@ type V0043JobInfo struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId        *int32          `json:"job_id,omitempty"`
+	JobId        *int64          `json:"job_id,omitempty"`
 	JobResources *V0043JobRes    `json:"job_resources,omitempty"`
 	JobSizeStr   *V0043CsvString `json:"job_size_str,omitempty"`
 
@@ -2742,7 +2742,7 @@ type V0043OpenapiJobAllocResp struct {
 	Errors *V0043OpenapiErrors `json:"errors,omitempty"`
 
 	// JobId Submitted Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg Job submission user message
 	JobSubmitUserMsg *string               `json:"job_submit_user_msg,omitempty"`
@@ -2773,7 +2773,7 @@ type V0043OpenapiJobSubmitResponse struct {
 	Errors *V0043OpenapiErrors `json:"errors,omitempty"`
 
 	// JobId submitted Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg Job submission user message
 	JobSubmitUserMsg *string           `json:"job_submit_user_msg,omitempty"`
@@ -5268,7 +5268,9 @@ func (c *Client) SlurmV0043PostJobAllocateWithBody(ctx context.Context, contentT
 }
 
 func (c *Client) SlurmV0043PostJobAllocate(ctx context.Context, body SlurmV0043PostJobAllocateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043PostJobAllocateRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043PostJobAllocateRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5292,7 +5294,9 @@ func (c *Client) SlurmV0043PostJobSubmitWithBody(ctx context.Context, contentTyp
 }
 
 func (c *Client) SlurmV0043PostJobSubmit(ctx context.Context, body SlurmV0043PostJobSubmitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043PostJobSubmitRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043PostJobSubmitRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5340,7 +5344,9 @@ func (c *Client) SlurmV0043PostJobWithBody(ctx context.Context, jobId string, co
 }
 
 func (c *Client) SlurmV0043PostJob(ctx context.Context, jobId string, body SlurmV0043PostJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043PostJobRequest(c.Server, jobId, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043PostJobRequestWithBody(c.Server, jobId, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5364,7 +5370,9 @@ func (c *Client) SlurmV0043DeleteJobsWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmV0043DeleteJobs(ctx context.Context, body SlurmV0043DeleteJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043DeleteJobsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043DeleteJobsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5448,7 +5456,9 @@ func (c *Client) SlurmV0043PostNodeWithBody(ctx context.Context, nodeName string
 }
 
 func (c *Client) SlurmV0043PostNode(ctx context.Context, nodeName string, body SlurmV0043PostNodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043PostNodeRequest(c.Server, nodeName, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043PostNodeRequestWithBody(c.Server, nodeName, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5484,7 +5494,9 @@ func (c *Client) SlurmV0043PostNodesWithBody(ctx context.Context, contentType st
 }
 
 func (c *Client) SlurmV0043PostNodes(ctx context.Context, body SlurmV0043PostNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043PostNodesRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043PostNodesRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5556,7 +5568,9 @@ func (c *Client) SlurmV0043PostReservationWithBody(ctx context.Context, contentT
 }
 
 func (c *Client) SlurmV0043PostReservation(ctx context.Context, body SlurmV0043PostReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043PostReservationRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043PostReservationRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5616,7 +5630,9 @@ func (c *Client) SlurmV0043PostReservationsWithBody(ctx context.Context, content
 }
 
 func (c *Client) SlurmV0043PostReservations(ctx context.Context, body SlurmV0043PostReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0043PostReservationsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0043PostReservationsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5688,7 +5704,9 @@ func (c *Client) SlurmdbV0043PostAccountsWithBody(ctx context.Context, contentTy
 }
 
 func (c *Client) SlurmdbV0043PostAccounts(ctx context.Context, body SlurmdbV0043PostAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostAccountsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostAccountsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5712,7 +5730,9 @@ func (c *Client) SlurmdbV0043PostAccountsAssociationWithBody(ctx context.Context
 }
 
 func (c *Client) SlurmdbV0043PostAccountsAssociation(ctx context.Context, body SlurmdbV0043PostAccountsAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostAccountsAssociationRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostAccountsAssociationRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5784,7 +5804,9 @@ func (c *Client) SlurmdbV0043PostAssociationsWithBody(ctx context.Context, conte
 }
 
 func (c *Client) SlurmdbV0043PostAssociations(ctx context.Context, body SlurmdbV0043PostAssociationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostAssociationsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostAssociationsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5844,7 +5866,9 @@ func (c *Client) SlurmdbV0043PostClustersWithBody(ctx context.Context, params *S
 }
 
 func (c *Client) SlurmdbV0043PostClusters(ctx context.Context, params *SlurmdbV0043PostClustersParams, body SlurmdbV0043PostClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostClustersRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostClustersRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5880,7 +5904,9 @@ func (c *Client) SlurmdbV0043PostConfigWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0043PostConfig(ctx context.Context, body SlurmdbV0043PostConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostConfigRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostConfigRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5988,7 +6014,9 @@ func (c *Client) SlurmdbV0043PostQosWithBody(ctx context.Context, params *Slurmd
 }
 
 func (c *Client) SlurmdbV0043PostQos(ctx context.Context, params *SlurmdbV0043PostQosParams, body SlurmdbV0043PostQosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostQosRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostQosRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6048,7 +6076,9 @@ func (c *Client) SlurmdbV0043PostTresWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmdbV0043PostTres(ctx context.Context, body SlurmdbV0043PostTresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostTresRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostTresRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6108,7 +6138,9 @@ func (c *Client) SlurmdbV0043PostUsersWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0043PostUsers(ctx context.Context, body SlurmdbV0043PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostUsersRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostUsersRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6132,7 +6164,9 @@ func (c *Client) SlurmdbV0043PostUsersAssociationWithBody(ctx context.Context, p
 }
 
 func (c *Client) SlurmdbV0043PostUsersAssociation(ctx context.Context, params *SlurmdbV0043PostUsersAssociationParams, body SlurmdbV0043PostUsersAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostUsersAssociationRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostUsersAssociationRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6192,7 +6226,9 @@ func (c *Client) SlurmdbV0043PostWckeysWithBody(ctx context.Context, params *Slu
 }
 
 func (c *Client) SlurmdbV0043PostWckeys(ctx context.Context, params *SlurmdbV0043PostWckeysParams, body SlurmdbV0043PostWckeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0043PostWckeysRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0043PostWckeysRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -14357,14 +14393,14 @@ func ParseSlurmV0043GetDiagResponse(rsp *http.Response) (*SlurmV0043GetDiagRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14390,14 +14426,14 @@ func ParseSlurmV0043PostJobAllocateResponse(rsp *http.Response) (*SlurmV0043Post
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiJobAllocResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiJobAllocResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14423,14 +14459,14 @@ func ParseSlurmV0043PostJobSubmitResponse(rsp *http.Response) (*SlurmV0043PostJo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14456,14 +14492,14 @@ func ParseSlurmV0043DeleteJobResponse(rsp *http.Response) (*SlurmV0043DeleteJobR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiKillJobResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiKillJobResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14489,14 +14525,14 @@ func ParseSlurmV0043GetJobResponse(rsp *http.Response) (*SlurmV0043GetJobRespons
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14522,14 +14558,14 @@ func ParseSlurmV0043PostJobResponse(rsp *http.Response) (*SlurmV0043PostJobRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14555,14 +14591,14 @@ func ParseSlurmV0043DeleteJobsResponse(rsp *http.Response) (*SlurmV0043DeleteJob
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14588,14 +14624,14 @@ func ParseSlurmV0043GetJobsResponse(rsp *http.Response) (*SlurmV0043GetJobsRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14621,14 +14657,14 @@ func ParseSlurmV0043GetJobsStateResponse(rsp *http.Response) (*SlurmV0043GetJobs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14654,14 +14690,14 @@ func ParseSlurmV0043GetLicensesResponse(rsp *http.Response) (*SlurmV0043GetLicen
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14687,14 +14723,14 @@ func ParseSlurmV0043DeleteNodeResponse(rsp *http.Response) (*SlurmV0043DeleteNod
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14720,14 +14756,14 @@ func ParseSlurmV0043GetNodeResponse(rsp *http.Response) (*SlurmV0043GetNodeRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14753,14 +14789,14 @@ func ParseSlurmV0043PostNodeResponse(rsp *http.Response) (*SlurmV0043PostNodeRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14786,14 +14822,14 @@ func ParseSlurmV0043GetNodesResponse(rsp *http.Response) (*SlurmV0043GetNodesRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14819,14 +14855,14 @@ func ParseSlurmV0043PostNodesResponse(rsp *http.Response) (*SlurmV0043PostNodesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14852,14 +14888,14 @@ func ParseSlurmV0043GetPartitionResponse(rsp *http.Response) (*SlurmV0043GetPart
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14885,14 +14921,14 @@ func ParseSlurmV0043GetPartitionsResponse(rsp *http.Response) (*SlurmV0043GetPar
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14918,14 +14954,14 @@ func ParseSlurmV0043GetPingResponse(rsp *http.Response) (*SlurmV0043GetPingRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14951,14 +14987,14 @@ func ParseSlurmV0043GetReconfigureResponse(rsp *http.Response) (*SlurmV0043GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -14984,14 +15020,14 @@ func ParseSlurmV0043PostReservationResponse(rsp *http.Response) (*SlurmV0043Post
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15017,14 +15053,14 @@ func ParseSlurmV0043DeleteReservationResponse(rsp *http.Response) (*SlurmV0043De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15050,14 +15086,14 @@ func ParseSlurmV0043GetReservationResponse(rsp *http.Response) (*SlurmV0043GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15083,14 +15119,14 @@ func ParseSlurmV0043GetReservationsResponse(rsp *http.Response) (*SlurmV0043GetR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15116,14 +15152,14 @@ func ParseSlurmV0043PostReservationsResponse(rsp *http.Response) (*SlurmV0043Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15149,14 +15185,14 @@ func ParseSlurmV0043GetSharesResponse(rsp *http.Response) (*SlurmV0043GetSharesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15182,14 +15218,14 @@ func ParseSlurmdbV0043DeleteAccountResponse(rsp *http.Response) (*SlurmdbV0043De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15215,14 +15251,14 @@ func ParseSlurmdbV0043GetAccountResponse(rsp *http.Response) (*SlurmdbV0043GetAc
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15248,14 +15284,14 @@ func ParseSlurmdbV0043GetAccountsResponse(rsp *http.Response) (*SlurmdbV0043GetA
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15281,14 +15317,14 @@ func ParseSlurmdbV0043PostAccountsResponse(rsp *http.Response) (*SlurmdbV0043Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15314,14 +15350,14 @@ func ParseSlurmdbV0043PostAccountsAssociationResponse(rsp *http.Response) (*Slur
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15347,14 +15383,14 @@ func ParseSlurmdbV0043DeleteAssociationResponse(rsp *http.Response) (*SlurmdbV00
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15380,14 +15416,14 @@ func ParseSlurmdbV0043GetAssociationResponse(rsp *http.Response) (*SlurmdbV0043G
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15413,14 +15449,14 @@ func ParseSlurmdbV0043DeleteAssociationsResponse(rsp *http.Response) (*SlurmdbV0
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15446,14 +15482,14 @@ func ParseSlurmdbV0043GetAssociationsResponse(rsp *http.Response) (*SlurmdbV0043
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15479,14 +15515,14 @@ func ParseSlurmdbV0043PostAssociationsResponse(rsp *http.Response) (*SlurmdbV004
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15512,14 +15548,14 @@ func ParseSlurmdbV0043DeleteClusterResponse(rsp *http.Response) (*SlurmdbV0043De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15545,14 +15581,14 @@ func ParseSlurmdbV0043GetClusterResponse(rsp *http.Response) (*SlurmdbV0043GetCl
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15578,14 +15614,14 @@ func ParseSlurmdbV0043GetClustersResponse(rsp *http.Response) (*SlurmdbV0043GetC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15611,14 +15647,14 @@ func ParseSlurmdbV0043PostClustersResponse(rsp *http.Response) (*SlurmdbV0043Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15644,14 +15680,14 @@ func ParseSlurmdbV0043GetConfigResponse(rsp *http.Response) (*SlurmdbV0043GetCon
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15677,14 +15713,14 @@ func ParseSlurmdbV0043PostConfigResponse(rsp *http.Response) (*SlurmdbV0043PostC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15710,14 +15746,14 @@ func ParseSlurmdbV0043GetDiagResponse(rsp *http.Response) (*SlurmdbV0043GetDiagR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15743,14 +15779,14 @@ func ParseSlurmdbV0043GetInstanceResponse(rsp *http.Response) (*SlurmdbV0043GetI
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15776,14 +15812,14 @@ func ParseSlurmdbV0043GetInstancesResponse(rsp *http.Response) (*SlurmdbV0043Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15809,14 +15845,14 @@ func ParseSlurmdbV0043GetJobResponse(rsp *http.Response) (*SlurmdbV0043GetJobRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15842,14 +15878,14 @@ func ParseSlurmdbV0043GetJobsResponse(rsp *http.Response) (*SlurmdbV0043GetJobsR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15875,14 +15911,14 @@ func ParseSlurmdbV0043GetPingResponse(rsp *http.Response) (*SlurmdbV0043GetPingR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdPingResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdPingResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15908,14 +15944,14 @@ func ParseSlurmdbV0043GetQosResponse(rsp *http.Response) (*SlurmdbV0043GetQosRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15941,14 +15977,14 @@ func ParseSlurmdbV0043PostQosResponse(rsp *http.Response) (*SlurmdbV0043PostQosR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15974,14 +16010,14 @@ func ParseSlurmdbV0043DeleteSingleQosResponse(rsp *http.Response) (*SlurmdbV0043
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16007,14 +16043,14 @@ func ParseSlurmdbV0043GetSingleQosResponse(rsp *http.Response) (*SlurmdbV0043Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16040,14 +16076,14 @@ func ParseSlurmdbV0043GetTresResponse(rsp *http.Response) (*SlurmdbV0043GetTresR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16073,14 +16109,14 @@ func ParseSlurmdbV0043PostTresResponse(rsp *http.Response) (*SlurmdbV0043PostTre
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16106,14 +16142,14 @@ func ParseSlurmdbV0043DeleteUserResponse(rsp *http.Response) (*SlurmdbV0043Delet
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16139,14 +16175,14 @@ func ParseSlurmdbV0043GetUserResponse(rsp *http.Response) (*SlurmdbV0043GetUserR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16172,14 +16208,14 @@ func ParseSlurmdbV0043GetUsersResponse(rsp *http.Response) (*SlurmdbV0043GetUser
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16205,14 +16241,14 @@ func ParseSlurmdbV0043PostUsersResponse(rsp *http.Response) (*SlurmdbV0043PostUs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16238,14 +16274,14 @@ func ParseSlurmdbV0043PostUsersAssociationResponse(rsp *http.Response) (*Slurmdb
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16271,14 +16307,14 @@ func ParseSlurmdbV0043DeleteWckeyResponse(rsp *http.Response) (*SlurmdbV0043Dele
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16304,14 +16340,14 @@ func ParseSlurmdbV0043GetWckeyResponse(rsp *http.Response) (*SlurmdbV0043GetWcke
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16337,14 +16373,14 @@ func ParseSlurmdbV0043GetWckeysResponse(rsp *http.Response) (*SlurmdbV0043GetWck
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16370,14 +16406,14 @@ func ParseSlurmdbV0043PostWckeysResponse(rsp *http.Response) (*SlurmdbV0043PostW
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0043OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -1373,7 +1373,7 @@ type V0044Job struct {
 	AllocationNodes *int32 `json:"allocation_nodes,omitempty"`
 	Array           *struct {
 		// JobId Job ID of job array, or 0 if N/A
-		JobId  *int32 `json:"job_id,omitempty"`
+		JobId  *int64 `json:"job_id,omitempty"`
 		Limits *struct {
 			Max *struct {
 				Running *struct {
@@ -1426,7 +1426,7 @@ type V0044Job struct {
 	Group *string `json:"group,omitempty"`
 	Het   *struct {
 		// JobId Heterogeneous job ID, if applicable
-		JobId     *int32                  `json:"job_id,omitempty"`
+		JobId     *int64                  `json:"job_id,omitempty"`
 		JobOffset *V0044Uint32NoValStruct `json:"job_offset,omitempty"`
 	} `json:"het,omitempty"`
 
@@ -1434,7 +1434,7 @@ type V0044Job struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillRequestUser User ID that requested termination of the job
 	KillRequestUser *string `json:"kill_request_user,omitempty"`
@@ -1597,7 +1597,7 @@ type V0044JobArrayResponseMsgEntry struct {
 	ErrorCode *int32 `json:"error_code,omitempty"`
 
 	// JobId Job ID for updated job
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// StepId Step ID for updated job
 	StepId *string `json:"step_id,omitempty"`
@@ -1714,7 +1714,7 @@ type V0044JobDescMsg struct {
 	Immediate *bool `json:"immediate,omitempty"`
 
 	// JobId Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// KillOnNodeFail If true, kill job on node failure
 	KillOnNodeFail   *bool                   `json:"kill_on_node_fail,omitempty"`
@@ -2086,7 +2086,7 @@ type V0044JobInfo struct {
 	Hold *bool `json:"hold,omitempty"`
 
 	// JobId Job ID
-	JobId        *int32          `json:"job_id,omitempty"`
+	JobId        *int64          `json:"job_id,omitempty"`
 	JobResources *V0044JobRes    `json:"job_resources,omitempty"`
 	JobSizeStr   *V0044CsvString `json:"job_size_str,omitempty"`
 
@@ -2851,7 +2851,7 @@ type V0044OpenapiJobAllocResp struct {
 	Errors *V0044OpenapiErrors `json:"errors,omitempty"`
 
 	// JobId Submitted Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg Job submission user message
 	JobSubmitUserMsg *string               `json:"job_submit_user_msg,omitempty"`
@@ -2899,7 +2899,7 @@ type V0044OpenapiJobSubmitResponse struct {
 	Errors *V0044OpenapiErrors `json:"errors,omitempty"`
 
 	// JobId submitted Job ID
-	JobId *int32 `json:"job_id,omitempty"`
+	JobId *int64 `json:"job_id,omitempty"`
 
 	// JobSubmitUserMsg Job submission user message
 	JobSubmitUserMsg *string           `json:"job_submit_user_msg,omitempty"`
@@ -5454,7 +5454,9 @@ func (c *Client) SlurmV0044PostJobAllocateWithBody(ctx context.Context, contentT
 }
 
 func (c *Client) SlurmV0044PostJobAllocate(ctx context.Context, body SlurmV0044PostJobAllocateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostJobAllocateRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostJobAllocateRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5478,7 +5480,9 @@ func (c *Client) SlurmV0044PostJobSubmitWithBody(ctx context.Context, contentTyp
 }
 
 func (c *Client) SlurmV0044PostJobSubmit(ctx context.Context, body SlurmV0044PostJobSubmitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostJobSubmitRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostJobSubmitRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5526,7 +5530,9 @@ func (c *Client) SlurmV0044PostJobWithBody(ctx context.Context, jobId string, co
 }
 
 func (c *Client) SlurmV0044PostJob(ctx context.Context, jobId string, body SlurmV0044PostJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostJobRequest(c.Server, jobId, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostJobRequestWithBody(c.Server, jobId, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5550,7 +5556,9 @@ func (c *Client) SlurmV0044DeleteJobsWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmV0044DeleteJobs(ctx context.Context, body SlurmV0044DeleteJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044DeleteJobsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044DeleteJobsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5610,7 +5618,9 @@ func (c *Client) SlurmV0044PostNewNodeWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmV0044PostNewNode(ctx context.Context, body SlurmV0044PostNewNodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostNewNodeRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostNewNodeRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5658,7 +5668,9 @@ func (c *Client) SlurmV0044PostNodeWithBody(ctx context.Context, nodeName string
 }
 
 func (c *Client) SlurmV0044PostNode(ctx context.Context, nodeName string, body SlurmV0044PostNodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostNodeRequest(c.Server, nodeName, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostNodeRequestWithBody(c.Server, nodeName, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5694,7 +5706,9 @@ func (c *Client) SlurmV0044PostNodesWithBody(ctx context.Context, contentType st
 }
 
 func (c *Client) SlurmV0044PostNodes(ctx context.Context, body SlurmV0044PostNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostNodesRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostNodesRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5766,7 +5780,9 @@ func (c *Client) SlurmV0044PostReservationWithBody(ctx context.Context, contentT
 }
 
 func (c *Client) SlurmV0044PostReservation(ctx context.Context, body SlurmV0044PostReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostReservationRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostReservationRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5826,7 +5842,9 @@ func (c *Client) SlurmV0044PostReservationsWithBody(ctx context.Context, content
 }
 
 func (c *Client) SlurmV0044PostReservations(ctx context.Context, body SlurmV0044PostReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmV0044PostReservationsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmV0044PostReservationsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5910,7 +5928,9 @@ func (c *Client) SlurmdbV0044PostAccountsWithBody(ctx context.Context, contentTy
 }
 
 func (c *Client) SlurmdbV0044PostAccounts(ctx context.Context, body SlurmdbV0044PostAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostAccountsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostAccountsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -5934,7 +5954,9 @@ func (c *Client) SlurmdbV0044PostAccountsAssociationWithBody(ctx context.Context
 }
 
 func (c *Client) SlurmdbV0044PostAccountsAssociation(ctx context.Context, body SlurmdbV0044PostAccountsAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostAccountsAssociationRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostAccountsAssociationRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6006,7 +6028,9 @@ func (c *Client) SlurmdbV0044PostAssociationsWithBody(ctx context.Context, conte
 }
 
 func (c *Client) SlurmdbV0044PostAssociations(ctx context.Context, body SlurmdbV0044PostAssociationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostAssociationsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostAssociationsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6066,7 +6090,9 @@ func (c *Client) SlurmdbV0044PostClustersWithBody(ctx context.Context, params *S
 }
 
 func (c *Client) SlurmdbV0044PostClusters(ctx context.Context, params *SlurmdbV0044PostClustersParams, body SlurmdbV0044PostClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostClustersRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostClustersRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6102,7 +6128,9 @@ func (c *Client) SlurmdbV0044PostConfigWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0044PostConfig(ctx context.Context, body SlurmdbV0044PostConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostConfigRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostConfigRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6174,7 +6202,9 @@ func (c *Client) SlurmdbV0044PostJobWithBody(ctx context.Context, jobId string,
 }
 
 func (c *Client) SlurmdbV0044PostJob(ctx context.Context, jobId string, body SlurmdbV0044PostJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostJobRequest(c.Server, jobId, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostJobRequestWithBody(c.Server, jobId, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6210,7 +6240,9 @@ func (c *Client) SlurmdbV0044PostJobsWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmdbV0044PostJobs(ctx context.Context, body SlurmdbV0044PostJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostJobsRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostJobsRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6258,7 +6290,9 @@ func (c *Client) SlurmdbV0044PostQosWithBody(ctx context.Context, params *Slurmd
 }
 
 func (c *Client) SlurmdbV0044PostQos(ctx context.Context, params *SlurmdbV0044PostQosParams, body SlurmdbV0044PostQosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostQosRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostQosRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6318,7 +6352,9 @@ func (c *Client) SlurmdbV0044PostTresWithBody(ctx context.Context, contentType s
 }
 
 func (c *Client) SlurmdbV0044PostTres(ctx context.Context, body SlurmdbV0044PostTresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostTresRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostTresRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6378,7 +6414,9 @@ func (c *Client) SlurmdbV0044PostUsersWithBody(ctx context.Context, contentType
 }
 
 func (c *Client) SlurmdbV0044PostUsers(ctx context.Context, body SlurmdbV0044PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostUsersRequest(c.Server, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostUsersRequestWithBody(c.Server, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6402,7 +6440,9 @@ func (c *Client) SlurmdbV0044PostUsersAssociationWithBody(ctx context.Context, p
 }
 
 func (c *Client) SlurmdbV0044PostUsersAssociation(ctx context.Context, params *SlurmdbV0044PostUsersAssociationParams, body SlurmdbV0044PostUsersAssociationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostUsersAssociationRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostUsersAssociationRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -6462,7 +6502,9 @@ func (c *Client) SlurmdbV0044PostWckeysWithBody(ctx context.Context, params *Slu
 }
 
 func (c *Client) SlurmdbV0044PostWckeys(ctx context.Context, params *SlurmdbV0044PostWckeysParams, body SlurmdbV0044PostWckeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlurmdbV0044PostWckeysRequest(c.Server, params, body)
+	req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {
+		return NewSlurmdbV0044PostWckeysRequestWithBody(c.Server, params, "application/json", bodyReader)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -14974,14 +15016,14 @@ func ParseSlurmV0044GetDiagResponse(rsp *http.Response) (*SlurmV0044GetDiagRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiDiagResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15007,14 +15049,14 @@ func ParseSlurmV0044PostJobAllocateResponse(rsp *http.Response) (*SlurmV0044Post
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobAllocResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobAllocResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15040,14 +15082,14 @@ func ParseSlurmV0044PostJobSubmitResponse(rsp *http.Response) (*SlurmV0044PostJo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobSubmitResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15073,14 +15115,14 @@ func ParseSlurmV0044DeleteJobResponse(rsp *http.Response) (*SlurmV0044DeleteJobR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiKillJobResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiKillJobResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15106,14 +15148,14 @@ func ParseSlurmV0044GetJobResponse(rsp *http.Response) (*SlurmV0044GetJobRespons
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15139,14 +15181,14 @@ func ParseSlurmV0044PostJobResponse(rsp *http.Response) (*SlurmV0044PostJobRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobPostResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15172,14 +15214,14 @@ func ParseSlurmV0044DeleteJobsResponse(rsp *http.Response) (*SlurmV0044DeleteJob
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiKillJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15205,14 +15247,14 @@ func ParseSlurmV0044GetJobsResponse(rsp *http.Response) (*SlurmV0044GetJobsRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15238,14 +15280,14 @@ func ParseSlurmV0044GetJobsStateResponse(rsp *http.Response) (*SlurmV0044GetJobs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobInfoResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15271,14 +15313,14 @@ func ParseSlurmV0044GetLicensesResponse(rsp *http.Response) (*SlurmV0044GetLicen
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiLicensesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15304,14 +15346,14 @@ func ParseSlurmV0044PostNewNodeResponse(rsp *http.Response) (*SlurmV0044PostNewN
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15337,14 +15379,14 @@ func ParseSlurmV0044DeleteNodeResponse(rsp *http.Response) (*SlurmV0044DeleteNod
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15370,14 +15412,14 @@ func ParseSlurmV0044GetNodeResponse(rsp *http.Response) (*SlurmV0044GetNodeRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15403,14 +15445,14 @@ func ParseSlurmV0044PostNodeResponse(rsp *http.Response) (*SlurmV0044PostNodeRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15436,14 +15478,14 @@ func ParseSlurmV0044GetNodesResponse(rsp *http.Response) (*SlurmV0044GetNodesRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiNodesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15469,14 +15511,14 @@ func ParseSlurmV0044PostNodesResponse(rsp *http.Response) (*SlurmV0044PostNodesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15502,14 +15544,14 @@ func ParseSlurmV0044GetPartitionResponse(rsp *http.Response) (*SlurmV0044GetPart
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15535,14 +15577,14 @@ func ParseSlurmV0044GetPartitionsResponse(rsp *http.Response) (*SlurmV0044GetPar
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiPartitionResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15568,14 +15610,14 @@ func ParseSlurmV0044GetPingResponse(rsp *http.Response) (*SlurmV0044GetPingRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiPingArrayResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15601,14 +15643,14 @@ func ParseSlurmV0044GetReconfigureResponse(rsp *http.Response) (*SlurmV0044GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15634,14 +15676,14 @@ func ParseSlurmV0044PostReservationResponse(rsp *http.Response) (*SlurmV0044Post
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15667,14 +15709,14 @@ func ParseSlurmV0044DeleteReservationResponse(rsp *http.Response) (*SlurmV0044De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15700,14 +15742,14 @@ func ParseSlurmV0044GetReservationResponse(rsp *http.Response) (*SlurmV0044GetRe
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15733,14 +15775,14 @@ func ParseSlurmV0044GetReservationsResponse(rsp *http.Response) (*SlurmV0044GetR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiReservationResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15766,14 +15808,14 @@ func ParseSlurmV0044PostReservationsResponse(rsp *http.Response) (*SlurmV0044Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiReservationModResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15799,14 +15841,14 @@ func ParseSlurmV0044GetResourcesResponse(rsp *http.Response) (*SlurmV0044GetReso
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResourceLayoutResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResourceLayoutResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15832,14 +15874,14 @@ func ParseSlurmV0044GetSharesResponse(rsp *http.Response) (*SlurmV0044GetSharesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSharesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15865,14 +15907,14 @@ func ParseSlurmdbV0044DeleteAccountResponse(rsp *http.Response) (*SlurmdbV0044De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAccountsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15898,14 +15940,14 @@ func ParseSlurmdbV0044GetAccountResponse(rsp *http.Response) (*SlurmdbV0044GetAc
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15931,14 +15973,14 @@ func ParseSlurmdbV0044GetAccountsResponse(rsp *http.Response) (*SlurmdbV0044GetA
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAccountsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15964,14 +16006,14 @@ func ParseSlurmdbV0044PostAccountsResponse(rsp *http.Response) (*SlurmdbV0044Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -15997,14 +16039,14 @@ func ParseSlurmdbV0044PostAccountsAssociationResponse(rsp *http.Response) (*Slur
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAccountsAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16030,14 +16072,14 @@ func ParseSlurmdbV0044DeleteAssociationResponse(rsp *http.Response) (*SlurmdbV00
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16063,14 +16105,14 @@ func ParseSlurmdbV0044GetAssociationResponse(rsp *http.Response) (*SlurmdbV0044G
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16096,14 +16138,14 @@ func ParseSlurmdbV0044DeleteAssociationsResponse(rsp *http.Response) (*SlurmdbV0
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAssocsRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16129,14 +16171,14 @@ func ParseSlurmdbV0044GetAssociationsResponse(rsp *http.Response) (*SlurmdbV0044
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiAssocsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16162,14 +16204,14 @@ func ParseSlurmdbV0044PostAssociationsResponse(rsp *http.Response) (*SlurmdbV004
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16195,14 +16237,14 @@ func ParseSlurmdbV0044DeleteClusterResponse(rsp *http.Response) (*SlurmdbV0044De
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiClustersRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16228,14 +16270,14 @@ func ParseSlurmdbV0044GetClusterResponse(rsp *http.Response) (*SlurmdbV0044GetCl
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16261,14 +16303,14 @@ func ParseSlurmdbV0044GetClustersResponse(rsp *http.Response) (*SlurmdbV0044GetC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiClustersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16294,14 +16336,14 @@ func ParseSlurmdbV0044PostClustersResponse(rsp *http.Response) (*SlurmdbV0044Pos
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16327,14 +16369,14 @@ func ParseSlurmdbV0044GetConfigResponse(rsp *http.Response) (*SlurmdbV0044GetCon
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdConfigResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16360,14 +16402,14 @@ func ParseSlurmdbV0044PostConfigResponse(rsp *http.Response) (*SlurmdbV0044PostC
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16393,14 +16435,14 @@ func ParseSlurmdbV0044GetDiagResponse(rsp *http.Response) (*SlurmdbV0044GetDiagR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdStatsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16426,14 +16468,14 @@ func ParseSlurmdbV0044GetInstanceResponse(rsp *http.Response) (*SlurmdbV0044GetI
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16459,14 +16501,14 @@ func ParseSlurmdbV0044GetInstancesResponse(rsp *http.Response) (*SlurmdbV0044Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiInstancesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16492,14 +16534,14 @@ func ParseSlurmdbV0044GetJobResponse(rsp *http.Response) (*SlurmdbV0044GetJobRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16525,14 +16567,14 @@ func ParseSlurmdbV0044PostJobResponse(rsp *http.Response) (*SlurmdbV0044PostJobR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobModifyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobModifyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16558,14 +16600,14 @@ func ParseSlurmdbV0044GetJobsResponse(rsp *http.Response) (*SlurmdbV0044GetJobsR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdJobsResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16591,14 +16633,14 @@ func ParseSlurmdbV0044PostJobsResponse(rsp *http.Response) (*SlurmdbV0044PostJob
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiJobModifyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiJobModifyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16624,14 +16666,14 @@ func ParseSlurmdbV0044GetPingResponse(rsp *http.Response) (*SlurmdbV0044GetPingR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdPingResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdPingResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16657,14 +16699,14 @@ func ParseSlurmdbV0044GetQosResponse(rsp *http.Response) (*SlurmdbV0044GetQosRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16690,14 +16732,14 @@ func ParseSlurmdbV0044PostQosResponse(rsp *http.Response) (*SlurmdbV0044PostQosR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16723,14 +16765,14 @@ func ParseSlurmdbV0044DeleteSingleQosResponse(rsp *http.Response) (*SlurmdbV0044
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdQosRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16756,14 +16798,14 @@ func ParseSlurmdbV0044GetSingleQosResponse(rsp *http.Response) (*SlurmdbV0044Get
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiSlurmdbdQosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16789,14 +16831,14 @@ func ParseSlurmdbV0044GetTresResponse(rsp *http.Response) (*SlurmdbV0044GetTresR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiTresResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16822,14 +16864,14 @@ func ParseSlurmdbV0044PostTresResponse(rsp *http.Response) (*SlurmdbV0044PostTre
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16855,14 +16897,14 @@ func ParseSlurmdbV0044DeleteUserResponse(rsp *http.Response) (*SlurmdbV0044Delet
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16888,14 +16930,14 @@ func ParseSlurmdbV0044GetUserResponse(rsp *http.Response) (*SlurmdbV0044GetUserR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16921,14 +16963,14 @@ func ParseSlurmdbV0044GetUsersResponse(rsp *http.Response) (*SlurmdbV0044GetUser
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiUsersResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16954,14 +16996,14 @@ func ParseSlurmdbV0044PostUsersResponse(rsp *http.Response) (*SlurmdbV0044PostUs
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -16987,14 +17029,14 @@ func ParseSlurmdbV0044PostUsersAssociationResponse(rsp *http.Response) (*Slurmdb
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiUsersAddCondRespStr
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -17020,14 +17062,14 @@ func ParseSlurmdbV0044DeleteWckeyResponse(rsp *http.Response) (*SlurmdbV0044Dele
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiWckeyRemovedResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -17053,14 +17095,14 @@ func ParseSlurmdbV0044GetWckeyResponse(rsp *http.Response) (*SlurmdbV0044GetWcke
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -17086,14 +17128,14 @@ func ParseSlurmdbV0044GetWckeysResponse(rsp *http.Response) (*SlurmdbV0044GetWck
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiWckeyResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -17119,14 +17161,14 @@ func ParseSlurmdbV0044PostWckeysResponse(rsp *http.Response) (*SlurmdbV0044PostW
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest V0044OpenapiResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if err := unmarshalResponse(rsp, bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSONDefault = &dest
@@ -118,7 +118,7 @@ func (f *QoSFixtures) QoSCreateRequest(name string) *types.QoSCreate {
 		Name:           name,
 		Description:    "Test QoS creation",
 		Priority:       100,
-		Flags:          []string{"DenyOnLimit"},
+		Flags:          []types.QoSFlag{types.QoSFlagsDenyLimit},
 		PreemptMode:    []string{"cluster"},
 		GraceTime:      300,
 		UsageFactor:    1.5,
@@ -136,7 +136,7 @@ func (f *QoSFixtures) QoSUpdateRequest() *types.QoSUpdate {
 	return &types.QoSUpdate{
 		Description:    stringPtr("Updated description"),
 		Priority:       &priority,
-		Flags:          &[]string{"DenyOnLimit", "RequiresReservation"},
+		Flags:          &[]types.QoSFlag{types.QoSFlagsDenyLimit, types.QoSFlagsRequiredReservation},
 		PreemptMode:    &[]string{"suspend"},
 		UsageFactor:    &usageFactor,
 		UsageThreshold: &usageThreshold,
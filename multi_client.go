@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// clusterContextKey is the context key holding the target cluster name
+type clusterContextKey struct{}
+
+// WithCluster returns a context that targets the named cluster. A MultiClient
+// routes every call made with this context to the client registered under
+// name; single-cluster clients ignore it.
+func WithCluster(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, clusterContextKey{}, name)
+}
+
+// ClusterFromContext returns the cluster name set by WithCluster
+func ClusterFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(clusterContextKey{}).(string)
+	return name, ok && name != ""
+}
+
+// MultiClient fans a single SlurmClient out over several clusters. Each call
+// is routed to the cluster named in its context (see WithCluster), or to the
+// default cluster when the context names none.
+//
+// Version, Capabilities, Analytics and Events take no context and always refer
+// to the default cluster.
+type MultiClient struct {
+	clients        map[string]SlurmClient
+	defaultCluster string
+}
+
+var _ SlurmClient = (*MultiClient)(nil)
+
+// NewMultiClient creates a MultiClient from clients keyed by cluster name.
+// defaultCluster must be one of the keys.
+func NewMultiClient(clients map[string]SlurmClient, defaultCluster string) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.NewClientError(errors.ErrorCodeInvalidConfiguration, "at least one cluster client is required")
+	}
+	if _, ok := clients[defaultCluster]; !ok {
+		return nil, errors.NewClientError(errors.ErrorCodeInvalidConfiguration,
+			fmt.Sprintf("default cluster %q has no client", defaultCluster))
+	}
+	m := &MultiClient{
+		clients:        make(map[string]SlurmClient, len(clients)),
+		defaultCluster: defaultCluster,
+	}
+	for name, client := range clients {
+		m.clients[name] = client
+	}
+	return m, nil
+}
+
+// ClusterNames returns the registered cluster names in sorted order
+func (m *MultiClient) ClusterNames() []string {
+	names := make([]string, 0, len(m.clients))
+	for name := range m.clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Client returns the client targeted by ctx
+func (m *MultiClient) Client(ctx context.Context) (SlurmClient, error) {
+	name, ok := ClusterFromContext(ctx)
+	if !ok {
+		name = m.defaultCluster
+	}
+	client, ok := m.clients[name]
+	if !ok {
+		return nil, errors.NewClientError(errors.ErrorCodeInvalidRequest,
+			fmt.Sprintf("unknown cluster %q", name))
+	}
+	return client, nil
+}
+
+// Version returns the API version of the default cluster
+func (m *MultiClient) Version() string {
+	return m.clients[m.defaultCluster].Version()
+}
+
+// Capabilities returns the capabilities of the default cluster
+func (m *MultiClient) Capabilities() ClientCapabilities {
+	return m.clients[m.defaultCluster].Capabilities()
+}
+
+// ImplementedMethods returns the implemented methods of the default cluster
+func (m *MultiClient) ImplementedMethods() map[string][]string {
+	return m.clients[m.defaultCluster].ImplementedMethods()
+}
+
+// Analytics returns the AnalyticsManager of the default cluster
+func (m *MultiClient) Analytics() AnalyticsManager {
+	return m.clients[m.defaultCluster].Analytics()
+}
+
+// Jobs returns a JobManager that routes by context
+func (m *MultiClient) Jobs() JobManager { return &multiJobManager{m} }
+
+// Nodes returns a NodeManager that routes by context
+func (m *MultiClient) Nodes() NodeManager { return &multiNodeManager{m} }
+
+// Partitions returns a PartitionManager that routes by context
+func (m *MultiClient) Partitions() PartitionManager { return &multiPartitionManager{m} }
+
+// Info returns an InfoManager that routes by context
+func (m *MultiClient) Info() InfoManager { return &multiInfoManager{m} }
+
+// Reservations returns a ReservationManager that routes by context
+func (m *MultiClient) Reservations() ReservationManager { return &multiReservationManager{m} }
+
+// QoS returns a QoSManager that routes by context
+func (m *MultiClient) QoS() QoSManager { return &multiQoSManager{m} }
+
+// Accounts returns an AccountManager that routes by context
+func (m *MultiClient) Accounts() AccountManager { return &multiAccountManager{m} }
+
+// Users returns a UserManager that routes by context
+func (m *MultiClient) Users() UserManager { return &multiUserManager{m} }
+
+// Clusters returns a ClusterManager that routes by context
+func (m *MultiClient) Clusters() ClusterManager { return &multiClusterManager{m} }
+
+// Associations returns an AssociationManager that routes by context
+func (m *MultiClient) Associations() AssociationManager { return &multiAssociationManager{m} }
+
+// WCKeys returns a WCKeyManager that routes by context
+func (m *MultiClient) WCKeys() WCKeyManager { return &multiWCKeyManager{m} }
+
+// GetLicenses retrieves license information from the targeted cluster
+func (m *MultiClient) GetLicenses(ctx context.Context) (*types.LicenseList, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetLicenses(ctx)
+}
+
+// GetShares retrieves fairshare information from the targeted cluster
+func (m *MultiClient) GetShares(ctx context.Context, opts *types.GetSharesOptions) (*types.SharesList, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetShares(ctx, opts)
+}
+
+// GetConfig retrieves the configuration of the targeted cluster
+func (m *MultiClient) GetConfig(ctx context.Context) (*types.Config, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetConfig(ctx)
+}
+
+// GetDiagnostics retrieves diagnostics from the targeted cluster
+func (m *MultiClient) GetDiagnostics(ctx context.Context) (*types.Diagnostics, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDiagnostics(ctx)
+}
+
+// GetDBDiagnostics retrieves database diagnostics from the targeted cluster
+func (m *MultiClient) GetDBDiagnostics(ctx context.Context) (*types.Diagnostics, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDBDiagnostics(ctx)
+}
+
+// GetInstance retrieves a database instance from the targeted cluster
+func (m *MultiClient) GetInstance(ctx context.Context, opts *types.GetInstanceOptions) (*types.Instance, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetInstance(ctx, opts)
+}
+
+// GetInstances retrieves database instances from the targeted cluster
+func (m *MultiClient) GetInstances(ctx context.Context, opts *types.GetInstancesOptions) (*types.InstanceList, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetInstances(ctx, opts)
+}
+
+// GetTRES retrieves all TRES from the targeted cluster
+func (m *MultiClient) GetTRES(ctx context.Context) (*types.TRESList, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetTRES(ctx)
+}
+
+// CreateTRES creates a TRES entry on the targeted cluster
+func (m *MultiClient) CreateTRES(ctx context.Context, req *types.CreateTRESRequest) (*types.TRES, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateTRES(ctx, req)
+}
+
+// Reconfigure triggers a reconfiguration of the targeted cluster
+func (m *MultiClient) Reconfigure(ctx context.Context) (*types.ReconfigureResponse, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Reconfigure(ctx)
+}
+
+// Warmup opens connections to the targeted cluster
+func (m *MultiClient) Warmup(ctx context.Context, n int) error {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Warmup(ctx, n)
+}
+
+// CheckSLO probes the targeted cluster against slo
+func (m *MultiClient) CheckSLO(ctx context.Context, slo types.SLOConfig) (*types.SLOResult, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.CheckSLO(ctx, slo)
+}
+
+// Versions returns the versions of the targeted cluster
+func (m *MultiClient) Versions(ctx context.Context) (*types.VersionInfo, error) {
+	c, err := m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Versions(ctx)
+}
+
+// Events returns the events of the default cluster
+func (m *MultiClient) Events() <-chan ClientEvent {
+	return m.clients[m.defaultCluster].Events()
+}
+
+// Close closes every cluster client and returns the first error
+func (m *MultiClient) Close() error {
+	var firstErr error
+	for _, name := range m.ClusterNames() {
+		if err := m.clients[name].Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to close client for cluster %q: %w", name, err)
+		}
+	}
+	return firstErr
+}
+
+// Shutdown shuts down every cluster client and returns the first error
+func (m *MultiClient) Shutdown(ctx context.Context) error {
+	var firstErr error
+	for _, name := range m.ClusterNames() {
+		if err := m.clients[name].Shutdown(ctx); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to shut down client for cluster %q: %w", name, err)
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,866 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm
+
+import (
+	"context"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// The managers below resolve the target cluster on every call, so a manager
+// obtained once from a MultiClient can serve calls for any cluster.
+
+type multiJobManager struct {
+	m *MultiClient
+}
+
+func (p *multiJobManager) List(ctx context.Context, opts *types.ListJobsOptions) (*types.JobList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().List(ctx, opts)
+}
+
+func (p *multiJobManager) Get(ctx context.Context, jobID string) (*types.Job, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().Get(ctx, jobID)
+}
+
+func (p *multiJobManager) GetWithOptions(ctx context.Context, jobID string, opts *types.GetJobOptions) (*types.Job, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().GetWithOptions(ctx, jobID, opts)
+}
+
+func (p *multiJobManager) ListArrayTasks(ctx context.Context, arrayJobID string) ([]*types.Job, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().ListArrayTasks(ctx, arrayJobID)
+}
+
+func (p *multiJobManager) Submit(ctx context.Context, job *types.JobSubmission) (*types.JobSubmitResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().Submit(ctx, job)
+}
+
+func (p *multiJobManager) SubmitRaw(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().SubmitRaw(ctx, job)
+}
+
+func (p *multiJobManager) Update(ctx context.Context, jobID string, update *types.JobUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().Update(ctx, jobID, update)
+}
+
+func (p *multiJobManager) Cancel(ctx context.Context, jobID string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().Cancel(ctx, jobID)
+}
+
+func (p *multiJobManager) Hold(ctx context.Context, jobID string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().Hold(ctx, jobID)
+}
+
+func (p *multiJobManager) Release(ctx context.Context, jobID string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().Release(ctx, jobID)
+}
+
+func (p *multiJobManager) Signal(ctx context.Context, jobID string, signal string, opts *SignalOptions) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().Signal(ctx, jobID, signal, opts)
+}
+
+func (p *multiJobManager) Notify(ctx context.Context, jobID string, message string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().Notify(ctx, jobID, message)
+}
+
+func (p *multiJobManager) Requeue(ctx context.Context, jobID string, opts *RequeueOptions) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().Requeue(ctx, jobID, opts)
+}
+
+func (p *multiJobManager) Watch(ctx context.Context, opts *types.WatchJobsOptions) (<-chan types.JobEvent, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().Watch(ctx, opts)
+}
+
+func (p *multiJobManager) Allocate(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().Allocate(ctx, req)
+}
+
+func (p *multiJobManager) CancelByName(ctx context.Context, name string) (int, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return c.Jobs().CancelByName(ctx, name)
+}
+
+func (p *multiJobManager) CancelByUser(ctx context.Context, user string) (int, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return c.Jobs().CancelByUser(ctx, user)
+}
+
+func (p *multiJobManager) Script(ctx context.Context, jobID string) (string, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return "", err
+	}
+	return c.Jobs().Script(ctx, jobID)
+}
+
+func (p *multiJobManager) Validate(ctx context.Context, job *types.JobSubmission) (*types.ValidationResult, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().Validate(ctx, job)
+}
+
+func (p *multiJobManager) ListByTag(ctx context.Context, key, value string) ([]*types.Job, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().ListByTag(ctx, key, value)
+}
+
+func (p *multiJobManager) ListAll(ctx context.Context, opts *types.ListAllJobsOptions) ([]types.Job, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().ListAll(ctx, opts)
+}
+
+func (p *multiJobManager) WaitForArray(ctx context.Context, arrayJobID string, opts *types.WaitForArrayOptions) (*types.ArrayResult, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().WaitForArray(ctx, arrayJobID, opts)
+}
+
+func (p *multiJobManager) RunArray(ctx context.Context, base *types.JobSubmission, arraySpec string, opts *types.RunArrayOptions) (*types.ArrayRunReport, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().RunArray(ctx, base, arraySpec, opts)
+}
+
+func (p *multiJobManager) ListSteps(ctx context.Context, jobID string) ([]JobStep, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().ListSteps(ctx, jobID)
+}
+
+func (p *multiJobManager) TRESUsage(ctx context.Context, jobID string) (*TRESUsageReport, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().TRESUsage(ctx, jobID)
+}
+
+func (p *multiJobManager) History(ctx context.Context, opts *types.JobHistoryOptions) ([]types.Job, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Jobs().History(ctx, opts)
+}
+
+func (p *multiJobManager) CancelAndWait(ctx context.Context, jobIDs []string, opts *types.CancelAndWaitOptions) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().CancelAndWait(ctx, jobIDs, opts)
+}
+
+func (p *multiJobManager) CancelIfPending(ctx context.Context, jobID string) (bool, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return false, err
+	}
+	return c.Jobs().CancelIfPending(ctx, jobID)
+}
+
+func (p *multiJobManager) CancelStep(ctx context.Context, jobID, stepID string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Jobs().CancelStep(ctx, jobID, stepID)
+}
+
+type multiNodeManager struct {
+	m *MultiClient
+}
+
+func (p *multiNodeManager) List(ctx context.Context, opts *types.ListNodesOptions) (*types.NodeList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Nodes().List(ctx, opts)
+}
+
+func (p *multiNodeManager) Get(ctx context.Context, nodeName string) (*types.Node, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Nodes().Get(ctx, nodeName)
+}
+
+func (p *multiNodeManager) Update(ctx context.Context, nodeName string, update *types.NodeUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().Update(ctx, nodeName, update)
+}
+
+func (p *multiNodeManager) Delete(ctx context.Context, nodeName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().Delete(ctx, nodeName)
+}
+
+func (p *multiNodeManager) Drain(ctx context.Context, nodeName string, reason string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().Drain(ctx, nodeName, reason)
+}
+
+func (p *multiNodeManager) DrainNodes(ctx context.Context, nodeNames []string, reason string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().DrainNodes(ctx, nodeNames, reason)
+}
+
+func (p *multiNodeManager) Resume(ctx context.Context, nodeName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().Resume(ctx, nodeName)
+}
+
+func (p *multiNodeManager) Reboot(ctx context.Context, nodeName string, opts *types.RebootOptions) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().Reboot(ctx, nodeName, opts)
+}
+
+func (p *multiNodeManager) Watch(ctx context.Context, opts *types.WatchNodesOptions) (<-chan types.NodeEvent, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Nodes().Watch(ctx, opts)
+}
+
+func (p *multiNodeManager) PowerUsage(ctx context.Context) (map[string]types.PowerStats, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Nodes().PowerUsage(ctx)
+}
+
+func (p *multiNodeManager) WaitForState(ctx context.Context, nodes []string, state types.NodeState, opts *types.WaitForNodeStateOptions) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().WaitForState(ctx, nodes, state, opts)
+}
+
+func (p *multiNodeManager) SetPowerState(ctx context.Context, nodeName string, state types.NodePowerState) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Nodes().SetPowerState(ctx, nodeName, state)
+}
+
+func (p *multiNodeManager) GetPowerState(ctx context.Context, nodeName string) (types.NodePowerState, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return "", err
+	}
+	return c.Nodes().GetPowerState(ctx, nodeName)
+}
+
+type multiPartitionManager struct {
+	m *MultiClient
+}
+
+func (p *multiPartitionManager) List(ctx context.Context, opts *types.ListPartitionsOptions) (*types.PartitionList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Partitions().List(ctx, opts)
+}
+
+func (p *multiPartitionManager) Get(ctx context.Context, partitionName string) (*types.Partition, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Partitions().Get(ctx, partitionName)
+}
+
+func (p *multiPartitionManager) Create(ctx context.Context, partition *types.PartitionCreate) (*types.PartitionCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Partitions().Create(ctx, partition)
+}
+
+func (p *multiPartitionManager) Update(ctx context.Context, partitionName string, update *types.PartitionUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Partitions().Update(ctx, partitionName, update)
+}
+
+func (p *multiPartitionManager) Delete(ctx context.Context, partitionName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Partitions().Delete(ctx, partitionName)
+}
+
+func (p *multiPartitionManager) Watch(ctx context.Context, opts *types.WatchPartitionsOptions) (<-chan types.PartitionEvent, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Partitions().Watch(ctx, opts)
+}
+
+type multiInfoManager struct {
+	m *MultiClient
+}
+
+func (p *multiInfoManager) Get(ctx context.Context) (*types.ClusterInfo, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Info().Get(ctx)
+}
+
+func (p *multiInfoManager) Ping(ctx context.Context) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Info().Ping(ctx)
+}
+
+func (p *multiInfoManager) PingDatabase(ctx context.Context) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Info().PingDatabase(ctx)
+}
+
+func (p *multiInfoManager) Stats(ctx context.Context) (*types.ClusterStats, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Info().Stats(ctx)
+}
+
+func (p *multiInfoManager) Version(ctx context.Context) (*types.APIVersion, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Info().Version(ctx)
+}
+
+func (p *multiInfoManager) PingControllers(ctx context.Context) ([]types.PingResult, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Info().PingControllers(ctx)
+}
+
+func (p *multiInfoManager) TRESList(ctx context.Context) ([]TRES, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Info().TRESList(ctx)
+}
+
+func (p *multiInfoManager) QueueDepthHistory(ctx context.Context, window time.Duration) ([]types.QueueDepthSample, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Info().QueueDepthHistory(ctx, window)
+}
+
+type multiReservationManager struct {
+	m *MultiClient
+}
+
+func (p *multiReservationManager) List(ctx context.Context, opts *types.ListReservationsOptions) (*types.ReservationList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Reservations().List(ctx, opts)
+}
+
+func (p *multiReservationManager) Get(ctx context.Context, reservationName string) (*types.Reservation, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Reservations().Get(ctx, reservationName)
+}
+
+func (p *multiReservationManager) Create(ctx context.Context, reservation *types.ReservationCreate) (*types.ReservationCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Reservations().Create(ctx, reservation)
+}
+
+func (p *multiReservationManager) Update(ctx context.Context, reservationName string, update *types.ReservationUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Reservations().Update(ctx, reservationName, update)
+}
+
+func (p *multiReservationManager) Delete(ctx context.Context, reservationName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Reservations().Delete(ctx, reservationName)
+}
+
+func (p *multiReservationManager) Jobs(ctx context.Context, reservationName string) ([]*types.Job, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Reservations().Jobs(ctx, reservationName)
+}
+
+func (p *multiReservationManager) Skip(ctx context.Context, reservationName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Reservations().Skip(ctx, reservationName)
+}
+
+func (p *multiReservationManager) StartNow(ctx context.Context, reservationName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Reservations().StartNow(ctx, reservationName)
+}
+
+func (p *multiReservationManager) DrainJobs(ctx context.Context, reservationName string, opts *types.DrainReservationJobsOptions) (int, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return c.Reservations().DrainJobs(ctx, reservationName, opts)
+}
+
+func (p *multiReservationManager) ExportICal(ctx context.Context, opts *ExportICalOptions) (string, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return "", err
+	}
+	return c.Reservations().ExportICal(ctx, opts)
+}
+
+func (p *multiReservationManager) ReserveLicenses(ctx context.Context, license string, count int, ttl time.Duration, opts *types.ReserveLicensesOptions) (*types.LicenseReservation, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Reservations().ReserveLicenses(ctx, license, count, ttl, opts)
+}
+
+func (p *multiReservationManager) ReleaseLicenses(ctx context.Context, token string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Reservations().ReleaseLicenses(ctx, token)
+}
+
+type multiQoSManager struct {
+	m *MultiClient
+}
+
+func (p *multiQoSManager) List(ctx context.Context, opts *types.ListQoSOptions) (*types.QoSList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.QoS().List(ctx, opts)
+}
+
+func (p *multiQoSManager) Get(ctx context.Context, qosName string) (*types.QoS, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.QoS().Get(ctx, qosName)
+}
+
+func (p *multiQoSManager) Create(ctx context.Context, qos *types.QoSCreate) (*types.QoSCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.QoS().Create(ctx, qos)
+}
+
+func (p *multiQoSManager) Update(ctx context.Context, qosName string, update *types.QoSUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.QoS().Update(ctx, qosName, update)
+}
+
+func (p *multiQoSManager) Delete(ctx context.Context, qosName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.QoS().Delete(ctx, qosName)
+}
+
+type multiAccountManager struct {
+	m *MultiClient
+}
+
+func (p *multiAccountManager) List(ctx context.Context, opts *types.ListAccountsOptions) (*types.AccountList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Accounts().List(ctx, opts)
+}
+
+func (p *multiAccountManager) Get(ctx context.Context, accountName string) (*types.Account, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Accounts().Get(ctx, accountName)
+}
+
+func (p *multiAccountManager) Create(ctx context.Context, account *types.AccountCreate) (*types.AccountCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Accounts().Create(ctx, account)
+}
+
+func (p *multiAccountManager) Update(ctx context.Context, accountName string, update *types.AccountUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Accounts().Update(ctx, accountName, update)
+}
+
+func (p *multiAccountManager) Delete(ctx context.Context, accountName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Accounts().Delete(ctx, accountName)
+}
+
+func (p *multiAccountManager) Subtree(ctx context.Context, root string) (*types.AccountTree, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Accounts().Subtree(ctx, root)
+}
+
+func (p *multiAccountManager) FindEmpty(ctx context.Context) ([]*types.Account, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Accounts().FindEmpty(ctx)
+}
+
+type multiUserManager struct {
+	m *MultiClient
+}
+
+func (p *multiUserManager) List(ctx context.Context, opts *types.ListUsersOptions) (*types.UserList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Users().List(ctx, opts)
+}
+
+func (p *multiUserManager) Get(ctx context.Context, userName string) (*types.User, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Users().Get(ctx, userName)
+}
+
+func (p *multiUserManager) Create(ctx context.Context, user *types.UserCreate) (*types.UserCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Users().Create(ctx, user)
+}
+
+func (p *multiUserManager) Update(ctx context.Context, userName string, update *types.UserUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Users().Update(ctx, userName, update)
+}
+
+func (p *multiUserManager) Delete(ctx context.Context, userName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Users().Delete(ctx, userName)
+}
+
+func (p *multiUserManager) AllowedQoS(ctx context.Context, userName, accountName string) ([]string, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Users().AllowedQoS(ctx, userName, accountName)
+}
+
+func (p *multiUserManager) EffectiveLimits(ctx context.Context, userName string) (*UserEffectiveLimits, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Users().EffectiveLimits(ctx, userName)
+}
+
+type multiClusterManager struct {
+	m *MultiClient
+}
+
+func (p *multiClusterManager) List(ctx context.Context, opts *types.ListClustersOptions) (*types.ClusterList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Clusters().List(ctx, opts)
+}
+
+func (p *multiClusterManager) Get(ctx context.Context, clusterName string) (*types.Cluster, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Clusters().Get(ctx, clusterName)
+}
+
+func (p *multiClusterManager) Create(ctx context.Context, cluster *types.ClusterCreate) (*types.ClusterCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Clusters().Create(ctx, cluster)
+}
+
+func (p *multiClusterManager) Delete(ctx context.Context, clusterName string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Clusters().Delete(ctx, clusterName)
+}
+
+type multiAssociationManager struct {
+	m *MultiClient
+}
+
+func (p *multiAssociationManager) List(ctx context.Context, opts *types.ListAssociationsOptions) (*types.AssociationList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Associations().List(ctx, opts)
+}
+
+func (p *multiAssociationManager) Get(ctx context.Context, associationID string) (*types.Association, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Associations().Get(ctx, associationID)
+}
+
+func (p *multiAssociationManager) Create(ctx context.Context, associations []*types.AssociationCreate) (*types.AssociationCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Associations().Create(ctx, associations)
+}
+
+func (p *multiAssociationManager) Update(ctx context.Context, associations []*types.AssociationUpdate) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Associations().Update(ctx, associations)
+}
+
+func (p *multiAssociationManager) Delete(ctx context.Context, associationID string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.Associations().Delete(ctx, associationID)
+}
+
+func (p *multiAssociationManager) FindOrphans(ctx context.Context) ([]*types.Association, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Associations().FindOrphans(ctx)
+}
+
+type multiWCKeyManager struct {
+	m *MultiClient
+}
+
+func (p *multiWCKeyManager) List(ctx context.Context, opts *types.WCKeyListOptions) (*types.WCKeyList, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.WCKeys().List(ctx, opts)
+}
+
+func (p *multiWCKeyManager) Get(ctx context.Context, wckeyName, user, cluster string) (*types.WCKey, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.WCKeys().Get(ctx, wckeyName, user, cluster)
+}
+
+func (p *multiWCKeyManager) Create(ctx context.Context, wckey *types.WCKeyCreate) (*types.WCKeyCreateResponse, error) {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.WCKeys().Create(ctx, wckey)
+}
+
+func (p *multiWCKeyManager) Delete(ctx context.Context, wckeyID string) error {
+	c, err := p.m.Client(ctx)
+	if err != nil {
+		return err
+	}
+	return c.WCKeys().Delete(ctx, wckeyID)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jontk/slurm-client"
+	"github.com/jontk/slurm-client/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPingServer returns a server answering v0.0.44 pings and counting them
+func newPingServer(t *testing.T, hits *int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/slurm/v0.0.44/ping/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(hits, 1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP"}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMultiClient_RoutesByContext(t *testing.T) {
+	ctx := context.Background()
+
+	var alphaHits, betaHits int32
+	alphaServer := newPingServer(t, &alphaHits)
+	betaServer := newPingServer(t, &betaHits)
+
+	alpha, err := slurm.NewClientWithVersion(ctx, "v0.0.44", slurm.WithBaseURL(alphaServer.URL))
+	require.NoError(t, err)
+	beta, err := slurm.NewClientWithVersion(ctx, "v0.0.44", slurm.WithBaseURL(betaServer.URL))
+	require.NoError(t, err)
+
+	multi, err := slurm.NewMultiClient(map[string]slurm.SlurmClient{
+		"alpha": alpha,
+		"beta":  beta,
+	}, "alpha")
+	require.NoError(t, err)
+	defer multi.Close()
+
+	// A manager held by generic code is routed per call
+	info := multi.Info()
+
+	require.NoError(t, info.Ping(slurm.WithCluster(ctx, "beta")))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&alphaHits))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&betaHits))
+
+	// Without a cluster in the context the default cluster is used
+	require.NoError(t, info.Ping(ctx))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&alphaHits))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&betaHits))
+
+	err = info.Ping(slurm.WithCluster(ctx, "gamma"))
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeInvalidRequest, errors.GetErrorCode(err))
+}
+
+func TestNewMultiClient_UnknownDefault(t *testing.T) {
+	_, err := slurm.NewMultiClient(map[string]slurm.SlurmClient{}, "alpha")
+	require.Error(t, err)
+
+	name, ok := slurm.ClusterFromContext(slurm.WithCluster(context.Background(), "alpha"))
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", name)
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"fmt"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// CostRates are the prices of allocated resources per hour, in whatever
+// currency the site charges in
+type CostRates struct {
+	CPUHour      float64 `json:"cpu_hour"`
+	GPUHour      float64 `json:"gpu_hour"`
+	MemoryGBHour float64 `json:"memory_gb_hour"`
+}
+
+// Cost prices the given CPU-hours, GPU-hours and memory GB-hours
+func (r CostRates) Cost(cpuHours, gpuHours, memoryGBHours float64) float64 {
+	return cpuHours*r.CPUHour + gpuHours*r.GPUHour + memoryGBHours*r.MemoryGBHour
+}
+
+func (r CostRates) validate() error {
+	if r.CPUHour < 0 || r.GPUHour < 0 || r.MemoryGBHour < 0 {
+		return fmt.Errorf("cost rates cannot be negative")
+	}
+	return nil
+}
+
+// EstimateCost prices a job's allocated TRES for the time it has run: from
+// its start to its end, or to now while it is still running. Jobs that have
+// not started cost nothing; jobs without allocation details are an error.
+func EstimateCost(job *types.Job, rates CostRates) (float64, error) {
+	if job == nil {
+		return 0, fmt.Errorf("job cannot be nil")
+	}
+	if err := rates.validate(); err != nil {
+		return 0, err
+	}
+	hours := jobRunHours(job, time.Now())
+	if hours == 0 {
+		return 0, nil
+	}
+
+	alloc := job.Allocated()
+	if alloc.IsZero() {
+		return 0, fmt.Errorf("job %d has no allocated resources to price", getJobIDFromJob(job))
+	}
+	cpuHours := float64(alloc.CPUs) * hours
+	gpuHours := float64(alloc.GPUs()) * hours
+	memoryGBHours := float64(alloc.MemoryMB) / 1024 * hours
+	return rates.Cost(cpuHours, gpuHours, memoryGBHours), nil
+}
+
+// jobRunHours returns how long the job has run as of now. A running job's
+// EndTime is when its time limit runs out, so it counts up to now instead.
+func jobRunHours(job *types.Job, now time.Time) float64 {
+	if job.StartTime.IsZero() || job.StartTime.After(now) || isPending(job) {
+		return 0
+	}
+	end := job.EndTime
+	if end.IsZero() || end.After(now) {
+		end = now
+	}
+	if end.Before(job.StartTime) {
+		return 0
+	}
+	return end.Sub(job.StartTime).Hours()
+}
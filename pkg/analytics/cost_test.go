@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateCost(t *testing.T) {
+	rates := CostRates{CPUHour: 0.05, GPUHour: 2.00, MemoryGBHour: 0.01}
+	start := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		job      *types.Job
+		expected float64
+	}{
+		{
+			name: "cpu job",
+			job: &types.Job{
+				StartTime:    start,
+				EndTime:      start.Add(2 * time.Hour),
+				TRESAllocStr: ptrStringTest("cpu=16,mem=64G,node=1,billing=16"),
+				JobState:     []types.JobState{types.JobStateCompleted},
+			},
+			// 32 CPU-hours and 128 GB-hours
+			expected: 32*0.05 + 128*0.01,
+		},
+		{
+			name: "gpu job",
+			job: &types.Job{
+				StartTime:    start,
+				EndTime:      start.Add(30 * time.Minute),
+				TRESAllocStr: ptrStringTest("cpu=8,mem=32G,node=1,gres/gpu=4,gres/gpu:a100=4"),
+				JobState:     []types.JobState{types.JobStateCompleted},
+			},
+			// 4 CPU-hours, 2 GPU-hours and 16 GB-hours; typed GPUs are not counted twice
+			expected: 4*0.05 + 2*2.00 + 16*0.01,
+		},
+		{
+			name: "never started",
+			job: &types.Job{
+				StartTime: start,
+				JobState:  []types.JobState{types.JobStatePending},
+			},
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, err := EstimateCost(tt.job, rates)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, cost, 1e-9)
+		})
+	}
+}
+
+func TestEstimateCost_Running(t *testing.T) {
+	// A running job is priced up to now, not to the end of its time limit
+	job := &types.Job{
+		StartTime:    time.Now().Add(-time.Hour),
+		EndTime:      time.Now().Add(23 * time.Hour),
+		TRESAllocStr: ptrStringTest("cpu=10,node=1"),
+		JobState:     []types.JobState{types.JobStateRunning},
+	}
+	cost, err := EstimateCost(job, CostRates{CPUHour: 1})
+	require.NoError(t, err)
+	assert.InDelta(t, 10.0, cost, 0.01)
+}
+
+func TestEstimateCost_Errors(t *testing.T) {
+	_, err := EstimateCost(nil, CostRates{})
+	require.Error(t, err)
+
+	started := &types.Job{
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now(),
+	}
+	_, err = EstimateCost(started, CostRates{CPUHour: -1})
+	require.Error(t, err)
+
+	// Started, but nothing is known about its allocation
+	_, err = EstimateCost(started, CostRates{CPUHour: 1})
+	require.Error(t, err)
+}
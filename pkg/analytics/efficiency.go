@@ -5,37 +5,34 @@ package analytics
 
 import (
 	"math"
-	"strconv"
 	"strings"
 	"time"
 
 	types "github.com/jontk/slurm-client/api"
 )
 
-// parseGPUCount extracts GPU count from SLURM Gres string
-// Gres format examples: "gpu:2", "gpu:tesla:2", "gpu:4,mic:1"
-func parseGPUCount(gres string) int {
-	if gres == "" {
-		return 0
-	}
-
-	// Split by comma to handle multiple Gres specs
-	parts := strings.Split(gres, ",")
-	for _, part := range parts {
-		// Check if this part is about GPUs
-		if strings.HasPrefix(strings.TrimSpace(part), "gpu") {
-			// Split by colon: gpu:type:count or gpu:count
-			segments := strings.Split(part, ":")
-			if len(segments) >= 2 {
-				// Last segment should be the count
-				countStr := segments[len(segments)-1]
-				if count, err := strconv.Atoi(strings.TrimSpace(countStr)); err == nil {
-					return count
-				}
-			}
+// gpuCount sums the GPUs of a GRES or TRES string such as
+// "gpu:a100:4(S:0-1)", "gres/gpu:a100:2,gres/gpu:v100:1" or
+// "cpu=4,gres/gpu=2,gres/gpu:a100=2". A TRES string lists the total of a
+// resource beside its typed counts, so the untyped count wins when present.
+func gpuCount(gres string) int {
+	var untyped, typed int64
+	hasUntyped := false
+	for _, entry := range types.ParseGRES(strings.ReplaceAll(gres, "=", ":")) {
+		if entry.Name != types.GRESGPU {
+			continue
+		}
+		if entry.Type == "" {
+			untyped += entry.Count
+			hasUntyped = true
+		} else {
+			typed += entry.Count
 		}
 	}
-	return 0
+	if hasUntyped {
+		return int(untyped)
+	}
+	return int(typed)
 }
 
 // getJobCPUs safely extracts the CPU count from a Job
@@ -47,88 +44,41 @@ func getJobCPUs(job *types.Job) uint32 {
 }
 
 // getJobIDFromJob safely extracts the job ID from a Job
-func getJobIDFromJob(job *types.Job) int32 {
+func getJobIDFromJob(job *types.Job) int64 {
 	if job == nil || job.JobID == nil {
 		return 0
 	}
 	return *job.JobID
 }
 
-// getJobMemoryBytes estimates total memory from available fields (returns bytes)
+// getJobMemoryBytes returns the job's requested memory in bytes
 func getJobMemoryBytes(job *types.Job) uint64 {
 	if job == nil {
 		return 0
 	}
-
-	// Try memory per node first
-	if job.MemoryPerNode != nil && *job.MemoryPerNode > 0 {
-		// MemoryPerNode is in MB, convert to bytes
-		return *job.MemoryPerNode * 1024 * 1024
-	}
-
-	// Try memory per CPU * CPUs
-	if job.MemoryPerCPU != nil && *job.MemoryPerCPU > 0 && job.CPUs != nil {
-		// MemoryPerCPU is in MB
-		return *job.MemoryPerCPU * uint64(*job.CPUs) * 1024 * 1024
-	}
-
-	return 0
+	return uint64(job.Requested().MemoryMB) * 1024 * 1024
 }
 
-// getJobGres extracts GRES info from the job
-// Returns a GRES string like "gpu:2" from available fields
-func getJobGres(job *types.Job) string {
+// jobGPUCount returns the GPUs a job requested, read from the first of its
+// per-node TRES, GRES detail and requested TRES that names any
+func jobGPUCount(job *types.Job) int {
 	if job == nil {
-		return ""
-	}
-
-	// Try TRESPerNode first (comma-separated TRES like "gres/gpu:1")
-	if job.TRESPerNode != nil && *job.TRESPerNode != "" {
-		// Parse TRES string for GPU info
-		if gres := extractGresFromTRES(*job.TRESPerNode); gres != "" {
-			return gres
-		}
+		return 0
 	}
-
-	// Try GRESDetail (list of detailed GRES allocations)
-	if len(job.GRESDetail) > 0 {
-		// Return first non-empty GRES detail containing gpu
-		for _, detail := range job.GRESDetail {
-			if detail != "" && strings.Contains(strings.ToLower(detail), "gpu") {
-				return detail
-			}
-		}
-		// Return first non-empty detail if no gpu found
-		for _, detail := range job.GRESDetail {
-			if detail != "" {
-				return detail
-			}
-		}
+	sources := make([]string, 0, 3)
+	if job.TRESPerNode != nil {
+		sources = append(sources, *job.TRESPerNode)
 	}
-
-	// Try TRESReqStr as fallback
-	if job.TRESReqStr != nil && *job.TRESReqStr != "" {
-		if gres := extractGresFromTRES(*job.TRESReqStr); gres != "" {
-			return gres
-		}
+	sources = append(sources, strings.Join(job.GRESDetail, ","))
+	if job.TRESReqStr != nil {
+		sources = append(sources, *job.TRESReqStr)
 	}
-
-	return ""
-}
-
-// extractGresFromTRES extracts GPU info from a TRES string
-// TRES format: "cpu=4,mem=16G,node=1,gres/gpu=2" or "gres/gpu:1"
-func extractGresFromTRES(tres string) string {
-	// Look for gres/gpu patterns
-	for _, part := range strings.Split(tres, ",") {
-		part = strings.TrimSpace(part)
-		lowerPart := strings.ToLower(part)
-		if strings.Contains(lowerPart, "gpu") {
-			// Return the part as-is
-			return part
+	for _, gres := range sources {
+		if count := gpuCount(gres); count > 0 {
+			return count
 		}
 	}
-	return ""
+	return 0
 }
 
 // EfficiencyCalculator provides methods for calculating job efficiency metrics
@@ -521,7 +471,7 @@ func (ec *EfficiencyCalculator) CalculateResourceWaste(
 	}
 
 	// GPU waste (in GPU-hours) - parse from Gres field
-	if gpuCount := parseGPUCount(getJobGres(job)); gpuCount > 0 {
+	if gpuCount := jobGPUCount(job); gpuCount > 0 {
 		// Estimate GPU efficiency from overall efficiency if available
 		gpuEfficiency := analytics.OverallEfficiency
 		if gpuEfficiency == 0 {
@@ -617,7 +567,7 @@ func (ec *EfficiencyCalculator) GenerateOptimizationRecommendations(
 	}
 
 	// GPU recommendations - use overall efficiency as proxy for GPU efficiency
-	if gpuCount := parseGPUCount(getJobGres(job)); gpuCount > 0 && analytics.OverallEfficiency < 50.0 {
+	if gpuCount := jobGPUCount(job); gpuCount > 0 && analytics.OverallEfficiency < 50.0 {
 		recommendations = append(recommendations, OptimizationRecommendation{
 			Resource:    "GPU",
 			Type:        "reduction",
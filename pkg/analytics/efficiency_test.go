@@ -16,7 +16,7 @@ import (
 )
 
 // Helper functions for pointer types
-func ptrInt32(i int32) *int32    { return &i }
+func ptrInt64(i int64) *int64    { return &i }
 func ptrUint32(u uint32) *uint32 { return &u }
 func ptrUint64(u uint64) *uint64 { return &u }
 
@@ -335,7 +335,7 @@ func TestCalculateResourceWaste(t *testing.T) {
 	calc := NewEfficiencyCalculator()
 
 	job := &types.Job{
-		JobID:         ptrInt32(12345),
+		JobID:         ptrInt64(12345),
 		CPUs:          ptrUint32(16),
 		MemoryPerNode: ptrUint64(64 * 1024), // 64GB in MB
 	}
@@ -378,7 +378,7 @@ func TestGenerateOptimizationRecommendations(t *testing.T) {
 	calc := NewEfficiencyCalculator()
 
 	job := &types.Job{
-		JobID:         ptrInt32(12345),
+		JobID:         ptrInt64(12345),
 		CPUs:          ptrUint32(16),
 		MemoryPerNode: ptrUint64(64 * 1024), // 64GB in MB
 	}
@@ -472,3 +472,34 @@ func TestCalculateCoreUtilizationVariance(t *testing.T) {
 	variance = calc.calculateCoreUtilizationVariance(imbalancedCores)
 	assert.Greater(t, variance, 30.0) // High variance
 }
+
+func TestGPUCount(t *testing.T) {
+	tests := []struct {
+		gres string
+		want int
+	}{
+		{"", 0},
+		{"gpu:2", 2},
+		{"gpu:a100:4(S:0-1)", 4},
+		{"gpu:a100:2(IDX:0,2),mps:400(S:0-1)", 2},
+		{"gres/gpu:a100:2,gres/gpu:v100:1", 3},
+		{"cpu=4,mem=16G,node=1,gres/gpu=2,gres/gpu:a100=2", 2},
+		{"gres/gpu:a100=2", 2},
+		{"mic:1", 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, gpuCount(tt.gres), tt.gres)
+	}
+}
+
+func TestJobGPUCount(t *testing.T) {
+	perNode := "gres/gpu:a100:2,gres/gpu:v100:2"
+	assert.Equal(t, 4, jobGPUCount(&types.Job{TRESPerNode: &perNode}))
+
+	requested := "cpu=8,gres/gpu=3"
+	assert.Equal(t, 3, jobGPUCount(&types.Job{
+		GRESDetail: []string{"mps:100"},
+		TRESReqStr: &requested,
+	}))
+	assert.Zero(t, jobGPUCount(nil))
+}
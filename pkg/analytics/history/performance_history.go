@@ -70,7 +70,7 @@ func (pht *PerformanceHistoryTracker) GetJobPerformanceHistory(
 	if job.Name != nil {
 		jobName = *job.Name
 	}
-	jobID := int32(0)
+	jobID := int64(0)
 	if job.JobID != nil {
 		jobID = *job.JobID
 	}
@@ -19,7 +19,7 @@ import (
 )
 
 // Helper functions for pointer types
-func ptrInt32(i int32) *int32    { return &i }
+func ptrInt64(i int64) *int64    { return &i }
 func ptrUint32(u uint32) *uint32 { return &u }
 func ptrUint64(u uint64) *uint64 { return &u }
 func ptrString(s string) *string { return &s }
@@ -31,7 +31,7 @@ func TestPerformanceHistoryTracker_GetJobPerformanceHistory(t *testing.T) {
 	startTime := time.Now().Add(-2 * time.Hour)
 	endTime := time.Now()
 	job := &types.Job{
-		JobID:         ptrInt32(123),
+		JobID:         ptrInt64(123),
 		Name:          ptrString("test-job"),
 		CPUs:          ptrUint32(16),
 		MemoryPerNode: ptrUint64(64 * 1024), // 64GB in MB
@@ -76,7 +76,7 @@ func TestPerformanceHistoryTracker_GetJobPerformanceHistory_WithOptions(t *testi
 	startTime := time.Now().Add(-4 * time.Hour)
 	endTime := time.Now()
 	job := &types.Job{
-		JobID:         ptrInt32(456),
+		JobID:         ptrInt64(456),
 		Name:          ptrString("filtered-job"),
 		CPUs:          ptrUint32(8),
 		MemoryPerNode: ptrUint64(32 * 1024), // 32GB in MB
@@ -391,7 +391,7 @@ func TestPerformanceHistoryTracker_ErrorCases(t *testing.T) {
 	assert.Contains(t, err.Error(), "job cannot be nil")
 
 	// Test with empty samples
-	job := &types.Job{JobID: ptrInt32(999)}
+	job := &types.Job{JobID: ptrInt64(999)}
 	_, err = tracker.GetJobPerformanceHistory(context.Background(), job, []types.JobComprehensiveAnalytics{}, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "no performance samples provided")
@@ -400,7 +400,7 @@ func TestPerformanceHistoryTracker_ErrorCases(t *testing.T) {
 	startTime := time.Now().Add(-2 * time.Hour)
 	endTime := time.Now()
 	job = &types.Job{
-		JobID:     ptrInt32(999),
+		JobID:     ptrInt64(999),
 		StartTime: startTime,
 		EndTime:   endTime,
 	}
@@ -171,8 +171,8 @@ func (pa *PerformanceAnalyzer) calculateResourceDifferences(jobA, jobB *types.Jo
 	}
 
 	// Check GPU allocation from GRES field
-	gpuA := parseGPUCount(getJobGres(jobA))
-	gpuB := parseGPUCount(getJobGres(jobB))
+	gpuA := jobGPUCount(jobA)
+	gpuB := jobGPUCount(jobB)
 	diffs.GPUDelta = gpuB - gpuA
 
 	runtimeA := pa.getJobRuntime(jobA)
@@ -239,8 +239,8 @@ func (pa *PerformanceAnalyzer) generateComparisonSummary(comparison *JobPerforma
 		float64(memA)/(1024*1024*1024),
 		float64(memB)/(1024*1024*1024),
 		diffs.MemoryDeltaGB)
-	gpuA := parseGPUCount(getJobGres(comparison.JobA))
-	gpuB := parseGPUCount(getJobGres(comparison.JobB))
+	gpuA := jobGPUCount(comparison.JobA)
+	gpuB := jobGPUCount(comparison.JobB)
 	if gpuA > 0 || gpuB > 0 {
 		summary += fmt.Sprintf("  GPU: A=%d, B=%d (diff: %+d)\n",
 			gpuA, gpuB, diffs.GPUDelta)
@@ -258,8 +258,8 @@ func (pa *PerformanceAnalyzer) generateComparisonSummary(comparison *JobPerforma
 		pa.betterOrWorse(metrics.OverallEfficiencyDelta))
 	summary += fmt.Sprintf("  CPU: %+.1f%%\n", metrics.CPUEfficiencyDelta)
 	summary += fmt.Sprintf("  Memory: %+.1f%%\n", metrics.MemoryEfficiencyDelta)
-	gpuA2 := parseGPUCount(getJobGres(comparison.JobA))
-	gpuB2 := parseGPUCount(getJobGres(comparison.JobB))
+	gpuA2 := jobGPUCount(comparison.JobA)
+	gpuB2 := jobGPUCount(comparison.JobB)
 	if gpuA2 > 0 || gpuB2 > 0 {
 		summary += fmt.Sprintf("  GPU: %+.1f%%\n", metrics.GPUEfficiencyDelta)
 	}
@@ -482,8 +482,8 @@ func (pa *PerformanceAnalyzer) calculateJobSimilarity(jobA, jobB *types.Job) flo
 	weights += 0.2
 
 	// GPU similarity (10% weight)
-	gpuA := parseGPUCount(getJobGres(jobA))
-	gpuB := parseGPUCount(getJobGres(jobB))
+	gpuA := jobGPUCount(jobA)
+	gpuB := jobGPUCount(jobB)
 	if gpuA == gpuB {
 		similarity += 0.1
 	} else if gpuA > 0 && gpuB > 0 {
@@ -572,7 +572,7 @@ func (pa *PerformanceAnalyzer) calculatePerformanceStatistics(similarJobs []*Sim
 		stats.OptimalResources = ResourceRecommendation{
 			CPUs:     int(getJobCPUs(bestJob.Job)),
 			MemoryGB: float64(getJobMemoryBytes(bestJob.Job)) / (1024 * 1024 * 1024),
-			GPUs:     parseGPUCount(getJobGres(bestJob.Job)),
+			GPUs:     jobGPUCount(bestJob.Job),
 			Reasoning: fmt.Sprintf("Based on best performing similar job with %.1f%% efficiency",
 				stats.BestEfficiency),
 		}
@@ -727,7 +727,7 @@ func (pa *PerformanceAnalyzer) calculateResourceCost(job *types.Job, runtime tim
 	hours := runtime.Hours()
 	cpuCost := float64(getJobCPUs(job)) * hours
 	memoryCost := float64(getJobMemoryBytes(job)) / (1024 * 1024 * 1024) * hours * 0.1 // Memory is cheaper
-	gpuCount := parseGPUCount(getJobGres(job))
+	gpuCount := jobGPUCount(job)
 	gpuCost := float64(gpuCount) * hours * 10.0 // GPUs are expensive
 
 	return cpuCost + memoryCost + gpuCost
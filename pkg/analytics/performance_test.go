@@ -18,7 +18,7 @@ import (
 )
 
 // Helper functions for pointer types
-func ptrInt32Test(i int32) *int32    { return &i }
+func ptrInt64Test(i int64) *int64    { return &i }
 func ptrUint32Test(u uint32) *uint32 { return &u }
 func ptrUint64Test(u uint64) *uint64 { return &u }
 func ptrStringTest(s string) *string { return &s }
@@ -30,7 +30,7 @@ func TestCompareJobPerformance(t *testing.T) {
 	startTimeA := time.Now().Add(-2 * time.Hour)
 	endTimeA := time.Now().Add(-1 * time.Hour)
 	jobA := &types.Job{
-		JobID:         ptrInt32Test(1),
+		JobID:         ptrInt64Test(1),
 		Name:          ptrStringTest("test-job"),
 		CPUs:          ptrUint32Test(16),
 		MemoryPerNode: ptrUint64Test(64 * 1024), // 64GB in MB
@@ -41,7 +41,7 @@ func TestCompareJobPerformance(t *testing.T) {
 	startTimeB := time.Now().Add(-90 * time.Minute)
 	endTimeB := time.Now().Add(-45 * time.Minute)
 	jobB := &types.Job{
-		JobID:         ptrInt32Test(2),
+		JobID:         ptrInt64Test(2),
 		Name:          ptrStringTest("test-job-optimized"),
 		CPUs:          ptrUint32Test(12),
 		MemoryPerNode: ptrUint64Test(48 * 1024), // 48GB in MB
@@ -112,7 +112,7 @@ func TestCompareJobPerformance(t *testing.T) {
 func TestCompareJobPerformance_Errors(t *testing.T) {
 	analyzer := NewPerformanceAnalyzer()
 
-	job := &types.Job{JobID: ptrInt32Test(999)}
+	job := &types.Job{JobID: ptrInt64Test(999)}
 	analytics := &types.JobComprehensiveAnalytics{}
 
 	// Test nil jobs
@@ -137,7 +137,7 @@ func TestGetSimilarJobsPerformance(t *testing.T) {
 	startTime := time.Now().Add(-1 * time.Hour)
 	endTime := time.Now()
 	referenceJob := &types.Job{
-		JobID:         ptrInt32Test(100),
+		JobID:         ptrInt64Test(100),
 		Name:          ptrStringTest("matrix-multiply"),
 		UserName:      ptrStringTest("user123"),
 		Partition:     ptrStringTest("compute"),
@@ -159,7 +159,7 @@ func TestGetSimilarJobsPerformance(t *testing.T) {
 		{
 			// Very similar job with better performance
 			Job: &types.Job{
-				JobID:         ptrInt32Test(101),
+				JobID:         ptrInt64Test(101),
 				Name:          ptrStringTest("matrix-multiply"),
 				UserName:      ptrStringTest("user123"),
 				Partition:     ptrStringTest("compute"),
@@ -175,7 +175,7 @@ func TestGetSimilarJobsPerformance(t *testing.T) {
 		{
 			// Similar job with different resources
 			Job: &types.Job{
-				JobID:         ptrInt32Test(102),
+				JobID:         ptrInt64Test(102),
 				Name:          ptrStringTest("matrix-multiply"),
 				UserName:      ptrStringTest("user123"),
 				Partition:     ptrStringTest("compute"),
@@ -191,7 +191,7 @@ func TestGetSimilarJobsPerformance(t *testing.T) {
 		{
 			// Less similar job (different user)
 			Job: &types.Job{
-				JobID:         ptrInt32Test(103),
+				JobID:         ptrInt64Test(103),
 				Name:          ptrStringTest("matrix-multiply"),
 				UserName:      ptrStringTest("user456"),
 				Partition:     ptrStringTest("compute"),
@@ -207,7 +207,7 @@ func TestGetSimilarJobsPerformance(t *testing.T) {
 		{
 			// Dissimilar job (should be filtered out)
 			Job: &types.Job{
-				JobID:         ptrInt32Test(104),
+				JobID:         ptrInt64Test(104),
 				Name:          ptrStringTest("deep-learning"),
 				UserName:      ptrStringTest("user789"),
 				Partition:     ptrStringTest("gpu"),
@@ -238,7 +238,7 @@ func TestGetSimilarJobsPerformance(t *testing.T) {
 	}
 
 	// Check that jobs are sorted by efficiency (best first)
-	assert.Equal(t, int32(101), *analysis.SimilarJobs[0].Job.JobID)
+	assert.Equal(t, int64(101), *analysis.SimilarJobs[0].Job.JobID)
 	assert.Equal(t, 1, analysis.SimilarJobs[0].PerformanceRank)
 	assert.InDelta(t, 15.0, analysis.SimilarJobs[0].EfficiencyDelta, 0.1)
 
@@ -388,7 +388,7 @@ func TestGenerateRecommendations(t *testing.T) {
 
 	// Reference job with suboptimal resources
 	referenceJob := &types.Job{
-		JobID:         ptrInt32Test(200),
+		JobID:         ptrInt64Test(200),
 		CPUs:          ptrUint32Test(32),         // Over-allocated
 		MemoryPerNode: ptrUint64Test(128 * 1024), // Over-allocated
 	}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// WaitHistoryWindow is how far back PredictWait looks for similar jobs
+const WaitHistoryWindow = 7 * 24 * time.Hour
+
+// ErrNoWaitHistory is returned by PredictWait when no recent job resembles
+// the submission closely enough to base a prediction on.
+var ErrNoWaitHistory = errors.New("no similar jobs in recent history")
+
+// PredictWait estimates how long a job shaped like spec would wait in the
+// queue. It reads the slurmdbd records of jobs in the same partition and QoS
+// submitted in the last WaitHistoryWindow, so jobs that have already left
+// the queue count, keeps those that have started with a similar CPU, node
+// and time limit request, and returns the median of their waits. A job's
+// wait runs from when it became eligible to when it started.
+func PredictWait(ctx context.Context, client types.SlurmClient, spec *types.JobSubmission) (time.Duration, error) {
+	if client == nil {
+		return 0, fmt.Errorf("client cannot be nil")
+	}
+	if spec == nil {
+		return 0, fmt.Errorf("job submission cannot be nil")
+	}
+
+	now := time.Now()
+	jobs, err := client.Jobs().History(ctx, &types.JobHistoryOptions{
+		Since:     now.Add(-WaitHistoryWindow),
+		Partition: spec.Partition,
+		QoS:       spec.QoS,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list job history: %w", err)
+	}
+
+	waits := similarJobWaits(jobs, spec, now)
+	if len(waits) == 0 {
+		return 0, ErrNoWaitHistory
+	}
+	sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+	return medianSortedDuration(waits), nil
+}
+
+// similarJobWaits returns the queue waits of the jobs in history that
+// resemble spec and started within WaitHistoryWindow of now
+func similarJobWaits(history []types.Job, spec *types.JobSubmission, now time.Time) []time.Duration {
+	since := now.Add(-WaitHistoryWindow)
+	var waits []time.Duration
+	for i := range history {
+		job := &history[i]
+		if job.SubmitTime.IsZero() || job.SubmitTime.Before(since) {
+			continue
+		}
+		// A pending job's StartTime is only the scheduler's estimate
+		if job.StartTime.IsZero() || job.StartTime.After(now) || isPending(job) {
+			continue
+		}
+		if !matchesString(spec.Partition, job.Partition) || !matchesString(spec.QoS, job.QoS) {
+			continue
+		}
+		if !similarSize(spec.CPUs, job.CPUs) || !similarSize(spec.Nodes, job.NodeCount) ||
+			!similarSize(spec.TimeLimit, job.TimeLimit) {
+			continue
+		}
+
+		queued := job.SubmitTime
+		if job.EligibleTime.After(queued) {
+			queued = job.EligibleTime
+		}
+		if wait := job.StartTime.Sub(queued); wait >= 0 {
+			waits = append(waits, wait)
+		}
+	}
+	return waits
+}
+
+func isPending(job *types.Job) bool {
+	for _, state := range job.JobState {
+		if state == types.JobStatePending {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesString reports whether a job field matches a requested value; an
+// empty request matches anything
+func matchesString(want string, got *string) bool {
+	return want == "" || (got != nil && *got == want)
+}
+
+// similarSize reports whether a job's request is within a factor of two of
+// the wanted value. Unset values on either side match.
+func similarSize(want int, got *uint32) bool {
+	if want <= 0 || got == nil || *got == 0 {
+		return true
+	}
+	return int64(*got)*2 >= int64(want) && int64(*got) <= int64(want)*2
+}
+
+// medianSortedDuration returns the median of sorted, non-empty durations
+func medianSortedDuration(sorted []time.Duration) time.Duration {
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
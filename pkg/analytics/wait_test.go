@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// historyClient serves a fixed job history; other methods are not used
+type historyClient struct {
+	types.SlurmClient
+	jobs historyJobs
+}
+
+func (c *historyClient) Jobs() types.JobManager { return c.jobs }
+
+type historyJobs struct {
+	types.JobManager
+	history []types.Job
+	filters *types.JobHistoryOptions
+}
+
+func (j historyJobs) History(_ context.Context, opts *types.JobHistoryOptions) ([]types.Job, error) {
+	*j.filters = *opts
+	return j.history, nil
+}
+
+func historyJob(partition, qos string, cpus uint32, submitted time.Time, wait time.Duration, state types.JobState) types.Job {
+	return types.Job{
+		Partition:  ptrStringTest(partition),
+		QoS:        ptrStringTest(qos),
+		CPUs:       ptrUint32Test(cpus),
+		TimeLimit:  ptrUint32Test(60),
+		SubmitTime: submitted,
+		StartTime:  submitted.Add(wait),
+		JobState:   []types.JobState{state},
+	}
+}
+
+func TestPredictWait(t *testing.T) {
+	now := time.Now()
+	hourAgo := now.Add(-time.Hour)
+	history := []types.Job{
+		historyJob("gpu", "normal", 8, hourAgo, 2*time.Minute, types.JobStateCompleted),
+		historyJob("gpu", "normal", 16, hourAgo, 10*time.Minute, types.JobStateRunning),
+		historyJob("gpu", "normal", 4, hourAgo, 4*time.Minute, types.JobStateCompleted),
+		// Too large, other QoS, too old, and still pending
+		historyJob("gpu", "normal", 64, hourAgo, 3*time.Hour, types.JobStateCompleted),
+		historyJob("gpu", "high", 8, hourAgo, time.Second, types.JobStateCompleted),
+		historyJob("gpu", "normal", 8, now.Add(-30*24*time.Hour), 5*time.Hour, types.JobStateCompleted),
+		historyJob("gpu", "normal", 8, hourAgo, 2*time.Hour, types.JobStatePending),
+	}
+
+	// Eligibility after submission shortens the wait
+	delayed := historyJob("gpu", "normal", 8, hourAgo, 20*time.Minute, types.JobStateCompleted)
+	delayed.EligibleTime = hourAgo.Add(14 * time.Minute)
+	history = append(history, delayed)
+
+	var filters types.JobHistoryOptions
+	client := &historyClient{jobs: historyJobs{history: history, filters: &filters}}
+	spec := &types.JobSubmission{Partition: "gpu", QoS: "normal", CPUs: 8, TimeLimit: 60}
+
+	wait, err := PredictWait(context.Background(), client, spec)
+	require.NoError(t, err)
+	// Waits of 2, 4, 6 and 10 minutes
+	assert.Equal(t, 5*time.Minute, wait)
+	assert.Equal(t, "gpu", filters.Partition)
+	assert.Equal(t, "normal", filters.QoS)
+	assert.WithinDuration(t, now.Add(-WaitHistoryWindow), filters.Since, time.Minute)
+}
+
+func TestPredictWait_NoHistory(t *testing.T) {
+	var filters types.JobHistoryOptions
+	client := &historyClient{jobs: historyJobs{filters: &filters}}
+
+	_, err := PredictWait(context.Background(), client, &types.JobSubmission{Partition: "debug"})
+	require.ErrorIs(t, err, ErrNoWaitHistory)
+
+	_, err = PredictWait(context.Background(), client, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records the requests a client sends that change the
+// cluster, such as job submissions and account deletions, together with
+// their outcome, for compliance logs and admin tooling.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Redacted replaces the values of redacted fields
+const Redacted = "REDACTED"
+
+// MethodExec is the Method of records for Slurm commands run by the CLI
+// fallback, such as scancel, rather than REST requests
+const MethodExec = "EXEC"
+
+// Record describes one mutating request and its result.
+type Record struct {
+	// Time is when the request was sent
+	Time time.Time `json:"time"`
+	// User is the Slurm user the request was made as, taken from the
+	// X-SLURM-USER-NAME header; it is empty when only a token is sent
+	User string `json:"user,omitempty"`
+	// Method and Path identify the operation and its target, e.g.
+	// DELETE /slurmdb/v0.0.44/account/physics. Commands run by the CLI
+	// fallback have Method MethodExec, the command as Path and its
+	// arguments in Args.
+	Method string   `json:"method"`
+	Path   string   `json:"path"`
+	Args   []string `json:"args,omitempty"`
+	// Request and Response are the JSON bodies, when there are any
+	Request  json.RawMessage `json:"request,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	// StatusCode is the HTTP status of the response; zero when the
+	// request failed before one was received
+	StatusCode int `json:"status_code,omitempty"`
+	// Error is the transport error, if the request failed
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+	// Attempts is how many times the request was sent, counting retries;
+	// the record describes the outcome of the last one
+	Attempts int `json:"attempts,omitempty"`
+	// DryRun is set when the request was held back by dry-run mode
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// Sink receives audit records. Write is called once a request has
+// completed, from the goroutine that made it; an error is logged and does
+// not fail the request. A Sink that buffers records may also implement
+// Flush(ctx context.Context) error, which the client calls on Shutdown.
+type Sink interface {
+	Write(record *Record) error
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc func(record *Record) error
+
+// Write implements Sink
+func (f SinkFunc) Write(record *Record) error { return f(record) }
+
+// jsonSink writes records as JSON lines
+type jsonSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONSink returns a Sink writing each record to w as one line of JSON.
+// It is safe for concurrent use. When w has a Flush method, as a
+// bufio.Writer does, the sink's Flush calls it.
+func NewJSONSink(w io.Writer) Sink {
+	return &jsonSink{w: w}
+}
+
+// Write implements Sink
+func (s *jsonSink) Write(record *Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// Flush flushes w if it buffers output
+func (s *jsonSink) Flush(ctx context.Context) error {
+	f, ok := s.w.(interface{ Flush() error })
+	if !ok {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return f.Flush()
+}
+
+// redactingSink masks fields before passing records on
+type redactingSink struct {
+	next   Sink
+	fields map[string]bool
+}
+
+// Redact returns a Sink that replaces the value of every field with one of
+// the given names, at any depth of the request and response bodies, with
+// Redacted before passing the record to sink. For example, "environment"
+// and "script" keep job environments and batch scripts out of the log.
+func Redact(sink Sink, fields ...string) Sink {
+	names := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		names[field] = true
+	}
+	return &redactingSink{next: sink, fields: names}
+}
+
+// Write implements Sink
+func (s *redactingSink) Write(record *Record) error {
+	redacted := *record
+	redacted.Request = s.redact(record.Request)
+	redacted.Response = s.redact(record.Response)
+	return s.next.Write(&redacted)
+}
+
+// Flush flushes the sink records are passed to, if it buffers them
+func (s *redactingSink) Flush(ctx context.Context) error {
+	if f, ok := s.next.(interface{ Flush(context.Context) error }); ok {
+		return f.Flush(ctx)
+	}
+	return nil
+}
+
+func (s *redactingSink) redact(body json.RawMessage) json.RawMessage {
+	if len(body) == 0 {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	data, err := json.Marshal(s.redactValue(value))
+	if err != nil {
+		return body
+	}
+	return data
+}
+
+func (s *redactingSink) redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if s.fields[key] {
+				v[key] = Redacted
+			} else {
+				v[key] = s.redactValue(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = s.redactValue(item)
+		}
+	}
+	return value
+}
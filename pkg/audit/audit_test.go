@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedact(t *testing.T) {
+	var got *Record
+	sink := Redact(SinkFunc(func(record *Record) error {
+		got = record
+		return nil
+	}), "environment", "script")
+
+	record := &Record{
+		Method:  "POST",
+		Path:    "/slurm/v0.0.44/job/submit",
+		Request: json.RawMessage(`{"script":"#!/bin/bash","job":{"name":"train","environment":["TOKEN=secret"],"tasks":4}}`),
+	}
+	require.NoError(t, sink.Write(record))
+
+	require.NotNil(t, got)
+	assert.JSONEq(t, `{"script":"REDACTED","job":{"name":"train","environment":"REDACTED","tasks":4}}`, string(got.Request))
+	assert.Contains(t, string(record.Request), "TOKEN=secret", "the original record is left alone")
+}
+
+func TestJSONSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONSink(&buf)
+
+	require.NoError(t, sink.Write(&Record{Method: "DELETE", Path: "/slurmdb/v0.0.44/account/physics", StatusCode: 200}))
+	require.NoError(t, sink.Write(&Record{Method: "POST", Path: "/slurm/v0.0.44/job/submit", DryRun: true}))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	var first Record
+	require.NoError(t, json.Unmarshal(lines[0], &first))
+	assert.Equal(t, "DELETE", first.Method)
+	assert.Equal(t, 200, first.StatusCode)
+	assert.Contains(t, string(lines[1]), `"dry_run":true`)
+}
+
+func TestJSONSink_Flush(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	sink := Redact(NewJSONSink(w), "script")
+
+	require.NoError(t, sink.Write(&Record{Method: "POST", Path: "/slurm/v0.0.44/job/submit"}))
+	assert.Zero(t, buf.Len(), "the record is still buffered")
+
+	flusher, ok := sink.(interface{ Flush(context.Context) error })
+	require.True(t, ok)
+	require.NoError(t, flusher.Flush(context.Background()))
+	assert.Contains(t, buf.String(), `"method":"POST"`)
+}
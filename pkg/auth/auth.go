@@ -17,6 +17,14 @@ type Provider interface {
 	Type() string
 }
 
+// Identity is implemented by providers that authenticate as a named Slurm
+// user
+type Identity interface {
+	// Username returns the user sent in the X-SLURM-USER-NAME header, or ""
+	// if the provider does not send one
+	Username() string
+}
+
 // TokenAuth implements token-based authentication
 type TokenAuth struct {
 	token string
@@ -4,8 +4,10 @@
 package auth
 
 import (
+	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/jontk/slurm-client/tests/helpers"
 )
@@ -189,3 +191,26 @@ func TestAuthenticateMultipleTimes(t *testing.T) {
 	tokenValue := req.Header.Get("X-SLURM-USER-TOKEN")
 	helpers.AssertEqual(t, "test-token", tokenValue)
 }
+
+func TestIdentity(t *testing.T) {
+	refresh := func(ctx context.Context) (string, time.Time, error) { return "token", time.Time{}, nil }
+	for _, tt := range []struct {
+		name     string
+		provider Provider
+		username string
+	}{
+		{"file token", NewFileTokenAuth("/dev/null", WithFileTokenUser("alice")), "alice"},
+		{"munge", NewMungeAuth(WithMungeUser("bob")), "bob"},
+		{"refreshing token", NewRefreshingTokenAuth(refresh, WithRefreshUser("carol")), "carol"},
+		{"no user", NewRefreshingTokenAuth(refresh), ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, ok := tt.provider.(Identity)
+			helpers.AssertEqual(t, true, ok)
+			helpers.AssertEqual(t, tt.username, identity.Username())
+		})
+	}
+
+	_, ok := Provider(NewTokenAuth("token")).(Identity)
+	helpers.AssertEqual(t, false, ok)
+}
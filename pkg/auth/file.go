@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrEmptyTokenFile is returned, wrapped, when a token file holds no token
+var ErrEmptyTokenFile = errors.New("token file is empty")
+
+// FileTokenAuth authenticates requests with a token read from a file, such
+// as a JWT mounted from a Kubernetes secret. The file is checked before
+// each request and read again when its modification time or size has
+// changed, so a token rotated in place is picked up without restarting the
+// client.
+type FileTokenAuth struct {
+	path     string
+	username string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// FileTokenOption configures a FileTokenAuth
+type FileTokenOption func(*FileTokenAuth)
+
+// WithFileTokenUser also sends username in the X-SLURM-USER-NAME header
+func WithFileTokenUser(username string) FileTokenOption {
+	return func(f *FileTokenAuth) {
+		f.username = username
+	}
+}
+
+// NewFileTokenAuth creates a token authentication provider that reads the
+// token from path. The file is not read until the first request.
+func NewFileTokenAuth(path string, opts ...FileTokenOption) *FileTokenAuth {
+	f := &FileTokenAuth{path: path}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Authenticate adds the token in the file to the request. It fails if the
+// file is missing or holds no token.
+func (f *FileTokenAuth) Authenticate(_ context.Context, req *http.Request) error {
+	token, err := f.currentToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-SLURM-USER-TOKEN", token)
+	if f.username != "" {
+		req.Header.Set("X-SLURM-USER-NAME", f.username)
+	}
+	return nil
+}
+
+// Type returns the authentication type
+func (f *FileTokenAuth) Type() string {
+	return "token"
+}
+
+// Username returns the user set with WithFileTokenUser
+func (f *FileTokenAuth) Username() string {
+	return f.username
+}
+
+func (f *FileTokenAuth) currentToken() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	if f.token != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.token, nil
+	}
+
+	data, err := os.ReadFile(f.path) // #nosec G304 -- the path comes from client configuration
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := string(bytes.TrimSpace(data))
+	if token == "" {
+		return "", fmt.Errorf("%s: %w", f.path, ErrEmptyTokenFile)
+	}
+	f.token = token
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	return token, nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/tests/helpers"
+)
+
+func TestFileTokenAuth(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	write := func(token string, modTime time.Time) {
+		helpers.RequireNoError(t, os.WriteFile(path, []byte(token), 0o600))
+		helpers.RequireNoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	mtime := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	write("jwt-one\n", mtime)
+
+	auth := NewFileTokenAuth(path, WithFileTokenUser("alice"))
+	helpers.AssertEqual(t, "token", auth.Type())
+
+	ctx := helpers.TestContext(t)
+	authenticate := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
+		helpers.RequireNoError(t, err)
+		return req, auth.Authenticate(ctx, req)
+	}
+
+	req, err := authenticate()
+	helpers.RequireNoError(t, err)
+	helpers.AssertEqual(t, "jwt-one", req.Header.Get("X-SLURM-USER-TOKEN"))
+	helpers.AssertEqual(t, "alice", req.Header.Get("X-SLURM-USER-NAME"))
+
+	// Rotated in place: picked up on the next request
+	write("jwt-two", mtime.Add(time.Minute))
+	req, err = authenticate()
+	helpers.RequireNoError(t, err)
+	helpers.AssertEqual(t, "jwt-two", req.Header.Get("X-SLURM-USER-TOKEN"))
+
+	write("  \n", mtime.Add(2*time.Minute))
+	_, err = authenticate()
+	if !errors.Is(err, ErrEmptyTokenFile) {
+		t.Fatalf("expected an empty token file error, got %v", err)
+	}
+
+	helpers.RequireNoError(t, os.Remove(path))
+	_, err = authenticate()
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected a missing token file error, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMungeTTL is the lifetime munged gives a credential unless asked
+// for another
+const DefaultMungeTTL = 5 * time.Minute
+
+// MungeAuth authenticates requests with a MUNGE credential, for clusters
+// where slurmrestd sits behind a proxy that checks MUNGE credentials. The
+// credential is made by running the munge command, sent in the
+// X-SLURM-USER-TOKEN header, and reused until a fifth of its lifetime is
+// left. The proxy therefore has to accept the same credential more than
+// once; munged's own replay check would refuse it.
+type MungeAuth struct {
+	path     string
+	socket   string
+	ttl      time.Duration
+	username string
+	now      func() time.Time
+
+	mu         sync.Mutex
+	credential string
+	expires    time.Time
+}
+
+// MungeOption configures a MungeAuth
+type MungeOption func(*MungeAuth)
+
+// WithMungePath sets the munge binary to run (default "munge" on PATH)
+func WithMungePath(path string) MungeOption {
+	return func(m *MungeAuth) {
+		m.path = path
+	}
+}
+
+// WithMungeSocket sets the munged socket to ask for credentials, for a
+// munged that does not listen on the default socket
+func WithMungeSocket(socket string) MungeOption {
+	return func(m *MungeAuth) {
+		m.socket = socket
+	}
+}
+
+// WithMungeTTL sets the lifetime requested for each credential. munged caps
+// it at its own configured maximum.
+func WithMungeTTL(ttl time.Duration) MungeOption {
+	return func(m *MungeAuth) {
+		m.ttl = ttl
+	}
+}
+
+// WithMungeUser also sends username in the X-SLURM-USER-NAME header
+func WithMungeUser(username string) MungeOption {
+	return func(m *MungeAuth) {
+		m.username = username
+	}
+}
+
+// NewMungeAuth creates a MUNGE authentication provider
+func NewMungeAuth(opts ...MungeOption) *MungeAuth {
+	m := &MungeAuth{
+		path: "munge",
+		ttl:  DefaultMungeTTL,
+		now:  time.Now,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.ttl < time.Second {
+		m.ttl = time.Second
+	}
+	return m
+}
+
+// Authenticate adds a MUNGE credential to the request, encoding a new one
+// if there is none yet or the current one is close to expiring
+func (m *MungeAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	credential, err := m.currentCredential(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-SLURM-USER-TOKEN", credential)
+	if m.username != "" {
+		req.Header.Set("X-SLURM-USER-NAME", m.username)
+	}
+	return nil
+}
+
+// Type returns the authentication type
+func (m *MungeAuth) Type() string {
+	return "munge"
+}
+
+// Username returns the user set with WithMungeUser
+func (m *MungeAuth) Username() string {
+	return m.username
+}
+
+func (m *MungeAuth) currentCredential(ctx context.Context) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if m.credential != "" && now.Before(m.expires.Add(-m.ttl/5)) {
+		return m.credential, nil
+	}
+	credential, err := m.encode(ctx)
+	if err != nil {
+		return "", err
+	}
+	m.credential = credential
+	m.expires = now.Add(m.ttl)
+	return credential, nil
+}
+
+// encode runs munge to create a credential with no payload
+func (m *MungeAuth) encode(ctx context.Context) (string, error) {
+	args := []string{"--no-input", "--ttl=" + strconv.Itoa(int(m.ttl/time.Second))}
+	if m.socket != "" {
+		args = append(args, "--socket="+m.socket)
+	}
+	// #nosec G204 -- the binary comes from client configuration and the
+	// arguments are passed directly, without a shell
+	cmd := exec.CommandContext(ctx, m.path, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) || errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("munge is not available: %q was not found; install MUNGE or set its path with WithMungePath: %w", m.path, err)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("failed to create a MUNGE credential (is munged running?): %w: %s", err, msg)
+		}
+		return "", fmt.Errorf("failed to create a MUNGE credential (is munged running?): %w", err)
+	}
+	credential := strings.TrimSpace(stdout.String())
+	if credential == "" {
+		return "", fmt.Errorf("munge returned an empty credential")
+	}
+	return credential, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/tests/helpers"
+)
+
+// fakeMunge writes a munge script that prints a new credential on every run
+// and records its arguments, returning its path and the arguments file
+func fakeMunge(t *testing.T) (string, string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake munge is a shell script")
+	}
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	counter := filepath.Join(dir, "count")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\necho x >> " + counter +
+		"\necho \"MUNGE:cred$(wc -l < " + counter + " | tr -d ' '):\"\n"
+	path := filepath.Join(dir, "munge")
+	helpers.RequireNoError(t, os.WriteFile(path, []byte(script), 0o755)) // #nosec G306 -- test executable
+	return path, argsFile
+}
+
+func TestMungeAuth(t *testing.T) {
+	path, argsFile := fakeMunge(t)
+	auth := NewMungeAuth(WithMungePath(path), WithMungeSocket("/run/munge/alt.socket"), WithMungeUser("alice"))
+	clock := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	auth.now = func() time.Time { return clock }
+
+	helpers.AssertEqual(t, "munge", auth.Type())
+
+	ctx := helpers.TestContext(t)
+	authenticate := func() *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
+		helpers.RequireNoError(t, err)
+		helpers.RequireNoError(t, auth.Authenticate(ctx, req))
+		return req
+	}
+
+	req := authenticate()
+	helpers.AssertEqual(t, "MUNGE:cred1:", req.Header.Get("X-SLURM-USER-TOKEN"))
+	helpers.AssertEqual(t, "alice", req.Header.Get("X-SLURM-USER-NAME"))
+	args, err := os.ReadFile(argsFile) // #nosec G304 -- test file
+	helpers.RequireNoError(t, err)
+	helpers.AssertEqual(t, "--no-input --ttl=300 --socket=/run/munge/alt.socket", strings.TrimSpace(string(args)))
+
+	// The credential is reused while most of its lifetime is left
+	clock = clock.Add(3 * time.Minute)
+	helpers.AssertEqual(t, "MUNGE:cred1:", authenticate().Header.Get("X-SLURM-USER-TOKEN"))
+
+	// and replaced before it expires
+	clock = clock.Add(time.Minute + time.Second)
+	helpers.AssertEqual(t, "MUNGE:cred2:", authenticate().Header.Get("X-SLURM-USER-TOKEN"))
+}
+
+func TestMungeAuth_Unavailable(t *testing.T) {
+	auth := NewMungeAuth(WithMungePath(filepath.Join(t.TempDir(), "munge")))
+	ctx := helpers.TestContext(t)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
+	helpers.RequireNoError(t, err)
+
+	err = auth.Authenticate(ctx, req)
+	if err == nil || !strings.Contains(err.Error(), "munge is not available") {
+		t.Fatalf("expected munge to be reported unavailable, got %v", err)
+	}
+	helpers.AssertEqual(t, "", req.Header.Get("X-SLURM-USER-TOKEN"))
+}
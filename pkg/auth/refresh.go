@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultRefreshSkew is how long before its expiry a token is replaced
+// unless another skew is configured
+const DefaultRefreshSkew = time.Minute
+
+// Refresher is implemented by providers whose credentials can be renewed on
+// demand. When a request authenticated by such a provider is rejected with
+// 401 Unauthorized, the client calls Refresh and retries the request once.
+type Refresher interface {
+	// Refresh renews the credentials that rejected, the request as it was
+	// sent, carried. Credentials that have been renewed since are kept, so
+	// that requests rejected together cause a single renewal.
+	Refresh(ctx context.Context, rejected *http.Request) error
+}
+
+// TokenRefreshFunc returns a new token and the time it expires. A zero
+// expiry means the token is kept until the server rejects it.
+type TokenRefreshFunc func(ctx context.Context) (string, time.Time, error)
+
+// RefreshingTokenAuth authenticates requests with a token, such as a JWT,
+// that it renews through a callback before the token expires, and again
+// whenever the server rejects it. It is safe for concurrent use; requests
+// made while a token is being fetched wait for it.
+type RefreshingTokenAuth struct {
+	refresh  TokenRefreshFunc
+	skew     time.Duration
+	username string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// RefreshOption configures a RefreshingTokenAuth
+type RefreshOption func(*RefreshingTokenAuth)
+
+// WithRefreshSkew sets how long before its expiry a token is replaced
+// (default DefaultRefreshSkew)
+func WithRefreshSkew(skew time.Duration) RefreshOption {
+	return func(r *RefreshingTokenAuth) {
+		r.skew = skew
+	}
+}
+
+// WithRefreshUser also sends username in the X-SLURM-USER-NAME header,
+// which most slurmrestd deployments require alongside the token
+func WithRefreshUser(username string) RefreshOption {
+	return func(r *RefreshingTokenAuth) {
+		r.username = username
+	}
+}
+
+// WithInitialToken starts with a token already in hand, so the first
+// request does not call the refresh callback
+func WithInitialToken(token string, expires time.Time) RefreshOption {
+	return func(r *RefreshingTokenAuth) {
+		r.token = token
+		r.expires = expires
+	}
+}
+
+// NewRefreshingTokenAuth creates a token authentication provider that gets
+// its tokens from refresh
+func NewRefreshingTokenAuth(refresh TokenRefreshFunc, opts ...RefreshOption) *RefreshingTokenAuth {
+	r := &RefreshingTokenAuth{
+		refresh: refresh,
+		skew:    DefaultRefreshSkew,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.skew < 0 {
+		r.skew = 0
+	}
+	return r
+}
+
+// Authenticate adds the current token to the request, fetching a new one
+// first if there is none yet or the current one is within the skew of its
+// expiry
+func (r *RefreshingTokenAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	token, err := r.currentToken(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-SLURM-USER-TOKEN", token)
+	if r.username != "" {
+		req.Header.Set("X-SLURM-USER-NAME", r.username)
+	}
+	return nil
+}
+
+// Refresh fetches a new token unless the one rejected has already been
+// replaced
+func (r *RefreshingTokenAuth) Refresh(ctx context.Context, rejected *http.Request) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rejected != nil && r.token != "" && rejected.Header.Get("X-SLURM-USER-TOKEN") != r.token {
+		return nil
+	}
+	return r.fetch(ctx)
+}
+
+// Type returns the authentication type
+func (r *RefreshingTokenAuth) Type() string {
+	return "token"
+}
+
+// Username returns the user set with WithRefreshUser
+func (r *RefreshingTokenAuth) Username() string {
+	return r.username
+}
+
+func (r *RefreshingTokenAuth) currentToken(ctx context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.token != "" && (r.expires.IsZero() || r.now().Before(r.expires.Add(-r.skew))) {
+		return r.token, nil
+	}
+	if err := r.fetch(ctx); err != nil {
+		return "", err
+	}
+	return r.token, nil
+}
+
+// fetch replaces the token; r.mu must be held
+func (r *RefreshingTokenAuth) fetch(ctx context.Context) error {
+	if r.refresh == nil {
+		return fmt.Errorf("no token refresh function configured")
+	}
+	token, expires, err := r.refresh(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to refresh token: %w", err)
+	}
+	if token == "" {
+		return fmt.Errorf("token refresh returned an empty token")
+	}
+	r.token = token
+	r.expires = expires
+	return nil
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client/tests/helpers"
+)
+
+func TestRefreshingTokenAuth(t *testing.T) {
+	clock := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	var refreshes atomic.Int32
+	auth := NewRefreshingTokenAuth(func(ctx context.Context) (string, time.Time, error) {
+		n := refreshes.Add(1)
+		return fmt.Sprintf("jwt%d", n), clock.Add(30 * time.Minute), nil
+	}, WithRefreshSkew(2*time.Minute), WithRefreshUser("alice"))
+	auth.now = func() time.Time { return clock }
+
+	helpers.AssertEqual(t, "token", auth.Type())
+
+	ctx := helpers.TestContext(t)
+	authenticate := func() *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
+		helpers.RequireNoError(t, err)
+		helpers.RequireNoError(t, auth.Authenticate(ctx, req))
+		return req
+	}
+
+	// Concurrent first requests share one fetch
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			helpers.AssertEqual(t, "jwt1", authenticate().Header.Get("X-SLURM-USER-TOKEN"))
+		}()
+	}
+	wg.Wait()
+	helpers.AssertEqual(t, int32(1), refreshes.Load())
+
+	req := authenticate()
+	helpers.AssertEqual(t, "alice", req.Header.Get("X-SLURM-USER-NAME"))
+
+	// Replaced once within the skew of its expiry
+	clock = clock.Add(27 * time.Minute)
+	helpers.AssertEqual(t, "jwt1", authenticate().Header.Get("X-SLURM-USER-TOKEN"))
+	clock = clock.Add(time.Minute + time.Second)
+	helpers.AssertEqual(t, "jwt2", authenticate().Header.Get("X-SLURM-USER-TOKEN"))
+
+	// A rejection of an already replaced token does not refresh again
+	helpers.RequireNoError(t, auth.Refresh(ctx, req))
+	helpers.AssertEqual(t, int32(2), refreshes.Load())
+	helpers.RequireNoError(t, auth.Refresh(ctx, authenticate()))
+	helpers.AssertEqual(t, "jwt3", authenticate().Header.Get("X-SLURM-USER-TOKEN"))
+}
+
+func TestRefreshingTokenAuth_RefreshFails(t *testing.T) {
+	auth := NewRefreshingTokenAuth(func(ctx context.Context) (string, time.Time, error) {
+		return "", time.Time{}, fmt.Errorf("identity provider unavailable")
+	})
+	ctx := helpers.TestContext(t)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
+	helpers.RequireNoError(t, err)
+
+	err = auth.Authenticate(ctx, req)
+	if err == nil || !strings.Contains(err.Error(), "identity provider unavailable") {
+		t.Fatalf("expected the refresh error, got %v", err)
+	}
+	helpers.AssertEqual(t, "", req.Header.Get("X-SLURM-USER-TOKEN"))
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cli runs the Slurm command-line tools (scontrol, sacct and
+// scancel) for the few operations slurmrestd does not expose, so a client
+// can fall back to them on hosts where the tools are installed and
+// configured.
+//
+// Only these operations use the CLI:
+//   - Partitions().Create, Update and Delete, via "scontrol create",
+//     "scontrol update" and "scontrol delete" with PartitionName=...
+//   - Jobs().Script, via "scontrol write batch_script"
+//   - Jobs().GetWithOptions with IncludeAccounting, via "sacct"
+//   - Jobs().ListSteps, via "sacct"
+//   - Jobs().Signal of a single step, via "scancel --signal"
+//   - Jobs().CancelStep, via "scancel"
+//   - Jobs().CancelIfPending, via "scancel --state=PENDING"
+//   - Nodes().Reboot, via "scontrol reboot"
+//
+// Each runs only when the REST API reports the operation as unsupported,
+// or cannot express it. The commands that change the cluster are recorded
+// to the audit sink set with SetAuditSink.
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/jontk/slurm-client/pkg/audit"
+)
+
+// Config locates the Slurm commands and sets their environment.
+type Config struct {
+	// ScontrolPath is the scontrol binary (default "scontrol" on PATH)
+	ScontrolPath string
+	// SacctPath is the sacct binary (default "sacct" on PATH)
+	SacctPath string
+	// ScancelPath is the scancel binary (default "scancel" on PATH)
+	ScancelPath string
+	// Env holds extra KEY=value entries added to the process environment,
+	// e.g. "SLURM_CONF=/etc/slurm/other.conf"
+	Env []string
+}
+
+// Runner runs Slurm commands as described by a Config.
+type Runner struct {
+	config Config
+	audit  audit.Sink
+}
+
+// NewRunner creates a Runner, filling in default command paths
+func NewRunner(config Config) *Runner {
+	if config.ScontrolPath == "" {
+		config.ScontrolPath = "scontrol"
+	}
+	if config.SacctPath == "" {
+		config.SacctPath = "sacct"
+	}
+	if config.ScancelPath == "" {
+		config.ScancelPath = "scancel"
+	}
+	return &Runner{config: config}
+}
+
+// SetAuditSink records every command that changes the cluster, such as
+// scancel and scontrol update, to sink. Reads are not recorded.
+func (r *Runner) SetAuditSink(sink audit.Sink) {
+	r.audit = sink
+}
+
+// mutate runs a command that changes the cluster and records it to the
+// audit sink. As with the REST audit log, a record that cannot be written
+// does not fail the command.
+func (r *Runner) mutate(ctx context.Context, path string, args ...string) error {
+	if r.audit == nil {
+		_, err := r.run(ctx, path, args...)
+		return err
+	}
+	record := &audit.Record{
+		Time:   time.Now(),
+		Method: audit.MethodExec,
+		Path:   path,
+		Args:   args,
+	}
+	_, err := r.run(ctx, path, args...)
+	record.Duration = time.Since(record.Time)
+	if err != nil {
+		record.Error = err.Error()
+	}
+	_ = r.audit.Write(record)
+	return err
+}
+
+// run executes a command and returns its standard output. On failure the
+// error includes what the command wrote to standard error.
+func (r *Runner) run(ctx context.Context, path string, args ...string) (string, error) {
+	// #nosec G204 -- the binary comes from client configuration and the
+	// arguments are passed directly, without a shell
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Env = append(os.Environ(), r.config.Env...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s %s failed: %w: %s", path, strings.Join(args, " "), err, msg)
+		}
+		return "", fmt.Errorf("%s %s failed: %w", path, strings.Join(args, " "), err)
+	}
+	return stdout.String(), nil
+}
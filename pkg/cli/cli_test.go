@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// installFakeTool writes a shell script named name into a directory at the
+// front of PATH and returns the file its arguments are recorded in
+func installFakeTool(t *testing.T, name, body string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake Slurm tools are shell scripts")
+	}
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, name+".args")
+	script := "#!/bin/sh\necho \"$@\" > " + argsFile + "\n" + body + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755)) // #nosec G306 -- test executable
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return argsFile
+}
+
+func readArgs(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path) // #nosec G304 -- test file
+	require.NoError(t, err)
+	return strings.TrimSpace(string(data))
+}
+
+func TestRunner_JobScript(t *testing.T) {
+	argsFile := installFakeTool(t, "scontrol", "printf '#!/bin/bash\\nsrun hostname\\n'")
+
+	script, err := NewRunner(Config{}).JobScript(context.Background(), "1234")
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/bash\nsrun hostname\n", script)
+	assert.Equal(t, "write batch_script 1234 -", readArgs(t, argsFile))
+
+	_, err = NewRunner(Config{}).JobScript(context.Background(), "12; rm -rf /")
+	require.Error(t, err)
+}
+
+func TestRunner_ControllerVersion(t *testing.T) {
+	argsFile := installFakeTool(t, "scontrol", "printf 'SLURM_CONF = /etc/slurm/slurm.conf\\nSLURM_VERSION           = 24.05.4\\nSLURMCTLD_PORT = 6817\\n'")
+
+	version, err := NewRunner(Config{}).ControllerVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "24.05.4", version)
+	assert.Equal(t, "show config", readArgs(t, argsFile))
+}
+
+func TestRunner_SignalStep(t *testing.T) {
+	argsFile := installFakeTool(t, "scancel", "exit 0")
+
+	require.NoError(t, NewRunner(Config{}).SignalStep(context.Background(), "1234", "0", 10))
+	assert.Equal(t, "--signal=10 1234.0", readArgs(t, argsFile))
+
+	require.Error(t, NewRunner(Config{}).SignalStep(context.Background(), "1234", "0 5", 10))
+}
+
+func TestRunner_CancelStep(t *testing.T) {
+	argsFile := installFakeTool(t, "scancel", "exit 0")
+
+	require.NoError(t, NewRunner(Config{}).CancelStep(context.Background(), "1234", "2"))
+	assert.Equal(t, "1234.2", readArgs(t, argsFile))
+
+	require.Error(t, NewRunner(Config{}).CancelStep(context.Background(), "1234", ""))
+	require.Error(t, NewRunner(Config{}).CancelStep(context.Background(), "-1", "0"))
+}
+
+func TestRunner_AuditSink(t *testing.T) {
+	installFakeTool(t, "scancel", "exit 0")
+	installFakeTool(t, "scontrol", "echo 'Invalid node name specified' >&2; exit 1")
+	installFakeTool(t, "sacct", "exit 0")
+
+	var records []audit.Record
+	runner := NewRunner(Config{})
+	runner.SetAuditSink(audit.SinkFunc(func(record *audit.Record) error {
+		records = append(records, *record)
+		return nil
+	}))
+
+	require.NoError(t, runner.CancelStep(context.Background(), "1234", "0"))
+	require.Error(t, runner.RebootNode(context.Background(), "nope", nil))
+	// Reads are not recorded
+	_, _ = runner.JobSteps(context.Background(), "1234")
+
+	require.Len(t, records, 2)
+	assert.Equal(t, audit.MethodExec, records[0].Method)
+	assert.Equal(t, "scancel", records[0].Path)
+	assert.Equal(t, []string{"1234.0"}, records[0].Args)
+	assert.Empty(t, records[0].Error)
+	assert.Equal(t, "scontrol", records[1].Path)
+	assert.Contains(t, records[1].Error, "Invalid node name specified")
+}
+
+func TestRunner_CancelPending(t *testing.T) {
+	argsFile := installFakeTool(t, "scancel", "exit 0")
+
+	require.NoError(t, NewRunner(Config{}).CancelPending(context.Background(), "1234"))
+	assert.Equal(t, "--state=PENDING 1234", readArgs(t, argsFile))
+
+	require.Error(t, NewRunner(Config{}).CancelPending(context.Background(), "1234 5678"))
+}
+
+func TestRunner_UpdatePartition(t *testing.T) {
+	argsFile := installFakeTool(t, "scontrol", "exit 0")
+
+	maxTime := int32(120)
+	state := types.PartitionStateDrain
+	hidden := true
+	jobs := int32(4)
+	err := NewRunner(Config{}).UpdatePartition(context.Background(), "gpu", &types.PartitionUpdate{
+		MaxTime:       &maxTime,
+		State:         &state,
+		AllowAccounts: []string{"physics", "chem"},
+		Hidden:        &hidden,
+		OverSubscribe: &types.PartitionMaximumsOversubscribe{
+			Flags: []types.PartitionMaximumsOversubscribeFlagsValue{types.PartitionMaximumsOversubscribeFlagsForce},
+			Jobs:  &jobs,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		"update PartitionName=gpu AllowAccounts=physics,chem MaxTime=120 State=DRAIN Hidden=YES OverSubscribe=FORCE:4",
+		readArgs(t, argsFile))
+
+	// Fields scontrol cannot set are rejected before anything runs
+	require.NoError(t, os.Remove(argsFile))
+	tres := "cpu=4"
+	err = NewRunner(Config{}).UpdatePartition(context.Background(), "gpu", &types.PartitionUpdate{TresStr: &tres})
+	require.Error(t, err)
+	assert.NoFileExists(t, argsFile)
+}
+
+func TestRunner_UpdatePartition_AliasedFields(t *testing.T) {
+	argsFile := installFakeTool(t, "scontrol", "exit 0")
+
+	login := "login[01-02]"
+	mem := int64(4096)
+	err := NewRunner(Config{}).UpdatePartition(context.Background(), "gpu", &types.PartitionUpdate{
+		AllocNodes:        &login,
+		AllowAllocNodes:   &login,
+		DefaultMemPerNode: &mem,
+		DefMemPerNode:     &mem,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "update PartitionName=gpu AllocNodes=login[01-02] DefMemPerNode=4096", readArgs(t, argsFile))
+
+	require.NoError(t, os.Remove(argsFile))
+	other := int64(8192)
+	err = NewRunner(Config{}).UpdatePartition(context.Background(), "gpu", &types.PartitionUpdate{
+		DefaultMemPerNode: &mem,
+		DefMemPerNode:     &other,
+	})
+	require.Error(t, err)
+	assert.NoFileExists(t, argsFile)
+}
+
+func TestRunner_RebootNode(t *testing.T) {
+	argsFile := installFakeTool(t, "scontrol", "exit 0")
+
+	err := NewRunner(Config{}).RebootNode(context.Background(), "node01", &types.RebootOptions{
+		ASAP:      true,
+		NextState: "RESUME",
+		Reason:    "firmware 2.1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "reboot ASAP nextstate=RESUME reason=firmware 2.1 node01", readArgs(t, argsFile))
+
+	require.NoError(t, NewRunner(Config{}).RebootNode(context.Background(), "node02", nil))
+	assert.Equal(t, "reboot node02", readArgs(t, argsFile))
+
+	require.Error(t, NewRunner(Config{}).RebootNode(context.Background(), "node01 node02", nil))
+}
+
+func TestRunner_CreateAndDeletePartition(t *testing.T) {
+	argsFile := installFakeTool(t, "scontrol", "exit 0")
+
+	err := NewRunner(Config{}).CreatePartition(context.Background(), &types.PartitionCreate{
+		Name:   "debug",
+		Nodes:  "node[01-02]",
+		Hidden: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "create PartitionName=debug Nodes=node[01-02] Hidden=YES", readArgs(t, argsFile))
+
+	// Fields scontrol cannot set are refused before anything runs
+	err = NewRunner(Config{}).CreatePartition(context.Background(), &types.PartitionCreate{Name: "debug", Priority: 5})
+	require.Error(t, err)
+
+	require.NoError(t, NewRunner(Config{}).DeletePartition(context.Background(), "debug"))
+	assert.Equal(t, "delete PartitionName=debug", readArgs(t, argsFile))
+	require.Error(t, NewRunner(Config{}).DeletePartition(context.Background(), ""))
+}
+
+func TestRunner_CommandFailure(t *testing.T) {
+	installFakeTool(t, "scontrol", "echo 'Invalid partition name specified' >&2; exit 1")
+
+	err := NewRunner(Config{}).UpdatePartition(context.Background(), "nope", &types.PartitionUpdate{})
+	require.NoError(t, err, "an empty update runs nothing")
+
+	maxNodes := int32(2)
+	err = NewRunner(Config{}).UpdatePartition(context.Background(), "nope", &types.PartitionUpdate{MaxNodes: &maxNodes})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid partition name specified")
+}
+
+func TestRunner_JobAccounting(t *testing.T) {
+	argsFile := installFakeTool(t, "sacct",
+		"echo '77|CANCELLED by 1000|0:15|0:0|2025-03-01T10:00:00|2025-03-01T10:05:00|2025-03-01T11:05:00|cpu=8,mem=16G,node=2|cpu=8,mem=16G|node[01-02]|train|alice|physics|gpu'")
+
+	job, err := NewRunner(Config{}).JobAccounting(context.Background(), "77")
+	require.NoError(t, err)
+	assert.Contains(t, readArgs(t, argsFile), "--jobs 77")
+	assert.Equal(t, int64(77), *job.JobID)
+	assert.Equal(t, []types.JobState{types.JobStateCancelled}, job.JobState)
+	require.NotNil(t, job.ExitCode)
+	assert.Equal(t, uint32(0), *job.ExitCode.ReturnCode)
+	assert.Equal(t, uint16(15), *job.ExitCode.Signal.ID)
+	assert.Equal(t, time.Hour, job.EndTime.Sub(job.StartTime))
+	assert.Equal(t, "cpu=8,mem=16G,node=2", *job.TRESAllocStr)
+	assert.Equal(t, "node[01-02]", *job.Nodes)
+	assert.Equal(t, "train", *job.Name)
+	assert.Equal(t, "alice", *job.UserName)
+	assert.Equal(t, "physics", *job.Account)
+	assert.Equal(t, "gpu", *job.Partition)
+
+	_, err = NewRunner(Config{}).JobAccounting(context.Background(), "78")
+	require.Error(t, err)
+}
+
+func TestRunner_JobSteps(t *testing.T) {
+	argsFile := installFakeTool(t, "sacct", `printf '%s\n' \
+'77|mpi|COMPLETED|2025-03-01T10:00:00|2025-03-01T11:05:00|cpu=8,mem=16G,node=2|node[01-02]|0:0' \
+'77.batch|batch|COMPLETED|2025-03-01T10:00:00|2025-03-01T11:05:00|cpu=4,mem=8G,node=1|node01|0:0' \
+'77.0|hydra|FAILED|2025-03-01T10:01:00|2025-03-01T11:04:00|cpu=8,mem=16G,node=2|node[01-02]|3:0'`)
+
+	steps, err := NewRunner(Config{}).JobSteps(context.Background(), "77")
+	require.NoError(t, err)
+	assert.Contains(t, readArgs(t, argsFile), "--jobs 77")
+	require.Len(t, steps, 2)
+	assert.Equal(t, "batch", steps[0].ID)
+	assert.Equal(t, "0", steps[1].ID)
+	assert.Equal(t, "77", steps[1].JobID)
+	assert.Equal(t, "hydra", steps[1].Name)
+	assert.Equal(t, "FAILED", steps[1].State)
+	assert.Equal(t, 8, steps[1].CPUs)
+	assert.Equal(t, 16384, steps[1].Memory)
+	assert.Equal(t, "node[01-02]", steps[1].Nodes)
+	assert.Equal(t, 3, steps[1].ExitCode)
+	assert.Equal(t, 63*time.Minute, steps[1].EndTime.Sub(*steps[1].StartTime))
+
+	_, err = NewRunner(Config{}).JobSteps(context.Background(), "78")
+	require.Error(t, err)
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/errors"
+)
+
+// sacctFields are the columns JobAccounting requests, in order
+var sacctFields = []string{
+	"JobID", "State", "ExitCode", "DerivedExitCode",
+	"Submit", "Start", "End", "AllocTRES", "ReqTRES", "NodeList",
+	"JobName", "User", "Account", "Partition",
+}
+
+// sacctTimeLayout is the timestamp format sacct prints by default
+const sacctTimeLayout = "2006-01-02T15:04:05"
+
+// JobAccounting returns the accounting record of a job as reported by
+// sacct. Only the fields slurmctld drops once a job leaves the queue are
+// populated: state, exit codes, times, TRES and nodes, plus the name, user,
+// account and partition that identify a purged job.
+func (r *Runner) JobAccounting(ctx context.Context, jobID string) (*types.Job, error) {
+	id, err := strconv.ParseUint(jobID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job ID %q: %w", jobID, err)
+	}
+	out, err := r.run(ctx, r.config.SacctPath,
+		"--jobs", jobID, "--allocations", "--noheader", "--parsable2",
+		"--format", strings.Join(sacctFields, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		columns := strings.Split(strings.TrimSpace(line), "|")
+		if len(columns) != len(sacctFields) || columns[0] != jobID {
+			continue
+		}
+		return parseSacctJob(int64(id), columns), nil
+	}
+	return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+		fmt.Sprintf("Accounting record for job %s not found", jobID))
+}
+
+// parseSacctJob builds a Job from one line of sacct output
+func parseSacctJob(jobID int64, columns []string) *types.Job {
+	job := &types.Job{
+		JobID:           &jobID,
+		ExitCode:        parseSacctExitCode(columns[2]),
+		DerivedExitCode: parseSacctExitCode(columns[3]),
+		SubmitTime:      parseSacctTime(columns[4]),
+		StartTime:       parseSacctTime(columns[5]),
+		EndTime:         parseSacctTime(columns[6]),
+		TRESAllocStr:    optionalString(columns[7]),
+		TRESReqStr:      optionalString(columns[8]),
+		Name:            optionalString(columns[10]),
+		UserName:        optionalString(columns[11]),
+		Account:         optionalString(columns[12]),
+		Partition:       optionalString(columns[13]),
+	}
+	// States such as "CANCELLED by 1000" carry the cancelling user
+	if state, _, _ := strings.Cut(columns[1], " "); state != "" {
+		job.JobState = []types.JobState{types.JobState(state)}
+	}
+	if nodes := columns[9]; nodes != "None assigned" {
+		job.Nodes = optionalString(nodes)
+	}
+	return job
+}
+
+// parseSacctExitCode parses an exit code written as "<return code>:<signal>"
+func parseSacctExitCode(s string) *types.ExitCode {
+	rc, sig, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil
+	}
+	code := &types.ExitCode{}
+	if v, err := strconv.ParseUint(rc, 10, 32); err == nil {
+		returnCode := uint32(v)
+		code.ReturnCode = &returnCode
+	}
+	if v, err := strconv.ParseUint(sig, 10, 16); err == nil && v != 0 {
+		signal := uint16(v)
+		code.Signal = &types.ExitCodeSignal{ID: &signal}
+	}
+	return code
+}
+
+// parseSacctTime parses a sacct timestamp, which is in local time. Values
+// such as "Unknown" and "None" yield the zero time.
+func parseSacctTime(s string) time.Time {
+	t, err := time.ParseInLocation(sacctTimeLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// sacctStepFields are the columns JobSteps requests, in order
+var sacctStepFields = []string{
+	"JobID", "JobName", "State", "Start", "End", "AllocTRES", "NodeList", "ExitCode",
+}
+
+// JobSteps returns the steps of a job as reported by sacct
+func (r *Runner) JobSteps(ctx context.Context, jobID string) ([]types.JobStep, error) {
+	if _, err := strconv.ParseUint(jobID, 10, 32); err != nil {
+		return nil, fmt.Errorf("invalid job ID %q: %w", jobID, err)
+	}
+	out, err := r.run(ctx, r.config.SacctPath,
+		"--jobs", jobID, "--noheader", "--parsable2",
+		"--format", strings.Join(sacctStepFields, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]types.JobStep, 0)
+	found := false
+	for _, line := range strings.Split(out, "\n") {
+		columns := strings.Split(strings.TrimSpace(line), "|")
+		if len(columns) != len(sacctStepFields) {
+			continue
+		}
+		if columns[0] == jobID {
+			found = true
+			continue
+		}
+		stepID, ok := strings.CutPrefix(columns[0], jobID+".")
+		if !ok {
+			continue
+		}
+		steps = append(steps, parseSacctStep(jobID, stepID, columns))
+	}
+	if !found && len(steps) == 0 {
+		return nil, errors.NewSlurmError(errors.ErrorCodeResourceNotFound,
+			fmt.Sprintf("Accounting record for job %s not found", jobID))
+	}
+	return steps, nil
+}
+
+// parseSacctStep builds a JobStep from one line of sacct output
+func parseSacctStep(jobID, stepID string, columns []string) types.JobStep {
+	step := types.JobStep{
+		ID:           stepID,
+		JobID:        jobID,
+		Name:         columns[1],
+		TRESAllocStr: columns[5],
+	}
+	if state, _, _ := strings.Cut(columns[2], " "); state != "" {
+		step.State = state
+	}
+	if t := parseSacctTime(columns[3]); !t.IsZero() {
+		step.StartTime = &t
+	}
+	if t := parseSacctTime(columns[4]); !t.IsZero() {
+		step.EndTime = &t
+	}
+	if tres, err := types.ParseTRESList(columns[5]); err == nil {
+		for _, entry := range tres {
+			switch {
+			case entry.Type == "cpu" && entry.Count != nil:
+				step.CPUs = int(*entry.Count)
+			case entry.Type == "mem" && entry.Count != nil:
+				step.Memory = int(*entry.Count)
+			}
+		}
+	}
+	if nodes := columns[6]; nodes != "None assigned" {
+		step.Nodes = nodes
+	}
+	if code := parseSacctExitCode(columns[7]); code != nil && code.ReturnCode != nil {
+		step.ExitCode = int(*code.ReturnCode)
+	}
+	return step
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// stepIDPattern matches the step part of a <job>.<step> ID
+var stepIDPattern = regexp.MustCompile(`^([0-9]+|batch|extern|interactive)$`)
+
+// SignalStep sends signal, given by number, to one step of a job
+func (r *Runner) SignalStep(ctx context.Context, jobID, stepID string, signal int) error {
+	step, err := stepTarget(jobID, stepID)
+	if err != nil {
+		return err
+	}
+	return r.mutate(ctx, r.config.ScancelPath, "--signal="+strconv.Itoa(signal), step)
+}
+
+// CancelPending cancels a job only if it is still pending. The state filter
+// is applied by scancel as it signals, so a running job is left alone.
+func (r *Runner) CancelPending(ctx context.Context, jobID string) error {
+	if _, err := strconv.ParseUint(jobID, 10, 32); err != nil {
+		return fmt.Errorf("invalid job ID %q: %w", jobID, err)
+	}
+	return r.mutate(ctx, r.config.ScancelPath, "--state=PENDING", jobID)
+}
+
+// CancelStep cancels one step of a job, leaving the job and its other
+// steps running
+func (r *Runner) CancelStep(ctx context.Context, jobID, stepID string) error {
+	step, err := stepTarget(jobID, stepID)
+	if err != nil {
+		return err
+	}
+	return r.mutate(ctx, r.config.ScancelPath, step)
+}
+
+// stepTarget validates a job and step ID and joins them as scancel takes
+// them, e.g. "1234.0"
+func stepTarget(jobID, stepID string) (string, error) {
+	if _, err := strconv.ParseUint(jobID, 10, 32); err != nil {
+		return "", fmt.Errorf("invalid job ID %q: %w", jobID, err)
+	}
+	if !stepIDPattern.MatchString(stepID) {
+		return "", fmt.Errorf("invalid step ID %q", stepID)
+	}
+	return jobID + "." + stepID, nil
+}
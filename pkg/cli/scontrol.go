@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// JobScript returns the batch script of a pending or running job
+func (r *Runner) JobScript(ctx context.Context, jobID string) (string, error) {
+	if _, err := strconv.ParseUint(jobID, 10, 32); err != nil {
+		return "", fmt.Errorf("invalid job ID %q: %w", jobID, err)
+	}
+	// "-" writes the script to standard output instead of a file
+	return r.run(ctx, r.config.ScontrolPath, "write", "batch_script", jobID, "-")
+}
+
+// ControllerVersion returns the SLURM_VERSION slurmctld reports in
+// "scontrol show config"
+func (r *Runner) ControllerVersion(ctx context.Context) (string, error) {
+	out, err := r.run(ctx, r.config.ScontrolPath, "show", "config")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(out, "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if ok && strings.TrimSpace(key) == "SLURM_VERSION" {
+			return strings.TrimSpace(value), nil
+		}
+	}
+	return "", fmt.Errorf("scontrol show config reported no SLURM_VERSION")
+}
+
+// RebootNode asks slurmctld to reboot a node with "scontrol reboot"
+func (r *Runner) RebootNode(ctx context.Context, name string, opts *types.RebootOptions) error {
+	if name == "" || strings.ContainsAny(name, " =") {
+		return fmt.Errorf("invalid node name %q", name)
+	}
+	args := []string{"reboot"}
+	if opts != nil {
+		if opts.ASAP {
+			args = append(args, "ASAP")
+		}
+		if opts.NextState != "" {
+			args = append(args, "nextstate="+opts.NextState)
+		}
+		if opts.Reason != "" {
+			args = append(args, "reason="+opts.Reason)
+		}
+	}
+	args = append(args, name)
+	return r.mutate(ctx, r.config.ScontrolPath, args...)
+}
+
+// UpdatePartition applies update to the named partition with scontrol. It
+// fails without running anything if update sets a field scontrol cannot
+// change.
+func (r *Runner) UpdatePartition(ctx context.Context, name string, update *types.PartitionUpdate) error {
+	if name == "" || strings.ContainsAny(name, " =") {
+		return fmt.Errorf("invalid partition name %q", name)
+	}
+	settings, err := partitionSettings(update)
+	if err != nil {
+		return err
+	}
+	if len(settings) == 0 {
+		return nil
+	}
+	args := append([]string{"update", "PartitionName=" + name}, settings...)
+	return r.mutate(ctx, r.config.ScontrolPath, args...)
+}
+
+// CreatePartition adds a partition to the running slurmctld with "scontrol
+// create". Like any partition made this way it is lost when slurmctld
+// restarts unless it is also added to slurm.conf.
+func (r *Runner) CreatePartition(ctx context.Context, partition *types.PartitionCreate) error {
+	if partition == nil {
+		return fmt.Errorf("partition is required")
+	}
+	if partition.Name == "" || strings.ContainsAny(partition.Name, " =") {
+		return fmt.Errorf("invalid partition name %q", partition.Name)
+	}
+	settings, err := partitionSettings(partitionCreateUpdate(partition))
+	if err != nil {
+		return err
+	}
+	args := append([]string{"create", "PartitionName=" + partition.Name}, settings...)
+	return r.mutate(ctx, r.config.ScontrolPath, args...)
+}
+
+// DeletePartition removes a partition from the running slurmctld with
+// "scontrol delete"
+func (r *Runner) DeletePartition(ctx context.Context, name string) error {
+	if name == "" || strings.ContainsAny(name, " =") {
+		return fmt.Errorf("invalid partition name %q", name)
+	}
+	return r.mutate(ctx, r.config.ScontrolPath, "delete", "PartitionName="+name)
+}
+
+// partitionCreateUpdate returns the settings of p as an update, leaving out
+// the fields p leaves at their zero value so slurmctld's defaults apply
+func partitionCreateUpdate(p *types.PartitionCreate) *types.PartitionUpdate {
+	update := &types.PartitionUpdate{JobDefaults: p.JobDefaults}
+	list := func(v []string) []string {
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	}
+	str := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	num := func(v int32) *int32 {
+		if v == 0 {
+			return nil
+		}
+		return &v
+	}
+	num64 := func(v int64) *int64 {
+		if v == 0 {
+			return nil
+		}
+		return &v
+	}
+	flag := func(v bool) *bool {
+		if !v {
+			return nil
+		}
+		return &v
+	}
+
+	update.AllowAccounts = list(p.AllowAccounts)
+	update.AllowGroups = list(p.AllowGroups)
+	update.AllowQoS = list(p.AllowQoS)
+	update.DenyAccounts = list(p.DenyAccounts)
+	update.DenyQoS = list(p.DenyQoS)
+	update.PreemptMode = list(p.PreemptMode)
+	update.SelectTypeParameters = list(p.SelectTypeParameters)
+	update.AllocNodes = str(p.AllocNodes)
+	update.AllowAllocNodes = str(p.AllowAllocNodes)
+	update.DefaultMemPerCPU = num64(p.DefaultMemPerCPU)
+	update.DefaultMemPerNode = num64(p.DefaultMemPerNode)
+	update.DefMemPerNode = num64(p.DefMemPerNode)
+	update.DefaultTime = num(p.DefaultTime)
+	update.GraceTime = num(p.GraceTime)
+	update.MaxCPUsPerNode = num(p.MaxCPUsPerNode)
+	update.MaxMemPerNode = num64(p.MaxMemPerNode)
+	update.MaxMemPerCPU = num64(p.MaxMemPerCPU)
+	update.MaxNodes = num(p.MaxNodes)
+	update.MaxTime = num(p.MaxTime)
+	update.MinNodes = num(p.MinNodes)
+	update.Nodes = str(p.Nodes)
+	update.OverTimeLimit = num(p.OverTimeLimit)
+	update.Priority = num(p.Priority)
+	update.PriorityJobFactor = num(p.PriorityJobFactor)
+	update.PriorityTier = num(p.PriorityTier)
+	update.QoS = str(p.QoS)
+	if p.State != "" {
+		state := p.State
+		update.State = &state
+	}
+	update.TresStr = str(p.TresStr)
+	update.BillingWeightStr = str(p.BillingWeightStr)
+	update.ResumeTimeout = num(p.ResumeTimeout)
+	update.SuspendTime = num(p.SuspendTime)
+	update.SuspendTimeout = num(p.SuspendTimeout)
+	update.Hidden = flag(p.Hidden)
+	update.ExclusiveUser = flag(p.ExclusiveUser)
+	update.LLN = flag(p.LLN)
+	update.RootOnly = flag(p.RootOnly)
+	update.ReqResv = flag(p.ReqResv)
+	update.PowerDownOnIdle = flag(p.PowerDownOnIdle)
+	return update
+}
+
+// aliasedSetting returns whichever of two PartitionUpdate fields for the
+// same scontrol key is set, so the key is given once. Setting both to
+// different values is an error.
+func aliasedSetting[T comparable](name string, v *T, aliasName string, alias *T) (*T, error) {
+	if v != nil && alias != nil && *v != *alias {
+		return nil, fmt.Errorf("%s and %s set the same limit to different values", name, aliasName)
+	}
+	if v != nil {
+		return v, nil
+	}
+	return alias, nil
+}
+
+// partitionSettings converts update to scontrol Key=Value arguments
+func partitionSettings(update *types.PartitionUpdate) ([]string, error) {
+	if update == nil {
+		return nil, nil
+	}
+	switch {
+	case len(update.SelectTypeParameters) > 0:
+		return nil, fmt.Errorf("SelectTypeParameters cannot be changed with scontrol")
+	case len(update.JobDefaults) > 0:
+		return nil, fmt.Errorf("JobDefaults cannot be changed with scontrol")
+	case update.TresStr != nil:
+		return nil, fmt.Errorf("TresStr cannot be changed with scontrol")
+	case update.Priority != nil:
+		return nil, fmt.Errorf("Priority is not accepted by scontrol; set PriorityJobFactor or PriorityTier")
+	}
+	allocNodes, err := aliasedSetting("AllocNodes", update.AllocNodes, "AllowAllocNodes", update.AllowAllocNodes)
+	if err != nil {
+		return nil, err
+	}
+	defMemPerNode, err := aliasedSetting("DefaultMemPerNode", update.DefaultMemPerNode, "DefMemPerNode", update.DefMemPerNode)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings []string
+	setString := func(key string, v *string) {
+		if v != nil {
+			settings = append(settings, key+"="+*v)
+		}
+	}
+	setList := func(key string, v []string) {
+		if v != nil {
+			settings = append(settings, key+"="+strings.Join(v, ","))
+		}
+	}
+	setInt := func(key string, v *int32) {
+		if v != nil {
+			settings = append(settings, key+"="+strconv.FormatInt(int64(*v), 10))
+		}
+	}
+	setInt64 := func(key string, v *int64) {
+		if v != nil {
+			settings = append(settings, key+"="+strconv.FormatInt(*v, 10))
+		}
+	}
+	setBool := func(key string, v *bool) {
+		if v != nil {
+			value := "NO"
+			if *v {
+				value = "YES"
+			}
+			settings = append(settings, key+"="+value)
+		}
+	}
+
+	setString("AllocNodes", allocNodes)
+	setList("AllowAccounts", update.AllowAccounts)
+	setList("AllowGroups", update.AllowGroups)
+	setList("AllowQos", update.AllowQoS)
+	setList("DenyAccounts", update.DenyAccounts)
+	setList("DenyQos", update.DenyQoS)
+	setInt64("DefMemPerCPU", update.DefaultMemPerCPU)
+	setInt64("DefMemPerNode", defMemPerNode)
+	setInt("DefaultTime", update.DefaultTime)
+	setInt("GraceTime", update.GraceTime)
+	setInt("MaxCPUsPerNode", update.MaxCPUsPerNode)
+	setInt64("MaxMemPerNode", update.MaxMemPerNode)
+	setInt64("MaxMemPerCPU", update.MaxMemPerCPU)
+	setInt("MaxNodes", update.MaxNodes)
+	setInt("MaxTime", update.MaxTime)
+	setInt("MinNodes", update.MinNodes)
+	setString("Nodes", update.Nodes)
+	if update.OverTimeLimit != nil && *update.OverTimeLimit == int32(types.OverTimeLimitUnlimited) {
+		settings = append(settings, "OverTimeLimit=UNLIMITED")
+	} else {
+		setInt("OverTimeLimit", update.OverTimeLimit)
+	}
+	setList("PreemptMode", update.PreemptMode)
+	setInt("PriorityJobFactor", update.PriorityJobFactor)
+	setInt("PriorityTier", update.PriorityTier)
+	setString("QoS", update.QoS)
+	if update.State != nil {
+		settings = append(settings, "State="+strings.ToUpper(string(*update.State)))
+	}
+	setString("TRESBillingWeights", update.BillingWeightStr)
+	setInt("ResumeTimeout", update.ResumeTimeout)
+	setInt("SuspendTime", update.SuspendTime)
+	setInt("SuspendTimeout", update.SuspendTimeout)
+	setBool("Hidden", update.Hidden)
+	setBool("ExclusiveUser", update.ExclusiveUser)
+	setBool("LLN", update.LLN)
+	setBool("RootOnly", update.RootOnly)
+	setBool("ReqResv", update.ReqResv)
+	setBool("PowerDownOnIdle", update.PowerDownOnIdle)
+	if update.OverSubscribe != nil {
+		settings = append(settings, "OverSubscribe="+overSubscribeSetting(update.OverSubscribe))
+	}
+	return settings, nil
+}
+
+// overSubscribeSetting formats an OverSubscribe policy as scontrol expects,
+// e.g. "FORCE:4", "YES:2" or "NO"
+func overSubscribeSetting(o *types.PartitionMaximumsOversubscribe) string {
+	force := false
+	for _, flag := range o.Flags {
+		if flag == types.PartitionMaximumsOversubscribeFlagsForce {
+			force = true
+		}
+	}
+	switch {
+	case force && o.Jobs != nil:
+		return "FORCE:" + strconv.FormatInt(int64(*o.Jobs), 10)
+	case force:
+		return "FORCE"
+	case o.Jobs != nil && *o.Jobs > 1:
+		return "YES:" + strconv.FormatInt(int64(*o.Jobs), 10)
+	default:
+		return "NO"
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codec defines how the generated API clients encode request bodies
+// and decode response bodies. The clients call the codec directly, so each
+// body is encoded or decoded exactly once. Bodies stay JSON on the wire: a
+// custom codec replaces the JSON implementation, for example with a faster
+// or stricter decoder, not the format.
+package codec
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Codec encodes and decodes message bodies.
+type Codec interface {
+	// Marshal encodes the typed request body v
+	Marshal(v any) ([]byte, error)
+	// Unmarshal decodes data into v, a pointer to a typed response
+	Unmarshal(data []byte, v any) error
+}
+
+// JSON is the default codec, using encoding/json.
+type JSON struct{}
+
+// Marshal implements Codec
+func (JSON) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+// Unmarshal implements Codec
+func (JSON) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+// Carrier is implemented by HTTP doers that carry a codec; the generated
+// clients encode request bodies with the codec of their doer.
+type Carrier interface {
+	Codec() Codec
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying c. The generated clients decode
+// a response with the codec carried by its request's context.
+func NewContext(ctx context.Context, c Codec) context.Context {
+	return context.WithValue(ctx, contextKey{}, c)
+}
+
+// FromContext returns the codec carried by ctx, or JSON if it carries none
+func FromContext(ctx context.Context) Codec {
+	if c, ok := ctx.Value(contextKey{}).(Codec); ok && c != nil {
+		return c
+	}
+	return JSON{}
+}
+
+// FromDoer returns the codec carried by doer, or JSON if it carries none
+func FromDoer(doer any) Codec {
+	if carrier, ok := doer.(Carrier); ok {
+		if c := carrier.Codec(); c != nil {
+			return c
+		}
+	}
+	return JSON{}
+}
@@ -17,6 +17,10 @@ type Config struct {
 	// Timeout is the request timeout
 	Timeout time.Duration
 
+	// Timeouts sets default deadlines for classes of operations, such as a
+	// longer one for job submissions than for reads
+	Timeouts Timeouts
+
 	// UserAgent is the user agent string
 	UserAgent string
 
@@ -98,6 +102,10 @@ func (c *Config) Validate() error {
 		return ErrInvalidMaxRetries
 	}
 
+	if c.Timeouts.List < 0 || c.Timeouts.Get < 0 || c.Timeouts.Write < 0 || c.Timeouts.Watch < 0 {
+		return ErrInvalidOperationTimeout
+	}
+
 	return nil
 }
 
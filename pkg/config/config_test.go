@@ -277,3 +277,19 @@ func TestConfigDefaults(t *testing.T) {
 	helpers.AssertEqual(t, false, config.Debug)
 	helpers.AssertEqual(t, false, config.InsecureSkipVerify)
 }
+
+func TestTimeouts(t *testing.T) {
+	var timeouts Timeouts
+	helpers.AssertNoError(t, timeouts.Set(OperationWrite, 2*time.Minute))
+	helpers.AssertNoError(t, timeouts.Set(OperationWatch, time.Hour))
+	assert.Equal(t, 2*time.Minute, timeouts.For(OperationWrite))
+	assert.Equal(t, time.Hour, timeouts.Watch)
+	assert.Zero(t, timeouts.For(OperationList))
+
+	assert.ErrorIs(t, timeouts.Set(OperationGet, -time.Second), ErrInvalidOperationTimeout)
+	assert.Error(t, timeouts.Set("submit", time.Second))
+
+	config := NewDefault()
+	config.Timeouts.List = -time.Second
+	assert.ErrorIs(t, config.Validate(), ErrInvalidOperationTimeout)
+}
@@ -12,6 +12,9 @@ var (
 	// ErrInvalidTimeout is returned when the timeout is invalid
 	ErrInvalidTimeout = errors.New("timeout must be greater than 0")
 
+	// ErrInvalidOperationTimeout is returned when an operation timeout is negative
+	ErrInvalidOperationTimeout = errors.New("operation timeouts cannot be negative")
+
 	// ErrInvalidMaxRetries is returned when max retries is invalid
 	ErrInvalidMaxRetries = errors.New("max retries must be greater than or equal to 0")
 )
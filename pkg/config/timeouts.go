@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// OperationClass groups the operations that share a default timeout
+type OperationClass string
+
+const (
+	// OperationList covers requests that list jobs, nodes, accounts and
+	// other objects
+	OperationList OperationClass = "list"
+	// OperationGet covers requests that read a single object, and pings,
+	// diagnostics and other reads
+	OperationGet OperationClass = "get"
+	// OperationWrite covers requests that change the cluster, such as job
+	// submissions, updates and cancellations
+	OperationWrite OperationClass = "write"
+	// OperationWatch covers the whole of a Watch call, across all its polls
+	OperationWatch OperationClass = "watch"
+)
+
+// Timeouts holds a default deadline for each class of operation. A deadline
+// applies only when the caller's context has none of its own. Zero leaves
+// list, get and write requests to the HTTP client's timeout, and watches
+// running until their context ends.
+//
+// Once any of List, Get or Write is set, these deadlines replace the HTTP
+// client's timeout, so they can be longer than it, and the classes left at
+// zero get it as their deadline.
+type Timeouts struct {
+	List  time.Duration
+	Get   time.Duration
+	Write time.Duration
+	Watch time.Duration
+}
+
+// For returns the timeout of class
+func (t Timeouts) For(class OperationClass) time.Duration {
+	switch class {
+	case OperationList:
+		return t.List
+	case OperationGet:
+		return t.Get
+	case OperationWrite:
+		return t.Write
+	case OperationWatch:
+		return t.Watch
+	default:
+		return 0
+	}
+}
+
+// Set sets the timeout of class
+func (t *Timeouts) Set(class OperationClass, timeout time.Duration) error {
+	if timeout < 0 {
+		return ErrInvalidOperationTimeout
+	}
+	switch class {
+	case OperationList:
+		t.List = timeout
+	case OperationGet:
+		t.Get = timeout
+	case OperationWrite:
+		t.Write = timeout
+	case OperationWatch:
+		t.Watch = timeout
+	default:
+		return fmt.Errorf("unknown operation class %q", class)
+	}
+	return nil
+}
@@ -252,6 +252,19 @@ func NewAuthError(authMethod, tokenType string, cause error) *AuthenticationErro
 	return NewAuthenticationError(code, message, authMethod, tokenType, cause)
 }
 
+// NewAuthPluginMismatchError creates an error for a server that rejected the
+// request because it expects a different authentication plugin than the one
+// the client used. The plugin is only known when the server names it, in a
+// WWW-Authenticate header or its error body; other rejections stay
+// ErrorCodeUnauthorized.
+func NewAuthPluginMismatchError(expected, provided string) *SlurmError {
+	err := NewSlurmError(ErrorCodeAuthPluginMismatch,
+		fmt.Sprintf("slurmrestd expects %s authentication", expected))
+	err.StatusCode = http.StatusUnauthorized
+	err.Details = fmt.Sprintf("client authenticated with %q; configure a %s auth provider", provided, expected)
+	return err
+}
+
 // NewValidationErrorf creates a validation error with formatted message
 func NewValidationErrorf(field string, value interface{}, format string, args ...interface{}) *ValidationError {
 	message := fmt.Sprintf(format, args...)
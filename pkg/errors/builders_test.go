@@ -162,7 +162,7 @@ func TestWrapHTTPError(t *testing.T) {
 			statusCode: 400,
 			body:       []byte("SLURM_INVALID_JOB_ID: job not found"),
 			apiVersion: "v0.0.42",
-			expected:   ErrorCodeResourceNotFound,
+			expected:   ErrorCodeJobNotFound,
 		},
 		{
 			name:       "empty body",
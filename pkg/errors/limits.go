@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LimitExceededError is returned when Slurm rejects a request because it
+// would go over an association or QoS limit, such as MaxSubmitJobs.
+type LimitExceededError struct {
+	*SlurmError
+	// Limit is the sacctmgr name of the limit, e.g. "MaxSubmitJobs" or
+	// "GrpTRES"; empty when Slurm only reports that an accounting policy
+	// was violated without naming the limit
+	Limit string `json:"limit,omitempty"`
+	// Scope is "association" or "qos" when known
+	Scope string `json:"scope,omitempty"`
+	// Current is the usage counted against the limit; zero if not reported
+	Current int64 `json:"current,omitempty"`
+	// Max is the configured value of the limit; zero if not reported
+	Max int64 `json:"max,omitempty"`
+}
+
+// NewLimitExceededError creates a new limit exceeded error
+func NewLimitExceededError(limit, scope string, current, max int64, cause error) *LimitExceededError {
+	message := "request exceeds a Slurm limit"
+	if limit != "" {
+		message = "request exceeds the " + limit + " limit"
+		if scope != "" {
+			message = "request exceeds the " + scope + " " + limit + " limit"
+		}
+	}
+	if max > 0 {
+		message += fmt.Sprintf(" (%d of %d)", current, max)
+	}
+	return &LimitExceededError{
+		SlurmError: NewSlurmErrorWithCause(ErrorCodeLimitExceeded, message, cause),
+		Limit:      limit,
+		Scope:      scope,
+		Current:    current,
+		Max:        max,
+	}
+}
+
+// IsLimitExceededError checks if an error is a limit exceeded error
+func IsLimitExceededError(err error) bool {
+	var limitErr *LimitExceededError
+	if stderrors.As(err, &limitErr) {
+		return true
+	}
+	var slurmErr *SlurmError
+	if stderrors.As(err, &slurmErr) {
+		return slurmErr.Code == ErrorCodeLimitExceeded
+	}
+	return false
+}
+
+var (
+	// Pending reasons such as QOSMaxSubmitJobPerUserLimit or AssocGrpCpuLimit
+	limitReasonPattern = regexp.MustCompile(`\b(Assoc|QOS)((?:Max|Grp|Min)[A-Za-z]+?)(PerUser|PerAccount|PerNode)?Limit\b`)
+	// sacctmgr limit names such as MaxSubmitJobs=100 or QOSGrpTRES
+	limitNamePattern = regexp.MustCompile(`\b(Assoc|QOS)?((?:Max|Grp)(?:SubmitJobs|Jobs|Wall|TRESMins|TRESRunMins|TRES)(?:PerUser|PerAccount|PerNode|PerJob)?)\b`)
+	// A value after the limit name: "=100", " limit of 100", " (100)"
+	limitMaxPattern = regexp.MustCompile(`^(?:\s+limit)?(?:\s*[=:]\s*|\s+of\s+|\s*\(\s*)(\d+)`)
+	// Usage against the limit: "(100/100)" or "100 of 100"
+	limitRatioPattern   = regexp.MustCompile(`\b(\d+)\s*(?:/|\bof\b)\s*(\d+)\b`)
+	limitCurrentPattern = regexp.MustCompile(`(?i)\bcurrent\w*\s*[=:]?\s*(\d+)`)
+)
+
+// limitReasonNames maps the limit part of a pending reason onto the
+// sacctmgr name of the limit where the two differ
+var limitReasonNames = map[string]string{
+	"MaxSubmitJob":    "MaxSubmitJobs",
+	"GrpSubmitJob":    "GrpSubmitJobs",
+	"MaxJob":          "MaxJobs",
+	"GrpJob":          "GrpJobs",
+	"MaxWallDuration": "MaxWall",
+}
+
+// ParseLimitExceeded reads the limit a rejection was about from the error
+// Slurm returned. It recognizes pending-reason style names such as
+// QOSMaxSubmitJobPerUserLimit, sacctmgr names such as MaxSubmitJobs=100,
+// and the accounting policy violation Slurm reports when it does not name
+// the limit. It returns nil when err is not about a limit.
+func ParseLimitExceeded(err error) *LimitExceededError {
+	if err == nil {
+		return nil
+	}
+	var limitErr *LimitExceededError
+	if stderrors.As(err, &limitErr) {
+		return limitErr
+	}
+
+	text := err.Error()
+	var limit, scope, rest string
+	if m := limitReasonPattern.FindStringSubmatchIndex(text); m != nil {
+		if strings.EqualFold(text[m[2]:m[3]], "QOS") {
+			scope = "qos"
+		} else {
+			scope = "association"
+		}
+		limit = text[m[4]:m[5]]
+		if name, ok := limitReasonNames[limit]; ok {
+			limit = name
+		}
+		if m[6] >= 0 {
+			limit += text[m[6]:m[7]]
+		}
+		rest = text[m[1]:]
+	} else if m := limitNamePattern.FindStringSubmatchIndex(text); m != nil {
+		limit = text[m[4]:m[5]]
+		rest = text[m[1]:]
+		lower := strings.ToLower(text)
+		switch {
+		case m[2] >= 0 && text[m[2]:m[3]] == "Assoc":
+			scope = "association"
+		case strings.Contains(lower, "qos"):
+			scope = "qos"
+		case strings.Contains(lower, "association"):
+			scope = "association"
+		}
+	} else if !strings.Contains(strings.ToLower(text), "accounting/qos policy") {
+		return nil
+	}
+
+	var current, max int64
+	if m := limitRatioPattern.FindStringSubmatch(rest); m != nil {
+		current, _ = strconv.ParseInt(m[1], 10, 64)
+		max, _ = strconv.ParseInt(m[2], 10, 64)
+	} else {
+		if m := limitMaxPattern.FindStringSubmatch(rest); m != nil {
+			max, _ = strconv.ParseInt(m[1], 10, 64)
+		}
+		if m := limitCurrentPattern.FindStringSubmatch(rest); m != nil {
+			current, _ = strconv.ParseInt(m[1], 10, 64)
+		}
+	}
+
+	limitErr = NewLimitExceededError(limit, scope, current, max, err)
+	var slurmErr *SlurmError
+	if stderrors.As(err, &slurmErr) {
+		limitErr.Details = slurmErr.Message
+		limitErr.StatusCode = slurmErr.StatusCode
+		limitErr.APIVersion = slurmErr.APIVersion
+		limitErr.RequestID = slurmErr.RequestID
+	}
+	return limitErr
+}
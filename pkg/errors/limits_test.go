@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseLimitExceeded(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		limit   string
+		scope   string
+		current int64
+		max     int64
+	}{
+		{
+			name:    "pending reason with usage",
+			message: "QOSMaxSubmitJobPerUserLimit (100/100)",
+			limit:   "MaxSubmitJobsPerUser",
+			scope:   "qos",
+			current: 100,
+			max:     100,
+		},
+		{
+			name:    "association reason",
+			message: "Job violates accounting/QOS policy: AssocMaxSubmitJobLimit",
+			limit:   "MaxSubmitJobs",
+			scope:   "association",
+		},
+		{
+			name:    "sacctmgr name with value",
+			message: "association MaxSubmitJobs=50 reached, current=50",
+			limit:   "MaxSubmitJobs",
+			scope:   "association",
+			current: 50,
+			max:     50,
+		},
+		{
+			name:    "group TRES",
+			message: "QOSGrpTRES limit of 64 reached",
+			limit:   "GrpTRES",
+			scope:   "qos",
+			max:     64,
+		},
+		{
+			name:    "unnamed accounting policy",
+			message: "Job violates accounting/QOS policy (job submit limit, user's size and/or time limits)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cause := NewSlurmAPIError(500, "v0.0.44", []SlurmAPIErrorDetail{{Description: tt.message}}).SlurmError
+			limitErr := ParseLimitExceeded(cause)
+			if limitErr == nil {
+				t.Fatalf("ParseLimitExceeded(%q) = nil", tt.message)
+			}
+			if limitErr.Limit != tt.limit || limitErr.Scope != tt.scope {
+				t.Errorf("got limit %q scope %q, want %q %q", limitErr.Limit, limitErr.Scope, tt.limit, tt.scope)
+			}
+			if limitErr.Current != tt.current || limitErr.Max != tt.max {
+				t.Errorf("got %d of %d, want %d of %d", limitErr.Current, limitErr.Max, tt.current, tt.max)
+			}
+			if limitErr.Code != ErrorCodeLimitExceeded || limitErr.Retryable {
+				t.Errorf("unexpected code %v retryable %v", limitErr.Code, limitErr.Retryable)
+			}
+			if limitErr.APIVersion != "v0.0.44" || !errors.Is(limitErr, cause) {
+				t.Error("expected the Slurm error to be kept as the cause")
+			}
+			if !IsLimitExceededError(fmt.Errorf("submit: %w", limitErr)) {
+				t.Error("IsLimitExceededError should see through wrapping")
+			}
+		})
+	}
+}
+
+func TestParseLimitExceeded_OtherErrors(t *testing.T) {
+	for _, err := range []error{
+		nil,
+		NewSlurmError(ErrorCodePartitionUnavailable, "Invalid partition name specified"),
+		errors.New("connection refused"),
+	} {
+		if limitErr := ParseLimitExceeded(err); limitErr != nil {
+			t.Errorf("ParseLimitExceeded(%v) = %v, want nil", err, limitErr)
+		}
+	}
+}
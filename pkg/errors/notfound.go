@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strings"
+)
+
+// notFoundMessages maps the messages Slurm answers a request for an object
+// that does not exist with to the code reported
+var notFoundMessages = []struct {
+	message string
+	code    ErrorCode
+}{
+	{"invalid job id specified", ErrorCodeJobNotFound},
+	{"invalid node name specified", ErrorCodeNodeNotFound},
+	{"invalid partition name specified", ErrorCodePartitionNotFound},
+	{"invalid account or account/partition combination specified", ErrorCodeAccountNotFound},
+}
+
+// notFoundKinds names the object each not-found code is about
+var notFoundKinds = map[ErrorCode]string{
+	ErrorCodeJobNotFound:       "job",
+	ErrorCodeNodeNotFound:      "node",
+	ErrorCodePartitionNotFound: "partition",
+	ErrorCodeAccountNotFound:   "account",
+}
+
+// notFoundCode returns the not-found code for a message of Slurm's
+func notFoundCode(message string) (ErrorCode, bool) {
+	text := strings.ToLower(message)
+	for _, m := range notFoundMessages {
+		if strings.Contains(text, m.message) {
+			return m.code, true
+		}
+	}
+	return "", false
+}
+
+// IsNotFoundError checks if an error reports that a job, node, partition,
+// account or other object does not exist
+func IsNotFoundError(err error) bool {
+	switch GetErrorCode(err) {
+	case ErrorCodeResourceNotFound, ErrorCodeJobNotFound, ErrorCodeNodeNotFound,
+		ErrorCodePartitionNotFound, ErrorCodeAccountNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseNotFound returns err with code, one of the not-found codes, when it
+// reports that the object called name does not exist: Slurm answered with a
+// message such as "Invalid job id specified", or the response held no such
+// object. A 404 without such a message, as from a server that does not
+// serve the endpoint, is not about the object and is returned unchanged, as
+// is any other error.
+func ParseNotFound(err error, code ErrorCode, name string) error {
+	var slurmErr *SlurmError
+	if err == nil || !stderrors.As(err, &slurmErr) || slurmErr.Code == code {
+		return err
+	}
+	if messageCode, ok := notFoundCode(err.Error()); ok {
+		if messageCode != code {
+			return err
+		}
+	} else if slurmErr.Code != ErrorCodeResourceNotFound || slurmErr.StatusCode == http.StatusNotFound {
+		return err
+	}
+
+	notFoundErr := NewSlurmErrorWithCause(code, notFoundKinds[code]+" "+name+" not found", err)
+	notFoundErr.Details = slurmErr.Message
+	notFoundErr.StatusCode = slurmErr.StatusCode
+	notFoundErr.APIVersion = slurmErr.APIVersion
+	notFoundErr.RequestID = slurmErr.RequestID
+	return notFoundErr
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseNotFound(t *testing.T) {
+	// The adapter found no such job in the response
+	cause := NewSlurmError(ErrorCodeResourceNotFound, "Job 42 not found")
+	err := ParseNotFound(fmt.Errorf("get: %w", cause), ErrorCodeJobNotFound, "42")
+	if GetErrorCode(err) != ErrorCodeJobNotFound {
+		t.Fatalf("expected %s, got %v", ErrorCodeJobNotFound, err)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("the original error should be kept as the cause")
+	}
+	if !IsNotFoundError(err) {
+		t.Error("IsNotFoundError should recognize the error")
+	}
+
+	// Slurm's message says which kind of object is missing
+	invalidNode := NewSlurmError(ErrorCodeServerInternal, "Invalid node name specified")
+	invalidNode.StatusCode = 500
+	if got := GetErrorCode(ParseNotFound(invalidNode, ErrorCodeNodeNotFound, "n1")); got != ErrorCodeNodeNotFound {
+		t.Errorf("expected %s, got %s", ErrorCodeNodeNotFound, got)
+	}
+	if got := ParseNotFound(invalidNode, ErrorCodeJobNotFound, "42"); got != invalidNode {
+		t.Errorf("a missing node should not be reported as a missing job: %v", got)
+	}
+
+	// A 404 from a missing endpoint is left alone
+	missingEndpoint := NewSlurmError(ErrorCodeResourceNotFound, "HTTP 404: Not Found")
+	missingEndpoint.StatusCode = 404
+	if got := ParseNotFound(missingEndpoint, ErrorCodeJobNotFound, "42"); got != missingEndpoint {
+		t.Errorf("generic 404 parsed as %v", got)
+	}
+	if !IsNotFoundError(missingEndpoint) {
+		t.Error("a generic 404 is still a not-found error")
+	}
+
+	if err := ParseNotFound(nil, ErrorCodeJobNotFound, "42"); err != nil {
+		t.Errorf("nil error parsed as %v", err)
+	}
+	if IsNotFoundError(errors.New("connection refused")) {
+		t.Error("unrelated error reported as not found")
+	}
+}
+
+func TestSlurmAPIErrorAs(t *testing.T) {
+	apiErr := NewSlurmAPIError(500, "v0.0.41", []SlurmAPIErrorDetail{{ErrorCode: "Invalid job id specified"}})
+	if got := GetErrorCode(fmt.Errorf("get: %w", apiErr)); got != ErrorCodeJobNotFound {
+		t.Errorf("expected %s, got %s", ErrorCodeJobNotFound, got)
+	}
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	stderrors "errors"
+	"strings"
+)
+
+// NotRequeueableError is returned when Slurm refuses to requeue a job
+// because of its state or how it was submitted, for instance a pending
+// job, an interactive job or a job submitted with --no-requeue.
+type NotRequeueableError struct {
+	*SlurmError
+	JobID string `json:"job_id,omitempty"`
+	// Reason says why the job cannot be requeued
+	Reason string `json:"reason,omitempty"`
+}
+
+// NewNotRequeueableError creates a new not requeueable error
+func NewNotRequeueableError(jobID, reason string, cause error) *NotRequeueableError {
+	return &NotRequeueableError{
+		SlurmError: NewSlurmErrorWithCause(ErrorCodeConflict, "job "+jobID+" cannot be requeued: "+reason, cause),
+		JobID:      jobID,
+		Reason:     reason,
+	}
+}
+
+// IsNotRequeueableError checks if an error is a not requeueable error
+func IsNotRequeueableError(err error) bool {
+	var requeueErr *NotRequeueableError
+	return stderrors.As(err, &requeueErr)
+}
+
+// requeueRefusals maps the messages slurmctld answers a requeue of a job
+// in the wrong state with to the reason reported
+var requeueRefusals = []struct {
+	message string
+	reason  string
+}{
+	{"requested operation is presently disabled", "requeue is disabled for the job"},
+	{"only batch jobs are accepted", "only batch jobs can be requeued"},
+	{"job is pending execution", "the job is still pending"},
+	{"job can not be altered now", "the job is changing state"},
+	{"already completing or completed", "the job is completing"},
+}
+
+// ParseNotRequeueable recognizes the errors Slurm returns when the job
+// cannot be requeued in its current state and returns them as a
+// NotRequeueableError. It returns nil for any other error.
+func ParseNotRequeueable(jobID string, err error) *NotRequeueableError {
+	if err == nil {
+		return nil
+	}
+	var requeueErr *NotRequeueableError
+	if stderrors.As(err, &requeueErr) {
+		return requeueErr
+	}
+
+	text := strings.ToLower(err.Error())
+	for _, refusal := range requeueRefusals {
+		if !strings.Contains(text, refusal.message) {
+			continue
+		}
+		requeueErr = NewNotRequeueableError(jobID, refusal.reason, err)
+		var slurmErr *SlurmError
+		if stderrors.As(err, &slurmErr) {
+			requeueErr.Details = slurmErr.Message
+			requeueErr.StatusCode = slurmErr.StatusCode
+			requeueErr.APIVersion = slurmErr.APIVersion
+			requeueErr.RequestID = slurmErr.RequestID
+		}
+		return requeueErr
+	}
+	return nil
+}
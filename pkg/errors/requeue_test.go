@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseNotRequeueable(t *testing.T) {
+	tests := []struct {
+		message string
+		reason  string
+	}{
+		{"Requested operation is presently disabled", "requeue is disabled for the job"},
+		{"Only batch jobs are accepted or processed", "only batch jobs can be requeued"},
+		{"Job is pending execution", "the job is still pending"},
+		{"Job can not be altered now, try again later", "the job is changing state"},
+		{"Job/step already completing or completed", "the job is completing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			cause := NewSlurmError(ErrorCodeInvalidRequest, tt.message)
+			cause.StatusCode = 500
+			err := ParseNotRequeueable("42", fmt.Errorf("requeue: %w", cause))
+			if err == nil {
+				t.Fatalf("expected a NotRequeueableError for %q", tt.message)
+			}
+			if err.Reason != tt.reason {
+				t.Errorf("Reason = %q, want %q", err.Reason, tt.reason)
+			}
+			if err.JobID != "42" || err.Code != ErrorCodeConflict || err.StatusCode != 500 {
+				t.Errorf("unexpected error fields: %+v", err)
+			}
+			if !errors.Is(err, cause) {
+				t.Error("the Slurm error should be kept as the cause")
+			}
+			if !IsNotRequeueableError(err) {
+				t.Error("IsNotRequeueableError should recognize the error")
+			}
+		})
+	}
+
+	if err := ParseNotRequeueable("42", errors.New("connection refused")); err != nil {
+		t.Errorf("unrelated error parsed as %v", err)
+	}
+	if err := ParseNotRequeueable("42", nil); err != nil {
+		t.Errorf("nil error parsed as %v", err)
+	}
+}
@@ -51,6 +51,7 @@ const (
 	ErrorCodeTokenExpired       ErrorCode = "TOKEN_EXPIRED"
 	ErrorCodePermissionDenied   ErrorCode = "PERMISSION_DENIED"
 	ErrorCodeUnauthorized       ErrorCode = "UNAUTHORIZED"
+	ErrorCodeAuthPluginMismatch ErrorCode = "AUTH_PLUGIN_MISMATCH"
 
 	// API and request errors
 	ErrorCodeInvalidRequest   ErrorCode = "INVALID_REQUEST"
@@ -58,6 +59,14 @@ const (
 	ErrorCodeResourceNotFound ErrorCode = "RESOURCE_NOT_FOUND"
 	ErrorCodeConflict         ErrorCode = "CONFLICT"
 	ErrorCodeRateLimited      ErrorCode = "RATE_LIMITED"
+	ErrorCodeLimitExceeded    ErrorCode = "LIMIT_EXCEEDED"
+
+	// Objects that do not exist, told apart from a generic 404 such as an
+	// endpoint the server does not serve
+	ErrorCodeJobNotFound       ErrorCode = "JOB_NOT_FOUND"
+	ErrorCodeNodeNotFound      ErrorCode = "NODE_NOT_FOUND"
+	ErrorCodePartitionNotFound ErrorCode = "PARTITION_NOT_FOUND"
+	ErrorCodeAccountNotFound   ErrorCode = "ACCOUNT_NOT_FOUND"
 
 	// Server and Slurm errors
 	ErrorCodeServerInternal       ErrorCode = "SERVER_INTERNAL"
@@ -175,6 +184,16 @@ type SlurmAPIError struct {
 	Errors      []SlurmAPIErrorDetail `json:"errors,omitempty"`
 }
 
+// As lets errors.As find the SlurmError an API error carries, so that
+// GetErrorCode and the Is helpers see through it
+func (e *SlurmAPIError) As(target interface{}) bool {
+	if slurmErr, ok := target.(**SlurmError); ok && e.SlurmError != nil {
+		*slurmErr = e.SlurmError
+		return true
+	}
+	return false
+}
+
 // SlurmAPIErrorDetail represents detailed error information from Slurm API responses
 type SlurmAPIErrorDetail struct {
 	ErrorNumber int    `json:"error_number"`
@@ -286,11 +305,13 @@ func getErrorCategory(code ErrorCode) ErrorCategory {
 	switch code {
 	case ErrorCodeNetworkTimeout, ErrorCodeConnectionRefused, ErrorCodeDNSResolution, ErrorCodeTLSHandshake:
 		return CategoryNetwork
-	case ErrorCodeInvalidCredentials, ErrorCodeTokenExpired, ErrorCodePermissionDenied, ErrorCodeUnauthorized:
+	case ErrorCodeInvalidCredentials, ErrorCodeTokenExpired, ErrorCodePermissionDenied, ErrorCodeUnauthorized,
+		ErrorCodeAuthPluginMismatch:
 		return CategoryAuthentication
 	case ErrorCodeInvalidRequest, ErrorCodeValidationFailed:
 		return CategoryValidation
-	case ErrorCodeResourceNotFound, ErrorCodeConflict, ErrorCodeResourceExhausted, ErrorCodeJobQueueFull, ErrorCodePartitionUnavailable:
+	case ErrorCodeResourceNotFound, ErrorCodeConflict, ErrorCodeResourceExhausted, ErrorCodeJobQueueFull, ErrorCodePartitionUnavailable,
+		ErrorCodeLimitExceeded, ErrorCodeJobNotFound, ErrorCodeNodeNotFound, ErrorCodePartitionNotFound, ErrorCodeAccountNotFound:
 		return CategoryResource
 	case ErrorCodeServerInternal, ErrorCodeSlurmDaemonDown, ErrorCodeRateLimited:
 		return CategoryServer
@@ -364,7 +385,7 @@ func mapSlurmErrorCodeToClientCode(slurmErrorCode string, statusCode int) ErrorC
 	case "SLURM_INVALID_PARTITION_NAME":
 		return ErrorCodePartitionUnavailable
 	case "SLURM_INVALID_JOB_ID":
-		return ErrorCodeResourceNotFound
+		return ErrorCodeJobNotFound
 	case "SLURM_JOB_ALREADY_COMPLETE":
 		return ErrorCodeConflict
 	case "SLURM_NODE_NOT_AVAIL":
@@ -373,6 +394,9 @@ func mapSlurmErrorCodeToClientCode(slurmErrorCode string, statusCode int) ErrorC
 		// Return the error code as-is for generic VALIDATION_ERROR
 		return ErrorCode(slurmErrorCode)
 	default:
+		if code, ok := notFoundCode(slurmErrorCode); ok {
+			return code
+		}
 		// Fall back to HTTP status code mapping
 		return mapHTTPStatusToErrorCode(statusCode)
 	}
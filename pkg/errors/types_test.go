@@ -329,7 +329,8 @@ func TestMapSlurmErrorCodeToClientCode(t *testing.T) {
 		{"SLURM_PROTOCOL_VERSION_ERROR", 400, ErrorCodeVersionMismatch},
 		{"SLURM_AUTHENTICATION_ERROR", 401, ErrorCodeInvalidCredentials},
 		{"SLURM_ACCESS_DENIED", 403, ErrorCodePermissionDenied},
-		{"SLURM_INVALID_JOB_ID", 404, ErrorCodeResourceNotFound},
+		{"SLURM_INVALID_JOB_ID", 404, ErrorCodeJobNotFound},
+		{"Invalid job id specified", 500, ErrorCodeJobNotFound},
 		{"SLURM_JOB_ALREADY_COMPLETE", 409, ErrorCodeConflict},
 		{"UNKNOWN_SLURM_ERROR", 500, ErrorCodeServerInternal}, // Falls back to HTTP status
 	}
@@ -52,7 +52,7 @@ func getV0040Mapping() *VersionSpecificErrorMapping {
 			"SLURM_COMMUNICATIONS_SHUTDOWN_ERROR":   ErrorCodeSlurmDaemonDown,
 
 			// Job-related errors
-			"SLURM_INVALID_JOB_ID":       ErrorCodeResourceNotFound,
+			"SLURM_INVALID_JOB_ID":       ErrorCodeJobNotFound,
 			"SLURM_JOB_PENDING":          ErrorCodeResourceExhausted,
 			"SLURM_JOB_ALREADY_COMPLETE": ErrorCodeConflict,
 			"SLURM_DUPLICATE_JOB_ID":     ErrorCodeConflict,
@@ -60,12 +60,12 @@ func getV0040Mapping() *VersionSpecificErrorMapping {
 			"SLURM_JOB_SUSPENDED":        ErrorCodeConflict,
 
 			// Node-related errors
-			"SLURM_INVALID_NODE_NAME":                 ErrorCodeResourceNotFound,
+			"SLURM_INVALID_NODE_NAME":                 ErrorCodeNodeNotFound,
 			"SLURM_NODE_NOT_AVAIL":                    ErrorCodeResourceExhausted,
 			"SLURM_REQUESTED_NODE_CONFIG_UNAVAILABLE": ErrorCodeResourceExhausted,
 
 			// Partition-related errors
-			"SLURM_INVALID_PARTITION_NAME": ErrorCodeResourceNotFound,
+			"SLURM_INVALID_PARTITION_NAME": ErrorCodePartitionNotFound,
 			"SLURM_PARTITION_DOWN":         ErrorCodePartitionUnavailable,
 			"SLURM_PARTITION_NOT_AVAIL":    ErrorCodePartitionUnavailable,
 
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events reports what a client does on its own while serving
+// requests, such as retrying them, waiting out rate limits, tripping its
+// circuit breaker and refreshing credentials, for operational dashboards
+// that have no metrics backend.
+package events
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Type identifies the kind of an Event
+type Type string
+
+const (
+	// TypeRetry is sent when a failed request is about to be retried
+	TypeRetry Type = "retry"
+	// TypeRateLimitWait is sent instead of TypeRetry when the request was
+	// rejected as rate limited (HTTP 429) and the client waits to retry it
+	TypeRateLimitWait Type = "rate_limit_wait"
+	// TypeCircuitBreaker is sent when the circuit breaker opens or closes
+	TypeCircuitBreaker Type = "circuit_breaker"
+	// TypeAuthRefresh is sent when the client renewed its credentials after
+	// a request was rejected as unauthorized
+	TypeAuthRefresh Type = "auth_refresh"
+)
+
+// CircuitState is the state a circuit breaker moved to
+type CircuitState string
+
+const (
+	// CircuitOpen means requests are rejected without being sent
+	CircuitOpen CircuitState = "open"
+	// CircuitClosed means requests are sent again
+	CircuitClosed CircuitState = "closed"
+)
+
+// DefaultBufferSize is the number of events a Bus holds for a slow consumer
+// before it drops new ones
+const DefaultBufferSize = 128
+
+// Event describes one thing the client did on its own
+type Event struct {
+	Type Type      `json:"type"`
+	Time time.Time `json:"time"`
+	// Method and Path identify the request the event is about, e.g.
+	// GET /slurm/v0.0.44/jobs
+	Method string `json:"method,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// Attempt is the number of the attempt that failed, starting at 1, for
+	// retries and rate-limit waits
+	Attempt int `json:"attempt,omitempty"`
+	// Wait is how long the client waits before the next attempt
+	Wait time.Duration `json:"wait,omitempty"`
+	// StatusCode is the HTTP status of the response behind the event; zero
+	// when there was none
+	StatusCode int `json:"status_code,omitempty"`
+	// Error describes the failure behind the event, such as the transport
+	// error of a retried request or a failed credential refresh
+	Error string `json:"error,omitempty"`
+	// CircuitState is the state a circuit breaker moved to
+	CircuitState CircuitState `json:"circuit_state,omitempty"`
+}
+
+// Bus delivers events to one channel without ever blocking the requests
+// that emit them. Events are dropped until Events is first called, and
+// whenever the channel's buffer is full.
+type Bus struct {
+	ch         chan Event
+	subscribed atomic.Bool
+	dropped    atomic.Uint64
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewBus creates a Bus holding up to size undelivered events. A size of
+// zero or less uses DefaultBufferSize.
+func NewBus(size int) *Bus {
+	if size <= 0 {
+		size = DefaultBufferSize
+	}
+	return &Bus{ch: make(chan Event, size)}
+}
+
+// Events returns the channel events are delivered on. It is closed by Close.
+func (b *Bus) Events() <-chan Event {
+	b.subscribed.Store(true)
+	return b.ch
+}
+
+// Emit delivers event, setting its Time if unset, or drops it if nobody
+// has asked for events or the buffer is full
+func (b *Bus) Emit(event Event) {
+	if !b.subscribed.Load() {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+	select {
+	case b.ch <- event:
+	default:
+		b.dropped.Add(1)
+	}
+}
+
+// Dropped returns the number of events dropped because the buffer was full
+func (b *Bus) Dropped() uint64 {
+	return b.dropped.Load()
+}
+
+// Close closes the events channel. Events emitted afterwards are dropped.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		b.closed = true
+		close(b.ch)
+	}
+}
+
+// busKey is the context key set by WithBus
+type busKey struct{}
+
+// WithBus returns a context whose requests report their events to bus
+func WithBus(ctx context.Context, bus *Bus) context.Context {
+	return context.WithValue(ctx, busKey{}, bus)
+}
+
+// Emit sends event to the Bus of ctx, if it has one
+func Emit(ctx context.Context, event Event) {
+	if bus, ok := ctx.Value(busKey{}).(*Bus); ok && bus != nil {
+		bus.Emit(event)
+	}
+}
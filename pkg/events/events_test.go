@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus(t *testing.T) {
+	bus := NewBus(2)
+	ctx := WithBus(context.Background(), bus)
+
+	// Nobody is listening yet
+	Emit(ctx, Event{Type: TypeRetry, Attempt: 1})
+
+	events := bus.Events()
+	Emit(ctx, Event{Type: TypeRetry, Attempt: 2})
+	Emit(ctx, Event{Type: TypeRateLimitWait, Attempt: 3})
+	Emit(ctx, Event{Type: TypeRetry, Attempt: 4}) // the buffer is full
+	assert.Equal(t, uint64(1), bus.Dropped())
+
+	event := <-events
+	assert.Equal(t, TypeRetry, event.Type)
+	assert.Equal(t, 2, event.Attempt)
+	assert.False(t, event.Time.IsZero())
+	assert.Equal(t, TypeRateLimitWait, (<-events).Type)
+
+	bus.Close()
+	bus.Close()
+	Emit(ctx, Event{Type: TypeRetry})
+	_, ok := <-events
+	assert.False(t, ok, "the channel is closed")
+
+	// A context without a bus is ignored
+	require.NotPanics(t, func() { Emit(context.Background(), Event{Type: TypeRetry}) })
+}
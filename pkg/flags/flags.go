@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package flags works on the flag lists of Slurm resources, such as the
+// flags of an account, QoS or reservation. A flag list is a slice of a
+// string type, so typed flag constants and raw strings mix freely: values a
+// newer slurmrestd sends are kept as they are, and Validate is there for
+// callers that want to reject values they do not know.
+package flags
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flag is implemented by the typed flag values of every resource
+type Flag interface {
+	~string
+}
+
+// Has reports whether set contains flag. Flags are compared without regard
+// to case, as slurmrestd accepts them in any case.
+func Has[F Flag](set []F, flag F) bool {
+	for _, f := range set {
+		if strings.EqualFold(string(f), string(flag)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add returns set with the given flags appended, skipping those it already
+// has. set itself is not modified.
+func Add[F Flag](set []F, add ...F) []F {
+	result := append(make([]F, 0, len(set)+len(add)), set...)
+	for _, flag := range add {
+		if flag != "" && !Has(result, flag) {
+			result = append(result, flag)
+		}
+	}
+	return result
+}
+
+// Remove returns set without the given flags. set itself is not modified.
+func Remove[F Flag](set []F, remove ...F) []F {
+	result := make([]F, 0, len(set))
+	for _, flag := range set {
+		if !Has(remove, flag) {
+			result = append(result, flag)
+		}
+	}
+	return result
+}
+
+// Validate returns an error naming every flag of set that is not one of
+// known.
+func Validate[F Flag](set []F, known ...F) error {
+	var unknown []string
+	for _, flag := range set {
+		if !Has(known, flag) {
+			unknown = append(unknown, string(flag))
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown flags: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Parse converts raw flag strings to the flag type F, keeping every value
+func Parse[F Flag](values []string) []F {
+	if values == nil {
+		return nil
+	}
+	set := make([]F, len(values))
+	for i, value := range values {
+		set[i] = F(value)
+	}
+	return set
+}
+
+// Strings converts set to raw flag strings, for the fields that take them
+func Strings[F Flag](set []F) []string {
+	if set == nil {
+		return nil
+	}
+	values := make([]string, len(set))
+	for i, flag := range set {
+		values[i] = string(flag)
+	}
+	return values
+}
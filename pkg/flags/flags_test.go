@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package flags
+
+import (
+	"testing"
+
+	"github.com/jontk/slurm-client/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasAddRemove(t *testing.T) {
+	set := []api.QoSFlag{api.QoSFlagsDenyLimit}
+
+	assert.True(t, Has(set, api.QoSFlagsDenyLimit))
+	assert.True(t, Has(set, "deny_limit"))
+	assert.False(t, Has(set, api.QoSFlagsNoDecay))
+
+	added := Add(set, api.QoSFlagsNoDecay, api.QoSFlagsDenyLimit, "")
+	assert.Equal(t, []api.QoSFlag{api.QoSFlagsDenyLimit, api.QoSFlagsNoDecay}, added)
+	assert.Equal(t, []api.QoSFlag{api.QoSFlagsDenyLimit}, set, "Add must not modify its input")
+
+	removed := Remove(added, "DENY_LIMIT")
+	assert.Equal(t, []api.QoSFlag{api.QoSFlagsNoDecay}, removed)
+	assert.Len(t, added, 2, "Remove must not modify its input")
+	assert.Empty(t, Remove(removed, api.QoSFlagsNoDecay))
+}
+
+func TestParseStrings(t *testing.T) {
+	raw := []string{"MAINT", "SOME_FUTURE_FLAG"}
+	set := Parse[api.ReservationFlag](raw)
+	assert.Equal(t, []api.ReservationFlag{api.ReservationFlagsMaint, "SOME_FUTURE_FLAG"}, set)
+	assert.Equal(t, raw, Strings(set))
+
+	assert.Nil(t, Parse[api.ReservationFlag](nil))
+	assert.Nil(t, Strings[api.ReservationFlag](nil))
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("account", func(t *testing.T) {
+		require.NoError(t, Validate([]api.AccountFlag{api.AccountFlagsDeleted, "usersarecoords"}, api.AccountFlagValues()...))
+		assert.EqualError(t, Validate([]api.AccountFlag{"NOT_A_FLAG"}, api.AccountFlagValues()...),
+			"unknown flags: NOT_A_FLAG")
+	})
+
+	t.Run("qos", func(t *testing.T) {
+		qos := Parse[api.QoSFlag]([]string{"DENY_LIMIT", "NO_DECAY", "PARTITION_QOS"})
+		require.NoError(t, Validate(qos, api.QoSFlagValues()...))
+		assert.EqualError(t, Validate(Add(qos, "NO_RESERVATIONS", "RELATIVE_X"), api.QoSFlagValues()...),
+			"unknown flags: NO_RESERVATIONS, RELATIVE_X")
+		assert.EqualError(t, Validate([]api.QoSFlag{api.QoSFlagsNotSet, api.QoSFlagsAdd}, api.QoSFlagValues()...),
+			"unknown flags: NOT_SET, ADD")
+	})
+
+	t.Run("reservation", func(t *testing.T) {
+		reservation := []api.ReservationFlag{api.ReservationFlagMaintenance, api.ReservationFlagsNoHoldJobsAfterEnd}
+		require.NoError(t, Validate(reservation, api.ReservationFlagValues()...))
+		assert.Error(t, Validate(Add(reservation, "WEEKLY_ISH"), api.ReservationFlagValues()...))
+	})
+
+	t.Run("partition", func(t *testing.T) {
+		require.NoError(t, Validate([]api.PartitionOversubscribeFlag{"force"}, api.PartitionOversubscribeFlagValues()...))
+		assert.Error(t, Validate([]api.PartitionOversubscribeFlag{"exclusive"}, api.PartitionOversubscribeFlagValues()...))
+	})
+}
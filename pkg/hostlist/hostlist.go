@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hostlist expands Slurm hostlist expressions such as
+// "node[01-04,08],gpu[1-2]-ib" into individual host names.
+package hostlist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxHosts bounds the number of names a single expression may expand to,
+// matching the range limit slurmctld applies to hostlists
+const MaxHosts = 64 * 1024
+
+// Expand returns the host names described by a hostlist expression, in the
+// order they appear. Zero padding of range bounds is preserved, and several
+// bracketed ranges in one name expand to their cartesian product.
+func Expand(expr string) ([]string, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, nil
+	}
+
+	parts, err := splitTopLevel(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []string
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		expanded, err := expandName(part, MaxHosts-len(hosts))
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, expanded...)
+	}
+	return hosts, nil
+}
+
+// splitTopLevel splits expr on commas that are not inside brackets
+func splitTopLevel(expr string) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range expr {
+		switch r {
+		case '[':
+			if depth > 0 {
+				return nil, fmt.Errorf("nested '[' at offset %d in hostlist %q", i, expr)
+			}
+			depth++
+		case ']':
+			if depth == 0 {
+				return nil, fmt.Errorf("unmatched ']' at offset %d in hostlist %q", i, expr)
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(expr[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unterminated '[' in hostlist %q", expr)
+	}
+	return append(parts, strings.TrimSpace(expr[start:])), nil
+}
+
+// expandName expands a single host name that may contain bracketed ranges
+func expandName(name string, limit int) ([]string, error) {
+	open := strings.IndexByte(name, '[')
+	if open < 0 {
+		if limit < 1 {
+			return nil, fmt.Errorf("hostlist expands to more than %d hosts", MaxHosts)
+		}
+		return []string{name}, nil
+	}
+	closing := strings.IndexByte(name[open:], ']') + open
+	prefix, ranges, rest := name[:open], name[open+1:closing], name[closing+1:]
+
+	values, err := expandRanges(ranges)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range in host %q: %w", name, err)
+	}
+	suffixes, err := expandName(rest, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(values)*len(suffixes) > limit {
+		return nil, fmt.Errorf("hostlist expands to more than %d hosts", MaxHosts)
+	}
+
+	hosts := make([]string, 0, len(values)*len(suffixes))
+	for _, v := range values {
+		for _, s := range suffixes {
+			hosts = append(hosts, prefix+v+s)
+		}
+	}
+	return hosts, nil
+}
+
+// expandRanges expands the body of a bracket, e.g. "01-03,07"
+func expandRanges(ranges string) ([]string, error) {
+	var values []string
+	for _, r := range strings.Split(ranges, ",") {
+		lo, hi, isRange := strings.Cut(r, "-")
+		if !isRange {
+			hi = lo
+		}
+		start, err := strconv.Atoi(lo)
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("bad range bound %q", lo)
+		}
+		end, err := strconv.Atoi(hi)
+		if err != nil || end < 0 {
+			return nil, fmt.Errorf("bad range bound %q", hi)
+		}
+		if end < start {
+			return nil, fmt.Errorf("range %q is descending", r)
+		}
+		if end-start >= MaxHosts || len(values)+end-start >= MaxHosts {
+			return nil, fmt.Errorf("hostlist expands to more than %d hosts", MaxHosts)
+		}
+		width := len(lo)
+		for n := start; n <= end; n++ {
+			values = append(values, fmt.Sprintf("%0*d", width, n))
+		}
+	}
+	return values, nil
+}
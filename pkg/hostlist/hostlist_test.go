@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package hostlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected []string
+	}{
+		{name: "empty", expr: "", expected: nil},
+		{name: "single host", expr: "login1", expected: []string{"login1"}},
+		{name: "plain list", expr: "a1,b2", expected: []string{"a1", "b2"}},
+		{name: "zero padded range", expr: "node[08-11]", expected: []string{"node08", "node09", "node10", "node11"}},
+		{name: "range and singles", expr: "cn[1-2,5]", expected: []string{"cn1", "cn2", "cn5"}},
+		{name: "suffix", expr: "gpu[1-2]-ib", expected: []string{"gpu1-ib", "gpu2-ib"}},
+		{
+			name:     "multiple brackets",
+			expr:     "r[1-2]n[1-2]",
+			expected: []string{"r1n1", "r1n2", "r2n1", "r2n2"},
+		},
+		{
+			name:     "mixed list",
+			expr:     "node[1-2],login1,gpu[03-04]",
+			expected: []string{"node1", "node2", "login1", "gpu03", "gpu04"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hosts, err := Expand(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, hosts)
+		})
+	}
+}
+
+func TestExpand_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"node[1-3",
+		"node1-3]",
+		"node[a-b]",
+		"node[5-1]",
+		"node[[1-2]]",
+		"node[0-99999999]",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := Expand(expr)
+			assert.Error(t, err)
+		})
+	}
+}
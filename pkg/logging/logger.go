@@ -281,6 +281,19 @@ func (NoOpLogger) Error(_ string, _ ...any)          {}
 func (NoOpLogger) With(_ ...any) Logger                { return NoOpLogger{} }
 func (NoOpLogger) WithContext(_ context.Context) Logger { return NoOpLogger{} }
 
+// WarnEnabled reports whether logger records warnings, so callers can skip
+// work, such as decoding a request body, whose only use is a warning. It is
+// false for NoOpLogger and for a logger set to a level above warn.
+func WarnEnabled(logger Logger) bool {
+	switch l := logger.(type) {
+	case nil, NoOpLogger, *NoOpLogger:
+		return false
+	case *slogLogger:
+		return l.logger.Enabled(context.Background(), slog.LevelWarn)
+	}
+	return true
+}
+
 // DefaultLogger is a package-level logger for convenience
 var DefaultLogger = NewLogger(DefaultConfig())
 
@@ -274,6 +274,13 @@ func TestNoOpLogger(t *testing.T) {
 	assert.Equal(t, NoOpLogger{}, contextLogger)
 }
 
+func TestWarnEnabled(t *testing.T) {
+	assert.False(t, WarnEnabled(nil))
+	assert.False(t, WarnEnabled(NoOpLogger{}))
+	assert.False(t, WarnEnabled(NewLogger(&Config{Level: slog.LevelError, Output: os.Stdout}).With("key", "value")))
+	assert.True(t, WarnEnabled(NewLogger(&Config{Level: slog.LevelWarn, Output: os.Stdout})))
+}
+
 func TestDefaultLogger(t *testing.T) {
 	// DefaultLogger should be initialized
 	assert.NotNil(t, DefaultLogger)
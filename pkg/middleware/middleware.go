@@ -14,6 +14,8 @@ import (
 	"sync"
 	"time"
 
+	slurmerrors "github.com/jontk/slurm-client/pkg/errors"
+	"github.com/jontk/slurm-client/pkg/events"
 	"github.com/jontk/slurm-client/pkg/logging"
 	"github.com/jontk/slurm-client/pkg/retry"
 )
@@ -160,50 +162,100 @@ func WithLogging(logger logging.Logger) Middleware {
 func WithRetry(maxAttempts int, shouldRetry ShouldRetryFunc) Middleware {
 	return func(next http.RoundTripper) http.RoundTripper {
 		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
-			var lastErr error
-			var lastResp *http.Response
+			if retry.Disabled(req.Context()) {
+				return next.RoundTrip(req)
+			}
 
-			for attempt := range maxAttempts {
-			// Clone request for retry
+			start := time.Now()
+			lastStatusCode := 0
+			for attempt := 0; ; attempt++ {
+				// Clone request for retry
 				reqCopy := cloneRequest(req)
 
 				resp, err := next.RoundTrip(reqCopy)
 
 				// Check if we should retry
+				if attempt >= maxAttempts-1 {
+					// Out of attempts: a failure that would have been
+					// retried had attempts remained is exhausted
+					if attempt > 0 && shouldRetry(resp, err, attempt-1) {
+						return retryOutcome(req, resp, err, attempt+1, lastStatusCode, start)
+					}
+					return resp, err
+				}
 				if !shouldRetry(resp, err, attempt) {
 					return resp, err
 				}
 
+				if resp != nil {
+					lastStatusCode = resp.StatusCode
+				}
+
 				// Close response body if present
 				if resp != nil && resp.Body != nil {
 					_, _ = io.Copy(io.Discard, resp.Body) // Intentionally ignore error during cleanup
 					_ = resp.Body.Close()                 // Intentionally ignore error during cleanup
 				}
 
-				lastErr = err
-				lastResp = resp
-
 				// Calculate backoff
-				if attempt < maxAttempts-1 {
-					backoff := calculateBackoff(attempt)
-					select {
-					case <-time.After(backoff):
-						// Continue to next attempt
-					case <-req.Context().Done():
-						return nil, req.Context().Err()
-					}
+				backoff := calculateBackoff(attempt)
+				emitRetry(req, resp, err, attempt, backoff)
+				select {
+				case <-time.After(backoff):
+					// Continue to next attempt
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
 				}
 			}
-
-			// Return last response/error
-			if lastErr != nil {
-				return nil, fmt.Errorf("all %d attempts failed: %w", maxAttempts, lastErr)
-			}
-			return lastResp, nil
 		})
 	}
 }
 
+// emitRetry reports that attempt, counted from zero, failed with resp or err
+// and that req is sent again after wait. A rate-limited response is reported
+// as a rate-limit wait.
+func emitRetry(req *http.Request, resp *http.Response, err error, attempt int, wait time.Duration) {
+	event := events.Event{
+		Type:    events.TypeRetry,
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Attempt: attempt + 1,
+		Wait:    wait,
+	}
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+		if resp.StatusCode == http.StatusTooManyRequests {
+			event.Type = events.TypeRateLimitWait
+		}
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	events.Emit(req.Context(), event)
+}
+
+// retryOutcome returns the failed result of the last of attempts attempts,
+// one the retry policy would have retried had attempts remained, as a
+// retry.RetryExhaustedError, with the Slurm error of a failed response read
+// out of its body, so callers can tell how long they were retried for.
+// Results the policy does not retry never reach it and are returned as is.
+func retryOutcome(req *http.Request, resp *http.Response, err error, attempts, lastStatusCode int, start time.Time) (*http.Response, error) {
+	if attempts < 2 || req.Context().Err() != nil {
+		return resp, err
+	}
+	if err != nil {
+		return nil, retry.NewRetryExhaustedError(err, attempts, lastStatusCode, time.Since(start))
+	}
+	if resp == nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, nil
+	}
+
+	body, _ := io.ReadAll(resp.Body) // Read what we can; the status alone still describes the failure
+	_ = resp.Body.Close()
+	slurmErr := slurmerrors.WrapHTTPError(resp.StatusCode, body, "")
+	return nil, retry.NewRetryExhaustedError(slurmErr, attempts, resp.StatusCode, time.Since(start))
+}
+
 // ShouldRetryFunc determines if a request should be retried
 type ShouldRetryFunc func(resp *http.Response, err error, attempt int) bool
 
@@ -251,47 +303,54 @@ func calculateBackoff(attempt int) time.Duration {
 func WithRetryPolicy(policy retry.Policy) Middleware {
 	return func(next http.RoundTripper) http.RoundTripper {
 		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
-			var lastErr error
-			var lastResp *http.Response
+			if retry.Disabled(req.Context()) {
+				return next.RoundTrip(req)
+			}
 
+			start := time.Now()
 			maxAttempts := policy.MaxRetries() + 1 // MaxRetries is number of retries, not total attempts
-			for attempt := range maxAttempts {
+			lastStatusCode := 0
+			for attempt := 0; ; attempt++ {
 				// Clone request for retry
 				reqCopy := cloneRequest(req)
 
 				resp, err := next.RoundTrip(reqCopy)
 
 				// Check if we should retry using the policy
+				if attempt >= maxAttempts-1 {
+					// Policies refuse every attempt past their budget, so
+					// ask whether the previous attempt would have retried
+					// this result
+					if attempt > 0 && policy.ShouldRetry(req.Context(), resp, err, attempt-1) {
+						return retryOutcome(req, resp, err, attempt+1, lastStatusCode, start)
+					}
+					return resp, err
+				}
 				if !policy.ShouldRetry(req.Context(), resp, err, attempt) {
 					return resp, err
 				}
 
+				if resp != nil {
+					lastStatusCode = resp.StatusCode
+				}
+
 				// Close response body if present
 				if resp != nil && resp.Body != nil {
 					_, _ = io.Copy(io.Discard, resp.Body)
 					_ = resp.Body.Close()
 				}
 
-				lastErr = err
-				lastResp = resp
-
-				// Use policy's wait time for backoff
-				if attempt < maxAttempts-1 {
-					waitTime := policy.WaitTime(attempt)
-					select {
-					case <-time.After(waitTime):
-						// Continue to next attempt
-					case <-req.Context().Done():
-						return nil, req.Context().Err()
-					}
+				// Use policy's wait time for backoff, which may follow the
+				// response's Retry-After header
+				waitTime := retry.WaitTimeFor(policy, resp, attempt)
+				emitRetry(req, resp, err, attempt, waitTime)
+				select {
+				case <-time.After(waitTime):
+					// Continue to next attempt
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
 				}
 			}
-
-			// Return last response/error
-			if lastErr != nil {
-				return nil, fmt.Errorf("all %d attempts failed: %w", maxAttempts, lastErr)
-			}
-			return lastResp, nil
 		})
 	}
 }
@@ -404,10 +463,23 @@ func WithCircuitBreaker(threshold int, timeout time.Duration) Middleware {
 
 			resp, err := next.RoundTrip(req)
 
+			var state events.CircuitState
 			if err != nil || (resp != nil && resp.StatusCode >= 500) {
-				breaker.RecordFailure()
-			} else {
-				breaker.RecordSuccess()
+				if breaker.RecordFailure() {
+					state = events.CircuitOpen
+				}
+			} else if breaker.RecordSuccess() {
+				state = events.CircuitClosed
+			}
+			if state != "" {
+				event := events.Event{Type: events.TypeCircuitBreaker, Method: req.Method, Path: req.URL.Path, CircuitState: state}
+				if resp != nil {
+					event.StatusCode = resp.StatusCode
+				}
+				if err != nil {
+					event.Error = err.Error()
+				}
+				events.Emit(req.Context(), event)
 			}
 
 			return resp, err
@@ -435,17 +507,67 @@ func (cb *circuitBreaker) Allow() bool {
 	return time.Since(cb.lastFail) > cb.timeout
 }
 
-func (cb *circuitBreaker) RecordFailure() {
+// RecordFailure counts a failure and reports whether it opened the breaker
+func (cb *circuitBreaker) RecordFailure() bool {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
 	cb.failures++
 	cb.lastFail = time.Now()
+	return cb.failures == cb.threshold
 }
 
-func (cb *circuitBreaker) RecordSuccess() {
+// RecordSuccess resets the failure count and reports whether it closed the
+// breaker
+func (cb *circuitBreaker) RecordSuccess() bool {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
+	wasOpen := cb.threshold > 0 && cb.failures >= cb.threshold
 	cb.failures = 0
+	return wasOpen
+}
+
+// WithMaxConcurrentRequests limits the number of requests in flight through
+// the transport to limit. A request holds its slot until its response body is
+// closed; further requests block until a slot frees up or their context is
+// done. A limit of zero or less disables the check.
+func WithMaxConcurrentRequests(limit int) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		if limit <= 0 {
+			return next
+		}
+		slots := make(chan struct{}, limit)
+
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			select {
+			case slots <- struct{}{}:
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			}
+			release := func() { <-slots }
+
+			resp, err := next.RoundTrip(req)
+			if err != nil || resp == nil || resp.Body == nil {
+				release()
+				return resp, err
+			}
+
+			resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+			return resp, nil
+		})
+	}
+}
+
+// releasingBody frees a concurrency slot when the response body is closed
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
 }
@@ -15,7 +15,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jontk/slurm-client/pkg/events"
 	"github.com/jontk/slurm-client/pkg/logging"
+	"github.com/jontk/slurm-client/pkg/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -368,11 +370,101 @@ func TestWithRetry(t *testing.T) {
 		assert.Nil(t, resp)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "all 2 attempts failed")
+		assert.ErrorIs(t, err, networkErr)
 
 		calls := mock.getCalls()
 		assert.Len(t, calls, 2) // Two attempts
 	})
 
+	t.Run("reports exhausted retries", func(t *testing.T) {
+		mock := newMockRoundTripper()
+		roundTripper := WithRetryPolicy(retry.NewFixedDelay(2, time.Millisecond))(mock)
+
+		mock.addResponse(&http.Response{StatusCode: http.StatusBadGateway, Body: io.NopCloser(strings.NewReader("error"))}, nil)
+		mock.addResponse(&http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("error"))}, nil)
+		mock.addResponse(&http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("error"))}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+		resp, err := roundTripper.RoundTrip(req)
+		assert.Nil(t, resp)
+
+		var exhausted *retry.RetryExhaustedError
+		require.ErrorAs(t, err, &exhausted)
+		assert.Equal(t, 3, exhausted.Attempts)
+		assert.Equal(t, http.StatusServiceUnavailable, exhausted.LastStatusCode)
+		assert.Equal(t, http.StatusServiceUnavailable, exhausted.StatusCode)
+		assert.Len(t, mock.getCalls(), 3)
+	})
+
+	t.Run("waits for Retry-After", func(t *testing.T) {
+		var attempts []time.Time
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts = append(attempts, time.Now())
+			if len(attempts) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		policy := retry.NewHTTPExponentialBackoff().WithMinWaitTime(time.Millisecond).WithJitter(false)
+		client := &http.Client{Transport: WithRetryPolicy(policy)(http.DefaultTransport)}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, attempts, 2)
+		assert.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), time.Second)
+	})
+
+	t.Run("returns a response that needed no retry", func(t *testing.T) {
+		mock := newMockRoundTripper()
+		roundTripper := WithRetryPolicy(retry.NewFixedDelay(2, time.Millisecond))(mock)
+
+		mock.addResponse(&http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("missing"))}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+		resp, err := roundTripper.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("returns a final response the policy does not retry", func(t *testing.T) {
+		mock := newMockRoundTripper()
+		roundTripper := WithRetryPolicy(retry.NewFixedDelay(1, time.Millisecond))(mock)
+
+		mock.addResponse(&http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("error"))}, nil)
+		mock.addResponse(&http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("missing"))}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+		resp, err := roundTripper.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Len(t, mock.getCalls(), 2)
+	})
+
+	t.Run("disabled by context", func(t *testing.T) {
+		mock := newMockRoundTripper()
+		middleware := WithRetry(3, DefaultShouldRetry)
+		roundTripper := middleware(mock)
+
+		mock.addResponse(&http.Response{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(strings.NewReader("error"))}, nil)
+
+		ctx := retry.WithNoRetry(context.Background())
+		req := httptest.NewRequest(http.MethodPost, "/test", http.NoBody).WithContext(ctx)
+		resp, err := roundTripper.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Len(t, mock.getCalls(), 1)
+	})
+
 	t.Run("context cancellation", func(t *testing.T) {
 		mock := newMockRoundTripper()
 		middleware := WithRetry(3, DefaultShouldRetry)
@@ -706,6 +798,30 @@ func TestWithCircuitBreaker(t *testing.T) {
 		assert.Error(t, err2)
 		assert.Contains(t, err2.Error(), "circuit breaker is open")
 	})
+
+	t.Run("reports transitions", func(t *testing.T) {
+		mock := newMockRoundTripper()
+		roundTripper := WithCircuitBreaker(1, time.Millisecond)(mock)
+		bus := events.NewBus(4)
+		busEvents := bus.Events()
+		req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody).WithContext(events.WithBus(context.Background(), bus))
+
+		mock.addResponse(nil, errors.New("network error"))
+		mock.addResponse(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil)
+		_, err := roundTripper.RoundTrip(req)
+		require.Error(t, err)
+		opened := <-busEvents
+		assert.Equal(t, events.TypeCircuitBreaker, opened.Type)
+		assert.Equal(t, events.CircuitOpen, opened.CircuitState)
+		assert.Equal(t, "network error", opened.Error)
+
+		time.Sleep(5 * time.Millisecond)
+		resp, err := roundTripper.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, events.CircuitClosed, (<-busEvents).CircuitState)
+		assert.Empty(t, busEvents)
+	})
 }
 
 func TestCircuitBreaker(t *testing.T) {
@@ -752,3 +868,70 @@ func TestMiddlewareInterface(t *testing.T) {
 	_ = WithRequestID(func() string { return "test" })
 	_ = WithCircuitBreaker(5, 1*time.Second)
 }
+
+func TestWithMaxConcurrentRequests(t *testing.T) {
+	const limit = 3
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+	})
+
+	rt := WithMaxConcurrentRequests(limit)(transport)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			resp, err := rt.RoundTrip(req)
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight, limit)
+	assert.Equal(t, limit, maxInFlight)
+}
+
+func TestWithMaxConcurrentRequests_HoldsSlotUntilBodyClosed(t *testing.T) {
+	transport := RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+	})
+	rt := WithMaxConcurrentRequests(1)(transport)
+
+	first, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.NoError(t, err)
+
+	// The slot is taken until the first body is closed, so this blocks until
+	// the context expires
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil).WithContext(ctx)
+	_, err = rt.RoundTrip(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, first.Body.Close())
+	require.NoError(t, first.Body.Close())
+
+	second, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.NoError(t, err)
+	require.NoError(t, second.Body.Close())
+}
@@ -11,6 +11,7 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jontk/slurm-client/pkg/logging"
@@ -31,6 +32,7 @@ type pooledClient struct {
 	lastUsed    time.Time
 	useCount    int64
 	activeConns int32
+	openConns   int32 // connections dialed and not yet closed, updated atomically
 }
 
 // Config holds configuration for the HTTP client pool
@@ -132,6 +134,9 @@ func (p *HTTPClientPool) GetClient(endpoint string) *http.Client {
 		lastUsed: time.Now(),
 		useCount: 1,
 	}
+	if transport, ok := client.Transport.(*http.Transport); ok {
+		transport.DialContext = countingDialer(transport.DialContext, &pc.openConns)
+	}
 
 	p.clients[endpoint] = pc
 	p.logger.Info("created new HTTP client for endpoint", "endpoint", endpoint)
@@ -194,6 +199,7 @@ func (p *HTTPClientPool) Stats() Stats {
 			LastUsed:    pc.lastUsed,
 			UseCount:    pc.useCount,
 			ActiveConns: pc.activeConns,
+			OpenConns:   atomic.LoadInt32(&pc.openConns),
 		}
 	}
 
@@ -256,6 +262,9 @@ type ClientStats struct {
 	LastUsed    time.Time
 	UseCount    int64
 	ActiveConns int32
+	// OpenConns is how many connections to the endpoint are open, in use
+	// or idle
+	OpenConns int32
 }
 
 // ConnectionManager manages connection lifecycle and health
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package pool
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"sync/atomic"
+)
+
+// Doer sends HTTP requests, such as an *http.Client
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Warmup opens n connections to endpoint through the pool's client for it,
+// capped at the idle connections the pool keeps per host
+func (p *HTTPClientPool) Warmup(ctx context.Context, endpoint string, n int) error {
+	if p.config.MaxIdleConnsPerHost > 0 && n > p.config.MaxIdleConnsPerHost {
+		n = p.config.MaxIdleConnsPerHost
+	}
+	if p.config.MaxConnsPerHost > 0 && n > p.config.MaxConnsPerHost {
+		n = p.config.MaxConnsPerHost
+	}
+	return Warmup(ctx, p.GetClient(endpoint), endpoint, n)
+}
+
+// Warmup opens n connections to endpoint, including their TLS handshakes,
+// and leaves them idle in client's transport so the first real requests
+// reuse them. It sends n concurrent HEAD requests to endpoint and holds
+// each connection until all n are open, so none is reused for another
+// warm-up request. The response status does not matter. Over HTTP/2 the
+// requests share one connection.
+//
+// n must not exceed the transport's connection limit per host, or Warmup
+// waits for connections that cannot be opened until ctx is done.
+func Warmup(ctx context.Context, client Doer, endpoint string, n int) error {
+	if n < 1 {
+		return fmt.Errorf("warm-up connection count must be at least 1, got %d", n)
+	}
+
+	// Each request arrives once it holds a connection or has failed, and
+	// the connections are held until every request has arrived
+	var arrived sync.WaitGroup
+	arrived.Add(n)
+	release := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(release)
+	}()
+
+	var failures atomic.Int32
+	var firstErr error
+	var errOnce sync.Once
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var arriveOnce sync.Once
+			arrive := func() { arriveOnce.Do(arrived.Done) }
+			defer arrive()
+
+			trace := &httptrace.ClientTrace{
+				GotConn: func(httptrace.GotConnInfo) {
+					arrive()
+					select {
+					case <-release:
+					case <-ctx.Done():
+					}
+				},
+			}
+			req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace), http.MethodHead, endpoint, http.NoBody)
+			if err == nil {
+				var resp *http.Response
+				if resp, err = client.Do(req); err == nil {
+					_, _ = io.Copy(io.Discard, resp.Body)
+					_ = resp.Body.Close()
+				}
+			}
+			if err != nil {
+				failures.Add(1)
+				errOnce.Do(func() { firstErr = err })
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return fmt.Errorf("failed to open %d of %d warm-up connections: %w", failures.Load(), n, firstErr)
+	}
+	return nil
+}
+
+// countingDialer wraps dial to keep open up to date with the number of
+// connections it dialed that are still open
+func countingDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error), open *int32) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		atomic.AddInt32(open, 1)
+		return &countedConn{Conn: conn, open: open}, nil
+	}
+}
+
+// countedConn decrements its counter once when closed
+type countedConn struct {
+	net.Conn
+	open      *int32
+	closeOnce sync.Once
+}
+
+func (c *countedConn) Close() error {
+	c.closeOnce.Do(func() { atomic.AddInt32(c.open, -1) })
+	return c.Conn.Close()
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package pool
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCountingServer returns a server and the number of connections it has
+// accepted
+func newCountingServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var accepted atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			accepted.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+	return server, &accepted
+}
+
+func TestHTTPClientPool_Warmup(t *testing.T) {
+	server, accepted := newCountingServer(t)
+	pool := NewHTTPClientPool(nil, nil)
+	defer func() { _ = pool.Close() }()
+
+	require.NoError(t, pool.Warmup(context.Background(), server.URL, 4))
+	assert.Equal(t, int32(4), accepted.Load())
+	assert.Equal(t, int32(4), pool.Stats().ClientStats[server.URL].OpenConns)
+
+	// Requests reuse the idle connections rather than dialing
+	for i := 0; i < 4; i++ {
+		resp, err := pool.GetClient(server.URL).Get(server.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.Equal(t, int32(4), accepted.Load())
+
+	// Closing the pool closes the idle connections
+	pc := pool.clients[server.URL]
+	require.NoError(t, pool.Close())
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&pc.openConns) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestHTTPClientPool_WarmupCapped(t *testing.T) {
+	server, accepted := newCountingServer(t)
+	config := DefaultPoolConfig()
+	config.MaxIdleConnsPerHost = 2
+	pool := NewHTTPClientPool(config, nil)
+	defer func() { _ = pool.Close() }()
+
+	require.NoError(t, pool.Warmup(context.Background(), server.URL, 10))
+	assert.Equal(t, int32(2), accepted.Load())
+	assert.Equal(t, int32(2), pool.Stats().ClientStats[server.URL].OpenConns)
+}
+
+func TestWarmup_Errors(t *testing.T) {
+	assert.Error(t, Warmup(context.Background(), http.DefaultClient, "http://127.0.0.1:1", 0))
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	err := Warmup(context.Background(), &http.Client{Transport: &http.Transport{}}, server.URL, 3)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3 of 3")
+}
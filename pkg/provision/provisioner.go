@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+// Package provision applies large sets of account and user changes, such as
+// onboarding a new group, with bounded concurrency and request rate.
+package provision
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+)
+
+// DefaultConcurrency is the default number of requests in flight
+const DefaultConcurrency = 4
+
+// OperationKind identifies the kind of change an operation applies
+type OperationKind string
+
+// Operation kinds
+const (
+	OpCreateAccount OperationKind = "create_account"
+	OpUpdateAccount OperationKind = "update_account"
+	OpCreateUser    OperationKind = "create_user"
+	OpUpdateUser    OperationKind = "update_user"
+)
+
+// Plan lists the changes to apply. Account changes are applied before user
+// changes so that users can be associated with accounts created in the same
+// plan.
+type Plan struct {
+	CreateAccounts []*types.AccountCreate
+	UpdateAccounts map[string]*types.AccountUpdate
+	CreateUsers    []*types.UserCreate
+	UpdateUsers    map[string]*types.UserUpdate
+}
+
+// Progress reports how far a plan has been applied
+type Progress struct {
+	Completed int // operations finished, successfully or not
+	Failed    int // operations that returned an error
+	Total     int
+}
+
+// OperationError records a failed operation
+type OperationError struct {
+	Kind OperationKind
+	Name string
+	Err  error
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("%s %s: %v", e.Kind, e.Name, e.Err)
+}
+
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// Result summarizes an applied plan
+type Result struct {
+	Succeeded int
+	Errors    []*OperationError
+}
+
+// operation is a single request of a plan
+type operation struct {
+	kind OperationKind
+	name string
+	run  func(ctx context.Context) error
+}
+
+// Provisioner applies Plans through an account and a user manager
+type Provisioner struct {
+	accounts    types.AccountManager
+	users       types.UserManager
+	concurrency int
+	interval    time.Duration
+	onProgress  func(Progress)
+}
+
+// NewProvisioner creates a provisioner, typically with
+// NewProvisioner(client.Accounts(), client.Users())
+func NewProvisioner(accounts types.AccountManager, users types.UserManager) *Provisioner {
+	return &Provisioner{
+		accounts:    accounts,
+		users:       users,
+		concurrency: DefaultConcurrency,
+	}
+}
+
+// WithConcurrency sets the number of requests in flight
+func (p *Provisioner) WithConcurrency(n int) *Provisioner {
+	if n > 0 {
+		p.concurrency = n
+	}
+	return p
+}
+
+// WithRateLimit caps the request rate across all workers. Zero or a negative
+// value removes the limit.
+func (p *Provisioner) WithRateLimit(perSecond float64) *Provisioner {
+	if perSecond > 0 {
+		p.interval = time.Duration(float64(time.Second) / perSecond)
+	} else {
+		p.interval = 0
+	}
+	return p
+}
+
+// WithProgress sets a callback invoked after every operation. Calls are
+// serialized, so the callback needs no locking of its own.
+func (p *Provisioner) WithProgress(fn func(Progress)) *Provisioner {
+	p.onProgress = fn
+	return p
+}
+
+// Apply runs every operation of plan. Failed operations are collected in the
+// Result rather than stopping the run; the returned error is only set when
+// ctx ends before the plan was applied.
+func (p *Provisioner) Apply(ctx context.Context, plan *Plan) (*Result, error) {
+	if plan == nil {
+		return &Result{}, nil
+	}
+
+	phases := [][]operation{p.accountOperations(plan), p.userOperations(plan)}
+	total := len(phases[0]) + len(phases[1])
+
+	var limiter <-chan time.Time
+	if p.interval > 0 {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+
+	run := &runState{total: total, onProgress: p.onProgress, result: &Result{}}
+	for _, ops := range phases {
+		p.runPhase(ctx, ops, limiter, run)
+		if err := ctx.Err(); err != nil {
+			return run.result, err
+		}
+	}
+	return run.result, nil
+}
+
+// runState tracks progress shared by the workers of a run
+type runState struct {
+	mu         sync.Mutex
+	total      int
+	completed  int
+	failed     int
+	onProgress func(Progress)
+	result     *Result
+}
+
+func (r *runState) record(op operation, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.completed++
+	if err != nil {
+		r.failed++
+		r.result.Errors = append(r.result.Errors, &OperationError{Kind: op.kind, Name: op.name, Err: err})
+	} else {
+		r.result.Succeeded++
+	}
+	if r.onProgress != nil {
+		r.onProgress(Progress{Completed: r.completed, Failed: r.failed, Total: r.total})
+	}
+}
+
+// runPhase runs ops on the configured number of workers and waits for them
+func (p *Provisioner) runPhase(ctx context.Context, ops []operation, limiter <-chan time.Time, run *runState) {
+	queue := make(chan operation)
+	var wg sync.WaitGroup
+	for range min(p.concurrency, len(ops)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for op := range queue {
+				run.record(op, op.run(ctx))
+			}
+		}()
+	}
+
+feed:
+	for _, op := range ops {
+		if limiter != nil {
+			select {
+			case <-limiter:
+			case <-ctx.Done():
+				break feed
+			}
+		}
+		select {
+		case queue <- op:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+}
+
+func (p *Provisioner) accountOperations(plan *Plan) []operation {
+	ops := make([]operation, 0, len(plan.CreateAccounts)+len(plan.UpdateAccounts))
+	for _, account := range plan.CreateAccounts {
+		ops = append(ops, operation{kind: OpCreateAccount, name: account.Name, run: func(ctx context.Context) error {
+			_, err := p.accounts.Create(ctx, account)
+			return err
+		}})
+	}
+	for _, name := range sortedKeys(plan.UpdateAccounts) {
+		update := plan.UpdateAccounts[name]
+		ops = append(ops, operation{kind: OpUpdateAccount, name: name, run: func(ctx context.Context) error {
+			return p.accounts.Update(ctx, name, update)
+		}})
+	}
+	return ops
+}
+
+func (p *Provisioner) userOperations(plan *Plan) []operation {
+	ops := make([]operation, 0, len(plan.CreateUsers)+len(plan.UpdateUsers))
+	for _, user := range plan.CreateUsers {
+		ops = append(ops, operation{kind: OpCreateUser, name: user.Name, run: func(ctx context.Context) error {
+			_, err := p.users.Create(ctx, user)
+			return err
+		}})
+	}
+	for _, name := range sortedKeys(plan.UpdateUsers) {
+		update := plan.UpdateUsers[name]
+		ops = append(ops, operation{kind: OpUpdateUser, name: name, run: func(ctx context.Context) error {
+			return p.users.Update(ctx, name, update)
+		}})
+	}
+	return ops
+}
+
+// sortedKeys returns the keys of m in sorted order, for a stable run order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package provision
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder stores the order in which entities were created or updated
+type recorder struct {
+	mu       sync.Mutex
+	calls    []string
+	inFlight int
+	maxSeen  int
+	fail     map[string]bool
+}
+
+func (r *recorder) do(call string) error {
+	r.mu.Lock()
+	r.inFlight++
+	if r.inFlight > r.maxSeen {
+		r.maxSeen = r.inFlight
+	}
+	r.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.inFlight--
+	r.calls = append(r.calls, call)
+	if r.fail[call] {
+		return fmt.Errorf("rejected %s", call)
+	}
+	return nil
+}
+
+type mockAccountManager struct{ r *recorder }
+
+func (m *mockAccountManager) List(ctx context.Context, opts *types.ListAccountsOptions) (*types.AccountList, error) {
+	return &types.AccountList{}, nil
+}
+func (m *mockAccountManager) Get(ctx context.Context, accountName string) (*types.Account, error) {
+	return &types.Account{}, nil
+}
+func (m *mockAccountManager) Create(ctx context.Context, account *types.AccountCreate) (*types.AccountCreateResponse, error) {
+	return &types.AccountCreateResponse{AccountName: account.Name}, m.r.do("account:" + account.Name)
+}
+func (m *mockAccountManager) Update(ctx context.Context, accountName string, update *types.AccountUpdate) error {
+	return m.r.do("account-update:" + accountName)
+}
+func (m *mockAccountManager) Delete(ctx context.Context, accountName string) error { return nil }
+func (m *mockAccountManager) Subtree(ctx context.Context, root string) (*types.AccountTree, error) {
+	return nil, nil
+}
+func (m *mockAccountManager) FindEmpty(ctx context.Context) ([]*types.Account, error) {
+	return nil, nil
+}
+
+type mockUserManager struct{ r *recorder }
+
+func (m *mockUserManager) List(ctx context.Context, opts *types.ListUsersOptions) (*types.UserList, error) {
+	return &types.UserList{}, nil
+}
+func (m *mockUserManager) Get(ctx context.Context, userName string) (*types.User, error) {
+	return &types.User{}, nil
+}
+func (m *mockUserManager) Create(ctx context.Context, user *types.UserCreate) (*types.UserCreateResponse, error) {
+	return &types.UserCreateResponse{}, m.r.do("user:" + user.Name)
+}
+func (m *mockUserManager) Update(ctx context.Context, userName string, update *types.UserUpdate) error {
+	return m.r.do("user-update:" + userName)
+}
+func (m *mockUserManager) Delete(ctx context.Context, userName string) error { return nil }
+func (m *mockUserManager) AllowedQoS(ctx context.Context, userName, accountName string) ([]string, error) {
+	return nil, nil
+}
+func (m *mockUserManager) EffectiveLimits(ctx context.Context, userName string) (*types.UserEffectiveLimits, error) {
+	return nil, nil
+}
+
+// newPlan builds a plan with the given number of accounts and users
+func newPlan(accounts, users int) *Plan {
+	plan := &Plan{}
+	for i := range accounts {
+		plan.CreateAccounts = append(plan.CreateAccounts, &types.AccountCreate{Name: fmt.Sprintf("acct%02d", i)})
+	}
+	for i := range users {
+		plan.CreateUsers = append(plan.CreateUsers, &types.UserCreate{
+			Name:           fmt.Sprintf("user%02d", i),
+			DefaultAccount: fmt.Sprintf("acct%02d", i%accounts),
+		})
+	}
+	return plan
+}
+
+func TestProvisioner_Apply(t *testing.T) {
+	ctx := context.Background()
+	r := &recorder{}
+
+	var progress []Progress
+	p := NewProvisioner(&mockAccountManager{r}, &mockUserManager{r}).
+		WithConcurrency(5).
+		WithRateLimit(2000).
+		WithProgress(func(pr Progress) { progress = append(progress, pr) })
+
+	result, err := p.Apply(ctx, newPlan(10, 40))
+	require.NoError(t, err)
+	assert.Equal(t, 50, result.Succeeded)
+	assert.Empty(t, result.Errors)
+
+	// One progress report per entity, counting up to the total
+	require.Len(t, progress, 50)
+	for i, pr := range progress {
+		assert.Equal(t, i+1, pr.Completed)
+		assert.Equal(t, 50, pr.Total)
+		assert.Equal(t, 0, pr.Failed)
+	}
+
+	// All accounts exist before the first user is created
+	require.Len(t, r.calls, 50)
+	for i, call := range r.calls {
+		if i < 10 {
+			assert.Contains(t, call, "account:")
+		} else {
+			assert.Contains(t, call, "user:")
+		}
+	}
+	assert.LessOrEqual(t, r.maxSeen, 5)
+}
+
+func TestProvisioner_Apply_CollectsErrors(t *testing.T) {
+	ctx := context.Background()
+	r := &recorder{fail: map[string]bool{"user:user03": true}}
+
+	var last Progress
+	p := NewProvisioner(&mockAccountManager{r}, &mockUserManager{r}).
+		WithProgress(func(pr Progress) { last = pr })
+
+	plan := newPlan(2, 5)
+	plan.UpdateUsers = map[string]*types.UserUpdate{"user00": {}}
+
+	result, err := p.Apply(ctx, plan)
+	require.NoError(t, err)
+	assert.Equal(t, 7, result.Succeeded)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, OpCreateUser, result.Errors[0].Kind)
+	assert.Equal(t, "user03", result.Errors[0].Name)
+	assert.Equal(t, Progress{Completed: 8, Failed: 1, Total: 8}, last)
+}
+
+func TestProvisioner_Apply_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &recorder{}
+
+	p := NewProvisioner(&mockAccountManager{r}, &mockUserManager{r}).
+		WithConcurrency(1).
+		WithRateLimit(20).
+		WithProgress(func(pr Progress) {
+			if pr.Completed == 2 {
+				cancel()
+			}
+		})
+
+	result, err := p.Apply(ctx, newPlan(5, 20))
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, result.Succeeded, 25)
+}
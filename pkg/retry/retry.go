@@ -5,10 +5,16 @@ package retry
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
 	"math/rand"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
+
+	slurmerrors "github.com/jontk/slurm-client/pkg/errors"
 )
 
 // Policy defines the interface for retry policies
@@ -23,7 +29,50 @@ type Policy interface {
 	MaxRetries() int
 }
 
-// HTTPExponentialBackoff implements exponential backoff retry policy for HTTP requests
+// ResponsePolicy is a Policy whose wait before the next attempt can depend
+// on the response that is retried. The retry middleware calls
+// ResponseWaitTime instead of WaitTime for policies that implement it.
+type ResponsePolicy interface {
+	Policy
+
+	// ResponseWaitTime returns the wait time before retrying resp, the
+	// response to attempt, which is nil when the attempt got no response
+	ResponseWaitTime(resp *http.Response, attempt int) time.Duration
+}
+
+// WaitTimeFor returns the wait time of policy before retrying resp, the
+// response to attempt, using ResponseWaitTime when the policy has it
+func WaitTimeFor(policy Policy, resp *http.Response, attempt int) time.Duration {
+	if responsePolicy, ok := policy.(ResponsePolicy); ok {
+		return responsePolicy.ResponseWaitTime(resp, attempt)
+	}
+	return policy.WaitTime(attempt)
+}
+
+// parseRetryAfter returns how long the Retry-After header of resp asks the
+// client to wait, given either in seconds or as an HTTP date
+func parseRetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 || seconds > int64(math.MaxInt64/time.Second) {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// HTTPExponentialBackoff implements exponential backoff retry policy for HTTP requests.
+// It waits at least as long as a response's Retry-After header asks for.
 type HTTPExponentialBackoff struct {
 	maxRetries    int
 	minWaitTime   time.Duration
@@ -134,6 +183,17 @@ func (e *HTTPExponentialBackoff) MaxRetries() int {
 	return e.maxRetries
 }
 
+// ResponseWaitTime returns the wait time before retrying resp, the response
+// to attempt: at least as long as its Retry-After header asks for, and no
+// longer than the maximum wait time
+func (e *HTTPExponentialBackoff) ResponseWaitTime(resp *http.Response, attempt int) time.Duration {
+	waitTime := e.WaitTime(attempt)
+	if retryAfter, ok := parseRetryAfter(resp, time.Now()); ok && retryAfter > waitTime {
+		waitTime = min(retryAfter, e.maxWaitTime)
+	}
+	return waitTime
+}
+
 // FixedDelay implements fixed delay retry policy
 type FixedDelay struct {
 	maxRetries int
@@ -213,3 +273,136 @@ func (n *NoRetry) WaitTime(_ int) time.Duration {
 func (n *NoRetry) MaxRetries() int {
 	return 0
 }
+
+// permanentError marks an error that Do must not retry
+type permanentError struct {
+	err error
+}
+
+func (p *permanentError) Error() string { return p.err.Error() }
+func (p *permanentError) Unwrap() error { return p.err }
+
+// Permanent wraps err so that Do returns it immediately instead of retrying
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// RetryExhaustedError is returned when an operation still fails after it was
+// retried. It embeds the SlurmError for the last failure, and errors.As and
+// errors.Is see through it to the error the last attempt returned.
+type RetryExhaustedError struct {
+	*slurmerrors.SlurmError
+
+	// Attempts is the number of attempts made, including the first
+	Attempts int
+	// LastStatusCode is the HTTP status of the last response received, or 0
+	// when no attempt got a response
+	LastStatusCode int
+	// Elapsed is the time from the first attempt until giving up
+	Elapsed time.Duration
+
+	err error
+}
+
+// NewRetryExhaustedError wraps err, the failure of the last of attempts
+// attempts. A zero lastStatusCode is taken from err when it carries one.
+func NewRetryExhaustedError(err error, attempts, lastStatusCode int, elapsed time.Duration) *RetryExhaustedError {
+	slurmErr := slurmerrors.WrapError(err)
+	if lastStatusCode == 0 {
+		lastStatusCode = slurmErr.StatusCode
+	}
+	return &RetryExhaustedError{
+		SlurmError:     slurmErr,
+		Attempts:       attempts,
+		LastStatusCode: lastStatusCode,
+		Elapsed:        elapsed,
+		err:            err,
+	}
+}
+
+func (e *RetryExhaustedError) Error() string {
+	return fmt.Sprintf("all %d attempts failed: %v", e.Attempts, e.err)
+}
+
+// Unwrap returns the error of the last attempt
+func (e *RetryExhaustedError) Unwrap() error { return e.err }
+
+// As finds the embedded SlurmError, which the last attempt's error does not
+// hold when it was a plain network error
+func (e *RetryExhaustedError) As(target any) bool {
+	if slurmErr, ok := target.(**slurmerrors.SlurmError); ok {
+		*slurmErr = e.SlurmError
+		return true
+	}
+	return false
+}
+
+// noRetryKey is the context key set by WithNoRetry
+type noRetryKey struct{}
+
+// WithNoRetry returns a context whose requests are attempted only once,
+// whatever the client's retry policy. Use it for operations that are not
+// safe to repeat, such as submitting a job.
+func WithNoRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// Disabled reports whether retries were turned off for ctx with WithNoRetry
+func Disabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noRetryKey{}).(bool)
+	return disabled
+}
+
+// Do calls fn until it succeeds, the policy declines another attempt or ctx
+// is done. It applies the same attempt counting and backoff as the HTTP retry
+// middleware, so workflows spanning several requests (e.g. submit, wait,
+// resubmit) can share a client's Policy, and makes one attempt when ctx
+// comes from WithNoRetry. Errors wrapped with Permanent end
+// the loop immediately and are returned unwrapped. When fn still fails after
+// being retried, its last error is returned in a RetryExhaustedError.
+func Do(ctx context.Context, policy Policy, fn func() error) error {
+	if policy == nil || Disabled(ctx) {
+		policy = NewNoRetry()
+	}
+
+	start := time.Now()
+	maxAttempts := policy.MaxRetries() + 1 // MaxRetries is number of retries, not total attempts
+	var lastErr error
+	for attempt := range maxAttempts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		var perm *permanentError
+		if errors.As(err, &perm) {
+			return perm.err
+		}
+		lastErr = err
+
+		if !policy.ShouldRetry(ctx, nil, err, attempt) {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if attempt > 0 {
+				return NewRetryExhaustedError(err, attempt+1, 0, time.Since(start))
+			}
+			return err
+		}
+		if attempt < maxAttempts-1 {
+			select {
+			case <-time.After(policy.WaitTime(attempt)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	return NewRetryExhaustedError(lastErr, maxAttempts, 0, time.Since(start))
+}
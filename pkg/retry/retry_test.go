@@ -6,12 +6,15 @@ package retry
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
+	slurmerrors "github.com/jontk/slurm-client/pkg/errors"
 	"github.com/jontk/slurm-client/tests/helpers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHTTPExponentialBackoff_Default(t *testing.T) {
@@ -315,3 +318,181 @@ func TestRetryableHTTPStatusCodes(t *testing.T) {
 		})
 	}
 }
+
+func TestDo_RespectsMaxRetries(t *testing.T) {
+	ctx := context.Background()
+	failure := errors.New("submit failed")
+
+	calls := 0
+	err := Do(ctx, NewFixedDelay(2, time.Millisecond), func() error {
+		calls++
+		return failure
+	})
+
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 3, calls, "one initial attempt plus MaxRetries retries")
+}
+
+func TestDo_RetryExhausted(t *testing.T) {
+	ctx := context.Background()
+	failure := slurmerrors.NewSlurmError(slurmerrors.ErrorCodeSlurmDaemonDown, "slurmctld unavailable")
+	failure.StatusCode = http.StatusServiceUnavailable
+
+	err := Do(ctx, NewFixedDelay(2, time.Millisecond), func() error {
+		return fmt.Errorf("ping: %w", failure)
+	})
+
+	var exhausted *RetryExhaustedError
+	require.ErrorAs(t, err, &exhausted)
+	assert.Equal(t, 3, exhausted.Attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, exhausted.LastStatusCode)
+	assert.Positive(t, exhausted.Elapsed)
+	assert.Same(t, failure, exhausted.SlurmError)
+
+	var slurmErr *slurmerrors.SlurmError
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Same(t, failure, slurmErr)
+	assert.Equal(t, "all 3 attempts failed: ping: [SLURM_DAEMON_DOWN] slurmctld unavailable", err.Error())
+
+	// A plain error is classified, and still reachable
+	plain := errors.New("connection reset")
+	err = Do(ctx, NewFixedDelay(1, time.Millisecond), func() error { return plain })
+	require.ErrorAs(t, err, &exhausted)
+	assert.ErrorIs(t, err, plain)
+	assert.Equal(t, 0, exhausted.LastStatusCode)
+	require.ErrorAs(t, err, &slurmErr)
+	assert.Equal(t, slurmerrors.ErrorCodeUnknown, slurmErr.Code)
+
+	// A single failed attempt is returned as it is
+	err = Do(ctx, NewNoRetry(), func() error { return plain })
+	assert.Equal(t, plain, err)
+}
+
+func TestDo_SucceedsAfterRetry(t *testing.T) {
+	ctx := context.Background()
+
+	calls := 0
+	err := Do(ctx, NewFixedDelay(3, time.Millisecond), func() error {
+		calls++
+		if calls < 2 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestDo_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	start := time.Now()
+	err := Do(ctx, NewFixedDelay(5, time.Minute), func() error {
+		calls++
+		cancel()
+		return errors.New("transient")
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestDo_Permanent(t *testing.T) {
+	ctx := context.Background()
+	failure := errors.New("invalid partition")
+
+	calls := 0
+	err := Do(ctx, NewFixedDelay(5, time.Millisecond), func() error {
+		calls++
+		return Permanent(failure)
+	})
+
+	assert.Equal(t, failure, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDo_NoRetry(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), NewNoRetry(), func() error {
+		calls++
+		return errors.New("failed")
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDo_WithNoRetry(t *testing.T) {
+	ctx := WithNoRetry(context.Background())
+	assert.True(t, Disabled(ctx))
+	assert.False(t, Disabled(context.Background()))
+
+	calls := 0
+	err := Do(ctx, NewFixedDelay(3, time.Millisecond), func() error {
+		calls++
+		return errors.New("failed")
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{"seconds", "5", 5 * time.Second, true},
+		{"zero", "0", 0, true},
+		{"http date", now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		{"date in the past", now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"missing", "", 0, false},
+		{"negative", "-3", 0, false},
+		{"garbage", "soon", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+			got, ok := parseRetryAfter(resp, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, ok := parseRetryAfter(nil, now)
+	assert.False(t, ok)
+}
+
+func TestHTTPExponentialBackoff_ResponseWaitTime(t *testing.T) {
+	policy := NewHTTPExponentialBackoff().
+		WithMinWaitTime(time.Millisecond).
+		WithMaxWaitTime(10 * time.Second).
+		WithJitter(false)
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	assert.Equal(t, time.Millisecond, WaitTimeFor(policy, resp, 0))
+
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, WaitTimeFor(policy, resp, 0))
+
+	// Capped by the maximum wait time
+	resp.Header.Set("Retry-After", "120")
+	assert.Equal(t, 10*time.Second, WaitTimeFor(policy, resp, 0))
+
+	// A shorter Retry-After does not cut the backoff short
+	longBackoff := NewHTTPExponentialBackoff().WithMinWaitTime(5 * time.Second).WithJitter(false)
+	resp.Header.Set("Retry-After", "1")
+	assert.Equal(t, 5*time.Second, WaitTimeFor(longBackoff, resp, 0))
+
+	// Policies without ResponseWaitTime keep their own wait
+	assert.Equal(t, time.Millisecond, WaitTimeFor(NewFixedDelay(3, time.Millisecond), resp, 0))
+}
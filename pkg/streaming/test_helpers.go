@@ -74,7 +74,17 @@ func (m *mockSlurmClient) Capabilities() types.ClientCapabilities {
 		SupportsJobSubmit: true,
 	}
 }
+func (m *mockSlurmClient) ImplementedMethods() map[string][]string { return nil }
+func (m *mockSlurmClient) Warmup(ctx context.Context, n int) error { return nil }
+func (m *mockSlurmClient) CheckSLO(ctx context.Context, slo types.SLOConfig) (*types.SLOResult, error) {
+	return nil, nil
+}
+func (m *mockSlurmClient) Versions(ctx context.Context) (*types.VersionInfo, error) {
+	return nil, nil
+}
+func (m *mockSlurmClient) Events() <-chan types.ClientEvent { return nil }
 func (m *mockSlurmClient) Close() error { return nil }
+func (m *mockSlurmClient) Shutdown(ctx context.Context) error { return nil }
 
 type mockJobManager struct {
 	watchFunc func(ctx context.Context, opts *types.WatchJobsOptions) (<-chan types.JobEvent, error)
@@ -83,21 +93,71 @@ type mockJobManager struct {
 func (m *mockJobManager) List(ctx context.Context, opts *types.ListJobsOptions) (*types.JobList, error) {
 	return nil, nil
 }
+func (m *mockJobManager) ListAll(ctx context.Context, opts *types.ListAllJobsOptions) ([]types.Job, error) {
+	return nil, nil
+}
 func (m *mockJobManager) Get(ctx context.Context, jobID string) (*types.Job, error) {
 	return nil, nil
 }
+func (m *mockJobManager) GetWithOptions(ctx context.Context, jobID string, opts *types.GetJobOptions) (*types.Job, error) {
+	return nil, nil
+}
+func (m *mockJobManager) ListArrayTasks(ctx context.Context, arrayJobID string) ([]*types.Job, error) {
+	return nil, nil
+}
+func (m *mockJobManager) Script(ctx context.Context, jobID string) (string, error) {
+	return "", nil
+}
+func (m *mockJobManager) ListByTag(ctx context.Context, key, value string) ([]*types.Job, error) {
+	return nil, nil
+}
+func (m *mockJobManager) ListSteps(ctx context.Context, jobID string) ([]types.JobStep, error) {
+	return nil, nil
+}
+func (m *mockJobManager) TRESUsage(ctx context.Context, jobID string) (*types.TRESUsageReport, error) {
+	return nil, nil
+}
+func (m *mockJobManager) History(ctx context.Context, opts *types.JobHistoryOptions) ([]types.Job, error) {
+	return nil, nil
+}
 //nolint:staticcheck // SA1019: Submit implements the deprecated JobWriter.Submit interface method
 func (m *mockJobManager) Submit(ctx context.Context, job *types.JobSubmission) (*types.JobSubmitResponse, error) {
 	return &types.JobSubmitResponse{}, nil
 }
+func (m *mockJobManager) Validate(ctx context.Context, job *types.JobSubmission) (*types.ValidationResult, error) {
+	return &types.ValidationResult{}, nil
+}
 func (m *mockJobManager) SubmitRaw(ctx context.Context, job *types.JobCreate) (*types.JobSubmitResponse, error) {
 	return &types.JobSubmitResponse{}, nil
 }
+func (m *mockJobManager) WaitForArray(ctx context.Context, arrayJobID string, opts *types.WaitForArrayOptions) (*types.ArrayResult, error) {
+	return nil, nil
+}
+func (m *mockJobManager) RunArray(ctx context.Context, base *types.JobSubmission, arraySpec string, opts *types.RunArrayOptions) (*types.ArrayRunReport, error) {
+	return nil, nil
+}
 func (m *mockJobManager) Allocate(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error) {
 	return nil, nil
 }
 func (m *mockJobManager) Cancel(ctx context.Context, jobID string) error { return nil }
-func (m *mockJobManager) Requeue(ctx context.Context, jobID string) error { return nil }
+func (m *mockJobManager) Requeue(ctx context.Context, jobID string, opts *types.RequeueOptions) error {
+	return nil
+}
+func (m *mockJobManager) CancelByName(ctx context.Context, name string) (int, error) {
+	return 0, nil
+}
+func (m *mockJobManager) CancelByUser(ctx context.Context, user string) (int, error) {
+	return 0, nil
+}
+func (m *mockJobManager) CancelAndWait(ctx context.Context, jobIDs []string, opts *types.CancelAndWaitOptions) error {
+	return nil
+}
+func (m *mockJobManager) CancelIfPending(ctx context.Context, jobID string) (bool, error) {
+	return false, nil
+}
+func (m *mockJobManager) CancelStep(ctx context.Context, jobID, stepID string) error {
+	return nil
+}
 func (m *mockJobManager) Update(ctx context.Context, jobID string, update *types.JobUpdate) error {
 	return nil
 }
@@ -112,7 +172,7 @@ func (m *mockJobManager) Watch(ctx context.Context, opts *types.WatchJobsOptions
 }
 func (m *mockJobManager) Hold(ctx context.Context, jobID string) error    { return nil }
 func (m *mockJobManager) Release(ctx context.Context, jobID string) error { return nil }
-func (m *mockJobManager) Signal(ctx context.Context, jobID string, signal string) error {
+func (m *mockJobManager) Signal(ctx context.Context, jobID string, signal string, opts *types.SignalOptions) error {
 	return nil
 }
 func (m *mockJobManager) Notify(ctx context.Context, jobID string, message string) error {
@@ -141,9 +201,21 @@ func (m *mockNodeManager) Delete(ctx context.Context, nodeName string) error {
 func (m *mockNodeManager) Drain(ctx context.Context, nodeName string, reason string) error {
 	return nil
 }
+func (m *mockNodeManager) DrainNodes(ctx context.Context, nodeNames []string, reason string) error {
+	return nil
+}
 func (m *mockNodeManager) Resume(ctx context.Context, nodeName string) error {
 	return nil
 }
+func (m *mockNodeManager) Reboot(ctx context.Context, nodeName string, opts *types.RebootOptions) error {
+	return nil
+}
+func (m *mockNodeManager) SetPowerState(ctx context.Context, nodeName string, state types.NodePowerState) error {
+	return nil
+}
+func (m *mockNodeManager) GetPowerState(ctx context.Context, nodeName string) (types.NodePowerState, error) {
+	return types.NodePowerUp, nil
+}
 func (m *mockNodeManager) Watch(ctx context.Context, opts *types.WatchNodesOptions) (<-chan types.NodeEvent, error) {
 	if m.watchFunc != nil {
 		return m.watchFunc(ctx, opts)
@@ -151,6 +223,13 @@ func (m *mockNodeManager) Watch(ctx context.Context, opts *types.WatchNodesOptio
 	return nil, nil
 }
 
+func (m *mockNodeManager) PowerUsage(ctx context.Context) (map[string]types.PowerStats, error) {
+	return nil, nil
+}
+func (m *mockNodeManager) WaitForState(ctx context.Context, nodes []string, state types.NodeState, opts *types.WaitForNodeStateOptions) error {
+	return nil
+}
+
 type mockPartitionManager struct {
 	watchFunc func(ctx context.Context, opts *types.WatchPartitionsOptions) (<-chan types.PartitionEvent, error)
 }
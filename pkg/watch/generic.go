@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// EventType is the kind of change a generic watch reports
+type EventType string
+
+const (
+	// EventAdded reports an object that was not in the previous listing
+	EventAdded EventType = "added"
+	// EventModified reports an object that differs from the previous listing
+	EventModified EventType = "modified"
+	// EventDeleted reports an object that is gone from the listing
+	EventDeleted EventType = "deleted"
+	// EventSynced is a bookmark sent once the first listing has been
+	// reported; it carries no object
+	EventSynced EventType = "synced"
+)
+
+// Event is a change to one object seen by Watch
+type Event[T any] struct {
+	Type EventType
+	// Key identifies the object, as returned by the keyer
+	Key string
+	// Object is the object as now listed; for EventDeleted it is the object
+	// as last listed
+	Object T
+	// Previous is the object as last listed, set for EventModified
+	Previous T
+	Time     time.Time
+}
+
+// Options configures a generic watch
+type Options[T any] struct {
+	// PollInterval is how often the lister is called (default
+	// DefaultPollInterval)
+	PollInterval time.Duration
+	// BufferSize is the capacity of the event channel (default 100)
+	BufferSize int
+	// Equal decides whether an object has changed between two listings
+	// (default reflect.DeepEqual). Supplying it narrows which changes are
+	// reported, such as only state changes.
+	Equal func(previous, current T) bool
+	// IncludeInitial reports every object of the first listing as added;
+	// by default the first listing only sets the baseline
+	IncludeInitial bool
+	// Synced sends an EventSynced event after the first listing, so that a
+	// consumer building a cache knows when it holds the full snapshot.
+	// Every event after it is a change.
+	Synced bool
+}
+
+// Watch polls lister and reports the objects added, modified and deleted
+// between listings, matched up by keyer. Listings that fail are skipped, as
+// are objects with an empty key. The channel is closed when ctx is done.
+func Watch[T any](ctx context.Context, lister func() ([]T, error), keyer func(T) string, opts *Options[T]) (<-chan Event[T], error) {
+	if lister == nil {
+		return nil, fmt.Errorf("lister cannot be nil")
+	}
+	if keyer == nil {
+		return nil, fmt.Errorf("keyer cannot be nil")
+	}
+
+	var o Options[T]
+	if opts != nil {
+		o = *opts
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = DefaultPollInterval
+	}
+	if o.BufferSize <= 0 {
+		o.BufferSize = 100
+	}
+	if o.Equal == nil {
+		o.Equal = func(previous, current T) bool { return reflect.DeepEqual(previous, current) }
+	}
+
+	eventChan := make(chan Event[T], o.BufferSize)
+	go func() {
+		defer close(eventChan)
+
+		ticker := time.NewTicker(o.PollInterval)
+		defer ticker.Stop()
+
+		var known map[string]T
+		for {
+			if current, ok := listByKey(lister, keyer); ok {
+				initial := known == nil
+				if !initial || o.IncludeInitial {
+					if !sendChanges(ctx, eventChan, known, current, o.Equal) {
+						return
+					}
+				}
+				if initial && o.Synced {
+					select {
+					case eventChan <- Event[T]{Type: EventSynced, Time: time.Now()}:
+					case <-ctx.Done():
+						return
+					}
+				}
+				known = current
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return eventChan, nil
+}
+
+// listByKey calls lister and keys its objects. It reports false when the
+// listing failed.
+func listByKey[T any](lister func() ([]T, error), keyer func(T) string) (map[string]T, bool) {
+	items, err := lister()
+	if err != nil {
+		return nil, false
+	}
+	byKey := make(map[string]T, len(items))
+	for _, item := range items {
+		if key := keyer(item); key != "" {
+			byKey[key] = item
+		}
+	}
+	return byKey, true
+}
+
+// sendChanges sends the events that turn known into current. It reports
+// false when ctx was done before they were all sent.
+func sendChanges[T any](ctx context.Context, eventChan chan<- Event[T], known, current map[string]T, equal func(previous, current T) bool) bool {
+	now := time.Now()
+	send := func(event Event[T]) bool {
+		event.Time = now
+		select {
+		case eventChan <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for key, object := range current {
+		previous, existed := known[key]
+		switch {
+		case !existed:
+			if !send(Event[T]{Type: EventAdded, Key: key, Object: object}) {
+				return false
+			}
+		case !equal(previous, object):
+			if !send(Event[T]{Type: EventModified, Key: key, Object: object, Previous: previous}) {
+				return false
+			}
+		}
+	}
+	for key, object := range known {
+		if _, exists := current[key]; !exists {
+			if !send(Event[T]{Type: EventDeleted, Key: key, Object: object}) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package watch_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/jontk/slurm-client/api"
+	"github.com/jontk/slurm-client/pkg/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reservationLister serves a settable list of reservations
+type reservationLister struct {
+	mu           sync.Mutex
+	reservations []types.Reservation
+	err          error
+}
+
+func (l *reservationLister) List() ([]types.Reservation, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]types.Reservation(nil), l.reservations...), l.err
+}
+
+func (l *reservationLister) set(err error, reservations ...types.Reservation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reservations, l.err = reservations, err
+}
+
+func reservationName(r types.Reservation) string {
+	if r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+func nextEvent[T any](t *testing.T, events <-chan watch.Event[T]) watch.Event[T] {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("expected an event")
+		return watch.Event[T]{}
+	}
+}
+
+func TestWatch_Reservations(t *testing.T) {
+	lister := &reservationLister{}
+	lister.set(nil, types.Reservation{Name: ptrString("maint"), NodeList: ptrString("node[1-4]")})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Only node list changes count; a changed partition is ignored
+	events, err := watch.Watch(ctx, lister.List, reservationName, &watch.Options[types.Reservation]{
+		PollInterval:   10 * time.Millisecond,
+		IncludeInitial: true,
+		Equal: func(previous, current types.Reservation) bool {
+			return *previous.NodeList == *current.NodeList
+		},
+	})
+	require.NoError(t, err)
+
+	event := nextEvent(t, events)
+	assert.Equal(t, watch.EventAdded, event.Type)
+	assert.Equal(t, "maint", event.Key)
+	assert.False(t, event.Time.IsZero())
+
+	lister.set(nil, types.Reservation{Name: ptrString("maint"), NodeList: ptrString("node[1-4]"), Partition: ptrString("debug")})
+	time.Sleep(30 * time.Millisecond)
+	lister.set(errors.New("slurmrestd unavailable"))
+	time.Sleep(30 * time.Millisecond)
+	lister.set(nil, types.Reservation{Name: ptrString("maint"), NodeList: ptrString("node[1-8]")})
+
+	event = nextEvent(t, events)
+	assert.Equal(t, watch.EventModified, event.Type)
+	assert.Equal(t, "node[1-4]", *event.Previous.NodeList)
+	assert.Equal(t, "node[1-8]", *event.Object.NodeList)
+
+	lister.set(nil)
+	event = nextEvent(t, events)
+	assert.Equal(t, watch.EventDeleted, event.Type)
+	assert.Equal(t, "node[1-8]", *event.Object.NodeList)
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatch_Baseline(t *testing.T) {
+	lister := &reservationLister{}
+	lister.set(nil, types.Reservation{Name: ptrString("maint")}, types.Reservation{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := watch.Watch(ctx, lister.List, reservationName, &watch.Options[types.Reservation]{PollInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	time.Sleep(30 * time.Millisecond)
+
+	lister.set(nil, types.Reservation{Name: ptrString("maint")}, types.Reservation{Name: ptrString("training")})
+	event := nextEvent(t, events)
+	assert.Equal(t, watch.EventAdded, event.Type)
+	assert.Equal(t, "training", event.Key)
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatch_Synced(t *testing.T) {
+	lister := &reservationLister{}
+	lister.set(errors.New("slurmrestd unavailable"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := watch.Watch(ctx, lister.List, reservationName, &watch.Options[types.Reservation]{
+		PollInterval:   10 * time.Millisecond,
+		IncludeInitial: true,
+		Synced:         true,
+	})
+	require.NoError(t, err)
+	time.Sleep(30 * time.Millisecond)
+
+	// The bookmark waits for a listing that succeeds and follows its objects
+	lister.set(nil, types.Reservation{Name: ptrString("maint")}, types.Reservation{Name: ptrString("training")})
+	added := map[string]bool{}
+	for range 2 {
+		event := nextEvent(t, events)
+		assert.Equal(t, watch.EventAdded, event.Type)
+		added[event.Key] = true
+	}
+	assert.Equal(t, map[string]bool{"maint": true, "training": true}, added)
+	event := nextEvent(t, events)
+	assert.Equal(t, watch.EventSynced, event.Type)
+	assert.Empty(t, event.Key)
+
+	lister.set(nil, types.Reservation{Name: ptrString("maint")})
+	event = nextEvent(t, events)
+	assert.Equal(t, watch.EventDeleted, event.Type)
+	assert.Equal(t, "training", event.Key)
+}
+
+func TestWatch_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := watch.Watch[types.Reservation](ctx, nil, reservationName, nil)
+	assert.Error(t, err)
+	_, err = watch.Watch(ctx, (&reservationLister{}).List, nil, nil)
+	assert.Error(t, err)
+}
@@ -8,7 +8,7 @@ import (
 )
 
 // getJobID safely extracts the job ID from a Job, returning 0 if nil
-func getJobID(job *types.Job) int32 {
+func getJobID(job *types.Job) int64 {
 	if job == nil || job.JobID == nil {
 		return 0
 	}
@@ -23,6 +23,14 @@ func getJobState(job *types.Job) types.JobState {
 	return job.JobState[0]
 }
 
+// getString safely dereferences an optional string field
+func getString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // getNodeName safely extracts the node name from a Node, returning empty string if nil
 func getNodeName(node *types.Node) string {
 	if node == nil || node.Name == nil {
@@ -39,6 +47,23 @@ func getNodeState(node *types.Node) types.NodeState {
 	return node.State[0]
 }
 
+// hasFeatures reports whether a node has every one of features
+func hasFeatures(node *types.Node, features []string) bool {
+	for _, feature := range features {
+		found := false
+		for _, f := range node.Features {
+			if f == feature {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // getPartitionName safely extracts the partition name from a Partition
 func getPartitionName(partition *types.Partition) string {
 	if partition == nil || partition.Name == nil {
@@ -3,20 +3,14 @@
 
 // Package watch provides polling-based watch implementations for Slurm resources.
 //
-// TODO(consolidation): This package and internal/adapters/v0_0_*/job_watch_extra.go
-// contain similar polling logic. The adapter implementations are currently used directly
-// and support additional features (MaxEvents, EventTypes filtering). Consider:
-// 1. Enhancing this package to support those features
-// 2. Migrating adapters to use this package
-// 3. Removing the adapter-specific implementations
-//
-// See plan/codex_feedback_8.md R3 for details.
+// The pollers are built on Watch, which watches any resource that can be
+// listed and keyed. Jobs().Watch and Nodes().Watch are built on JobPoller
+// and NodePoller, so a poller reports the same events as the client.
 package watch
 
 import (
 	"context"
 	"fmt"
-	"sync"
 	"time"
 
 	types "github.com/jontk/slurm-client/api"
@@ -30,8 +24,6 @@ type JobPoller struct {
 	listFunc     func(ctx context.Context, opts *types.ListJobsOptions) (*types.JobList, error)
 	pollInterval time.Duration
 	bufferSize   int
-	mu           sync.RWMutex
-	jobStates    map[int32]types.JobState // Track job states by JobId
 }
 
 // NewJobPoller creates a new job poller
@@ -40,7 +32,6 @@ func NewJobPoller(listFunc func(ctx context.Context, opts *types.ListJobsOptions
 		listFunc:     listFunc,
 		pollInterval: DefaultPollInterval,
 		bufferSize:   100,
-		jobStates:    make(map[int32]types.JobState),
 	}
 }
 
@@ -56,140 +47,119 @@ func (p *JobPoller) WithBufferSize(size int) *JobPoller {
 	return p
 }
 
-// Watch starts watching for job state changes
+// Watch starts watching for job changes. It reports types.JobEventAdded for
+// new jobs, types.JobEventModified when a job's state, reason or nodes change,
+// and types.JobEventDeleted when a job leaves the listing. opts.PollInterval,
+// when set, overrides the poller's interval.
 func (p *JobPoller) Watch(ctx context.Context, opts *types.WatchJobsOptions) (<-chan types.JobEvent, error) {
-	// Create event channel
-	eventChan := make(chan types.JobEvent, p.bufferSize)
-
-	// Initial state capture
 	if opts == nil {
 		opts = &types.WatchJobsOptions{}
 	}
-
-	// Start polling goroutine
-	go p.pollLoop(ctx, opts, eventChan)
-
-	return eventChan, nil
-}
-
-// pollLoop is the main polling loop
-func (p *JobPoller) pollLoop(ctx context.Context, opts *types.WatchJobsOptions, eventChan chan<- types.JobEvent) {
-	defer close(eventChan)
-
-	// Create a ticker for polling
-	ticker := time.NewTicker(p.pollInterval)
-	defer ticker.Stop()
-
-	// Perform initial poll to establish baseline
-	p.performPoll(ctx, opts, eventChan, true)
-
-	// Continue polling until context is cancelled
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			p.performPoll(ctx, opts, eventChan, false)
-		}
-	}
-}
-
-// performPoll executes a single poll operation
-func (p *JobPoller) performPoll(ctx context.Context, opts *types.WatchJobsOptions, eventChan chan<- types.JobEvent, isInitial bool) {
-	// Convert watch options to list options
-	listOpts := &types.ListJobsOptions{}
-	if len(opts.JobIDs) > 0 {
-		// For specific job IDs, we'll fetch all jobs and filter
-		// (Most SLURM APIs don't support filtering by multiple job IDs directly)
-		listOpts.Limit = 0 // No limit
-	}
-	if len(opts.States) > 0 {
-		listOpts.States = opts.States
+	pollInterval := p.pollInterval
+	if opts.PollInterval > 0 {
+		pollInterval = opts.PollInterval
 	}
 
-	// Fetch current job list
-	jobList, err := p.listFunc(ctx, listOpts)
-	if err != nil {
-		// Error occurred - just return (errors not sent as events)
-		return
+	listOpts := &types.ListJobsOptions{
+		UserID:    opts.UserID,
+		States:    opts.States,
+		Partition: opts.Partition,
 	}
-
-	// Process jobs
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	currentJobs := make(map[int32]bool)
-
-	for _, job := range jobList.Jobs {
-		job := job // Create local copy to avoid memory aliasing
-		jobID := getJobID(&job)
-		jobState := getJobState(&job)
-
-		// Filter by job IDs if specified
-		if len(opts.JobIDs) > 0 {
-			found := false
-			jobIDStr := fmt.Sprintf("%d", jobID)
+	lister := func() ([]types.Job, error) {
+		jobList, err := p.listFunc(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		if len(opts.JobIDs) == 0 {
+			return jobList.Jobs, nil
+		}
+		// Most SLURM APIs don't support filtering by multiple job IDs, so
+		// the jobs are filtered here
+		jobs := make([]types.Job, 0, len(opts.JobIDs))
+		for _, job := range jobList.Jobs {
+			jobIDStr := fmt.Sprintf("%d", getJobID(&job))
 			for _, id := range opts.JobIDs {
 				if jobIDStr == id {
-					found = true
+					jobs = append(jobs, job)
 					break
 				}
 			}
-			if !found {
-				continue
-			}
 		}
+		return jobs, nil
+	}
+	keyer := func(job types.Job) string {
+		if job.JobID == nil {
+			return ""
+		}
+		return fmt.Sprintf("%d", *job.JobID)
+	}
 
-		currentJobs[jobID] = true
-
-		previousState, exists := p.jobStates[jobID]
-
-		if !exists {
-			// New job detected
-			p.jobStates[jobID] = jobState
-			if !isInitial && (!opts.ExcludeNew) {
-				jobCopy := job
-				eventChan <- types.JobEvent{
-					EventType: "job_new",
-					JobId:     jobID,
-					NewState:  jobState,
-					EventTime: time.Now(),
-					Job:       &jobCopy,
-				}
-			}
-		} else if previousState != jobState {
-			// State change detected
-			p.jobStates[jobID] = jobState
-			jobCopy := job
-			eventChan <- types.JobEvent{
-				EventType:     "job_state_change",
-				JobId:         jobID,
-				PreviousState: previousState,
-				NewState:      jobState,
-				EventTime:     time.Now(),
-				Job:           &jobCopy,
-			}
+	events, err := Watch(ctx, lister, keyer, &Options[types.Job]{
+		PollInterval: pollInterval,
+		BufferSize:   p.bufferSize,
+		Equal:        sameJobStatus,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return relay(ctx, events, p.bufferSize, func(event Event[types.Job]) (types.JobEvent, bool) {
+		job := event.Object
+		jobEvent := types.JobEvent{
+			JobId:     getJobID(&job),
+			JobName:   getString(job.Name),
+			UserName:  getString(job.UserName),
+			NewState:  getJobState(&job),
+			Reason:    getString(job.StateReason),
+			NodeList:  getString(job.Nodes),
+			EventTime: event.Time,
+			Job:       &job,
+		}
+		switch event.Type {
+		case EventAdded:
+			jobEvent.EventType = types.JobEventAdded
+			return jobEvent, !opts.ExcludeNew
+		case EventModified:
+			jobEvent.EventType = types.JobEventModified
+			jobEvent.PreviousState = getJobState(&event.Previous)
+			return jobEvent, !opts.ExcludeCompleted || !terminalJob(&job)
+		default:
+			// The job was purged from slurmctld or no longer matches the
+			// filters; Job is as last listed
+			jobEvent.EventType = types.JobEventDeleted
+			jobEvent.PreviousState = jobEvent.NewState
+			return jobEvent, !opts.ExcludeCompleted
+		}
+	}), nil
+}
+
+// terminalJob reports whether the job has finished, successfully or not
+func terminalJob(job *types.Job) bool {
+	for _, state := range job.JobState {
+		switch state {
+		case types.JobStateCompleted, types.JobStateCancelled, types.JobStateFailed,
+			types.JobStateTimeout, types.JobStateNodeFail, types.JobStatePreempted,
+			types.JobStateBootFail, types.JobStateDeadline, types.JobStateOutOfMemory:
+			return true
 		}
 	}
+	return false
+}
 
-	// Check for completed/removed jobs
-	if !opts.ExcludeCompleted {
-		for jobID, state := range p.jobStates {
-			if !currentJobs[jobID] {
-				// Job no longer in list (completed or removed)
-				delete(p.jobStates, jobID)
-				// Note: NewState is JobState type, need to use appropriate constant
-				completedState := types.JobState("COMPLETED")
-				eventChan <- types.JobEvent{
-					EventType:     "job_completed",
-					JobId:         jobID,
-					PreviousState: state,
-					NewState:      completedState,
-					EventTime:     time.Now(),
-				}
-			}
+// sameJobStatus reports whether a job's state, reason and nodes are unchanged
+// between two listings. Other fields, such as the priority of a pending job,
+// change on every scheduler pass and are not reported.
+func sameJobStatus(previous, current types.Job) bool {
+	if len(previous.JobState) != len(current.JobState) {
+		return false
+	}
+	for i := range previous.JobState {
+		if previous.JobState[i] != current.JobState[i] {
+			return false
 		}
 	}
+	return getString(previous.StateReason) == getString(current.StateReason) &&
+		getString(previous.Nodes) == getString(current.Nodes)
 }
 
 // NodePoller implements real-time node monitoring through polling
@@ -197,8 +167,6 @@ type NodePoller struct {
 	listFunc     func(ctx context.Context, opts *types.ListNodesOptions) (*types.NodeList, error)
 	pollInterval time.Duration
 	bufferSize   int
-	mu           sync.RWMutex
-	nodeStates   map[string]types.NodeState // Track node states by name
 }
 
 // NewNodePoller creates a new node poller
@@ -207,7 +175,6 @@ func NewNodePoller(listFunc func(ctx context.Context, opts *types.ListNodesOptio
 		listFunc:     listFunc,
 		pollInterval: DefaultPollInterval,
 		bufferSize:   100,
-		nodeStates:   make(map[string]types.NodeState),
 	}
 }
 
@@ -223,133 +190,97 @@ func (p *NodePoller) WithBufferSize(size int) *NodePoller {
 	return p
 }
 
-// Watch starts watching for node state changes
+// Watch starts watching for node changes. Unless opts.SkipInitial is set,
+// it first reports every existing node as types.NodeEventAdded; either way
+// it then sends a types.NodeEventSynced bookmark. After that it reports
+// types.NodeEventAdded for new nodes, types.NodeEventModified when a node's
+// state or reason changes, and types.NodeEventDeleted when a node leaves
+// the listing. opts.PollInterval, when set, overrides the poller's interval.
 func (p *NodePoller) Watch(ctx context.Context, opts *types.WatchNodesOptions) (<-chan types.NodeEvent, error) {
-	// Create event channel
-	eventChan := make(chan types.NodeEvent, p.bufferSize)
-
-	// Initial state capture
 	if opts == nil {
 		opts = &types.WatchNodesOptions{}
 	}
-
-	// Start polling goroutine
-	go p.pollLoop(ctx, opts, eventChan)
-
-	return eventChan, nil
-}
-
-// pollLoop is the main polling loop for nodes
-func (p *NodePoller) pollLoop(ctx context.Context, opts *types.WatchNodesOptions, eventChan chan<- types.NodeEvent) {
-	defer close(eventChan)
-
-	// Create a ticker for polling
-	ticker := time.NewTicker(p.pollInterval)
-	defer ticker.Stop()
-
-	// Perform initial poll to establish baseline
-	p.performPoll(ctx, opts, eventChan, true)
-
-	// Continue polling until context is cancelled
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			p.performPoll(ctx, opts, eventChan, false)
-		}
+	pollInterval := p.pollInterval
+	if opts.PollInterval > 0 {
+		pollInterval = opts.PollInterval
 	}
-}
 
-// performPoll executes a single poll operation for nodes
-func (p *NodePoller) performPoll(ctx context.Context, opts *types.WatchNodesOptions, eventChan chan<- types.NodeEvent, isInitial bool) {
-	// Convert watch options to list options
-	listOpts := &types.ListNodesOptions{}
-	if len(opts.States) > 0 {
-		listOpts.States = opts.States
+	listOpts := &types.ListNodesOptions{States: opts.States, Partition: opts.Partition}
+	lister := func() ([]types.Node, error) {
+		nodeList, err := p.listFunc(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		nodes := filterByName(nodeList.Nodes, opts.NodeNames, getNodeName)
+		if len(opts.Features) == 0 {
+			return nodes, nil
+		}
+		withFeatures := make([]types.Node, 0, len(nodes))
+		for _, node := range nodes {
+			if hasFeatures(&node, opts.Features) {
+				withFeatures = append(withFeatures, node)
+			}
+		}
+		return withFeatures, nil
 	}
 
-	// Fetch current node list
-	nodeList, err := p.listFunc(ctx, listOpts)
+	events, err := Watch(ctx, lister, func(node types.Node) string { return getNodeName(&node) }, &Options[types.Node]{
+		PollInterval:   pollInterval,
+		BufferSize:     p.bufferSize,
+		IncludeInitial: !opts.SkipInitial,
+		Synced:         true,
+		Equal: func(previous, current types.Node) bool {
+			return getNodeState(&previous) == getNodeState(&current) &&
+				getString(previous.Reason) == getString(current.Reason)
+		},
+	})
 	if err != nil {
-		// Error occurred - just return (errors not sent as events)
-		return
+		return nil, err
 	}
 
-	// Process nodes
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	currentNodes := make(map[string]bool)
-
-	for _, node := range nodeList.Nodes {
-		node := node // Create local copy to avoid memory aliasing
-		nodeName := getNodeName(&node)
-		nodeState := getNodeState(&node)
-
-		// Filter by node names if specified
-		if len(opts.NodeNames) > 0 {
-			found := false
-			for _, name := range opts.NodeNames {
-				if nodeName == name {
-					found = true
-					break
-				}
-			}
-			if !found {
-				continue
-			}
+	return relay(ctx, events, p.bufferSize, func(event Event[types.Node]) (types.NodeEvent, bool) {
+		nodeEvent := types.NodeEvent{
+			NodeName:  event.Key,
+			EventTime: event.Time,
 		}
-
-		currentNodes[nodeName] = true
-
-		previousState, exists := p.nodeStates[nodeName]
-
-		if !exists {
-			// New node detected (unusual but possible)
-			p.nodeStates[nodeName] = nodeState
-			if !isInitial {
-				nodeCopy := node
-				eventChan <- types.NodeEvent{
-					EventType: "node_new",
-					NodeName:  nodeName,
-					NewState:  nodeState,
-					EventTime: time.Now(),
-					Node:      &nodeCopy,
-				}
-			}
-		} else if previousState != nodeState {
-			// State change detected
-			p.nodeStates[nodeName] = nodeState
-			nodeCopy := node
-			eventChan <- types.NodeEvent{
-				EventType:     "node_state_change",
-				NodeName:      nodeName,
-				PreviousState: previousState,
-				NewState:      nodeState,
-				EventTime:     time.Now(),
-				Node:          &nodeCopy,
-			}
+		node := event.Object
+		switch event.Type {
+		case EventSynced:
+			nodeEvent.EventType = types.NodeEventSynced
+			return nodeEvent, true
+		case EventDeleted:
+			// The node is as last listed
+			nodeEvent.EventType = types.NodeEventDeleted
+			nodeEvent.PreviousState = getNodeState(&node)
+			nodeEvent.Partitions = node.Partitions
+			return nodeEvent, true
+		case EventModified:
+			nodeEvent.EventType = types.NodeEventModified
+			nodeEvent.PreviousState = getNodeState(&event.Previous)
+		default:
+			nodeEvent.EventType = types.NodeEventAdded
 		}
-	}
+		nodeEvent.NewState = getNodeState(&node)
+		nodeEvent.Reason = getString(node.Reason)
+		nodeEvent.Partitions = node.Partitions
+		nodeEvent.Node = &node
+		return nodeEvent, true
+	}), nil
 }
 
 // PartitionPoller implements real-time partition monitoring through polling
 type PartitionPoller struct {
-	listFunc        func(ctx context.Context, opts *types.ListPartitionsOptions) (*types.PartitionList, error)
-	pollInterval    time.Duration
-	bufferSize      int
-	mu              sync.RWMutex
-	partitionStates map[string]types.PartitionState // Track partition states by name
+	listFunc     func(ctx context.Context, opts *types.ListPartitionsOptions) (*types.PartitionList, error)
+	pollInterval time.Duration
+	bufferSize   int
 }
 
 // NewPartitionPoller creates a new partition poller
 func NewPartitionPoller(listFunc func(ctx context.Context, opts *types.ListPartitionsOptions) (*types.PartitionList, error)) *PartitionPoller {
 	return &PartitionPoller{
-		listFunc:        listFunc,
-		pollInterval:    DefaultPollInterval,
-		bufferSize:      100,
-		partitionStates: make(map[string]types.PartitionState),
+		listFunc:     listFunc,
+		pollInterval: DefaultPollInterval,
+		bufferSize:   100,
 	}
 }
 
@@ -367,107 +298,88 @@ func (p *PartitionPoller) WithBufferSize(size int) *PartitionPoller {
 
 // Watch starts watching for partition state changes
 func (p *PartitionPoller) Watch(ctx context.Context, opts *types.WatchPartitionsOptions) (<-chan types.PartitionEvent, error) {
-	// Create event channel
-	eventChan := make(chan types.PartitionEvent, p.bufferSize)
-
-	// Initial state capture
 	if opts == nil {
 		opts = &types.WatchPartitionsOptions{}
 	}
 
-	// Start polling goroutine
-	go p.pollLoop(ctx, opts, eventChan)
-
-	return eventChan, nil
-}
-
-// pollLoop is the main polling loop for partitions
-func (p *PartitionPoller) pollLoop(ctx context.Context, opts *types.WatchPartitionsOptions, eventChan chan<- types.PartitionEvent) {
-	defer close(eventChan)
-
-	// Create a ticker for polling
-	ticker := time.NewTicker(p.pollInterval)
-	defer ticker.Stop()
-
-	// Perform initial poll to establish baseline
-	p.performPoll(ctx, opts, eventChan, true)
-
-	// Continue polling until context is cancelled
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			p.performPoll(ctx, opts, eventChan, false)
+	listOpts := &types.ListPartitionsOptions{States: opts.States}
+	lister := func() ([]types.Partition, error) {
+		partitionList, err := p.listFunc(ctx, listOpts)
+		if err != nil {
+			return nil, err
 		}
-	}
-}
-
-// performPoll executes a single poll operation for partitions
-func (p *PartitionPoller) performPoll(ctx context.Context, opts *types.WatchPartitionsOptions, eventChan chan<- types.PartitionEvent, isInitial bool) {
-	// Convert watch options to list options
-	listOpts := &types.ListPartitionsOptions{}
-	if len(opts.States) > 0 {
-		listOpts.States = opts.States
+		return filterByName(partitionList.Partitions, opts.PartitionNames, getPartitionName), nil
 	}
 
-	// Fetch current partition list
-	partitionList, err := p.listFunc(ctx, listOpts)
+	events, err := Watch(ctx, lister, func(partition types.Partition) string { return getPartitionName(&partition) }, &Options[types.Partition]{
+		PollInterval: p.pollInterval,
+		BufferSize:   p.bufferSize,
+		Equal: func(previous, current types.Partition) bool {
+			return getPartitionState(&previous) == getPartitionState(&current)
+		},
+	})
 	if err != nil {
-		// Error occurred - just return (errors not sent as events)
-		return
+		return nil, err
 	}
 
-	// Process partitions
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	for _, partition := range partitionList.Partitions {
-		partition := partition // Create local copy to avoid memory aliasing
-		partitionName := getPartitionName(&partition)
-		partitionState := getPartitionState(&partition)
-
-		// Filter by partition names if specified
-		if len(opts.PartitionNames) > 0 {
-			found := false
-			for _, name := range opts.PartitionNames {
-				if partitionName == name {
-					found = true
-					break
-				}
-			}
-			if !found {
+	return relay(ctx, events, p.bufferSize, func(event Event[types.Partition]) (types.PartitionEvent, bool) {
+		partition := event.Object
+		partitionEvent := types.PartitionEvent{
+			PartitionName: event.Key,
+			NewState:      getPartitionState(&partition),
+			EventTime:     event.Time,
+			Partition:     &partition,
+		}
+		switch event.Type {
+		case EventAdded:
+			partitionEvent.EventType = "partition_new"
+			return partitionEvent, true
+		case EventModified:
+			partitionEvent.EventType = "partition_state_change"
+			partitionEvent.PreviousState = getPartitionState(&event.Previous)
+			return partitionEvent, true
+		default:
+			return partitionEvent, false
+		}
+	}), nil
+}
+
+// relay converts the events of a generic watch, dropping those convert
+// rejects. The returned channel is closed once events is.
+func relay[T, E any](ctx context.Context, events <-chan Event[T], bufferSize int, convert func(Event[T]) (E, bool)) <-chan E {
+	out := make(chan E, bufferSize)
+	go func() {
+		defer close(out)
+		for event := range events {
+			converted, ok := convert(event)
+			if !ok {
 				continue
 			}
+			select {
+			case out <- converted:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+	return out
+}
 
-		previousState, exists := p.partitionStates[partitionName]
-
-		if !exists {
-			// First time seeing this partition
-			p.partitionStates[partitionName] = partitionState
-			if !isInitial {
-				partitionCopy := partition
-				eventChan <- types.PartitionEvent{
-					EventType:     "partition_new",
-					PartitionName: partitionName,
-					NewState:      partitionState,
-					EventTime:     time.Now(),
-					Partition:     &partitionCopy,
-				}
-			}
-		} else if previousState != partitionState {
-			// State change detected
-			p.partitionStates[partitionName] = partitionState
-			partitionCopy := partition
-			eventChan <- types.PartitionEvent{
-				EventType:     "partition_state_change",
-				PartitionName: partitionName,
-				PreviousState: previousState,
-				NewState:      partitionState,
-				EventTime:     time.Now(),
-				Partition:     &partitionCopy,
+// filterByName keeps the items whose name is in names; with no names it
+// keeps them all
+func filterByName[T any](items []T, names []string, name func(*T) string) []T {
+	if len(names) == 0 {
+		return items
+	}
+	filtered := make([]T, 0, len(names))
+	for i := range items {
+		itemName := name(&items[i])
+		for _, n := range names {
+			if itemName == n {
+				filtered = append(filtered, items[i])
+				break
 			}
 		}
 	}
+	return filtered
 }
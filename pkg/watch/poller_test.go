@@ -18,7 +18,7 @@ import (
 )
 
 // Helper functions for creating pointer types
-func ptrInt32(i int32) *int32    { return &i }
+func ptrInt64(i int64) *int64    { return &i }
 func ptrString(s string) *string { return &s }
 
 // Mock list function for testing
@@ -27,11 +27,13 @@ type mockJobLister struct {
 	jobs      []types.Job
 	err       error
 	callCount int
+	lastOpts  *types.ListJobsOptions
 }
 
 func (m *mockJobLister) List(ctx context.Context, opts *types.ListJobsOptions) (*types.JobList, error) {
 	m.mu.Lock()
 	m.callCount++
+	m.lastOpts = opts
 	err := m.err
 	jobs := make([]types.Job, len(m.jobs))
 	copy(jobs, m.jobs)
@@ -135,8 +137,8 @@ func TestJobPoller_Watch(t *testing.T) {
 	// Create a mock lister
 	lister := &mockJobLister{
 		jobs: []types.Job{
-			{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
-			{JobID: ptrInt32(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
 		},
 	}
 
@@ -156,9 +158,9 @@ func TestJobPoller_Watch(t *testing.T) {
 
 	// Update job states
 	lister.setJobs([]types.Job{
-		{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateCompleted}, UserName: ptrString("user1000")}, // State changed
-		{JobID: ptrInt32(2), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},   // State changed
-		{JobID: ptrInt32(3), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1001")},   // New job
+		{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateCompleted}, UserName: ptrString("user1000")}, // State changed
+		{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},   // State changed
+		{JobID: ptrInt64(3), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1001")},   // New job
 	})
 
 	// Collect events
@@ -191,9 +193,9 @@ done:
 	newJobCount := 0
 	for _, event := range events {
 		switch event.EventType {
-		case "job_state_change":
+		case types.JobEventModified:
 			stateChangeCount++
-		case "job_new":
+		case types.JobEventAdded:
 			newJobCount++
 		}
 	}
@@ -206,9 +208,9 @@ func TestJobPoller_WatchWithFilter(t *testing.T) {
 	// Create a mock lister
 	lister := &mockJobLister{
 		jobs: []types.Job{
-			{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
-			{JobID: ptrInt32(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
-			{JobID: ptrInt32(3), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1001")},
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(3), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1001")},
 		},
 	}
 
@@ -231,9 +233,9 @@ func TestJobPoller_WatchWithFilter(t *testing.T) {
 
 	// Update states
 	lister.setJobs([]types.Job{
-		{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateCompleted}, UserName: ptrString("user1000")}, // State changed
-		{JobID: ptrInt32(2), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},   // State changed
-		{JobID: ptrInt32(3), JobState: []types.JobState{types.JobStateCompleted}, UserName: ptrString("user1001")}, // State changed but filtered out
+		{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateCompleted}, UserName: ptrString("user1000")}, // State changed
+		{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},   // State changed
+		{JobID: ptrInt64(3), JobState: []types.JobState{types.JobStateCompleted}, UserName: ptrString("user1001")}, // State changed but filtered out
 	})
 
 	// Collect events
@@ -246,7 +248,7 @@ func TestJobPoller_WatchWithFilter(t *testing.T) {
 			if !ok {
 				t.Fatal("Event channel closed unexpectedly")
 			}
-			if event.EventType == "job_state_change" {
+			if event.EventType == types.JobEventModified {
 				events = append(events, event)
 			}
 			if len(events) >= 2 {
@@ -262,7 +264,7 @@ done:
 
 	// Verify we only got events for job 1 and 2
 	assert.Len(t, events, 2)
-	jobIDs := map[int32]bool{}
+	jobIDs := map[int64]bool{}
 	for _, event := range events {
 		jobIDs[event.JobId] = true
 	}
@@ -305,7 +307,7 @@ func TestJobPoller_ContextCancellation(t *testing.T) {
 	// Create a mock lister
 	lister := &mockJobLister{
 		jobs: []types.Job{
-			{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateRunning}},
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}},
 		},
 	}
 
@@ -350,25 +352,85 @@ func TestNodePoller_Watch(t *testing.T) {
 	eventChan, err := poller.Watch(ctx, nil)
 	require.NoError(t, err)
 
-	// Wait a bit
-	time.Sleep(50 * time.Millisecond)
+	next := func() types.NodeEvent {
+		select {
+		case event := <-eventChan:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("Timeout waiting for node event")
+			return types.NodeEvent{}
+		}
+	}
 
-	// Update node states
+	// The existing nodes come first, then the synced bookmark
+	initial := map[string]types.NodeState{}
+	for range 2 {
+		event := next()
+		assert.Equal(t, types.NodeEventAdded, event.EventType)
+		require.NotNil(t, event.Node)
+		initial[event.NodeName] = event.NewState
+	}
+	assert.Equal(t, map[string]types.NodeState{"node-001": types.NodeStateIdle, "node-002": types.NodeStateAllocated}, initial)
+	assert.Equal(t, types.NodeEventSynced, next().EventType)
+
+	// Drain one node and remove the other
 	lister.setNodes([]types.Node{
 		{Name: ptrString("node-001"), State: []types.NodeState{types.NodeStateDrain}},
-		{Name: ptrString("node-002"), State: []types.NodeState{types.NodeStateAllocated}},
 	})
 
-	// Wait for event
-	timeout := time.After(200 * time.Millisecond)
+	changes := map[string]types.NodeEvent{}
+	for range 2 {
+		event := next()
+		changes[event.NodeName] = event
+	}
+	assert.Equal(t, types.NodeEventModified, changes["node-001"].EventType)
+	assert.Equal(t, types.NodeStateIdle, changes["node-001"].PreviousState)
+	assert.Equal(t, types.NodeStateDrain, changes["node-001"].NewState)
+	assert.Equal(t, types.NodeEventDeleted, changes["node-002"].EventType)
+	assert.Equal(t, types.NodeStateAllocated, changes["node-002"].PreviousState)
+}
+
+func TestNodePoller_WatchSkipInitialAndFeatures(t *testing.T) {
+	lister := &mockNodeLister{
+		nodes: []types.Node{
+			{Name: ptrString("gpu-001"), State: []types.NodeState{types.NodeStateIdle}, Features: []string{"a100"}},
+			{Name: ptrString("cpu-001"), State: []types.NodeState{types.NodeStateIdle}},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventChan, err := watch.NewNodePoller(lister.List).Watch(ctx, &types.WatchNodesOptions{
+		Features:     []string{"a100"},
+		SkipInitial:  true,
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	next := func() types.NodeEvent {
+		select {
+		case event := <-eventChan:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("Timeout waiting for node event")
+			return types.NodeEvent{}
+		}
+	}
+	assert.Equal(t, types.NodeEventSynced, next().EventType)
+
+	// Only the node with the feature is reported
+	lister.setNodes([]types.Node{
+		{Name: ptrString("gpu-001"), State: []types.NodeState{types.NodeStateDown}, Features: []string{"a100"}},
+		{Name: ptrString("cpu-001"), State: []types.NodeState{types.NodeStateDown}},
+	})
+	event := next()
+	assert.Equal(t, types.NodeEventModified, event.EventType)
+	assert.Equal(t, "gpu-001", event.NodeName)
 	select {
 	case event := <-eventChan:
-		assert.Equal(t, "node_state_change", event.EventType)
-		assert.Equal(t, "node-001", event.NodeName)
-		assert.Equal(t, types.NodeStateIdle, event.PreviousState)
-		assert.Equal(t, types.NodeStateDrain, event.NewState)
-	case <-timeout:
-		t.Fatal("Timeout waiting for node event")
+		t.Fatalf("unexpected event %+v", event)
+	case <-time.After(50 * time.Millisecond):
 	}
 }
 
@@ -435,8 +497,8 @@ func TestJobPoller_WatchWithJobCompleted(t *testing.T) {
 	// Create a mock lister
 	lister := &mockJobLister{
 		jobs: []types.Job{
-			{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
-			{JobID: ptrInt32(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
 		},
 	}
 
@@ -457,14 +519,14 @@ func TestJobPoller_WatchWithJobCompleted(t *testing.T) {
 
 	// Update mock to simulate job completion (remove job 1)
 	lister.setJobs([]types.Job{
-		{JobID: ptrInt32(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
+		{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStatePending}, UserName: ptrString("user1000")},
 	})
 
 	// Wait for completion event
 	var completedEvent types.JobEvent
 	select {
 	case event := <-eventChan:
-		if event.EventType == "job_completed" {
+		if event.EventType == types.JobEventDeleted {
 			completedEvent = event
 		}
 	case <-time.After(200 * time.Millisecond):
@@ -472,14 +534,55 @@ func TestJobPoller_WatchWithJobCompleted(t *testing.T) {
 	}
 
 	// Verify completion event
-	assert.Equal(t, "job_completed", completedEvent.EventType)
-	assert.Equal(t, int32(1), completedEvent.JobId)
+	assert.Equal(t, types.JobEventDeleted, completedEvent.EventType)
+	assert.Equal(t, int64(1), completedEvent.JobId)
 	assert.Equal(t, types.JobStateRunning, completedEvent.PreviousState)
-	assert.Equal(t, types.JobStateCompleted, completedEvent.NewState)
+	assert.Equal(t, types.JobStateRunning, completedEvent.NewState)
+	require.NotNil(t, completedEvent.Job, "a deleted event carries the job as last listed")
 
 	cancel()
 }
 
+func TestJobPoller_WatchReasonChange(t *testing.T) {
+	lister := &mockJobLister{
+		jobs: []types.Job{
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStatePending}, StateReason: ptrString("Priority")},
+		},
+	}
+
+	// The interval in the options overrides the poller's
+	poller := watch.NewJobPoller(lister.List).WithPollInterval(time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventChan, err := poller.Watch(ctx, &types.WatchJobsOptions{
+		UserID:       "alice",
+		Partition:    "gpu",
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+	lister.setJobs([]types.Job{
+		{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStatePending}, StateReason: ptrString("Resources")},
+	})
+
+	select {
+	case event := <-eventChan:
+		assert.Equal(t, types.JobEventModified, event.EventType)
+		assert.Equal(t, types.JobStatePending, event.PreviousState)
+		assert.Equal(t, "Resources", event.Reason)
+	case <-time.After(time.Second):
+		t.Fatal("Expected a modified event for the reason change")
+	}
+
+	lister.mu.RLock()
+	assert.Equal(t, "alice", lister.lastOpts.UserID)
+	assert.Equal(t, "gpu", lister.lastOpts.Partition)
+	lister.mu.RUnlock()
+}
+
 func TestJobPoller_WatchWithExcludeNew(t *testing.T) {
 	// Start with empty job list
 	lister := &mockJobLister{
@@ -503,14 +606,14 @@ func TestJobPoller_WatchWithExcludeNew(t *testing.T) {
 
 	// Add a new job
 	lister.setJobs([]types.Job{
-		{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
+		{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
 	})
 
 	// Wait a bit more - should NOT get new job event
 	select {
 	case event := <-eventChan:
-		if event.EventType == "job_new" {
-			t.Fatal("Should not receive job_new event when ExcludeNew is true")
+		if event.EventType == types.JobEventAdded {
+			t.Fatal("Should not receive added event when ExcludeNew is true")
 		}
 	case <-time.After(150 * time.Millisecond):
 		// This is expected - no new job event should be sent
@@ -523,7 +626,7 @@ func TestJobPoller_WatchWithExcludeCompleted(t *testing.T) {
 	// Start with a job
 	lister := &mockJobLister{
 		jobs: []types.Job{
-			{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
 		},
 	}
 
@@ -548,8 +651,8 @@ func TestJobPoller_WatchWithExcludeCompleted(t *testing.T) {
 	// Wait a bit more - should NOT get completion event
 	select {
 	case event := <-eventChan:
-		if event.EventType == "job_completed" {
-			t.Fatal("Should not receive job_completed event when ExcludeCompleted is true")
+		if event.EventType == types.JobEventDeleted {
+			t.Fatal("Should not receive deleted event when ExcludeCompleted is true")
 		}
 	case <-time.After(150 * time.Millisecond):
 		// This is expected - no completion event should be sent
@@ -558,6 +661,44 @@ func TestJobPoller_WatchWithExcludeCompleted(t *testing.T) {
 	cancel()
 }
 
+func TestJobPoller_WatchWithExcludeCompleted_TerminalState(t *testing.T) {
+	lister := &mockJobLister{
+		jobs: []types.Job{
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}},
+			{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStatePending}},
+		},
+	}
+	poller := watch.NewJobPoller(lister.List).WithPollInterval(50 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	eventChan, err := poller.Watch(ctx, &types.WatchJobsOptions{
+		ExcludeNew:       true,
+		ExcludeCompleted: true,
+	})
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+
+	// Job 1 finishes while job 2 starts; only the start is reported
+	lister.setJobs([]types.Job{
+		{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateCompleted}},
+		{JobID: ptrInt64(2), JobState: []types.JobState{types.JobStateRunning}},
+	})
+
+	select {
+	case event := <-eventChan:
+		assert.Equal(t, types.JobEventModified, event.EventType)
+		assert.Equal(t, int64(2), event.JobId)
+	case <-time.After(time.Second):
+		t.Fatal("expected the modified event of job 2")
+	}
+	select {
+	case event := <-eventChan:
+		t.Fatalf("unexpected event %s for job %d", event.EventType, event.JobId)
+	case <-time.After(150 * time.Millisecond):
+	}
+}
+
 func TestNodePoller_WithMethods(t *testing.T) {
 	mockNodeLister := func(ctx context.Context, opts *types.ListNodesOptions) (*types.NodeList, error) {
 		return &types.NodeList{Nodes: []types.Node{}}, nil
@@ -669,7 +810,7 @@ func TestPartitionPoller_WatchWithFilteredPartitions(t *testing.T) {
 func TestJobPoller_WatchWithNilOptions(t *testing.T) {
 	lister := &mockJobLister{
 		jobs: []types.Job{
-			{JobID: ptrInt32(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
+			{JobID: ptrInt64(1), JobState: []types.JobState{types.JobStateRunning}, UserName: ptrString("user1000")},
 		},
 	}
 
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProbeServer returns a v0.0.44 server that answers pings and job
+// listings after delay, failing listings when failJobs is set
+func newProbeServer(t *testing.T, delay time.Duration, failJobs bool) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/slurm/v0.0.44/ping/":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"pings": []map[string]interface{}{{"hostname": "ctl", "pinged": "UP"}},
+			})
+		case "/slurm/v0.0.44/jobs/":
+			if failJobs {
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte("slurmctld busy"))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jobs": []interface{}{}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCheckSLO(t *testing.T) {
+	ctx := context.Background()
+
+	fast := newProbeServer(t, 0, false)
+	client, err := slurm.NewClientWithVersion(ctx, "v0.0.44", slurm.WithBaseURL(fast.URL))
+	require.NoError(t, err)
+	defer client.Close()
+
+	result, err := client.CheckSLO(ctx, slurm.SLOConfig{MaxP95: 5 * time.Second, Probes: 3})
+	require.NoError(t, err)
+	assert.True(t, result.Met, result.Violations)
+	assert.Equal(t, 6, result.Requests)
+	assert.Zero(t, result.Errors)
+	assert.LessOrEqual(t, result.P50, result.P95)
+	assert.LessOrEqual(t, result.P95, result.Max)
+
+	_, err = client.CheckSLO(ctx, slurm.SLOConfig{})
+	require.Error(t, err)
+}
+
+func TestCheckSLO_SlowClusterBreaches(t *testing.T) {
+	ctx := context.Background()
+
+	slow := newProbeServer(t, 30*time.Millisecond, false)
+	client, err := slurm.NewClientWithVersion(ctx, "v0.0.44", slurm.WithBaseURL(slow.URL))
+	require.NoError(t, err)
+	defer client.Close()
+
+	result, err := client.CheckSLO(ctx, slurm.SLOConfig{MaxP95: 10 * time.Millisecond, Probes: 2})
+	require.NoError(t, err)
+	assert.False(t, result.Met)
+	assert.GreaterOrEqual(t, result.P95, 30*time.Millisecond)
+	require.Len(t, result.Violations, 1)
+	assert.Contains(t, result.Violations[0], "p95 latency")
+}
+
+func TestCheckSLO_ErrorRateBreaches(t *testing.T) {
+	ctx := context.Background()
+
+	failing := newProbeServer(t, 0, true)
+	client, err := slurm.NewClientWithVersion(ctx, "v0.0.44", slurm.WithBaseURL(failing.URL))
+	require.NoError(t, err)
+	defer client.Close()
+
+	result, err := client.CheckSLO(ctx, slurm.SLOConfig{MaxP95: 5 * time.Second, MaxErrorRate: 0.1, Probes: 2})
+	require.NoError(t, err)
+	assert.False(t, result.Met)
+	assert.Equal(t, 2, result.Errors)
+	assert.InDelta(t, 0.5, result.ErrorRate, 0.001)
+	require.Len(t, result.Violations, 1)
+	assert.Contains(t, result.Violations[0], "error rate")
+}
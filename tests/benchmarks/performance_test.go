@@ -59,7 +59,7 @@ func benchmarkJobOperationsWithProfile(b *testing.B, profile performance.Perform
 	// Pre-populate some jobs for listing/getting
 	for i := range 100 {
 		job := &mocks.MockJob{
-			JobID:     int32(2000 + i), // Start from 2000 to avoid conflicts with default jobs
+			JobID:     int64(2000 + i), // Start from 2000 to avoid conflicts with default jobs
 			Name:      fmt.Sprintf("benchmark-job-%d", i),
 			UserID:    1001, // benchuser UID
 			State:     "RUNNING",
@@ -88,7 +88,7 @@ func TestAdapterPatternWithMockServer(t *testing.T) {
 			Name:              "test-qos",
 			Description:       "Test QoS created via adapter",
 			Priority:          500,
-			Flags:             []string{"DenyOnLimit"},
+			Flags:             []types.QoSFlag{types.QoSFlagsDenyLimit},
 			UsageFactor:       2.0,
 			GraceTime:         600,
 			MaxJobsPerUser:    20,
@@ -146,7 +146,7 @@ func TestAdapterWithRealServer(t *testing.T) {
 				Name:        testQoSName,
 				Description: "Test QoS created by Go adapter pattern test",
 				Priority:    500,
-				Flags:       []string{"DenyOnLimit"},
+				Flags:       []types.QoSFlag{types.QoSFlagsDenyLimit},
 				UsageFactor: 2.0,
 				GraceTime:   600,
 				Limits: &types.QoSLimits{
@@ -68,7 +68,7 @@ type MockStorage struct {
 // interface{} which can be real OpenAPI types. Consider using generated builders
 // from tests/mocks/generated/v0_0_40 instead.
 type MockJob struct {
-	JobID       int32             `json:"job_id"`
+	JobID       int64             `json:"job_id"`
 	Name        string            `json:"name"`
 	UserID      int32             `json:"user_id"`
 	State       string            `json:"state"`
@@ -696,11 +696,11 @@ func (m *MockSlurmServer) writeErrorResponse(w http.ResponseWriter, statusCode i
 	})
 }
 
-func (m *MockSlurmServer) generateJobID() int32 {
+func (m *MockSlurmServer) generateJobID() int64 {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.config.JobIDCounter++
-	return int32(m.config.JobIDCounter)
+	return int64(m.config.JobIDCounter)
 }
 
 func parseQueryParam(r *http.Request, param string) string {
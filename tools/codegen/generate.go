@@ -6,13 +6,22 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 )
 
+// wideIntegerFields are properties the Slurm specs declare as int32 although
+// their values can exceed that range: job IDs are unsigned 32-bit in Slurm.
+// They are generated as int64 so large IDs decode without overflow.
+var wideIntegerFields = map[string]bool{
+	"job_id": true,
+}
+
 // Code generation tool for Slurm REST API clients using oapi-codegen
 func main() {
 	if len(os.Args) < 2 {
@@ -49,12 +58,18 @@ func generateClient(version, specFile string) error {
 
 	outputFile := filepath.Join(outputDir, "client.go")
 
+	widenedSpec, err := widenIntegerFields(specFile)
+	if err != nil {
+		return fmt.Errorf("failed to prepare spec: %w", err)
+	}
+	defer os.Remove(widenedSpec)
+
 	// Generate client using oapi-codegen
 	cmd := exec.Command("oapi-codegen",
 		"-package", normalizeVersion(version),
 		"-generate", "client,models,spec",
 		"-o", outputFile,
-		specFile,
+		widenedSpec,
 	)
 
 	cmd.Stdout = os.Stdout
@@ -64,9 +79,79 @@ func generateClient(version, specFile string) error {
 		return fmt.Errorf("oapi-codegen failed: %w", err)
 	}
 
-	return nil
+	return routeThroughCodec(outputFile)
 }
 
+var (
+	// requestCall matches a client method building its request from a
+	// typed JSON body
+	requestCall = regexp.MustCompile(`req, err := New(\w+)Request\(c\.Server, ((?:\w+, )*)body\)`)
+	// responseUnmarshal matches the decoding of a response body in the
+	// Parse functions
+	responseUnmarshal = regexp.MustCompile(`json\.Unmarshal\(bodyBytes, &dest\)`)
+)
+
+// routeThroughCodec rewrites the generated client so that request bodies are
+// encoded and response bodies decoded with the client's codec (see codec.go
+// next to client.go) instead of encoding/json
+func routeThroughCodec(clientFile string) error {
+	data, err := os.ReadFile(clientFile)
+	if err != nil {
+		return err
+	}
+	data = requestCall.ReplaceAll(data, []byte("req, err := newRequestWithCodec(c.Client, body, func(bodyReader io.Reader) (*http.Request, error) {\n"+
+		"\t\treturn New${1}RequestWithBody(c.Server, ${2}\"application/json\", bodyReader)\n\t})"))
+	data = responseUnmarshal.ReplaceAll(data, []byte("unmarshalResponse(rsp, bodyBytes, &dest)"))
+	return os.WriteFile(clientFile, data, 0644)
+}
+
+// widenIntegerFields writes a copy of the spec in which every int32 property
+// listed in wideIntegerFields is declared int64, returning its path
+func widenIntegerFields(specFile string) (string, error) {
+	data, err := os.ReadFile(specFile)
+	if err != nil {
+		return "", err
+	}
+	var spec interface{}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return "", err
+	}
+	widen(spec)
+
+	out, err := os.CreateTemp("", "slurm-spec-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer out.Close()
+	if err := json.NewEncoder(out).Encode(spec); err != nil {
+		os.Remove(out.Name())
+		return "", err
+	}
+	return out.Name(), nil
+}
+
+// widen walks a decoded spec and rewrites the format of wide integer
+// properties wherever they are declared
+func widen(node interface{}) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if props, ok := v["properties"].(map[string]interface{}); ok {
+			for name, prop := range props {
+				schema, ok := prop.(map[string]interface{})
+				if ok && wideIntegerFields[name] && schema["type"] == "integer" && schema["format"] == "int32" {
+					schema["format"] = "int64"
+				}
+			}
+		}
+		for _, child := range v {
+			widen(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			widen(child)
+		}
+	}
+}
 
 func normalizeVersion(version string) string {
 	// Convert v0.0.42 to v0_0_42 for package names
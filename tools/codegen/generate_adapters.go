@@ -447,7 +447,7 @@ func (a *JobAdapter) holdJobImpl(ctx context.Context, req *types.JobHoldRequest)
 	}
 
 	// Call the API to update the job
-	resp, err := a.client.Slurm%sPostJobWithResponse(ctx, strconv.Itoa(int(req.JobId)), updateReq)
+	resp, err := a.client.Slurm%sPostJobWithResponse(ctx, strconv.FormatInt(req.JobId, 10), updateReq)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -493,9 +493,13 @@ func (a *JobAdapter) signalJobImpl(ctx context.Context, req *types.JobSignalRequ
 	params := &api.Slurm%sDeleteJobParams{
 		Signal: &req.Signal,
 	}
+	if req.BatchOnly {
+		flags := api.Slurm%sDeleteJobParamsFlags("BATCH_JOB")
+		params.Flags = &flags
+	}
 
 	// Call the API to signal the job
-	resp, err := a.client.Slurm%sDeleteJobWithResponse(ctx, strconv.Itoa(int(req.JobId)), params)
+	resp, err := a.client.Slurm%sDeleteJobWithResponse(ctx, strconv.FormatInt(req.JobId, 10), params)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -541,7 +545,7 @@ func (a *JobAdapter) notifyJobImpl(ctx context.Context, req *types.JobNotifyRequ
 }
 
 // requeueJobImpl implements the Requeue method
-func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int32) error {
+func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int64) error {
 	// Validate context
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -564,7 +568,7 @@ func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int32) error {
 	}
 
 	// Call the API to requeue the job
-	resp, err := a.client.Slurm%sDeleteJobWithResponse(ctx, strconv.Itoa(int(jobID)), params)
+	resp, err := a.client.Slurm%sDeleteJobWithResponse(ctx, strconv.FormatInt(jobID, 10), params)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -584,9 +588,6 @@ func (a *JobAdapter) requeueJobImpl(ctx context.Context, jobID int32) error {
 	return nil
 }
 
-// NOTE: watchJobsImpl is intentionally NOT generated here.
-// The real implementation is in job_watch_extra.go which uses polling.
-
 // allocateJobImpl implements the Allocate method
 func (a *JobAdapter) allocateJobImpl(ctx context.Context, req *types.JobAllocateRequest) (*types.JobAllocateResponse, error) {
 	// Validate context
@@ -784,7 +785,7 @@ func ptrInt32(i int32) *int32 {
 	return &i
 }
 
-`, apiPrefix, apiPrefix, apiPrefix, apiPrefix, version, apiPrefix, apiPrefix, apiPrefix, version, version, requeueFlagConst, apiPrefix, apiPrefix, apiPrefix, version, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix))
+`, apiPrefix, apiPrefix, apiPrefix, apiPrefix, version, apiPrefix, apiPrefix, apiPrefix, apiPrefix, version, version, requeueFlagConst, apiPrefix, apiPrefix, apiPrefix, version, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix, apiPrefix))
 	}
 
 	// Generate node helpers
@@ -902,9 +903,6 @@ func (a *NodeAdapter) resumeNodeImpl(ctx context.Context, nodeName string) error
 	return nil
 }
 
-// NOTE: watchNodesImpl is intentionally NOT generated here.
-// The real implementation is in node_watch_extra.go which uses polling.
-
 // convertCommonNodeUpdateToAPIRequestBody converts NodeUpdate to the API request body type
 func (a *NodeAdapter) convertCommonNodeUpdateToAPIRequestBody(update *types.NodeUpdate) api.Slurm%sPostNodeJSONRequestBody {
 	if update == nil {
@@ -1041,8 +1039,8 @@ func generateEntityAdapter(version, entityName string, entityDef EntityDef,
 
 	var buf bytes.Buffer
 
-	// Determine if we need strconv import (for int32 identifiers or Association update)
-	needsStrconv := entityDef.IdentifierType == "int32" || entityName == "Association"
+	// Determine if we need strconv import (for int64 identifiers or Association update)
+	needsStrconv := entityDef.IdentifierType == "int64" || entityName == "Association"
 
 	// Write header with conditional imports
 	buf.WriteString(fmt.Sprintf(`// Code generated by generate_adapters.go. DO NOT EDIT.
@@ -1206,7 +1204,11 @@ func (a *{{.Entity}}Adapter) List(ctx context.Context, opts *types.{{.ListOption
 
 	// Prepare parameters
 	params := &api.{{.ListParams}}{}
-
+{{if .ListFlags}}	if opts != nil && len(opts.Flags) > 0 {
+		flags := api.{{.ListParams}}Flags(types.JoinQueryFlags(opts.Flags))
+		params.Flags = &flags
+	}
+{{end}}
 	// Call the API
 	resp, err := a.client.{{.APIMethod}}(ctx, params)
 	if err != nil {
@@ -1302,6 +1304,12 @@ func generateListMethod(entityName string, entityDef EntityDef,
 		"APIPrefix":    versionDef.APIPrefix,
 		"Version":      strings.Replace(versionDef.APIPackage, "_", ".", -1),
 		"ResponseList": apiMethods.ResponseList,
+		"ListFlags":    "",
+	}
+	// Only the slurmctld listings take the "flags" query parameter
+	switch entityName {
+	case "Job", "Node", "Partition":
+		data["ListFlags"] = "true"
 	}
 
 	var buf bytes.Buffer
@@ -1318,7 +1326,7 @@ func (a *{{.Entity}}Adapter) Get(ctx context.Context, {{.Identifier}} {{.Identif
 	if err := a.ValidateContext(ctx); err != nil {
 		return nil, err
 	}
-{{if eq .IdentifierType "int32"}}	if err := a.ValidateResourceID({{.Identifier}}, "{{.Identifier}}"); err != nil {
+{{if eq .IdentifierType "int64"}}	if err := a.ValidateResourceID({{.Identifier}}, "{{.Identifier}}"); err != nil {
 		return nil, err
 	}
 {{else}}	if err := a.ValidateResourceName({{.Identifier}}, "{{.Identifier}}"); err != nil {
@@ -1332,11 +1340,11 @@ func (a *{{.Entity}}Adapter) Get(ctx context.Context, {{.Identifier}} {{.Identif
 	params := &api.{{.GetParams}}{}
 
 	// Call the API
-{{if eq .IdentifierType "int32"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.Itoa(int({{.Identifier}})), params)
+{{if eq .IdentifierType "int64"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.FormatInt({{.Identifier}}, 10), params)
 {{else}}	resp, err := a.client.{{.APIMethod}}(ctx, {{.Identifier}}, params)
 {{end}}{{else}}
 	// Call the API (no params)
-{{if eq .IdentifierType "int32"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.Itoa(int({{.Identifier}})))
+{{if eq .IdentifierType "int64"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.FormatInt({{.Identifier}}, 10))
 {{else}}	resp, err := a.client.{{.APIMethod}}(ctx, {{.Identifier}})
 {{end}}{{end}}	if err != nil {
 		return nil, a.HandleAPIError(err)
@@ -1414,7 +1422,7 @@ func (a *{{.Entity}}Adapter) Delete(ctx context.Context, {{.Identifier}} {{.Iden
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
 	}
-{{if eq .IdentifierType "int32"}}	if err := a.ValidateResourceID({{.Identifier}}, "{{.Identifier}}"); err != nil {
+{{if eq .IdentifierType "int64"}}	if err := a.ValidateResourceID({{.Identifier}}, "{{.Identifier}}"); err != nil {
 		return err
 	}
 {{else}}	if err := a.ValidateResourceName({{.Identifier}}, "{{.Identifier}}"); err != nil {
@@ -1425,7 +1433,7 @@ func (a *{{.Entity}}Adapter) Delete(ctx context.Context, {{.Identifier}} {{.Iden
 	}
 
 	// Call the API
-{{if eq .IdentifierType "int32"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.Itoa(int({{.Identifier}})))
+{{if eq .IdentifierType "int64"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.FormatInt({{.Identifier}}, 10))
 {{else}}	resp, err := a.client.{{.APIMethod}}(ctx, {{.Identifier}})
 {{end}}	if err != nil {
 		return a.HandleAPIError(err)
@@ -1456,7 +1464,7 @@ func (a *{{.Entity}}Adapter) Delete(ctx context.Context, {{.Identifier}} {{.Iden
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
 	}
-{{if eq .IdentifierType "int32"}}	if err := a.ValidateResourceID({{.Identifier}}, "{{.Identifier}}"); err != nil {
+{{if eq .IdentifierType "int64"}}	if err := a.ValidateResourceID({{.Identifier}}, "{{.Identifier}}"); err != nil {
 		return err
 	}
 {{else}}	if err := a.ValidateResourceName({{.Identifier}}, "{{.Identifier}}"); err != nil {
@@ -1467,7 +1475,7 @@ func (a *{{.Entity}}Adapter) Delete(ctx context.Context, {{.Identifier}} {{.Iden
 	}
 
 	// Call the API (params is nil for default behavior)
-{{if eq .IdentifierType "int32"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.Itoa(int({{.Identifier}})), nil)
+{{if eq .IdentifierType "int64"}}	resp, err := a.client.{{.APIMethod}}(ctx, strconv.FormatInt({{.Identifier}}, 10), nil)
 {{else}}	resp, err := a.client.{{.APIMethod}}(ctx, {{.Identifier}}, nil)
 {{end}}	if err != nil {
 		return a.HandleAPIError(err)
@@ -1757,7 +1765,7 @@ func generateJobUpdateMethod(entityDef EntityDef, versionDef VersionDef, apiMeth
 
 	return fmt.Sprintf(`
 // Update updates an existing job
-func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobUpdate) error {
+func (a *JobAdapter) Update(ctx context.Context, jobID int64, update *types.JobUpdate) error {
 	// Validate context
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -1776,7 +1784,7 @@ func (a *JobAdapter) Update(ctx context.Context, jobID int32, update *types.JobU
 	reqBody := a.convertCommonJobUpdateToAPIRequestBody(update)
 
 	// Call the API with job ID in path
-	resp, err := a.client.%s(ctx, strconv.Itoa(int(jobID)), reqBody)
+	resp, err := a.client.%s(ctx, strconv.FormatInt(jobID, 10), reqBody)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -2171,7 +2179,7 @@ func generateSubmitMethod(entityName string, entityDef EntityDef,
 
 var cancelMethodTemplate = template.Must(template.New("cancel").Parse(`
 // Cancel cancels a job
-func (a *JobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCancelRequest) error {
+func (a *JobAdapter) Cancel(ctx context.Context, jobID int64, opts *types.JobCancelRequest) error {
 	// Validate context
 	if err := a.ValidateContext(ctx); err != nil {
 		return err
@@ -2184,7 +2192,7 @@ func (a *JobAdapter) Cancel(ctx context.Context, jobID int32, opts *types.JobCan
 	}
 
 	// Call the delete/cancel API
-	resp, err := a.client.{{.APIMethod}}(ctx, strconv.Itoa(int(jobID)), nil)
+	resp, err := a.client.{{.APIMethod}}(ctx, strconv.FormatInt(jobID, 10), nil)
 	if err != nil {
 		return a.HandleAPIError(err)
 	}
@@ -2292,7 +2300,7 @@ func generateRequeueMethod(entityName string, entityDef EntityDef,
 
 	return `
 // Requeue requeues a job
-func (a *JobAdapter) Requeue(ctx context.Context, jobID int32) error {
+func (a *JobAdapter) Requeue(ctx context.Context, jobID int64) error {
 	return a.requeueJobImpl(ctx, jobID)
 }
 `, nil
@@ -3071,7 +3079,7 @@ func generateReadConverterTestCodeWithAssertions(entityName, apiType string) (te
 
 	case "Job":
 		testCode = fmt.Sprintf(`	// Create test API object with known values
-	testID := int32(12345)
+	testID := int64(12345)
 	testName := "test-job"
 	testUserName := "testuser"
 	apiObj := api.%s{
@@ -100,6 +100,12 @@ DEFAULT_DURATION_FIELDS = {
     'gettimeofday_latency', 'resume_timeout', 'suspend_timeout',
 }
 
+# Integer fields declared int32 in the spec whose values can exceed the int32
+# range (Slurm job IDs are unsigned 32-bit); these are generated as int64
+DEFAULT_WIDE_INTEGER_FIELDS = {
+    'job_id',
+}
+
 DEFAULT_FRIENDLY_OVERRIDES = {
     'assoc': 'Association',
     'cluster_rec': 'Cluster',
@@ -191,6 +197,7 @@ class Config:
     """Configuration for type generation, loaded from YAML or defaults."""
     timestamp_fields: Set[str] = field(default_factory=set)
     duration_fields: Set[str] = field(default_factory=set)
+    wide_integer_fields: Set[str] = field(default_factory=set)
     friendly_overrides: Dict[str, str] = field(default_factory=dict)
     primitive_unwrap_patterns: List[Tuple[str, str]] = field(default_factory=list)
     base_entities: Dict[str, str] = field(default_factory=dict)
@@ -220,6 +227,7 @@ class Config:
         config = cls()
         config.timestamp_fields = set(defaults.get('timestamp_fields', DEFAULT_TIMESTAMP_FIELDS))
         config.duration_fields = set(defaults.get('duration_fields', DEFAULT_DURATION_FIELDS))
+        config.wide_integer_fields = set(defaults.get('wide_integer_fields', DEFAULT_WIDE_INTEGER_FIELDS))
         config.friendly_overrides = {**DEFAULT_FRIENDLY_OVERRIDES, **defaults.get('friendly_overrides', {})}
 
         # Convert primitive_unwrap_patterns from dict to list of tuples
@@ -263,6 +271,7 @@ class Config:
 
         config.timestamp_fields = set(DEFAULT_TIMESTAMP_FIELDS)
         config.duration_fields = set(DEFAULT_DURATION_FIELDS)
+        config.wide_integer_fields = set(DEFAULT_WIDE_INTEGER_FIELDS)
         config.friendly_overrides = dict(DEFAULT_FRIENDLY_OVERRIDES)
         config.primitive_unwrap_patterns = list(DEFAULT_PRIMITIVE_UNWRAP_PATTERNS)
         config.base_entities = dict(DEFAULT_BASE_ENTITIES)
@@ -840,6 +849,9 @@ class TypeGenerator:
         elif fmt == 'date-time':
             go_type = 'time.Time'
 
+        if go_type == 'int32' and json_name in self.config.wide_integer_fields:
+            go_type = 'int64'
+
         return go_type
 
     def generate_type(
@@ -111,6 +111,12 @@ type TypeDetector interface {
 	DetectFieldType(ctx DetectionContext) (*Field, error)
 }
 
+// wideIntegerFields mirrors the fields generate.go declares as int64 in the
+// client models although the specs say int32
+var wideIntegerFields = map[string]bool{
+	"job_id": true,
+}
+
 // Common errors for type detection
 var (
 	ErrInlineObjectSkipped = fmt.Errorf("skipping inline object type")
@@ -334,6 +340,10 @@ func (d *BaseTypeDetector) detectInlineArrayType(ctx DetectionContext, field *Fi
 func (d *BaseTypeDetector) detectPrimitiveType(ctx DetectionContext, field *Field) (*Field, error) {
 	field.IsSimple = true
 	field.GoType = mapPrimitiveType(ctx.Property.Type, ctx.Property.Format)
+	if field.GoType == "int32" && wideIntegerFields[ctx.JSONName] {
+		// generate.go widens these in the client models
+		field.GoType = "int64"
+	}
 	return field, nil
 }
 
@@ -323,6 +323,12 @@ class TestTypeGenerator(unittest.TestCase):
         self.assertEqual(self.generator.resolve_type({'type': 'boolean'}, 'flag'), 'bool')
         self.assertEqual(self.generator.resolve_type({'type': 'number'}, 'amount'), 'float64')
 
+    def test_resolve_type_wide_integer_fields(self):
+        """Job IDs declared int32 are widened to int64."""
+        prop = {'type': 'integer', 'format': 'int32'}
+        self.assertEqual(self.generator.resolve_type(prop, 'job_id'), 'int64')
+        self.assertEqual(self.generator.resolve_type(prop, 'priority'), 'int32')
+
     def test_resolve_type_arrays(self):
         """Resolve array types correctly."""
         self.assertEqual(
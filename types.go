@@ -21,6 +21,7 @@ type AccountCreate = api.AccountCreate
 type AccountCreateRequest = api.AccountCreateRequest
 type AccountCreateResponse = api.AccountCreateResponse
 type AccountFairShare = api.AccountFairShare
+type AccountFlag = api.AccountFlag
 type AccountFlagsValue = api.AccountFlagsValue
 type AccountHierarchy = api.AccountHierarchy
 type AccountingAllocated = api.AccountingAllocated
@@ -31,6 +32,7 @@ type AccountLimits = api.AccountLimits
 type AccountList = api.AccountList
 type AccountListOptions = api.AccountListOptions
 type AccountQuota = api.AccountQuota
+type AccountTree = api.AccountTree
 type AccountUpdate = api.AccountUpdate
 type AccountUpdateRequest = api.AccountUpdateRequest
 type AccountUsage = api.AccountUsage
@@ -39,12 +41,17 @@ type AccountUserOptions = api.AccountUserOptions
 type AdministratorLevelValue = api.AdministratorLevelValue
 type AdminLevel = api.AdminLevel
 type APIVersion = api.APIVersion
+type ArrayResult = api.ArrayResult
+type ArrayRunReport = api.ArrayRunReport
+type ArrayTaskReport = api.ArrayTaskReport
+type ArrayTaskResult = api.ArrayTaskResult
 type Association = api.Association
 type AssociationCreate = api.AssociationCreate
 type AssociationCreateRequest = api.AssociationCreateRequest
 type AssociationCreateResponse = api.AssociationCreateResponse
 type AssociationDefault = api.AssociationDefault
 type AssociationDefaultFlagsValue = api.AssociationDefaultFlagsValue
+type AssociationFlag = api.AssociationFlag
 type AssociationHierarchy = api.AssociationHierarchy
 type AssociationLimits = api.AssociationLimits
 type AssociationList = api.AssociationList
@@ -74,10 +81,12 @@ type BatchRecommendation = api.BatchRecommendation
 type BatchStatistics = api.BatchStatistics
 type BulkDeleteOptions = api.BulkDeleteOptions
 type BulkDeleteResponse = api.BulkDeleteResponse
+type CancelAndWaitOptions = api.CancelAndWaitOptions
 type CertFlagsValue = api.CertFlagsValue
 type ChartData = api.ChartData
 type ClientCapabilities = api.ClientCapabilities
 type ClientConfig = api.ClientConfig
+type ClientEvent = api.ClientEvent
 type Cluster = api.Cluster
 type ClusterAssociations = api.ClusterAssociations
 type ClusterController = api.ClusterController
@@ -92,6 +101,12 @@ type ClusterOverview = api.ClusterOverview
 type ClusterStats = api.ClusterStats
 type ClusterUpdate = api.ClusterUpdate
 type Config = api.Config
+type Constraint = api.Constraint
+type ConstraintAnd = api.ConstraintAnd
+type ConstraintBracket = api.ConstraintBracket
+type ConstraintExpr = api.ConstraintExpr
+type ConstraintFeature = api.ConstraintFeature
+type ConstraintOr = api.ConstraintOr
 type Coord = api.Coord
 type CPUAnalytics = api.CPUAnalytics
 type CPUBindingFlagsValue = api.CPUBindingFlagsValue
@@ -101,6 +116,9 @@ type CronEntry = api.CronEntry
 type CrossResourceAnalysis = api.CrossResourceAnalysis
 type DeleteAssociationOptions = api.DeleteAssociationOptions
 type Diagnostics = api.Diagnostics
+type DrainReservationJobsOptions = api.DrainReservationJobsOptions
+type EffectiveLimit = api.EffectiveLimit
+type EffectiveLimitSet = api.EffectiveLimitSet
 type EfficiencyDataPoint = api.EfficiencyDataPoint
 type EfficiencyPoint = api.EfficiencyPoint
 type EfficiencyReport = api.EfficiencyReport
@@ -110,6 +128,7 @@ type EnergyUsage = api.EnergyUsage
 type ExecutiveSummary = api.ExecutiveSummary
 type ExitCode = api.ExitCode
 type ExitCodeSignal = api.ExitCodeSignal
+type ExportICalOptions = api.ExportICalOptions
 type ExtendedDiagnostics = api.ExtendedDiagnostics
 type FairShareHierarchy = api.FairShareHierarchy
 type FairShareNode = api.FairShareNode
@@ -119,8 +138,12 @@ type GetInstanceOptions = api.GetInstanceOptions
 type GetInstancesOptions = api.GetInstancesOptions
 type GetSharesOptions = api.GetSharesOptions
 type GPUDeviceUtilization = api.GPUDeviceUtilization
+type GRESEntry = api.GRESEntry
+type GRESUsage = api.GRESUsage
 type GPUProcess = api.GPUProcess
 type GPUUtilization = api.GPUUtilization
+type HetJobComponent = api.HetJobComponent
+type HTTPDoer = api.HTTPDoer
 type Instance = api.Instance
 type InstanceList = api.InstanceList
 type IOAnalytics = api.IOAnalytics
@@ -134,6 +157,7 @@ type JobAnalysisSummary = api.JobAnalysisSummary
 type Job = api.Job
 type JobCancelFlags = api.JobCancelFlags
 type JobCancelRequest = api.JobCancelRequest
+type JobComponent = api.JobComponent
 type JobComprehensiveAnalytics = api.JobComprehensiveAnalytics
 type JobCountPoint = api.JobCountPoint
 type JobCreate = api.JobCreate
@@ -141,6 +165,7 @@ type JobCreateRlimits = api.JobCreateRlimits
 type JobDependency = api.JobDependency
 type JobDurationTrend = api.JobDurationTrend
 type JobEvent = api.JobEvent
+type JobHistoryOptions = api.JobHistoryOptions
 type JobHoldRequest = api.JobHoldRequest
 type JobList = api.JobList
 type JobListOptions = api.JobListOptions
@@ -151,6 +176,7 @@ type JobPartitionPriority = api.JobPartitionPriority
 type JobPerformance = api.JobPerformance
 type JobPerformanceHistory = api.JobPerformanceHistory
 type JobPower = api.JobPower
+type JobPriorityComponents = api.JobPriorityComponents
 type JobPriorityFactors = api.JobPriorityFactors
 type JobPriorityInfo = api.JobPriorityInfo
 type JobResCore = api.JobResCore
@@ -184,12 +210,13 @@ type JobUpdate = api.JobUpdate
 type JobUpdateRequest = api.JobUpdateRequest
 type JobUtilization = api.JobUtilization
 type JobWatchEvent = api.JobWatchEvent
-type JobWatchOptions = api.JobWatchOptions
 type KillWarningFlagsValue = api.KillWarningFlagsValue
 type License = api.License
 type LicenseList = api.LicenseList
+type LicenseReservation = api.LicenseReservation
 type ListAccountsOptions = api.ListAccountsOptions
 type ListAccountUsersOptions = api.ListAccountUsersOptions
+type ListAllJobsOptions = api.ListAllJobsOptions
 type ListAssociationsOptions = api.ListAssociationsOptions
 type ListClustersOptions = api.ListClustersOptions
 type ListJobsOptions = api.ListJobsOptions
@@ -221,10 +248,10 @@ type NodeMaintenanceRequest = api.NodeMaintenanceRequest
 type NodePowerRequest = api.NodePowerRequest
 type NodePowerState = api.NodePowerState
 type NodeState = api.NodeState
+type NodeTRESUsage = api.NodeTRESUsage
 type NodeUpdate = api.NodeUpdate
 type NodeUpdateRequest = api.NodeUpdateRequest
 type NodeWatchEvent = api.NodeWatchEvent
-type NodeWatchOptions = api.NodeWatchOptions
 type NUMANodeMetrics = api.NUMANodeMetrics
 type OpenModeValue = api.OpenModeValue
 type OptimalJobConfiguration = api.OptimalJobConfiguration
@@ -247,6 +274,7 @@ type PartitionMaximumsOversubscribe = api.PartitionMaximumsOversubscribe
 type PartitionMaximumsOversubscribeFlagsValue = api.PartitionMaximumsOversubscribeFlagsValue
 type PartitionMinimums = api.PartitionMinimums
 type PartitionNodes = api.PartitionNodes
+type PartitionOversubscribeFlag = api.PartitionOversubscribeFlag
 type PartitionPartition = api.PartitionPartition
 type PartitionPriority = api.PartitionPriority
 type PartitionQoS = api.PartitionQoS
@@ -266,6 +294,8 @@ type PerformanceStatistics = api.PerformanceStatistics
 type PerformanceTrendAnalysis = api.PerformanceTrendAnalysis
 type PerformanceTrends = api.PerformanceTrends
 type PingResponse = api.PingResponse
+type PingResult = api.PingResult
+type PowerStats = api.PowerStats
 type PriorityWeights = api.PriorityWeights
 type ProcessInfo = api.ProcessInfo
 type ProfileValue = api.ProfileValue
@@ -273,6 +303,8 @@ type QoS = api.QoS
 type QoSCreate = api.QoSCreate
 type QoSCreateRequest = api.QoSCreateRequest
 type QoSCreateResponse = api.QoSCreateResponse
+type QoSDelta = api.QoSDelta
+type QoSFlag = api.QoSFlag
 type QoSFlagsValue = api.QoSFlagsValue
 type QoSLimits = api.QoSLimits
 type QoSLimitsMaxAccruing = api.QoSLimitsMaxAccruing
@@ -297,11 +329,14 @@ type QoSListOptions = api.QoSListOptions
 type QoSPreempt = api.QoSPreempt
 type QoSUpdate = api.QoSUpdate
 type QoSUpdateRequest = api.QoSUpdateRequest
+type QueueDepthSample = api.QueueDepthSample
 type QueueLengthPoint = api.QueueLengthPoint
+type RebootOptions = api.RebootOptions
 type ReconfigureResponse = api.ReconfigureResponse
 type ReportOptions = api.ReportOptions
 type ReportRecommendation = api.ReportRecommendation
 type ReportTrendAnalysis = api.ReportTrendAnalysis
+type RequeueOptions = api.RequeueOptions
 type Reservation = api.Reservation
 type ReservationCoreSpec = api.ReservationCoreSpec
 type ReservationCreate = api.ReservationCreate
@@ -316,12 +351,14 @@ type ReservationState = api.ReservationState
 type ReservationUpdate = api.ReservationUpdate
 type ReservationUpdateRequest = api.ReservationUpdateRequest
 type ReservationUsage = api.ReservationUsage
+type ReserveLicensesOptions = api.ReserveLicensesOptions
 type ResourceAnalysis = api.ResourceAnalysis
 type ResourceAnomaly = api.ResourceAnomaly
 type ResourceChange = api.ResourceChange
 type ResourceLimits = api.ResourceLimits
 type ResourceRecommendation = api.ResourceRecommendation
 type ResourceRequests = api.ResourceRequests
+type ResourceSpec = api.ResourceSpec
 type ResourceTimeSeries = api.ResourceTimeSeries
 type ResourceTrend = api.ResourceTrend
 type ResourceTrendData = api.ResourceTrendData
@@ -329,6 +366,7 @@ type ResourceTrendsOptions = api.ResourceTrendsOptions
 type ResourceTypeAnalysis = api.ResourceTypeAnalysis
 type ResourceUtilization = api.ResourceUtilization
 type ResourceWaste = api.ResourceWaste
+type RunArrayOptions = api.RunArrayOptions
 type SacctJobStepData = api.SacctJobStepData
 type SacctQueryOptions = api.SacctQueryOptions
 type SacctStepRecord = api.SacctStepRecord
@@ -336,6 +374,9 @@ type SelectTypeValue = api.SelectTypeValue
 type Share = api.Share
 type SharedValue = api.SharedValue
 type SharesList = api.SharesList
+type SignalOptions = api.SignalOptions
+type SLOConfig = api.SLOConfig
+type SLOResult = api.SLOResult
 type StateValue = api.StateValue
 type StatusValue = api.StatusValue
 type StepAccountingRecord = api.StepAccountingRecord
@@ -344,6 +385,7 @@ type StepID = api.StepID
 type StepOptimizationSuggestions = api.StepOptimizationSuggestions
 type StepPerformanceMetrics = api.StepPerformanceMetrics
 type StepResourceTrends = api.StepResourceTrends
+type StepTRESUsage = api.StepTRESUsage
 type StepTaskInfo = api.StepTaskInfo
 type StorageDevice = api.StorageDevice
 type TaskUtilization = api.TaskUtilization
@@ -354,6 +396,7 @@ type TrendInsight = api.TrendInsight
 type TrendsSummary = api.TrendsSummary
 type TRES = api.TRES
 type TRESList = api.TRESList
+type TRESUsageReport = api.TRESUsageReport
 type UserAccessValidation = api.UserAccessValidation
 type UserAccount = api.UserAccount
 type UserAccountAssociation = api.UserAccountAssociation
@@ -369,8 +412,10 @@ type UserCreateRequest = api.UserCreateRequest
 type UserCreateResponse = api.UserCreateResponse
 type UserDefault = api.UserDefault
 type UserDefaultFlagsValue = api.UserDefaultFlagsValue
+type UserEffectiveLimits = api.UserEffectiveLimits
 type UserEfficiencyTrends = api.UserEfficiencyTrends
 type UserFairShare = api.UserFairShare
+type UserLimitPath = api.UserLimitPath
 type UserList = api.UserList
 type UserListOptions = api.UserListOptions
 type UserPermissions = api.UserPermissions
@@ -379,6 +424,11 @@ type UserUpdate = api.UserUpdate
 type UserUpdateRequest = api.UserUpdateRequest
 type UserUsage = api.UserUsage
 type UtilizationPoint = api.UtilizationPoint
+type ValidationIssue = api.ValidationIssue
+type ValidationResult = api.ValidationResult
+type VersionInfo = api.VersionInfo
+type WaitForArrayOptions = api.WaitForArrayOptions
+type WaitForNodeStateOptions = api.WaitForNodeStateOptions
 type WatchJobsOptions = api.WatchJobsOptions
 type WatchMetricsOptions = api.WatchMetricsOptions
 type WatchNodesOptions = api.WatchNodesOptions
@@ -397,3 +447,18 @@ type WorkflowOptimization = api.WorkflowOptimization
 type WorkflowPerformance = api.WorkflowPerformance
 type WorkflowStage = api.WorkflowStage
 type X11Value = api.X11Value
+
+// DefaultSLOProbes is the number of probe rounds CheckSLO runs by default
+const DefaultSLOProbes = api.DefaultSLOProbes
+
+// ExplainReason returns a human-readable explanation of a Slurm job state
+// reason code. See api.ExplainReason.
+func ExplainReason(code string) string {
+	return api.ExplainReason(code)
+}
+
+// ParseTRESSpec reads the CPU, memory, node and GRES totals from a TRES
+// string. See api.ParseTRESSpec.
+func ParseTRESSpec(tres string) ResourceSpec {
+	return api.ParseTRESSpec(tres)
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm
+
+import (
+	"context"
+
+	"github.com/jontk/slurm-client/pkg/watch"
+)
+
+// Watch polls lister and reports each object added, modified or deleted
+// between listings, matching objects up by the key keyer gives them. It
+// watches any resource that can be listed, such as accounts, reservations
+// or QoS:
+//
+//	events, err := slurm.Watch(ctx, func() ([]slurm.Reservation, error) {
+//	    list, err := client.Reservations().List(ctx, nil)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return list.Reservations, nil
+//	}, func(r slurm.Reservation) string { return *r.Name }, nil)
+//
+// The first listing sets the baseline unless opts.IncludeInitial is set.
+// Failed listings are skipped, and the channel is closed when ctx is done.
+// Jobs().Watch, Nodes().Watch and Partitions().Watch remain for the events
+// specific to those resources.
+func Watch[T any](ctx context.Context, lister func() ([]T, error), keyer func(T) string, opts *watch.Options[T]) (<-chan watch.Event[T], error) {
+	return watch.Watch(ctx, lister, keyer, opts)
+}
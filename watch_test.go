@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 Jon Thor Kristinsson
+// SPDX-License-Identifier: Apache-2.0
+
+package slurm_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jontk/slurm-client"
+	"github.com/jontk/slurm-client/pkg/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatch_Reservations(t *testing.T) {
+	// Each listing returns the next state of the reservations, and the last
+	// one from then on
+	listings := []string{
+		`[{"name": "maint", "node_list": "node[1-4]"}]`,
+		`[{"name": "maint", "node_list": "node[1-8]"}, {"name": "training", "node_list": "gpu01"}]`,
+		`[{"name": "training", "node_list": "gpu01"}]`,
+	}
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/slurm/v0.0.44/reservations/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		i := min(int(calls.Add(1))-1, len(listings)-1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"reservations": %s}`, listings[i])
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client, err := slurm.NewClientWithVersion(ctx, "v0.0.44", slurm.WithBaseURL(server.URL))
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	lister := func() ([]slurm.Reservation, error) {
+		list, err := client.Reservations().List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return list.Reservations, nil
+	}
+	keyer := func(r slurm.Reservation) string { return *r.Name }
+
+	events, err := slurm.Watch(ctx, lister, keyer, &watch.Options[slurm.Reservation]{PollInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	got := make(map[string]watch.Event[slurm.Reservation])
+	for len(got) < 3 {
+		select {
+		case event := <-events:
+			got[string(event.Type)+"/"+event.Key] = event
+		case <-ctx.Done():
+			t.Fatalf("missing events, got %v", got)
+		}
+	}
+
+	modified := got["modified/maint"]
+	assert.Equal(t, "node[1-4]", *modified.Previous.NodeList)
+	assert.Equal(t, "node[1-8]", *modified.Object.NodeList)
+	assert.Equal(t, "gpu01", *got["added/training"].Object.NodeList)
+	assert.Equal(t, "node[1-8]", *got["deleted/maint"].Object.NodeList)
+
+	cancel()
+	for range events {
+	}
+}